@@ -0,0 +1,163 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/nextmn/rfc9433/lpm"
+	"github.com/nextmn/rfc9433/trace"
+)
+
+// Metadata carries information about a packet to and between behaviors.
+type Metadata struct {
+	SID       netip.Prefix    // prefix of the Registry entry matching the IPv6 (or IPv4) DA of the packet
+	Fragments [][]byte        // fragments following the returned packet, set by MTUGuard
+	Interface string          // egress interface of the returned packet, empty to use the routing table
+	Recorder  *trace.Recorder // if not nil, Registry records the packet here instead of in its own Recorder
+}
+
+// Behavior processes a packet (starting with the IP header), and returns the packet to forward.
+type Behavior interface {
+	Process(pkt []byte, meta *Metadata) (out []byte, err error)
+}
+
+// BehaviorFunc is an adapter to allow the use of ordinary functions as Behavior.
+type BehaviorFunc func(pkt []byte, meta *Metadata) ([]byte, error)
+
+// Process calls f(pkt, meta).
+func (f BehaviorFunc) Process(pkt []byte, meta *Metadata) ([]byte, error) {
+	return f(pkt, meta)
+}
+
+// Registry dispatches packets to the Behavior of the longest prefix matching their DA:
+// IPv6 prefixes (SIDs) for SRv6 and GTP6 packets, and IPv4 prefixes for GTP4 packets (H.M.GTP4.D).
+// Registry is itself a Behavior, and is safe for concurrent use:
+// registrations do not block the processing of packets.
+type Registry struct {
+	entries  *lpm.Table[Behavior]
+	recorder *trace.Recorder
+}
+
+// RegistryOption configures a Registry.
+type RegistryOption func(*Registry)
+
+// WithRecorder records the processing of each packet in rec, under the text form of the matching prefix
+// (e.g. "2001:db8:1::/64"). Packets matching no prefix are not recorded.
+func WithRecorder(rec *trace.Recorder) RegistryOption {
+	return func(r *Registry) {
+		r.recorder = rec
+	}
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry(opts ...RegistryOption) *Registry {
+	r := &Registry{
+		entries: lpm.NewTable[Behavior](),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Recorder returns the trace.Recorder of the Registry, or nil if there is none.
+func (r *Registry) Recorder() *trace.Recorder {
+	return r.recorder
+}
+
+// Register adds a Behavior for the prefix, replacing any existing one.
+func (r *Registry) Register(prefix netip.Prefix, b Behavior) error {
+	if err := r.entries.Insert(prefix, b); err != nil {
+		return ErrInvalidPrefix
+	}
+	return nil
+}
+
+// Replace atomically replaces the Behavior of a registered prefix, and returns the previous one,
+// e.g. to upgrade the Behavior of a SID in place.
+// Packets being processed complete with the previous Behavior, and the following ones use the new one:
+// no packet is dropped during the swap. It returns ErrNoBehavior if the prefix is not registered.
+func (r *Registry) Replace(prefix netip.Prefix, b Behavior) (Behavior, error) {
+	old, ok := r.entries.Replace(prefix, b)
+	if !ok {
+		return nil, ErrNoBehavior
+	}
+	return old, nil
+}
+
+// Unregister removes the Behavior of the prefix.
+func (r *Registry) Unregister(prefix netip.Prefix) {
+	r.entries.Delete(prefix)
+}
+
+// Lookup returns the longest prefix matching addr, and its Behavior.
+func (r *Registry) Lookup(addr netip.Addr) (prefix netip.Prefix, b Behavior, ok bool) {
+	return r.entries.Lookup(addr)
+}
+
+// Len returns the number of registered prefixes.
+func (r *Registry) Len() int {
+	return r.entries.Len()
+}
+
+// Process processes the packet with the Behavior matching its DA, and sets meta.SID.
+// meta may be nil.
+func (r *Registry) Process(pkt []byte, meta *Metadata) ([]byte, error) {
+	src, dst, err := addresses(pkt)
+	if err != nil {
+		return nil, err
+	}
+	prefix, b, ok := r.Lookup(dst)
+	if !ok {
+		return nil, ErrNoBehavior
+	}
+	if meta == nil {
+		meta = &Metadata{}
+	}
+	meta.SID = prefix
+	out, err := b.Process(pkt, meta)
+	rec := r.recorder
+	if meta.Recorder != nil {
+		rec = meta.Recorder
+	}
+	if rec != nil {
+		record := trace.Record{
+			Time: time.Now(),
+			Src:  src,
+			Dst:  dst,
+			Len:  len(pkt),
+		}
+		if err != nil {
+			record.Decision = trace.DecisionDrop
+			record.Reason = err.Error()
+		}
+		rec.Record(prefix.String(), record)
+	}
+	return out, err
+}
+
+// addresses returns the SA and the DA of an IPv4 or IPv6 packet.
+func addresses(pkt []byte) (src netip.Addr, dst netip.Addr, err error) {
+	if len(pkt) == 0 {
+		return src, dst, ErrTooShortToParse
+	}
+	switch pkt[0] >> 4 {
+	case 4:
+		if len(pkt) < ipv4MinHeaderLen {
+			return src, dst, ErrTooShortToParse
+		}
+		return netip.AddrFrom4([4]byte(pkt[12:16])), netip.AddrFrom4([4]byte(pkt[16:20])), nil
+	case 6:
+		if len(pkt) < ipv6HeaderLen {
+			return src, dst, ErrTooShortToParse
+		}
+		return netip.AddrFrom16([16]byte(pkt[8:24])), netip.AddrFrom16([16]byte(pkt[24:40])), nil
+	default:
+		return src, dst, ErrMalformedPacket
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"errors"
+	"net/netip"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/nextmn/rfc9433/headend"
+	"github.com/nextmn/rfc9433/trace"
+)
+
+func TestRegistry(t *testing.T) {
+	sid, src := mgtp4eAddrs(t)
+	r := NewRegistry()
+	if err := r.Register(netip.MustParsePrefix("2001:db8::/32"), NewMGTP4E(32, nil, nil)); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Register(netip.MustParsePrefix("203.0.113.0/24"), NewHMGTP4D(SRv6Policy{DstPrefix: netip.MustParsePrefix("2001:db8::/32")},
+		headend.StaticPrefix(netip.MustParsePrefix("2001:db8:1::/48")), nil)); err != nil {
+		t.Fatal(err)
+	}
+	var matched netip.Prefix
+	if err := r.Register(netip.MustParsePrefix("2001:db8:0:1::/64"), BehaviorFunc(func(pkt []byte, meta *Metadata) ([]byte, error) {
+		matched = meta.SID
+		return pkt, nil
+	})); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Register(netip.MustParsePrefix("::ffff:10.0.0.0/104"), NewMGTP4E(32, nil, nil)); !errors.Is(err, ErrInvalidPrefix) {
+		t.Errorf("Expected ErrInvalidPrefix, got %v", err)
+	}
+	if r.Len() != 3 {
+		t.Errorf("Unexpected number of prefixes: %d", r.Len())
+	}
+
+	// End.M.GTP4.E, then H.M.GTP4.D
+	meta := &Metadata{}
+	gtp4, err := r.Process(buildIPv6(t, 0, src, sid, nil, nhIPv4, innerIPv4), meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.SID != netip.MustParsePrefix("2001:db8::/32") {
+		t.Errorf("Unexpected SID: %s", meta.SID)
+	}
+	srv6, err := r.Process(gtp4, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.SID != netip.MustParsePrefix("203.0.113.0/24") {
+		t.Errorf("Unexpected SID: %s", meta.SID)
+	}
+	if p, err := parseIPv6(srv6); err != nil || p.dst != sid {
+		t.Errorf("Unexpected SRv6 packet: %v", err)
+	}
+
+	// longest prefix
+	other := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0x00, 0x00, 0x00, 0x01, 15: 1}
+	if _, err := r.Process(buildIPv6(t, 0, src, other, nil, nhIPv4, innerIPv4), nil); err != nil {
+		t.Fatal(err)
+	}
+	if matched != netip.MustParsePrefix("2001:db8:0:1::/64") {
+		t.Errorf("Unexpected SID: %s", matched)
+	}
+
+	r.Unregister(netip.MustParsePrefix("2001:db8::/32"))
+	if _, err := r.Process(buildIPv6(t, 0, src, sid, nil, nhIPv4, innerIPv4), nil); !errors.Is(err, ErrNoBehavior) {
+		t.Errorf("Expected ErrNoBehavior, got %v", err)
+	}
+	if _, err := r.Process([]byte{0x50}, nil); !errors.Is(err, ErrMalformedPacket) {
+		t.Errorf("Expected ErrMalformedPacket, got %v", err)
+	}
+}
+
+func TestRegistryReplace(t *testing.T) {
+	src := [16]byte{0x20, 0x01, 0x0d, 0xb8, 15: 1}
+	dst := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0x00, 0x01, 15: 1}
+	prefix := netip.MustParsePrefix("2001:db8:1::/48")
+	var counts [2]atomic.Int64
+	handler := func(i int) Behavior {
+		return BehaviorFunc(func(pkt []byte, meta *Metadata) ([]byte, error) {
+			counts[i].Add(1)
+			return pkt, nil
+		})
+	}
+	r := NewRegistry()
+	if _, err := r.Replace(prefix, handler(0)); !errors.Is(err, ErrNoBehavior) {
+		t.Errorf("Expected ErrNoBehavior, got %v", err)
+	}
+	if err := r.Register(prefix, handler(0)); err != nil {
+		t.Fatal(err)
+	}
+
+	// packets processed while the handler is swapped must not be dropped
+	const workers, packets = 4, 1000
+	pkt := buildIPv6(t, 0, src, dst, nil, nhIPv4, innerIPv4)
+	var wg sync.WaitGroup
+	var drops atomic.Int64
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range packets {
+				if _, err := r.Process(pkt, &Metadata{}); err != nil {
+					drops.Add(1)
+				}
+			}
+		}()
+	}
+	for i := range 100 {
+		if _, err := r.Replace(prefix, handler((i+1)%2)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wg.Wait()
+	if drops.Load() != 0 {
+		t.Errorf("%d packets dropped during the swap", drops.Load())
+	}
+	if n := counts[0].Load() + counts[1].Load(); n != workers*packets {
+		t.Errorf("Unexpected number of processed packets: %d", n)
+	}
+	if r.Len() != 1 {
+		t.Errorf("Unexpected number of prefixes: %d", r.Len())
+	}
+}
+
+func TestRegistryRecorder(t *testing.T) {
+	src := [16]byte{0x20, 0x01, 0x0d, 0xb8, 15: 1}
+	dst := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0x00, 0x01, 15: 1}
+	errProcess := errors.New("process")
+	rec := trace.NewRecorder(4)
+	r := NewRegistry(WithRecorder(rec))
+	if r.Recorder() != rec {
+		t.Fatal("Unexpected Recorder")
+	}
+	drop := true
+	if err := r.Register(netip.MustParsePrefix("2001:db8:1::/48"), BehaviorFunc(func(pkt []byte, meta *Metadata) ([]byte, error) {
+		if drop {
+			return nil, errProcess
+		}
+		return pkt, nil
+	})); err != nil {
+		t.Fatal(err)
+	}
+	pkt := buildIPv6(t, 0, src, dst, nil, nhIPv4, innerIPv4)
+	if _, err := r.Process(pkt, nil); !errors.Is(err, errProcess) {
+		t.Fatalf("Expected errProcess, got %v", err)
+	}
+	drop = false
+	if _, err := r.Process(pkt, nil); err != nil {
+		t.Fatal(err)
+	}
+	records := rec.Snapshot("2001:db8:1::/48")
+	if len(records) != 2 {
+		t.Fatalf("Unexpected number of records: %d", len(records))
+	}
+	if records[0].Decision != trace.DecisionDrop || records[0].Reason != errProcess.Error() || records[1].Decision != trace.DecisionForward {
+		t.Errorf("Unexpected decisions: %+v", records)
+	}
+	if records[1].Src != netip.AddrFrom16(src) || records[1].Dst != netip.AddrFrom16(dst) || records[1].Len != len(pkt) {
+		t.Errorf("Unexpected record: %+v", records[1])
+	}
+	other := trace.NewRecorder(4)
+	if _, err := r.Process(pkt, &Metadata{Recorder: other}); err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.Snapshot("2001:db8:1::/48")) != 2 || len(other.Snapshot("2001:db8:1::/48")) != 1 {
+		t.Error("Metadata.Recorder should replace the Recorder of the Registry")
+	}
+}
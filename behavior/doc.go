@@ -0,0 +1,9 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package behavior provides the data-path processing of RFC 9433 behaviors,
+// translating complete packets between the SR domain and GTP-U networks.
+// Behaviors can be dispatched according to the DA of packets with a Registry.
+package behavior
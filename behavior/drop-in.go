@@ -0,0 +1,66 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+// RFC 9433, section 5.4 (Drop-In Interworking Mode):
+// an SR path is inserted between two GTP-U entities. The SR Gateway on the ingress side
+// (H.M.GTP4.D or End.M.GTP6.D.Di) pushes a segment list made of transit segments
+// followed by the End.M.GTP4.E or End.M.GTP6.E SID of the SR Gateway on the egress side,
+// which restores the GTP-U packet.
+
+// DropInGTP4Segments returns the segment list, in the order it is visited,
+// made of the transit segments followed by the End.M.GTP4.E SID built from prefix, ipv4 and args.
+// The prefix must leave enough space for the IPv4 DA and Args.Mob.Session.
+func DropInGTP4Segments(transit [][16]byte, prefix netip.Prefix, ipv4 netip.Addr, args *encoding.ArgsMobSession) ([][16]byte, error) {
+	sid, err := encoding.NewMGTP4IPv6DstFromAddr(prefix, ipv4, args)
+	if err != nil {
+		return nil, err
+	}
+	if err := sid.Validate(); err != nil {
+		return nil, err
+	}
+	var last [16]byte
+	if err := sid.MarshalTo(last[:]); err != nil {
+		return nil, err
+	}
+	return appendSegment(transit, last), nil
+}
+
+// DropInGTP6Segments returns the segment list, in the order it is visited,
+// made of the transit segments followed by the End.M.GTP6.E SID built from prefix and args,
+// and by the IPv6 DA of the GTP-U packet (gNB), used by End.M.GTP6.E as IPv6 DA.
+// The prefix must leave enough space for Args.Mob.Session.
+func DropInGTP6Segments(transit [][16]byte, prefix netip.Prefix, args *encoding.ArgsMobSession, gnb netip.Addr) ([][16]byte, error) {
+	if !prefix.IsValid() || !prefix.Addr().Is6() || prefix.Addr().Is4In6() {
+		return nil, errors.ErrPrefixLength
+	}
+	if !gnb.Is6() || gnb.Is4In6() || args == nil {
+		return nil, errors.ErrInvalidAddress
+	}
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
+	if prefix.Bits()+8*args.MarshalLen() > 8*16 {
+		return nil, &errors.FieldError{Field: "args-mob-session", Offset: uint(prefix.Bits()), Need: uint(8 * args.MarshalLen()), Have: uint(8*16 - prefix.Bits()), Err: errors.ErrOutOfRange}
+	}
+	var sid [16]byte
+	if err := encoding.NewMGTP6IPv6Dst(prefix, args).MarshalTo(sid[:]); err != nil {
+		return nil, err
+	}
+	return append(appendSegment(transit, sid), gnb.As16()), nil
+}
+
+// appendSegment returns a copy of segments followed by s.
+func appendSegment(segments [][16]byte, s [16]byte) [][16]byte {
+	return append(append(make([][16]byte, 0, len(segments)+2), segments...), s)
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/encoding/errors"
+	"github.com/nextmn/rfc9433/srh"
+)
+
+func TestDropInGTP4Segments(t *testing.T) {
+	transit := [][16]byte{{0x20, 0x01, 0x0d, 0xb8, 0x00, 0x03, 15: 1}}
+	args := encoding.NewArgsMobSession(5, true, false, 0xcafe)
+	segments, err := DropInGTP4Segments(transit, netip.MustParsePrefix("2001:db8::/32"), netip.MustParseAddr("203.0.113.1"), args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sid, src := mgtp4eAddrs(t)
+	if diff := cmp.Diff(segments, [][16]byte{transit[0], sid}); diff != "" {
+		t.Error(diff)
+	}
+
+	// the egress SR Gateway restores the GTP-U packet
+	s := srh.NewSRH(0, segments)
+	s.SegmentsLeft = 0
+	out, err := NewMGTP4E(32, nil, nil).Translate(buildIPv6(t, 0, src, sid, s, nhIPv4, innerIPv4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := parseUDP4(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.dst != [4]byte{203, 0, 113, 1} {
+		t.Errorf("Unexpected IPv4 DA: %v", p.dst)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		prefix netip.Prefix
+		ipv4   netip.Addr
+		args   *encoding.ArgsMobSession
+		err    error
+	}{
+		{"args lost", netip.MustParsePrefix("2001:db8::/64"), netip.MustParseAddr("203.0.113.1"), args, errors.ErrOutOfRange},
+		{"ipv6 DA", netip.MustParsePrefix("2001:db8::/32"), netip.MustParseAddr("2001:db8::1"), args, errors.ErrInvalidAddress},
+		{"unspecified", netip.MustParsePrefix("2001:db8::/32"), netip.MustParseAddr("0.0.0.0"), args, errors.ErrInvalidAddress},
+		{"qfi", netip.MustParsePrefix("2001:db8::/32"), netip.MustParseAddr("203.0.113.1"), encoding.NewArgsMobSession(64, false, false, 1), errors.ErrOutOfRange},
+	} {
+		if _, err := DropInGTP4Segments(transit, tc.prefix, tc.ipv4, tc.args); !errors.Is(err, tc.err) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.err, err)
+		}
+	}
+}
+
+func TestDropInGTP6Segments(t *testing.T) {
+	gnb := netip.MustParseAddr("2001:db8:a::1")
+	args := encoding.NewArgsMobSession(5, true, false, 0xcafe)
+	segments, err := DropInGTP6Segments(nil, netip.MustParsePrefix("2001:db8:e::/48"), args, gnb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(segments, [][16]byte{mgtp6eSID(t), gnb.As16()}); diff != "" {
+		t.Error(diff)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		prefix netip.Prefix
+		gnb    netip.Addr
+		args   *encoding.ArgsMobSession
+		err    error
+	}{
+		{"args lost", netip.MustParsePrefix("2001:db8::/96"), gnb, args, errors.ErrOutOfRange},
+		{"ipv4 prefix", netip.MustParsePrefix("10.0.0.0/8"), gnb, args, errors.ErrPrefixLength},
+		{"ipv4 gnb", netip.MustParsePrefix("2001:db8:e::/48"), netip.MustParseAddr("203.0.113.1"), args, errors.ErrInvalidAddress},
+		{"qfi", netip.MustParsePrefix("2001:db8:e::/48"), gnb, encoding.NewArgsMobSession(64, false, false, 1), errors.ErrOutOfRange},
+	} {
+		if _, err := DropInGTP6Segments(nil, tc.prefix, tc.args, tc.gnb); !errors.Is(err, tc.err) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.err, err)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+// Encapsulate encapsulates an IPv4 or IPv6 packet in an SRv6 packet visiting the segments of path,
+// as done by H.Encaps (RFC 8986, section 5.1), or H.Encaps.Red if reduced is true.
+func Encapsulate(path [][16]byte, reduced bool, hopLimit uint8, src [16]byte, trafficClass uint8, pkt []byte) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, ErrNoSegment
+	}
+	nh, err := innerNextHeader(pkt)
+	if err != nil {
+		return nil, err
+	}
+	if hopLimit == 0 {
+		hopLimit = DefaultHopLimit
+	}
+	return encapsulate(path, reduced, hopLimit, src, trafficClass, nh, pkt)
+}
+
+// Decapsulate removes the outer IPv6 header and SRH of an SRv6 packet whose last segment is reached
+// (as done by End.DX4 and End.DX6, RFC 8986, sections 4.4 and 4.5),
+// and returns its IPv6 SA and DA with the inner IPv4 or IPv6 packet.
+func Decapsulate(pkt []byte) (src [16]byte, dst [16]byte, inner []byte, err error) {
+	p, err := parseIPv6(pkt)
+	if err != nil {
+		return src, dst, nil, err
+	}
+	if p.srh != nil && p.srh.SegmentsLeft != 0 {
+		return src, dst, nil, ErrSegmentsLeft
+	}
+	if p.nextHeader != nhIPv4 && p.nextHeader != nhIPv6 {
+		return src, dst, nil, ErrUnsupportedPayload
+	}
+	return p.src, p.dst, p.payload, nil
+}
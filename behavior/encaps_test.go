@@ -0,0 +1,69 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/srh"
+)
+
+func TestEncapsulate(t *testing.T) {
+	src := [16]byte{0x20, 0x01, 0x0d, 0xb8, 15: 1}
+	s1 := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0xff, 15: 1}
+	s2 := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0xff, 15: 2}
+	out, err := Encapsulate([][16]byte{s1, s2}, false, 0, src, 0xb8, innerIPv4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := parseIPv6(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.src != src || p.dst != s1 || p.trafficClass != 0xb8 || p.hopLimit != DefaultHopLimit || p.nextHeader != nhIPv4 {
+		t.Errorf("Unexpected IPv6 header: %+v", p)
+	}
+	if diff := cmp.Diff(p.srh, srh.NewSRH(nhIPv4, [][16]byte{s1, s2})); diff != "" {
+		t.Error(diff)
+	}
+
+	if _, err := Encapsulate(nil, false, 0, src, 0, innerIPv4); !errors.Is(err, ErrNoSegment) {
+		t.Errorf("Empty path should be rejected: %v", err)
+	}
+	if _, err := Encapsulate([][16]byte{s1}, false, 0, src, 0, []byte{0x00}); !errors.Is(err, ErrUnsupportedPayload) {
+		t.Errorf("Unsupported payload should be rejected: %v", err)
+	}
+}
+
+func TestDecapsulate(t *testing.T) {
+	src := [16]byte{0x20, 0x01, 0x0d, 0xb8, 15: 1}
+	s1 := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0xff, 15: 1}
+	s2 := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0xff, 15: 2}
+	pkt, err := Encapsulate([][16]byte{s1}, true, 0, src, 0, innerIPv4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotSrc, gotDst, inner, err := Decapsulate(pkt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotSrc != src || gotDst != s1 {
+		t.Errorf("Unexpected addresses: %x %x", gotSrc, gotDst)
+	}
+	if diff := cmp.Diff(inner, innerIPv4); diff != "" {
+		t.Error(diff)
+	}
+
+	pkt, err = Encapsulate([][16]byte{s1, s2}, false, 0, src, 0, innerIPv4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := Decapsulate(pkt); !errors.Is(err, ErrSegmentsLeft) {
+		t.Errorf("Segments left should be rejected: %v", err)
+	}
+}
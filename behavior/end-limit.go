@@ -0,0 +1,61 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/ratelimit"
+)
+
+// EndLimit implements End.Limit (RFC 9433, section 6.8):
+// packets exceeding the limit rate of the SID are dropped,
+// and the others are processed as with End (RFC 8986, section 4.1).
+type EndLimit struct {
+	prefixLen     uint
+	groupIDBits   uint
+	limitRateBits uint
+	limiter       *ratelimit.Limiter
+}
+
+// NewEndLimit creates an EndLimit for End.Limit SIDs with the given prefix length and field sizes.
+func NewEndLimit(prefixLen uint, groupIDBits uint, limitRateBits uint, limiter *ratelimit.Limiter) *EndLimit {
+	return &EndLimit{
+		prefixLen:     prefixLen,
+		groupIDBits:   groupIDBits,
+		limitRateBits: limitRateBits,
+		limiter:       limiter,
+	}
+}
+
+// Process returns a copy of the SRv6 packet with Segments Left decremented and the IPv6 DA updated,
+// or ErrRateLimited if the packet exceeds the limit rate.
+func (e *EndLimit) Process(pkt []byte, meta *Metadata) ([]byte, error) {
+	p, err := parseIPv6(pkt)
+	if err != nil {
+		return nil, err
+	}
+	if p.srh == nil || p.srh.SegmentsLeft == 0 || int(p.srh.SegmentsLeft) > len(p.srh.Segments) {
+		return nil, ErrSegmentsLeft
+	}
+	if p.hopLimit <= 1 {
+		return nil, ErrHopLimitExceeded
+	}
+	sid, err := encoding.ParseEndLimit(p.dst, e.prefixLen, e.groupIDBits, e.limitRateBits)
+	if err != nil {
+		return nil, err
+	}
+	if !e.limiter.Allow(sid, uint64(len(pkt))) {
+		return nil, ErrRateLimited
+	}
+	sl := p.srh.SegmentsLeft - 1
+	dst := p.srh.Segments[sl]
+	out := make([]byte, len(pkt))
+	copy(out, pkt)
+	out[7] = p.hopLimit - 1
+	copy(out[24:40], dst[:])
+	out[p.srhOffset+3] = sl
+	return out, nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/ratelimit"
+	"github.com/nextmn/rfc9433/srh"
+)
+
+func TestEndLimit(t *testing.T) {
+	src := [16]byte{0x20, 0x01, 0x0d, 0xb8, 15: 1}
+	next := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0x00, 0x03, 15: 1}
+	// 116 bytes packets, and a limit rate allowing a single packet
+	b, err := encoding.NewEndLimit(netip.MustParsePrefix("2001:db8:1::/48"), 7, 16, 150, 32).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sid := [16]byte(b)
+	pkt := buildIPv6(t, 0, src, sid, srh.NewSRH(0, [][16]byte{src, sid, next}), nhIPv4, innerIPv4)
+	pkt[ipv6HeaderLen+3] = 1 // Segments Left
+
+	e := NewEndLimit(48, 16, 32, ratelimit.NewLimiter(0))
+	out, err := e.Process(pkt, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := parseIPv6(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.dst != next || p.hopLimit != 63 || p.srh.SegmentsLeft != 0 {
+		t.Errorf("Unexpected packet: %+v", p)
+	}
+	if pkt[ipv6HeaderLen+3] != 1 {
+		t.Error("Input packet modified")
+	}
+	if _, err := e.Process(pkt, nil); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("Expected ErrRateLimited, got %v", err)
+	}
+
+	if _, err := e.Process(out, nil); !errors.Is(err, ErrSegmentsLeft) {
+		t.Errorf("Expected ErrSegmentsLeft, got %v", err)
+	}
+	pkt[7] = 1
+	if _, err := e.Process(pkt, nil); !errors.Is(err, ErrHopLimitExceeded) {
+		t.Errorf("Expected ErrHopLimitExceeded, got %v", err)
+	}
+}
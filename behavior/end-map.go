@@ -0,0 +1,73 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+// MapTable is the mapping table of End.MAP. *encoding.EndMAP is a MapTable.
+type MapTable interface {
+	// Rewrite returns the mapped SID of the IPv6 DA.
+	Rewrite(ipv6Addr [16]byte) ([16]byte, error)
+}
+
+// MapTableFunc is an adapter to allow the use of ordinary functions as MapTable.
+type MapTableFunc func(ipv6Addr [16]byte) ([16]byte, error)
+
+// Rewrite calls f(ipv6Addr).
+func (f MapTableFunc) Rewrite(ipv6Addr [16]byte) ([16]byte, error) {
+	return f(ipv6Addr)
+}
+
+// StaticMapTable is a MapTable mapping whole SIDs.
+type StaticMapTable map[[16]byte][16]byte
+
+// Rewrite returns the SID mapped to the IPv6 DA, or ErrNoMapping.
+func (t StaticMapTable) Rewrite(ipv6Addr [16]byte) ([16]byte, error) {
+	if m, ok := t[ipv6Addr]; ok {
+		return m, nil
+	}
+	return [16]byte{}, ErrNoMapping
+}
+
+// EndMAP implements End.MAP (RFC 9433, section 6.2):
+// the IPv6 DA is replaced by the SID mapped by the table.
+type EndMAP struct {
+	table MapTable
+}
+
+// NewEndMAP creates an EndMAP using the given mapping table.
+func NewEndMAP(table MapTable) *EndMAP {
+	return &EndMAP{
+		table: table,
+	}
+}
+
+// Translate returns a copy of the SRv6 packet (starting with the IPv6 header) with the IPv6 DA rewritten.
+// If the active segment of the SRH is the IPv6 DA, it is rewritten too
+// (this invalidates the HMAC TLV, if any).
+func (e *EndMAP) Translate(pkt []byte) ([]byte, error) {
+	p, err := parseIPv6(pkt)
+	if err != nil {
+		return nil, err
+	}
+	mapped, err := e.table.Rewrite(p.dst)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(pkt))
+	copy(out, pkt)
+	copy(out[24:40], mapped[:])
+	if p.srh != nil {
+		if active, ok := p.srh.ActiveSegment(); ok && active == p.dst {
+			offset := p.srhOffset + 8 + 16*int(p.srh.SegmentsLeft)
+			copy(out[offset:offset+16], mapped[:])
+		}
+	}
+	return out, nil
+}
+
+// Process implements Behavior.
+func (e *EndMAP) Process(pkt []byte, meta *Metadata) ([]byte, error) {
+	return e.Translate(pkt)
+}
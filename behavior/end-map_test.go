@@ -0,0 +1,69 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/srh"
+)
+
+func TestEndMAP(t *testing.T) {
+	src := [16]byte{0x20, 0x01, 0x0d, 0xb8, 15: 1}
+	sid := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0x00, 0x01, 15: 0x42}
+	mapped := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0x00, 0x02, 15: 0x42}
+	next := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0x00, 0x03, 15: 1}
+
+	prefixes := encoding.NewEndMAP()
+	if err := prefixes.Add(netip.MustParsePrefix("2001:db8:1::/48"), netip.MustParsePrefix("2001:db8:2::/48")); err != nil {
+		t.Fatal(err)
+	}
+	for _, table := range []MapTable{prefixes, StaticMapTable{sid: mapped}} {
+		for _, tc := range []struct {
+			name string
+			in   *srh.SRH
+			out  *srh.SRH
+		}{
+			{"no SRH", nil, nil},
+			{"SRH", srh.NewSRH(0, [][16]byte{src, sid, next}), &srh.SRH{NextHeader: nhIPv4, SegmentsLeft: 1, Segments: [][16]byte{next, mapped, src}}},
+			{"reduced SRH", &srh.SRH{SegmentsLeft: 1, Segments: [][16]byte{next}}, &srh.SRH{NextHeader: nhIPv4, SegmentsLeft: 1, Segments: [][16]byte{next}}},
+		} {
+			if tc.in != nil {
+				tc.in.SegmentsLeft = 1
+			}
+			pkt := buildIPv6(t, 0, src, sid, tc.in, nhIPv4, innerIPv4)
+			orig := append([]byte{}, pkt...)
+			out, err := NewEndMAP(table).Translate(pkt)
+			if err != nil {
+				t.Fatalf("%s: %v", tc.name, err)
+			}
+			if diff := cmp.Diff(pkt, orig); diff != "" {
+				t.Errorf("%s: input packet modified: %s", tc.name, diff)
+			}
+			p, err := parseIPv6(out)
+			if err != nil {
+				t.Fatalf("%s: %v", tc.name, err)
+			}
+			if p.dst != mapped || p.src != src {
+				t.Errorf("%s: unexpected addresses: %x %x", tc.name, p.src, p.dst)
+			}
+			if diff := cmp.Diff(p.srh, tc.out); diff != "" {
+				t.Errorf("%s: %s", tc.name, diff)
+			}
+			if diff := cmp.Diff(p.payload, innerIPv4); diff != "" {
+				t.Errorf("%s: %s", tc.name, diff)
+			}
+		}
+	}
+
+	if _, err := NewEndMAP(StaticMapTable{}).Translate(buildIPv6(t, 0, src, sid, nil, nhIPv4, innerIPv4)); !errors.Is(err, ErrNoMapping) {
+		t.Errorf("Expected ErrNoMapping, got %v", err)
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import "errors"
+
+var (
+	ErrTooShortToParse    = errors.New("too short to parse")
+	ErrMalformedPacket    = errors.New("malformed packet")
+	ErrSegmentsLeft       = errors.New("segments left is not zero")
+	ErrUnsupportedPayload = errors.New("unsupported payload")
+	ErrNoMapping          = errors.New("no mapping for this SID")
+	ErrNoBehavior         = errors.New("no behavior for this destination")
+	ErrInvalidPrefix      = errors.New("invalid prefix")
+	ErrRateLimited        = errors.New("rate limit exceeded")
+	ErrHopLimitExceeded   = errors.New("hop limit exceeded")
+	ErrPacketTooBig       = errors.New("packet exceeds the egress MTU")
+	ErrECNDrop            = errors.New("congestion experienced on a packet not ECN-capable")
+	ErrNoSegment          = errors.New("no segment")
+)
@@ -0,0 +1,153 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/gtpu"
+	"github.com/nextmn/rfc9433/headend"
+	"github.com/nextmn/rfc9433/srh"
+)
+
+// DefaultHopLimit is the Hop Limit of IPv6 headers pushed by headends when none is configured.
+const DefaultHopLimit = 64
+
+// SRv6Policy is the SR Policy applied by H.M.GTP4.D.
+type SRv6Policy struct {
+	DstPrefix netip.Prefix // Destination UPF Prefix, used to build the last segment
+	Segments  [][16]byte   // segments visited before the last segment, may be empty
+	Reduced   bool         // omit the first segment from the SRH (H.Encaps.Red)
+	HopLimit  uint8        // 0 means DefaultHopLimit
+}
+
+// HMGTP4D implements H.M.GTP4.D (RFC 9433, section 6.7):
+// IPv4/UDP/GTP-U packets are encapsulated in SRv6, with an IPv6 SA built from the IPv4 SA and UDP Source Port,
+// and a last segment built from the IPv4 DA, the TEID and the QFI.
+type HMGTP4D struct {
+	policy    SRv6Policy
+	srcPrefix headend.PrefixSelector
+	srcScheme encoding.SrcEncodingScheme
+	options   options
+}
+
+// NewHMGTP4D creates a HMGTP4D.
+// The Source UPF Prefix is chosen by srcPrefix, and the IPv6 SA is built with srcScheme (nil means NextMN).
+func NewHMGTP4D(policy SRv6Policy, srcPrefix headend.PrefixSelector, srcScheme encoding.SrcEncodingScheme, opts ...Option) *HMGTP4D {
+	if policy.HopLimit == 0 {
+		policy.HopLimit = DefaultHopLimit
+	}
+	return &HMGTP4D{
+		policy:    policy,
+		srcPrefix: srcPrefix,
+		srcScheme: srcScheme,
+		options:   newOptions(opts),
+	}
+}
+
+// Translate translates an IPv4/UDP/GTP-U packet (starting with the IPv4 header) into an SRv6 packet.
+// Only G-PDUs carrying IPv4 or IPv6 packets are translated.
+func (h *HMGTP4D) Translate(pkt []byte) ([]byte, error) {
+	p, err := parseUDP4(pkt)
+	if err != nil {
+		return nil, err
+	}
+	if p.dstPort != gtpu.Port {
+		return nil, ErrUnsupportedPayload
+	}
+	g, err := gtpu.ParseGPDU(p.payload)
+	if err != nil {
+		return nil, err
+	}
+	nh, err := innerNextHeader(g.TPDU)
+	if err != nil {
+		return nil, err
+	}
+
+	// IPv6 SA
+	prefix, err := h.srcPrefix.SelectPrefix(headend.Request{Peer: netip.AddrFrom4(p.src)})
+	if err != nil {
+		return nil, err
+	}
+	srcPort := p.srcPort
+	if h.options.ports != nil {
+		if srcPort, err = h.options.ports.PortForPacket(g.TPDU); err != nil {
+			return nil, err
+		}
+	}
+	var src [16]byte
+	if err := encoding.NewMGTP4IPv6SrcWithScheme(prefix, p.src, srcPort, h.srcScheme).MarshalTo(src[:]); err != nil {
+		return nil, err
+	}
+
+	// last segment
+	args := encoding.NewArgsMobSession(0, false, false, g.Header.TEID)
+	if g.PDUSessionContainer != nil {
+		args = g.PDUSessionContainer.ArgsMobSession(g.Header.TEID)
+	}
+	var last [16]byte
+	if err := encoding.NewHMGTP4IPv6Dst(h.policy.DstPrefix, p.dst, args).MarshalTo(last[:]); err != nil {
+		return nil, err
+	}
+
+	tc, payload, err := h.options.propagateECN(h.options.trafficClass(p.tos, args.QFI(), g.PDUSessionContainer != nil), g.TPDU)
+	if err != nil {
+		return nil, err
+	}
+	hopLimit, err := h.options.hopLimit(h.policy.HopLimit, p.ttl, payload)
+	if err != nil {
+		return nil, err
+	}
+	return encapsulate(appendSegment(h.policy.Segments, last), h.policy.Reduced, hopLimit, src, tc, nh, payload)
+}
+
+// encapsulate returns the SRv6 packet carrying payload through the segments of path (at least one).
+// If reduced is true, the first segment is omitted from the SRH.
+func encapsulate(path [][16]byte, reduced bool, hopLimit uint8, src [16]byte, trafficClass uint8, nextHeader uint8, payload []byte) ([]byte, error) {
+	var dst [16]byte
+	var s *srh.SRH
+	if reduced {
+		dst, s = srh.NewReducedSRH(nextHeader, path)
+	} else {
+		dst, s = path[0], srh.NewSRH(nextHeader, path)
+	}
+	srhLen := 0
+	ipNextHeader := nextHeader
+	if s != nil {
+		srhLen = s.MarshalLen()
+		ipNextHeader = nhRouting
+	}
+	out := make([]byte, ipv6HeaderLen+srhLen+len(payload))
+	putIPv6Header(out, trafficClass, 0, srhLen+len(payload), ipNextHeader, hopLimit, src, dst)
+	if s != nil {
+		if err := s.MarshalTo(out[ipv6HeaderLen:]); err != nil {
+			return nil, err
+		}
+	}
+	copy(out[ipv6HeaderLen+srhLen:], payload)
+	return out, nil
+}
+
+// innerNextHeader returns the IPv6 Next Header value of an IPv4 or IPv6 packet.
+func innerNextHeader(pkt []byte) (uint8, error) {
+	if len(pkt) == 0 {
+		return 0, ErrTooShortToParse
+	}
+	switch pkt[0] >> 4 {
+	case 4:
+		return nhIPv4, nil
+	case 6:
+		return nhIPv6, nil
+	default:
+		return 0, ErrUnsupportedPayload
+	}
+}
+
+// Process implements Behavior.
+func (h *HMGTP4D) Process(pkt []byte, meta *Metadata) ([]byte, error) {
+	return h.Translate(pkt)
+}
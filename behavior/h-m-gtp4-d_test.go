@@ -0,0 +1,83 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/headend"
+	"github.com/nextmn/rfc9433/srh"
+)
+
+func TestHMGTP4D(t *testing.T) {
+	sid, src := mgtp4eAddrs(t)
+	gtp4, err := NewMGTP4E(32, nil, nil).Translate(buildIPv6(t, 0xb8, src, sid, nil, nhIPv4, innerIPv4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	selector := headend.StaticPrefix(netip.MustParsePrefix("2001:db8:1::/48"))
+	s1 := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0xff, 15: 1}
+
+	for _, tc := range []struct {
+		name     string
+		segments [][16]byte
+		reduced  bool
+		dst      [16]byte
+		srh      *srh.SRH
+	}{
+		{"single segment", nil, false, sid, srh.NewSRH(nhIPv4, [][16]byte{sid})},
+		{"single segment reduced", nil, true, sid, nil},
+		{"two segments", [][16]byte{s1}, false, s1, srh.NewSRH(nhIPv4, [][16]byte{s1, sid})},
+		{"two segments reduced", [][16]byte{s1}, true, s1, &srh.SRH{NextHeader: nhIPv4, SegmentsLeft: 1, Segments: [][16]byte{sid}}},
+	} {
+		h := NewHMGTP4D(SRv6Policy{DstPrefix: netip.MustParsePrefix("2001:db8::/32"), Segments: tc.segments, Reduced: tc.reduced}, selector, nil)
+		out, err := h.Translate(gtp4)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		p, err := parseIPv6(out)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if p.src != src || p.dst != tc.dst || p.trafficClass != 0xb8 || p.hopLimit != DefaultHopLimit || p.nextHeader != nhIPv4 {
+			t.Errorf("%s: unexpected IPv6 header: %+v", tc.name, p)
+		}
+		if diff := cmp.Diff(p.srh, tc.srh); diff != "" {
+			t.Errorf("%s: %s", tc.name, diff)
+		}
+		if diff := cmp.Diff(p.payload, innerIPv4); diff != "" {
+			t.Errorf("%s: %s", tc.name, diff)
+		}
+	}
+}
+
+func TestHMGTP4DErrors(t *testing.T) {
+	sid, src := mgtp4eAddrs(t)
+	gtp4, err := NewMGTP4E(32, nil, nil).Translate(buildIPv6(t, 0, src, sid, nil, nhIPv4, innerIPv4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	selector := headend.StaticPrefix(netip.MustParsePrefix("2001:db8:1::/48"))
+	h := NewHMGTP4D(SRv6Policy{DstPrefix: netip.MustParsePrefix("2001:db8::/32")}, selector, nil)
+
+	otherPort := append([]byte{}, gtp4...)
+	otherPort[22] = 0x08
+	otherPort[23] = 0x69
+	if _, err := h.Translate(otherPort); !errors.Is(err, ErrUnsupportedPayload) {
+		t.Errorf("UDP port other than GTP-U should be rejected: %v", err)
+	}
+	noPrefix := NewHMGTP4D(SRv6Policy{DstPrefix: netip.MustParsePrefix("2001:db8::/32")}, headend.NewRoundRobin(nil), nil)
+	if _, err := noPrefix.Translate(gtp4); !errors.Is(err, headend.ErrNoPrefix) {
+		t.Errorf("Errors of the prefix selector should be returned: %v", err)
+	}
+	tooLong := NewHMGTP4D(SRv6Policy{DstPrefix: netip.MustParsePrefix("2001:db8::/64")}, selector, nil)
+	if _, err := tooLong.Translate(gtp4); err == nil {
+		t.Error("Destination UPF Prefix too long should be rejected")
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import "encoding/binary"
+
+const (
+	ipv4MinHeaderLen = 20
+	ipv4MFMask       = 0x2000
+	ipv4OffsetMask   = 0x1FFF
+)
+
+// udp4Packet is an IPv4/UDP packet.
+type udp4Packet struct {
+	tos     uint8
+	ttl     uint8
+	src     [4]byte
+	dst     [4]byte
+	srcPort uint16
+	dstPort uint16
+	payload []byte // UDP payload
+}
+
+// parseUDP4 parses an IPv4 packet carrying an UDP datagram. Fragments are rejected.
+func parseUDP4(pkt []byte) (*udp4Packet, error) {
+	if len(pkt) < ipv4MinHeaderLen {
+		return nil, ErrTooShortToParse
+	}
+	if pkt[0]>>4 != 4 {
+		return nil, ErrMalformedPacket
+	}
+	ihl := 4 * int(pkt[0]&0x0F)
+	totalLen := int(binary.BigEndian.Uint16(pkt[2:4]))
+	if ihl < ipv4MinHeaderLen || totalLen < ihl {
+		return nil, ErrMalformedPacket
+	}
+	if len(pkt) < totalLen {
+		return nil, ErrTooShortToParse
+	}
+	if binary.BigEndian.Uint16(pkt[6:8])&(ipv4MFMask|ipv4OffsetMask) != 0 {
+		return nil, ErrUnsupportedPayload
+	}
+	if pkt[9] != protoUDP {
+		return nil, ErrUnsupportedPayload
+	}
+	srcPort, dstPort, payload, err := parseUDP(pkt[ihl:totalLen])
+	if err != nil {
+		return nil, err
+	}
+	return &udp4Packet{
+		tos:     pkt[1],
+		ttl:     pkt[8],
+		src:     [4]byte(pkt[12:16]),
+		dst:     [4]byte(pkt[16:20]),
+		srcPort: srcPort,
+		dstPort: dstPort,
+		payload: payload,
+	}, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseUDP4(t *testing.T) {
+	pkt := []byte{
+		0x45, 0xb8, 0x00, 0x1e,
+		0x00, 0x00, 0x40, 0x00,
+		0x40, 0x11, 0x00, 0x00,
+		0xc6, 0x33, 0x64, 0x01,
+		0xcb, 0x00, 0x71, 0x01,
+		// UDP
+		0x04, 0xd2, 0x08, 0x68,
+		0x00, 0x0a, 0x00, 0x00,
+		0xca, 0xfe,
+		// after Total Length
+		0xff,
+	}
+	p, err := parseUDP4(pkt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(*p, udp4Packet{tos: 0xb8, ttl: 64, src: [4]byte{198, 51, 100, 1}, dst: [4]byte{203, 0, 113, 1}, srcPort: 1234, dstPort: 2152, payload: []byte{0xca, 0xfe}}, cmp.AllowUnexported(udp4Packet{})); diff != "" {
+		t.Error(diff)
+	}
+
+	fragment := append([]byte{}, pkt...)
+	fragment[6] = 0x20
+	tcp := append([]byte{}, pkt...)
+	tcp[9] = 6
+	for _, tc := range []struct {
+		name string
+		pkt  []byte
+		err  error
+	}{
+		{"short", pkt[:19], ErrTooShortToParse},
+		{"truncated", pkt[:29], ErrTooShortToParse},
+		{"version", append([]byte{0x65}, pkt[1:]...), ErrMalformedPacket},
+		{"ihl", append([]byte{0x44}, pkt[1:]...), ErrMalformedPacket},
+		{"fragment", fragment, ErrUnsupportedPayload},
+		{"tcp", tcp, ErrUnsupportedPayload},
+	} {
+		if _, err := parseUDP4(tc.pkt); !errors.Is(err, tc.err) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.err, err)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"encoding/binary"
+
+	"github.com/nextmn/rfc9433/srh"
+)
+
+const (
+	ipv6HeaderLen = 40
+	udpHeaderLen  = 8
+
+	protoUDP = 17
+
+	// IPv6 Next Header values
+	nhHopByHop = 0
+	nhIPv4     = 4
+	nhIPv6     = 41
+	nhRouting  = 43
+	nhDestOpts = 60
+	nhEthernet = 143
+)
+
+// ipv6Packet is an IPv6 packet with its extension headers parsed.
+type ipv6Packet struct {
+	trafficClass uint8
+	flowLabel    uint32
+	hopLimit     uint8
+	src          [16]byte
+	dst          [16]byte
+	srh          *srh.SRH // nil if the packet has no SRH
+	srhOffset    int      // position of the SRH in the packet
+	nextHeader   uint8    // protocol of the payload
+	payload      []byte
+}
+
+// parseIPv6 parses an IPv6 packet, skipping Hop-by-Hop and Destination Options headers.
+func parseIPv6(pkt []byte) (*ipv6Packet, error) {
+	if len(pkt) < ipv6HeaderLen {
+		return nil, ErrTooShortToParse
+	}
+	if pkt[0]>>4 != 6 {
+		return nil, ErrMalformedPacket
+	}
+	payloadLen := int(binary.BigEndian.Uint16(pkt[4:6]))
+	if len(pkt) < ipv6HeaderLen+payloadLen {
+		return nil, ErrTooShortToParse
+	}
+	pkt = pkt[:ipv6HeaderLen+payloadLen]
+	vtf := binary.BigEndian.Uint32(pkt[0:4])
+	p := &ipv6Packet{
+		trafficClass: uint8(vtf >> 20),
+		flowLabel:    vtf & 0xFFFFF,
+		hopLimit:     pkt[7],
+		src:          [16]byte(pkt[8:24]),
+		dst:          [16]byte(pkt[24:40]),
+	}
+	nh := pkt[6]
+	offset := ipv6HeaderLen
+	for {
+		switch nh {
+		case nhHopByHop, nhDestOpts:
+			if len(pkt) < offset+2 {
+				return nil, ErrTooShortToParse
+			}
+			nh, offset = pkt[offset], offset+(int(pkt[offset+1])+1)*8
+			if offset > len(pkt) {
+				return nil, ErrMalformedPacket
+			}
+		case nhRouting:
+			if p.srh != nil {
+				return nil, ErrMalformedPacket
+			}
+			s, err := srh.Parse(pkt[offset:])
+			if err != nil {
+				return nil, err
+			}
+			p.srh = s
+			p.srhOffset = offset
+			nh, offset = s.NextHeader, offset+s.MarshalLen()
+		default:
+			if offset > len(pkt) {
+				return nil, ErrTooShortToParse
+			}
+			p.nextHeader = nh
+			p.payload = pkt[offset:]
+			return p, nil
+		}
+	}
+}
+
+// putIPv6Header puts an IPv6 header in b.
+func putIPv6Header(b []byte, trafficClass uint8, flowLabel uint32, payloadLen int, nextHeader uint8, hopLimit uint8, src [16]byte, dst [16]byte) {
+	binary.BigEndian.PutUint32(b[0:4], 6<<28|uint32(trafficClass)<<20|flowLabel&0xFFFFF)
+	binary.BigEndian.PutUint16(b[4:6], uint16(payloadLen))
+	b[6] = nextHeader
+	b[7] = hopLimit
+	copy(b[8:24], src[:])
+	copy(b[24:40], dst[:])
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/srh"
+)
+
+// buildIPv6 returns an IPv6 packet with an optional SRH.
+func buildIPv6(t *testing.T, tc uint8, src, dst [16]byte, s *srh.SRH, nextHeader uint8, payload []byte) []byte {
+	t.Helper()
+	var ext []byte
+	if s != nil {
+		s.NextHeader = nextHeader
+		b, err := s.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		ext = b
+		nextHeader = nhRouting
+	}
+	pkt := make([]byte, ipv6HeaderLen, ipv6HeaderLen+len(ext)+len(payload))
+	binary.BigEndian.PutUint32(pkt[0:4], 6<<28|uint32(tc)<<20)
+	binary.BigEndian.PutUint16(pkt[4:6], uint16(len(ext)+len(payload)))
+	pkt[6] = nextHeader
+	pkt[7] = 64
+	copy(pkt[8:24], src[:])
+	copy(pkt[24:40], dst[:])
+	pkt = append(pkt, ext...)
+	return append(pkt, payload...)
+}
+
+func TestParseIPv6(t *testing.T) {
+	src := [16]byte{0x20, 0x01, 0x0d, 0xb8, 15: 1}
+	dst := [16]byte{0x20, 0x01, 0x0d, 0xb8, 15: 2}
+	s := srh.NewSRH(0, [][16]byte{dst, {0x20, 0x01, 0x0d, 0xb8, 15: 3}})
+	payload := []byte{0x45, 0x00, 0x00, 0x14}
+	pkt := buildIPv6(t, 0xb8, src, dst, s, nhIPv4, payload)
+
+	// Destination Options header before the SRH
+	opts := append([]byte{}, pkt[:ipv6HeaderLen]...)
+	opts[6] = nhDestOpts
+	binary.BigEndian.PutUint16(opts[4:6], uint16(len(pkt)-ipv6HeaderLen+8))
+	opts = append(opts, nhRouting, 0, 1, 4, 0, 0, 0, 0)
+	opts = append(opts, pkt[ipv6HeaderLen:]...)
+
+	for _, b := range [][]byte{pkt, opts, append(pkt, 0xff)} {
+		p, err := parseIPv6(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.trafficClass != 0xb8 || p.hopLimit != 64 || p.src != src || p.dst != dst || p.nextHeader != nhIPv4 {
+			t.Errorf("Unexpected header: %+v", p)
+		}
+		if diff := cmp.Diff(p.srh, s); diff != "" {
+			t.Error(diff)
+		}
+		if diff := cmp.Diff(p.payload, payload); diff != "" {
+			t.Error(diff)
+		}
+	}
+
+	// Destination Options header longer than the packet, followed by a Routing header
+	overrun := append([]byte{}, pkt[:ipv6HeaderLen]...)
+	overrun[6] = nhDestOpts
+	binary.BigEndian.PutUint16(overrun[4:6], 8)
+	overrun = append(overrun, nhRouting, 5, 0, 0, 0, 0, 0, 0)
+
+	for _, tc := range []struct {
+		name string
+		pkt  []byte
+		err  error
+	}{
+		{"short", pkt[:ipv6HeaderLen-1], ErrTooShortToParse},
+		{"truncated", pkt[:len(pkt)-1], ErrTooShortToParse},
+		{"version", append([]byte{0x40}, pkt[1:]...), ErrMalformedPacket},
+		{"extension header overrun", overrun, ErrMalformedPacket},
+	} {
+		if _, err := parseIPv6(tc.pkt); !errors.Is(err, tc.err) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.err, err)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"encoding/binary"
+
+	"github.com/nextmn/rfc9433/checksum"
+	"github.com/nextmn/rfc9433/egress"
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/gtpu"
+	"github.com/nextmn/rfc9433/ipv4"
+)
+
+// MGTP4E implements End.M.GTP4.E (RFC 9433, section 6.6):
+// SRv6 packets whose active segment is an End.M.GTP4.E SID are translated
+// into IPv4/UDP/GTP-U packets, using the IPv4 DA and Args.Mob.Session of the SID,
+// and the IPv4 SA and UDP Source Port of the IPv6 SA.
+type MGTP4E struct {
+	dstPrefixLen uint
+	srcScheme    encoding.SrcEncodingScheme
+	builder      *ipv4.HeaderBuilder
+	options      options
+}
+
+// NewMGTP4E creates a MGTP4E for End.M.GTP4.E SIDs with the given prefix length.
+// The IPv6 SA is parsed with srcScheme (nil means NextMN).
+// If builder is nil, outer IPv4 headers are built with the default policy.
+func NewMGTP4E(dstPrefixLen uint, srcScheme encoding.SrcEncodingScheme, builder *ipv4.HeaderBuilder, opts ...Option) *MGTP4E {
+	if builder == nil {
+		builder = ipv4.NewHeaderBuilder(ipv4.Policy{}, nil, nil)
+	}
+	return &MGTP4E{
+		dstPrefixLen: dstPrefixLen,
+		srcScheme:    srcScheme,
+		builder:      builder,
+		options:      newOptions(opts),
+	}
+}
+
+// Translate translates an SRv6 packet (starting with the IPv6 header) into an IPv4/UDP/GTP-U packet.
+// If the packet has a SRH, Segments Left must be zero.
+// The GTP-U header carries a DL PDU Session Container with the QFI and the R bit (as RQI) of the SID.
+func (e *MGTP4E) Translate(pkt []byte) ([]byte, error) {
+	return e.translate(pkt, nil)
+}
+
+// translate translates the packet, and sets the egress interface in meta (if not nil).
+func (e *MGTP4E) translate(pkt []byte, meta *Metadata) ([]byte, error) {
+	p, err := parseIPv6(pkt)
+	if err != nil {
+		return nil, err
+	}
+	if p.srh != nil && p.srh.SegmentsLeft != 0 {
+		return nil, ErrSegmentsLeft
+	}
+	switch p.nextHeader {
+	case nhIPv4, nhIPv6, nhEthernet:
+	default:
+		return nil, ErrUnsupportedPayload
+	}
+	// 0.0.0.0 is not a valid IPv4 DA
+	dst, err := encoding.ParseMGTP4IPv6Dst(p.dst, e.dstPrefixLen, encoding.WithRejectZeroIPv4())
+	if err != nil {
+		return nil, err
+	}
+	src, err := encoding.ParseMGTP4IPv6SrcWithScheme(p.src, e.srcScheme)
+	if err != nil {
+		return nil, err
+	}
+	srcPort := src.UDPPortNumber()
+	if srcPort == 0 {
+		srcPort = gtpu.Port
+	}
+	srcAddr := src.IPv4().As4()
+	dstPort := uint16(gtpu.Port)
+	if e.options.peers != nil {
+		peer, err := egress.ResolveMGTP4IPv6Dst(e.options.peers, dst)
+		if err != nil {
+			return nil, err
+		}
+		if peer.Src.Is4() {
+			srcAddr = peer.Src.As4()
+		}
+		if peer.Port != 0 {
+			dstPort = peer.Port
+		}
+		if meta != nil {
+			meta.Interface = peer.Interface
+		}
+	}
+
+	container := gtpu.NewPDUSessionContainer(gtpu.PDUTypeDLPDUSessionInformation, dst.ArgsMobSession())
+	tos, payload, err := e.options.propagateECN(e.options.trafficClass(p.trafficClass, container.QFI, true), p.payload)
+	if err != nil {
+		return nil, err
+	}
+	gtpHeader := gtpu.Header{
+		MessageType:             gtpu.MessageTypeGPDU,
+		TEID:                    dst.PDUSessionID(),
+		E:                       true,
+		NextExtensionHeaderType: gtpu.ExtensionHeaderTypePDUSessionContainer,
+		PayloadLen:              container.MarshalLen() + len(payload),
+	}
+	gtpLen := gtpHeader.MarshalLen() + gtpHeader.PayloadLen
+	ipHeader, err := e.builder.Build(srcAddr, dst.IPv4().As4(), ipv4.ProtocolUDP, tos, udpHeaderLen+gtpLen, payload)
+	if err != nil {
+		return nil, err
+	}
+	if ipHeader.TTL, err = e.options.hopLimit(ipHeader.TTL, p.hopLimit, payload); err != nil {
+		return nil, err
+	}
+
+	ipLen := ipHeader.MarshalLen()
+	out := make([]byte, ipLen+udpHeaderLen+gtpLen)
+	if err := ipHeader.MarshalTo(out); err != nil {
+		return nil, err
+	}
+	putUDPHeader(out[ipLen:], srcPort, dstPort, gtpLen)
+	b := out[ipLen+udpHeaderLen:]
+	if err := gtpHeader.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	b = b[gtpHeader.MarshalLen():]
+	if err := container.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	copy(b[container.MarshalLen():], payload)
+	udp := out[ipLen:]
+	binary.BigEndian.PutUint16(udp[6:8], checksum.UDPIPv4(ipHeader.Src, ipHeader.Dst, udp))
+	return out, nil
+}
+
+// Process implements Behavior.
+func (e *MGTP4E) Process(pkt []byte, meta *Metadata) ([]byte, error) {
+	return e.translate(pkt, meta)
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/egress"
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/gtpu"
+	"github.com/nextmn/rfc9433/ipv4"
+	"github.com/nextmn/rfc9433/srh"
+)
+
+// innerIPv4 is an IPv4 header with the DF bit set, and no payload.
+var innerIPv4 = []byte{
+	0x45, 0x00, 0x00, 0x14,
+	0x00, 0x00, 0x40, 0x00,
+	0x40, 0x11, 0x00, 0x00,
+	0x0a, 0x00, 0x00, 0x01,
+	0x0a, 0x00, 0x00, 0x02,
+}
+
+// mgtp4eAddrs returns an End.M.GTP4.E SID and an IPv6 SA using the NextMN scheme.
+func mgtp4eAddrs(t *testing.T) (sid [16]byte, src [16]byte) {
+	t.Helper()
+	dst := encoding.NewMGTP4IPv6Dst(netip.MustParsePrefix("2001:db8::/32"), [4]byte{203, 0, 113, 1}, encoding.NewArgsMobSession(5, true, false, 0xcafe))
+	d, err := dst.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := encoding.NewMGTP4IPv6Src(netip.MustParsePrefix("2001:db8:1::/48"), [4]byte{198, 51, 100, 1}, 1234).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return [16]byte(d), [16]byte(s)
+}
+
+func TestMGTP4E(t *testing.T) {
+	sid, src := mgtp4eAddrs(t)
+	s := srh.NewSRH(0, [][16]byte{sid})
+	pkt := buildIPv6(t, 0xb8, src, sid, s, nhIPv4, innerIPv4)
+
+	out, err := NewMGTP4E(32, nil, ipv4.NewHeaderBuilder(ipv4.Policy{}, ipv4.FixedTTL(63), nil)).Translate(pkt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ipHeader, err := (&ipv4.Header{
+		TOS:        0xb8,
+		DF:         true,
+		TTL:        63,
+		Protocol:   protoUDP,
+		Src:        [4]byte{198, 51, 100, 1},
+		Dst:        [4]byte{203, 0, 113, 1},
+		PayloadLen: 8 + 16 + len(innerIPv4),
+	}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := append(ipHeader,
+		// UDP
+		0x04, 0xd2, 0x08, 0x68,
+		0x00, 0x2c, 0x6c, 0x5d,
+		// GTP-U
+		0x34, 0xff, 0x00, 0x1c,
+		0x00, 0x00, 0xca, 0xfe,
+		0x00, 0x00, 0x00, 0x85,
+		// PDU Session Container
+		0x01, 0x00, 0x45, 0x00,
+	)
+	res = append(res, innerIPv4...)
+	if diff := cmp.Diff(out, res); diff != "" {
+		t.Error(diff)
+	}
+
+	h, err := gtpu.ParseHeader(out[len(ipHeader)+8:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.TEID != 0xcafe || h.NextExtensionHeaderType != gtpu.ExtensionHeaderTypePDUSessionContainer {
+		t.Errorf("Unexpected GTP-U header: %+v", h)
+	}
+	c, err := gtpu.ParsePDUSessionContainer(out[len(ipHeader)+8+h.MarshalLen():])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.QFI != 5 || !c.RQI {
+		t.Errorf("Unexpected PDU Session Container: %+v", c)
+	}
+}
+
+func TestMGTP4EErrors(t *testing.T) {
+	sid, src := mgtp4eAddrs(t)
+	e := NewMGTP4E(32, nil, nil)
+
+	s := srh.NewSRH(0, [][16]byte{sid, sid})
+	if _, err := e.Translate(buildIPv6(t, 0, src, sid, s, nhIPv4, innerIPv4)); !errors.Is(err, ErrSegmentsLeft) {
+		t.Errorf("Segments Left must be zero: %v", err)
+	}
+	if _, err := e.Translate(buildIPv6(t, 0, src, sid, nil, protoUDP, innerIPv4)); !errors.Is(err, ErrUnsupportedPayload) {
+		t.Errorf("UDP payload should be rejected: %v", err)
+	}
+	if _, err := e.Translate(buildIPv6(t, 0, src, [16]byte{0x20, 0x01, 0x0d, 0xb8}, nil, nhIPv4, innerIPv4)); err == nil {
+		t.Error("Unspecified IPv4 DA should be rejected")
+	}
+	if _, err := e.Translate(buildIPv6(t, 0, src, sid, nil, nhIPv4, innerIPv4)); err != nil {
+		t.Errorf("SRH should be optional: %v", err)
+	}
+}
+
+func TestMGTP4EPeerResolver(t *testing.T) {
+	sid, src := mgtp4eAddrs(t)
+	peers, err := egress.NewStaticPeers(map[netip.Prefix]egress.Peer{
+		netip.MustParsePrefix("203.0.113.0/24"): {Interface: "gtp0", Src: netip.MustParseAddr("192.0.2.1"), Port: 2153},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := NewMGTP4E(32, nil, nil, WithPeerResolver(peers))
+	meta := &Metadata{}
+	out, err := e.Process(buildIPv6(t, 0, src, sid, nil, nhIPv4, innerIPv4), meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.Interface != "gtp0" {
+		t.Errorf("Unexpected egress interface: %q", meta.Interface)
+	}
+	if diff := cmp.Diff(out[12:20], []byte{192, 0, 2, 1, 203, 0, 113, 1}); diff != "" {
+		t.Error(diff)
+	}
+	// UDP Source Port from the IPv6 SA, GTP-U port from the peer
+	if diff := cmp.Diff(out[20:24], []byte{0x04, 0xd2, 0x08, 0x69}); diff != "" {
+		t.Error(diff)
+	}
+
+	other := encoding.NewMGTP4IPv6Dst(netip.MustParsePrefix("2001:db8::/32"), [4]byte{198, 51, 100, 2}, encoding.NewArgsMobSession(5, false, false, 1))
+	d, err := other.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Process(buildIPv6(t, 0, src, [16]byte(d), nil, nhIPv4, innerIPv4), &Metadata{}); !errors.Is(err, egress.ErrUnknownPeer) {
+		t.Errorf("Expected ErrUnknownPeer, got %v", err)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/gtpu"
+)
+
+// GTP6Policy is the SR Policy bound to an End.M.GTP6.D SID.
+type GTP6Policy struct {
+	SID      *encoding.MGTP6D // End.M.GTP6.D (or End.M.GTP6.D.Di) SID and segment carrying Args.Mob.Session
+	Segments [][16]byte       // segments visited before the segments added by SID, may be empty
+	Reduced  bool             // omit the first segment from the SRH (H.Encaps.Red)
+	HopLimit uint8            // 0 means DefaultHopLimit
+}
+
+// GTP6PolicyLookup returns the SR Policy of the GTP-U tunnel identified by the IPv6 DA and the TEID.
+type GTP6PolicyLookup interface {
+	LookupGTP6Policy(dst [16]byte, teid uint32) (*GTP6Policy, error)
+}
+
+// GTP6PolicyLookupFunc is an adapter to allow the use of ordinary functions as GTP6PolicyLookup.
+type GTP6PolicyLookupFunc func(dst [16]byte, teid uint32) (*GTP6Policy, error)
+
+// LookupGTP6Policy calls f(dst, teid).
+func (f GTP6PolicyLookupFunc) LookupGTP6Policy(dst [16]byte, teid uint32) (*GTP6Policy, error) {
+	return f(dst, teid)
+}
+
+// MGTP6D implements End.M.GTP6.D (RFC 9433, section 6.3) and End.M.GTP6.D.Di (RFC 9433, section 6.4):
+// the IPv6/UDP/GTP-U headers are removed, and the packet is encapsulated
+// with the SR Policy of the GTP-U tunnel, carrying the Args.Mob.Session built from the GTP-U header.
+type MGTP6D struct {
+	src     [16]byte
+	lookup  GTP6PolicyLookup
+	options options
+}
+
+// NewMGTP6D creates a MGTP6D using src as IPv6 SA of the SRv6 packets.
+func NewMGTP6D(src netip.Addr, lookup GTP6PolicyLookup, opts ...Option) *MGTP6D {
+	return &MGTP6D{
+		src:     src.As16(),
+		lookup:  lookup,
+		options: newOptions(opts),
+	}
+}
+
+// Translate translates an IPv6/UDP/GTP-U packet (starting with the IPv6 header) into an SRv6 packet.
+// Only G-PDUs carrying IPv4 or IPv6 packets are translated.
+func (m *MGTP6D) Translate(pkt []byte) ([]byte, error) {
+	p, err := parseIPv6(pkt)
+	if err != nil {
+		return nil, err
+	}
+	if p.srh != nil && p.srh.SegmentsLeft != 0 {
+		return nil, ErrSegmentsLeft
+	}
+	if p.nextHeader != protoUDP {
+		return nil, ErrUnsupportedPayload
+	}
+	_, dstPort, payload, err := parseUDP(p.payload)
+	if err != nil {
+		return nil, err
+	}
+	if dstPort != gtpu.Port {
+		return nil, ErrUnsupportedPayload
+	}
+	g, err := gtpu.ParseGPDU(payload)
+	if err != nil {
+		return nil, err
+	}
+	nh, err := innerNextHeader(g.TPDU)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := m.lookup.LookupGTP6Policy(p.dst, g.Header.TEID)
+	if err != nil {
+		return nil, err
+	}
+	args := encoding.NewArgsMobSession(0, false, false, g.Header.TEID)
+	if g.PDUSessionContainer != nil {
+		args = g.PDUSessionContainer.ArgsMobSession(g.Header.TEID)
+	}
+	segments, err := policy.SID.Segments(p.dst, args)
+	if err != nil {
+		return nil, err
+	}
+	path := append(append(make([][16]byte, 0, len(policy.Segments)+len(segments)), policy.Segments...), segments...)
+	tc, tpdu, err := m.options.propagateECN(m.options.trafficClass(p.trafficClass, args.QFI(), g.PDUSessionContainer != nil), g.TPDU)
+	if err != nil {
+		return nil, err
+	}
+	hopLimit := policy.HopLimit
+	if hopLimit == 0 {
+		hopLimit = DefaultHopLimit
+	}
+	if hopLimit, err = m.options.hopLimit(hopLimit, p.hopLimit, tpdu); err != nil {
+		return nil, err
+	}
+	return encapsulate(path, policy.Reduced, hopLimit, m.src, tc, nh, tpdu)
+}
+
+// Process implements Behavior.
+func (m *MGTP6D) Process(pkt []byte, meta *Metadata) ([]byte, error) {
+	return m.Translate(pkt)
+}
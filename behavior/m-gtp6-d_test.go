@@ -0,0 +1,114 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	goerrors "errors"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/encoding/errors"
+	"github.com/nextmn/rfc9433/gtpu"
+	"github.com/nextmn/rfc9433/srh"
+)
+
+// buildGTP6 returns an IPv6/UDP/GTP-U packet with a PDU Session Container.
+func buildGTP6(t *testing.T, src, dst [16]byte, teid uint32, c *gtpu.PDUSessionContainer, tpdu []byte) []byte {
+	t.Helper()
+	h := gtpu.Header{
+		MessageType:             gtpu.MessageTypeGPDU,
+		TEID:                    teid,
+		E:                       true,
+		NextExtensionHeaderType: gtpu.ExtensionHeaderTypePDUSessionContainer,
+		PayloadLen:              c.MarshalLen() + len(tpdu),
+	}
+	gtp := make([]byte, udpHeaderLen+h.MarshalLen()+h.PayloadLen)
+	putUDPHeader(gtp, gtpu.Port, gtpu.Port, len(gtp)-udpHeaderLen)
+	if err := h.MarshalTo(gtp[udpHeaderLen:]); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.MarshalTo(gtp[udpHeaderLen+h.MarshalLen():]); err != nil {
+		t.Fatal(err)
+	}
+	copy(gtp[udpHeaderLen+h.MarshalLen()+c.MarshalLen():], tpdu)
+	return buildIPv6(t, 0xb8, src, dst, nil, protoUDP, gtp)
+}
+
+func TestMGTP6D(t *testing.T) {
+	gnb := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0x0a, 15: 1}
+	sid := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0x00, 0x0d, 15: 1}
+	s1 := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0xff, 15: 1}
+	lastPrefix := netip.MustParsePrefix("2001:db8:e::/48")
+	pkt := buildGTP6(t, gnb, sid, 0xcafe, &gtpu.PDUSessionContainer{PDUType: gtpu.PDUTypeULPDUSessionInformation, QFI: 5}, innerIPv4)
+	last, err := encoding.NewMGTP6IPv6Dst(lastPrefix, encoding.NewArgsMobSession(5, false, false, 0xcafe)).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	srgw := netip.MustParseAddr("2001:db8:d::1")
+
+	for _, tc := range []struct {
+		name   string
+		policy GTP6Policy
+		dst    [16]byte
+		srh    *srh.SRH
+	}{
+		{"End.M.GTP6.D", GTP6Policy{SID: encoding.NewMGTP6D(netip.MustParsePrefix("2001:db8:d::/48"), lastPrefix), Segments: [][16]byte{s1}},
+			s1, srh.NewSRH(nhIPv4, [][16]byte{s1, [16]byte(last)})},
+		{"End.M.GTP6.D.Di", GTP6Policy{SID: encoding.NewMGTP6DDi(netip.MustParsePrefix("2001:db8:d::/48"), lastPrefix), Reduced: true},
+			[16]byte(last), &srh.SRH{NextHeader: nhIPv4, SegmentsLeft: 1, Segments: [][16]byte{sid}}},
+	} {
+		var lookupDst [16]byte
+		var lookupTEID uint32
+		m := NewMGTP6D(srgw, GTP6PolicyLookupFunc(func(dst [16]byte, teid uint32) (*GTP6Policy, error) {
+			lookupDst, lookupTEID = dst, teid
+			return &tc.policy, nil
+		}))
+		out, err := m.Translate(pkt)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if lookupDst != sid || lookupTEID != 0xcafe {
+			t.Errorf("%s: unexpected lookup: %x %x", tc.name, lookupDst, lookupTEID)
+		}
+		p, err := parseIPv6(out)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if p.src != srgw.As16() || p.dst != tc.dst || p.trafficClass != 0xb8 || p.hopLimit != DefaultHopLimit || p.nextHeader != nhIPv4 {
+			t.Errorf("%s: unexpected IPv6 header: %+v", tc.name, p)
+		}
+		if diff := cmp.Diff(p.srh, tc.srh); diff != "" {
+			t.Errorf("%s: %s", tc.name, diff)
+		}
+		if diff := cmp.Diff(p.payload, innerIPv4); diff != "" {
+			t.Errorf("%s: %s", tc.name, diff)
+		}
+	}
+}
+
+func TestMGTP6DErrors(t *testing.T) {
+	gnb := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0x0a, 15: 1}
+	sid := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0x00, 0x0d, 15: 1}
+	pkt := buildGTP6(t, gnb, sid, 0xcafe, &gtpu.PDUSessionContainer{PDUType: gtpu.PDUTypeULPDUSessionInformation, QFI: 5}, innerIPv4)
+	errNoPolicy := goerrors.New("no policy")
+	noPolicy := NewMGTP6D(netip.MustParseAddr("2001:db8:d::1"), GTP6PolicyLookupFunc(func(dst [16]byte, teid uint32) (*GTP6Policy, error) {
+		return nil, errNoPolicy
+	}))
+	if _, err := noPolicy.Translate(pkt); !errors.Is(err, errNoPolicy) {
+		t.Errorf("Errors of the policy lookup should be returned: %v", err)
+	}
+	mismatch := NewMGTP6D(netip.MustParseAddr("2001:db8:d::1"), GTP6PolicyLookupFunc(func(dst [16]byte, teid uint32) (*GTP6Policy, error) {
+		return &GTP6Policy{SID: encoding.NewMGTP6D(netip.MustParsePrefix("2001:db8:f::/48"), netip.MustParsePrefix("2001:db8:e::/48"))}, nil
+	}))
+	if _, err := mismatch.Translate(pkt); !errors.Is(err, errors.ErrSIDMismatch) {
+		t.Errorf("IPv6 DA not matching the SID should be rejected: %v", err)
+	}
+	if _, err := noPolicy.Translate(buildIPv6(t, 0, gnb, sid, nil, nhIPv4, innerIPv4)); !errors.Is(err, ErrUnsupportedPayload) {
+		t.Errorf("Packets other than GTP-U should be rejected: %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"encoding/binary"
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/checksum"
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/gtpu"
+)
+
+// MGTP6E implements End.M.GTP6.E (RFC 9433, section 6.5):
+// SRv6 packets whose active segment is an End.M.GTP6.E SID are translated
+// into IPv6/UDP/GTP-U packets toward the last segment (the gNB),
+// using the TEID and QFI of the Args.Mob.Session of the SID.
+type MGTP6E struct {
+	prefixLen uint
+	src       [16]byte
+	hopLimit  uint8
+	options   options
+}
+
+// NewMGTP6E creates a MGTP6E for End.M.GTP6.E SIDs with the given prefix length,
+// using src as IPv6 SA of the GTP-U packets.
+// If hopLimit is 0, DefaultHopLimit is used.
+func NewMGTP6E(prefixLen uint, src netip.Addr, hopLimit uint8, opts ...Option) *MGTP6E {
+	if hopLimit == 0 {
+		hopLimit = DefaultHopLimit
+	}
+	return &MGTP6E{
+		prefixLen: prefixLen,
+		src:       src.As16(),
+		hopLimit:  hopLimit,
+		options:   newOptions(opts),
+	}
+}
+
+// Translate translates an SRv6 packet (starting with the IPv6 header) into an IPv6/UDP/GTP-U packet.
+// The packet must have a SRH with Segments Left equal to 1; its last segment (SRH[0]) is used as IPv6 DA.
+// The GTP-U header carries a DL PDU Session Container with the QFI and the R bit (as RQI) of the SID.
+func (e *MGTP6E) Translate(pkt []byte) ([]byte, error) {
+	p, err := parseIPv6(pkt)
+	if err != nil {
+		return nil, err
+	}
+	if p.srh == nil {
+		return nil, ErrMalformedPacket
+	}
+	if p.srh.SegmentsLeft != 1 || len(p.srh.Segments) == 0 {
+		return nil, ErrSegmentsLeft
+	}
+	switch p.nextHeader {
+	case nhIPv4, nhIPv6, nhEthernet:
+	default:
+		return nil, ErrUnsupportedPayload
+	}
+	sid, err := encoding.ParseMGTP6IPv6Dst(p.dst, e.prefixLen)
+	if err != nil {
+		return nil, err
+	}
+	dst := p.srh.Segments[0]
+
+	container := gtpu.NewPDUSessionContainer(gtpu.PDUTypeDLPDUSessionInformation, sid.ArgsMobSession())
+	tc, payload, err := e.options.propagateECN(e.options.trafficClass(p.trafficClass, container.QFI, true), p.payload)
+	if err != nil {
+		return nil, err
+	}
+	gtpHeader := gtpu.Header{
+		MessageType:             gtpu.MessageTypeGPDU,
+		TEID:                    sid.PDUSessionID(),
+		E:                       true,
+		NextExtensionHeaderType: gtpu.ExtensionHeaderTypePDUSessionContainer,
+		PayloadLen:              container.MarshalLen() + len(payload),
+	}
+	gtpLen := gtpHeader.MarshalLen() + gtpHeader.PayloadLen
+
+	out := make([]byte, ipv6HeaderLen+udpHeaderLen+gtpLen)
+	hopLimit, err := e.options.hopLimit(e.hopLimit, p.hopLimit, payload)
+	if err != nil {
+		return nil, err
+	}
+	putIPv6Header(out, tc, p.flowLabel, udpHeaderLen+gtpLen, protoUDP, hopLimit, e.src, dst)
+	udp := out[ipv6HeaderLen:]
+	putUDPHeader(udp, gtpu.Port, gtpu.Port, gtpLen)
+	b := udp[udpHeaderLen:]
+	if err := gtpHeader.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	b = b[gtpHeader.MarshalLen():]
+	if err := container.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	copy(b[container.MarshalLen():], payload)
+	binary.BigEndian.PutUint16(udp[6:8], checksum.UDPIPv6(e.src, dst, udp))
+	return out, nil
+}
+
+// Process implements Behavior.
+func (e *MGTP6E) Process(pkt []byte, meta *Metadata) ([]byte, error) {
+	return e.Translate(pkt)
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/checksum"
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/gtpu"
+	"github.com/nextmn/rfc9433/srh"
+)
+
+// mgtp6eSID returns an End.M.GTP6.E SID with a /48 prefix.
+func mgtp6eSID(t *testing.T) [16]byte {
+	t.Helper()
+	sid, err := encoding.NewMGTP6IPv6Dst(netip.MustParsePrefix("2001:db8:e::/48"), encoding.NewArgsMobSession(5, true, false, 0xcafe)).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return [16]byte(sid)
+}
+
+func TestMGTP6E(t *testing.T) {
+	sid := mgtp6eSID(t)
+	gnb := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0x00, 0x0a, 15: 1}
+	srgw := netip.MustParseAddr("2001:db8:d::1")
+	src := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0x00, 0x0c, 15: 1}
+
+	for _, s := range []*srh.SRH{
+		srh.NewSRH(0, [][16]byte{sid, gnb}),
+		{SegmentsLeft: 1, Segments: [][16]byte{gnb}}, // reduced
+	} {
+		out, err := NewMGTP6E(48, srgw, 0).Translate(buildIPv6(t, 0xb8, src, sid, s, nhIPv4, innerIPv4))
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := parseIPv6(out)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.src != srgw.As16() || p.dst != gnb || p.trafficClass != 0xb8 || p.hopLimit != DefaultHopLimit || p.nextHeader != protoUDP || p.srh != nil {
+			t.Errorf("Unexpected IPv6 header: %+v", p)
+		}
+		if c := checksum.Fold(checksum.Sum(checksum.PseudoHeaderIPv6(p.src, p.dst, protoUDP, len(p.payload)), p.payload)); c != 0 {
+			t.Errorf("Invalid UDP checksum")
+		}
+		srcPort, dstPort, payload, err := parseUDP(p.payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if srcPort != gtpu.Port || dstPort != gtpu.Port {
+			t.Errorf("Unexpected UDP ports: %d %d", srcPort, dstPort)
+		}
+		g, err := gtpu.ParseGPDU(payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if g.Header.TEID != 0xcafe {
+			t.Errorf("Unexpected TEID: %x", g.Header.TEID)
+		}
+		if diff := cmp.Diff(g.PDUSessionContainer, &gtpu.PDUSessionContainer{PDUType: gtpu.PDUTypeDLPDUSessionInformation, QFI: 5, RQI: true}); diff != "" {
+			t.Error(diff)
+		}
+		if diff := cmp.Diff(g.TPDU, innerIPv4); diff != "" {
+			t.Error(diff)
+		}
+	}
+}
+
+func TestMGTP6EErrors(t *testing.T) {
+	sid := mgtp6eSID(t)
+	gnb := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0x00, 0x0a, 15: 1}
+	src := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0x00, 0x0c, 15: 1}
+	e := NewMGTP6E(48, netip.MustParseAddr("2001:db8:d::1"), 0)
+
+	if _, err := e.Translate(buildIPv6(t, 0, src, sid, nil, nhIPv4, innerIPv4)); !errors.Is(err, ErrMalformedPacket) {
+		t.Errorf("SRH is required: %v", err)
+	}
+	s := srh.NewSRH(0, [][16]byte{sid, gnb})
+	s.SegmentsLeft = 0
+	if _, err := e.Translate(buildIPv6(t, 0, src, sid, s, nhIPv4, innerIPv4)); !errors.Is(err, ErrSegmentsLeft) {
+		t.Errorf("Segments Left must be 1: %v", err)
+	}
+	if _, err := e.Translate(buildIPv6(t, 0, src, sid, srh.NewSRH(0, [][16]byte{sid, gnb}), protoUDP, innerIPv4)); !errors.Is(err, ErrUnsupportedPayload) {
+		t.Errorf("UDP payload should be rejected: %v", err)
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/checksum"
+	"github.com/nextmn/rfc9433/icmp"
+	"github.com/nextmn/rfc9433/ipv4"
+)
+
+const ipv4DFMask = 0x40 // mask of the DF flag in the 7th byte of the IPv4 header
+
+// MTUAction is the action taken on packets exceeding the egress MTU.
+type MTUAction uint8
+
+const (
+	// MTUDrop drops the packet, and reports an ICMP error to its source.
+	MTUDrop MTUAction = iota
+	// MTUFragment fragments the outer IPv4 header (GTP4 packets emitted by End.M.GTP4.E).
+	// IPv6 packets, and IPv4 packets with the DF bit set (unless cleared), are dropped as with MTUDrop.
+	MTUFragment
+)
+
+// MTUSource gives the MTU towards a destination, e.g. the Path MTU estimate of a pmtu.Cache.
+type MTUSource interface {
+	MTU(dst netip.Addr) uint32
+}
+
+// MTUPolicy configures the handling of packets exceeding the egress MTU.
+type MTUPolicy struct {
+	MTU        int        // egress MTU in bytes; zero disables the check
+	Source     MTUSource  // per-destination MTU; if not nil, the lowest of MTU and the MTU towards the final destination of the packet is used
+	Action     MTUAction  // action taken on packets exceeding the MTU
+	ClearDF    bool       // clear the DF bit of the outer IPv4 header before fragmenting (MTUFragment only)
+	ICMPSource netip.Addr // source of ICMPv6 Packet Too Big messages; if invalid, no ICMPv6 message is generated
+}
+
+// PacketTooBigError is returned when a packet is dropped because it exceeds the egress MTU.
+// It carries the ICMP error message to send to the source of the packet,
+// whose reported MTU takes into account the encapsulation overhead.
+type PacketTooBigError struct {
+	MTU     int        // MTU for the packets of the source
+	Dst     netip.Addr // destination of Message (source of the packet)
+	Message []byte     // ICMPv4 or ICMPv6 message, starting with the ICMP header; nil if no message must be sent
+}
+
+// Error returns a description of the error.
+func (e *PacketTooBigError) Error() string {
+	return fmt.Sprintf("%s (mtu %d)", ErrPacketTooBig, e.MTU)
+}
+
+// Is allows the use of errors.Is(err, ErrPacketTooBig).
+func (e *PacketTooBigError) Is(target error) bool {
+	return target == ErrPacketTooBig
+}
+
+// MTUGuard is a Behavior applying a MTUPolicy to the packets returned by another Behavior
+// (e.g. the SRv6 encapsulation of H.M.GTP4.D, or the GTP4 re-encapsulation of End.M.GTP4.E).
+type MTUGuard struct {
+	b      Behavior
+	policy MTUPolicy
+}
+
+// NewMTUGuard creates a MTUGuard.
+func NewMTUGuard(b Behavior, policy MTUPolicy) *MTUGuard {
+	return &MTUGuard{
+		b:      b,
+		policy: policy,
+	}
+}
+
+// Process processes the packet with the wrapped Behavior, and applies the MTUPolicy to the result.
+// When the packet is fragmented, the first fragment is returned and the following ones are stored in meta.Fragments:
+// meta must not be nil, otherwise the packet is dropped.
+// When the packet is dropped, the returned error is a *PacketTooBigError.
+// When the DF bit is cleared, the Identification field is kept: the ipv4.IDGenerator
+// of the HeaderBuilder must not return 0 for atomic datagrams (e.g. ipv4.ZeroIfDF).
+func (g *MTUGuard) Process(pkt []byte, meta *Metadata) ([]byte, error) {
+	if meta != nil {
+		meta.Fragments = nil
+	}
+	out, err := g.b.Process(pkt, meta)
+	if err != nil {
+		return out, err
+	}
+	mtu := g.mtu(out)
+	if mtu <= 0 || len(out) <= mtu {
+		return out, nil
+	}
+	if g.policy.Action == MTUFragment && meta != nil && len(out) >= ipv4MinHeaderLen && out[0]>>4 == 4 {
+		if g.policy.ClearDF && out[6]&ipv4DFMask != 0 {
+			clearDF(out)
+		}
+		fragments, err := ipv4.Fragment(out, mtu)
+		switch err {
+		case nil:
+			meta.Fragments = fragments[1:]
+			return fragments[0], nil
+		case ipv4.ErrDontFragment:
+		default:
+			return nil, err
+		}
+	}
+	return nil, g.tooBig(pkt, mtu, len(out)-len(pkt))
+}
+
+// mtu returns the MTU applying to a processed packet.
+func (g *MTUGuard) mtu(out []byte) int {
+	mtu := g.policy.MTU
+	if g.policy.Source == nil {
+		return mtu
+	}
+	dst, ok := finalDestination(out)
+	if !ok {
+		return mtu
+	}
+	if m := int(g.policy.Source.MTU(dst)); m > 0 && (mtu <= 0 || m < mtu) {
+		return m
+	}
+	return mtu
+}
+
+// finalDestination returns the final destination of a packet:
+// the last segment of the SRH if any, the destination address otherwise.
+func finalDestination(pkt []byte) (netip.Addr, bool) {
+	if len(pkt) == 0 {
+		return netip.Addr{}, false
+	}
+	switch pkt[0] >> 4 {
+	case 4:
+		if len(pkt) < ipv4MinHeaderLen {
+			return netip.Addr{}, false
+		}
+		return netip.AddrFrom4([4]byte(pkt[16:20])), true
+	case 6:
+		p, err := parseIPv6(pkt)
+		if err != nil {
+			return netip.Addr{}, false
+		}
+		if p.srh != nil && len(p.srh.Segments) > 0 {
+			// the last segment is the first of the list
+			return netip.AddrFrom16(p.srh.Segments[0]), true
+		}
+		return netip.AddrFrom16(p.dst), true
+	}
+	return netip.Addr{}, false
+}
+
+// tooBig returns the error reporting that the packet is too big once processed,
+// given the MTU and the overhead added by the processing.
+func (g *MTUGuard) tooBig(pkt []byte, mtu int, overhead int) error {
+	e := &PacketTooBigError{
+		MTU: mtu - overhead,
+	}
+	if len(pkt) == 0 {
+		return e
+	}
+	switch pkt[0] >> 4 {
+	case 4:
+		// RFC 1191, section 4: only packets with the DF bit set are reported
+		if len(pkt) < ipv4MinHeaderLen || pkt[6]&ipv4DFMask == 0 {
+			return e
+		}
+		if msg, err := icmp.NewFragmentationNeeded(e.MTU, pkt); err == nil {
+			e.Dst = netip.AddrFrom4([4]byte(pkt[12:16]))
+			e.Message = msg
+		}
+	case 6:
+		if !g.policy.ICMPSource.Is6() || len(pkt) < ipv6HeaderLen {
+			return e
+		}
+		if msg, err := icmp.NewPacketTooBig(g.policy.ICMPSource.As16(), e.MTU, pkt); err == nil {
+			e.Dst = netip.AddrFrom16([16]byte(pkt[8:24]))
+			e.Message = msg
+		}
+	}
+	return e
+}
+
+// clearDF clears the DF bit of an IPv4 header, and updates its checksum.
+func clearDF(b []byte) {
+	old := [2]byte(b[6:8])
+	b[6] &^= ipv4DFMask
+	binary.BigEndian.PutUint16(b[10:12], checksum.Update(binary.BigEndian.Uint16(b[10:12]), old[:], b[6:8]))
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"encoding/binary"
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/nextmn/rfc9433/icmp"
+	"github.com/nextmn/rfc9433/ipv4"
+	"github.com/nextmn/rfc9433/pmtu"
+)
+
+// encapsulateIPv4 returns a Behavior encapsulating packets in an IPv4 header.
+func encapsulateIPv4(t *testing.T, df bool) Behavior {
+	t.Helper()
+	return BehaviorFunc(func(pkt []byte, meta *Metadata) ([]byte, error) {
+		h := ipv4.Header{
+			ID:         1,
+			DF:         df,
+			TTL:        64,
+			Protocol:   nhIPv6,
+			Src:        [4]byte{198, 51, 100, 1},
+			Dst:        [4]byte{203, 0, 113, 1},
+			PayloadLen: len(pkt),
+		}
+		b, err := h.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return append(b, pkt...), nil
+	})
+}
+
+func TestMTUGuard(t *testing.T) {
+	src := [16]byte{0x20, 0x01, 0x0d, 0xb8, 15: 1}
+	dst := [16]byte{0x20, 0x01, 0x0d, 0xb8, 15: 2}
+	pkt := buildIPv6(t, 0, src, dst, nil, nhIPv4, make([]byte, 100))
+	icmpSource := netip.MustParseAddr("2001:db8::ff")
+
+	// packet fitting in the MTU
+	g := NewMTUGuard(encapsulateIPv4(t, true), MTUPolicy{MTU: len(pkt) + 20})
+	meta := &Metadata{}
+	out, err := g.Process(pkt, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out) != len(pkt)+20 || meta.Fragments != nil {
+		t.Errorf("Packet should be forwarded unchanged: %d bytes, %d fragments", len(out), len(meta.Fragments))
+	}
+
+	// drop with ICMPv6 Packet Too Big
+	g = NewMTUGuard(encapsulateIPv4(t, true), MTUPolicy{MTU: 100, Action: MTUFragment, ICMPSource: icmpSource})
+	_, err = g.Process(pkt, meta)
+	var tooBig *PacketTooBigError
+	if !errors.As(err, &tooBig) || !errors.Is(err, ErrPacketTooBig) {
+		t.Fatalf("Expected PacketTooBigError, got %v", err)
+	}
+	if tooBig.MTU != 80 || tooBig.Dst != netip.AddrFrom16(src) {
+		t.Errorf("Unexpected error: %d, %s", tooBig.MTU, tooBig.Dst)
+	}
+	if mtu, _, err := icmp.ParsePacketTooBig(tooBig.Message); err != nil || mtu != 1280 {
+		t.Errorf("Unexpected ICMPv6 message: %d, %v", mtu, err)
+	}
+
+	// fragmentation after clearing DF
+	g = NewMTUGuard(encapsulateIPv4(t, true), MTUPolicy{MTU: 100, Action: MTUFragment, ClearDF: true})
+	out, err = g.Process(pkt, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(meta.Fragments) != 1 {
+		t.Fatalf("Unexpected number of fragments: %d", len(meta.Fragments)+1)
+	}
+	for _, f := range append([][]byte{out}, meta.Fragments...) {
+		if len(f) > 100 {
+			t.Errorf("Fragment exceeds the MTU: %d bytes", len(f))
+		}
+		if f[6]&ipv4DFMask != 0 || ipv4.Checksum(f[:ipv4MinHeaderLen]) != 0 {
+			t.Errorf("Unexpected fragment header: %x", f[:ipv4MinHeaderLen])
+		}
+	}
+	if n := len(out) + len(meta.Fragments[0]) - 2*ipv4MinHeaderLen; n != len(pkt) {
+		t.Errorf("Unexpected length of fragmented data: %d", n)
+	}
+
+	// fragmentation requires metadata
+	if _, err := g.Process(pkt, nil); !errors.Is(err, ErrPacketTooBig) {
+		t.Errorf("Expected ErrPacketTooBig, got %v", err)
+	}
+	// no ICMPv6 message without source address
+	g = NewMTUGuard(encapsulateIPv4(t, false), MTUPolicy{MTU: 100})
+	if _, err := g.Process(pkt, meta); !errors.As(err, &tooBig) || tooBig.Message != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestMTUGuardIPv4(t *testing.T) {
+	h := ipv4.Header{
+		DF:         true,
+		TTL:        64,
+		Protocol:   protoUDP,
+		Src:        [4]byte{192, 0, 2, 1},
+		Dst:        [4]byte{203, 0, 113, 1},
+		PayloadLen: 100,
+	}
+	pkt, err := h.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkt = append(pkt, make([]byte, 100)...)
+
+	// drop with ICMPv4 Fragmentation Needed, since DF is set on the input packet
+	g := NewMTUGuard(encapsulateIPv4(t, false), MTUPolicy{MTU: 120})
+	_, err = g.Process(pkt, &Metadata{})
+	var tooBig *PacketTooBigError
+	if !errors.As(err, &tooBig) {
+		t.Fatalf("Expected PacketTooBigError, got %v", err)
+	}
+	if tooBig.MTU != 100 || tooBig.Dst != netip.AddrFrom4(h.Src) {
+		t.Errorf("Unexpected error: %d, %s", tooBig.MTU, tooBig.Dst)
+	}
+	if len(tooBig.Message) != 8+len(pkt) || binary.BigEndian.Uint16(tooBig.Message[6:8]) != 100 {
+		t.Errorf("Unexpected ICMPv4 message: %x", tooBig.Message)
+	}
+
+	// input packet without DF: no ICMPv4 message
+	pkt[6] &^= ipv4DFMask
+	if _, err := g.Process(pkt, &Metadata{}); !errors.As(err, &tooBig) || tooBig.Message != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestMTUGuardSource(t *testing.T) {
+	src := [16]byte{0x20, 0x01, 0x0d, 0xb8, 15: 1}
+	dst := [16]byte{0x20, 0x01, 0x0d, 0xb8, 15: 2}
+	pkt := buildIPv6(t, 0, src, dst, nil, nhIPv4, make([]byte, 1300))
+	cache := pmtu.NewCache(1500, 0)
+	g := NewMTUGuard(encapsulateIPv4(t, false), MTUPolicy{MTU: 1500, Source: cache})
+
+	// link MTU of the cache
+	if _, err := g.Process(pkt, &Metadata{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Path MTU learned for the destination of the outer header
+	cache.Update(netip.AddrFrom4([4]byte{203, 0, 113, 1}), 1280)
+	_, err := g.Process(pkt, &Metadata{})
+	var tooBig *PacketTooBigError
+	if !errors.As(err, &tooBig) {
+		t.Fatalf("Expected PacketTooBigError, got %v", err)
+	}
+	if tooBig.MTU != 1280-20 {
+		t.Errorf("Unexpected MTU: %d", tooBig.MTU)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"encoding/binary"
+
+	"github.com/nextmn/rfc9433/checksum"
+	"github.com/nextmn/rfc9433/egress"
+	"github.com/nextmn/rfc9433/headend"
+	"github.com/nextmn/rfc9433/qos"
+)
+
+// Option configures the outer headers built by a translator
+// (MGTP4E, MGTP6E, HMGTP4D, MGTP6D).
+type Option func(*options)
+
+type options struct {
+	dscp    qos.DSCPMapper
+	ecn     bool // ECN propagation enabled
+	ecnMode qos.ECNMode
+	ttl     *HopLimitPolicy
+	ports   *headend.SourcePortGenerator
+	peers   egress.PeerResolver
+}
+
+// HopLimitMode defines how the Hop Limit (or TTL) of the outer header is set by a translator.
+type HopLimitMode uint8
+
+const (
+	// HopLimitFixed sets a fixed Hop Limit (or TTL).
+	HopLimitFixed HopLimitMode = iota
+	// HopLimitCopyInner copies the Hop Limit (or TTL) of the inner IPv4 or IPv6 packet.
+	// For other packets (e.g. Ethernet PDU Sessions), the configured Hop Limit of the translator is used.
+	HopLimitCopyInner
+	// HopLimitDecrement uses the Hop Limit (or TTL) of the incoming outer header, decremented by one.
+	// Packets whose Hop Limit would reach zero are dropped (ErrHopLimitExceeded).
+	HopLimitDecrement
+)
+
+// HopLimitPolicy defines the Hop Limit (or TTL) of the outer header built by a translator.
+type HopLimitPolicy struct {
+	Mode  HopLimitMode
+	Value uint8 // Hop Limit of HopLimitFixed; 0 means the configured Hop Limit of the translator
+}
+
+// newOptions returns the options with opts applied.
+func newOptions(opts []Option) options {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithDSCPMapping sets the DSCP of the outer IPv4 or IPv6 header according to the QFI of the packet
+// (Args.Mob.Session of the SID, or PDU Session Container of the GTP-U header).
+// By default, or if the QoS flow is not mapped, the DSCP of the packet is copied.
+func WithDSCPMapping(m qos.DSCPMapper) Option {
+	return func(o *options) {
+		o.dscp = m
+	}
+}
+
+// WithECNMode propagates ECN as done by a tunnel egress followed by a tunnel ingress in the given mode (RFC 6040):
+// congestion experienced in the incoming outer header is propagated to the outgoing outer header (ECNNormal),
+// or to the inner packet (ECNCompatibility). Not-ECT inner packets experiencing congestion are dropped (ErrECNDrop).
+// By default, the ECN field of the incoming outer header is copied.
+func WithECNMode(mode qos.ECNMode) Option {
+	return func(o *options) {
+		o.ecn = true
+		o.ecnMode = mode
+	}
+}
+
+// WithHopLimitPolicy sets the Hop Limit (or TTL) of the outer header according to the policy.
+// By default, the configured Hop Limit of the translator (or the TTLPolicy of its ipv4.HeaderBuilder) is used.
+func WithHopLimitPolicy(p HopLimitPolicy) Option {
+	return func(o *options) {
+		o.ttl = &p
+	}
+}
+
+// WithSourcePortGenerator replaces the UDP Source Port embedded in the IPv6 SA by H.M.GTP4.D
+// with a port derived from the flow of the inner packet, for load balancing in the SR domain.
+// By default, the UDP Source Port of the GTP4 packet is embedded.
+func WithSourcePortGenerator(g *headend.SourcePortGenerator) Option {
+	return func(o *options) {
+		o.ports = g
+	}
+}
+
+// WithPeerResolver finalizes the packets emitted by End.M.GTP4.E with the egress.Peer of their IPv4 DA:
+// its IPv4 SA, GTP-U destination port and egress interface (Metadata.Interface) are used.
+// Packets toward an unknown peer are dropped. By default, the IPv4 SA encoded in the IPv6 SA and gtpu.Port are used.
+func WithPeerResolver(r egress.PeerResolver) Option {
+	return func(o *options) {
+		o.peers = r
+	}
+}
+
+// trafficClass returns the Traffic Class (or TOS) of the outer header,
+// given the one of the packet and the QFI, if any.
+func (o *options) trafficClass(tc uint8, qfi uint8, hasQFI bool) uint8 {
+	if !hasQFI {
+		return tc
+	}
+	return qos.TrafficClass(o.dscp, qfi, tc)
+}
+
+// propagateECN returns the Traffic Class (or TOS) of the outer header, given the one of the incoming outer header
+// and the inner packet. The inner packet is copied if its ECN field is updated.
+func (o *options) propagateECN(tc uint8, inner []byte) (uint8, []byte, error) {
+	if !o.ecn {
+		return tc, inner, nil
+	}
+	ecn := qos.ECN(tc)
+	innerECN, ok := ecnOf(inner)
+	if ok {
+		if ecn, ok = qos.Decapsulate(innerECN, ecn); !ok {
+			return 0, nil, ErrECNDrop
+		}
+		if o.ecnMode == qos.ECNCompatibility && ecn != innerECN {
+			inner = withECN(inner, ecn)
+		}
+	}
+	return qos.SetECN(tc, qos.Encapsulate(o.ecnMode, ecn)), inner, nil
+}
+
+// ecnOf returns the ECN field of an IPv4 or IPv6 packet, and false for other packets.
+func ecnOf(pkt []byte) (uint8, bool) {
+	switch {
+	case len(pkt) >= ipv4MinHeaderLen && pkt[0]>>4 == 4:
+		return qos.ECN(pkt[1]), true
+	case len(pkt) >= ipv6HeaderLen && pkt[0]>>4 == 6:
+		return qos.ECN(pkt[1] >> 4), true
+	default:
+		return 0, false
+	}
+}
+
+// withECN returns a copy of an IPv4 or IPv6 packet with its ECN field set.
+// The checksum of the IPv4 header is updated.
+func withECN(pkt []byte, ecn uint8) []byte {
+	r := append([]byte(nil), pkt...)
+	if r[0]>>4 == 4 {
+		old := [2]byte(r[0:2])
+		r[1] = qos.SetECN(r[1], ecn)
+		binary.BigEndian.PutUint16(r[10:12], checksum.Update(binary.BigEndian.Uint16(r[10:12]), old[:], r[0:2]))
+		return r
+	}
+	r[1] = r[1]&^0x30 | (ecn&0x03)<<4
+	return r
+}
+
+// hopLimit returns the Hop Limit (or TTL) of the outer header, given the configured one,
+// the one of the incoming outer header, and the inner packet.
+func (o *options) hopLimit(configured uint8, incoming uint8, inner []byte) (uint8, error) {
+	if o.ttl == nil {
+		return configured, nil
+	}
+	switch o.ttl.Mode {
+	case HopLimitCopyInner:
+		switch {
+		case len(inner) >= ipv4MinHeaderLen && inner[0]>>4 == 4:
+			return inner[8], nil
+		case len(inner) >= ipv6HeaderLen && inner[0]>>4 == 6:
+			return inner[7], nil
+		}
+	case HopLimitDecrement:
+		if incoming <= 1 {
+			return 0, ErrHopLimitExceeded
+		}
+		return incoming - 1, nil
+	default:
+		if o.ttl.Value != 0 {
+			return o.ttl.Value, nil
+		}
+	}
+	return configured, nil
+}
@@ -0,0 +1,192 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"encoding/binary"
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/flowhash"
+	"github.com/nextmn/rfc9433/headend"
+	"github.com/nextmn/rfc9433/ipv4"
+	"github.com/nextmn/rfc9433/qos"
+)
+
+func TestWithDSCPMapping(t *testing.T) {
+	sid, src := mgtp4eAddrs(t)
+	pkt := buildIPv6(t, 0xb9, src, sid, nil, nhIPv4, innerIPv4)
+	tos := uint8(qos.DSCPCS5<<2 | 0x01) // QFI 5, ECN kept
+
+	// End.M.GTP4.E
+	gtp4, err := NewMGTP4E(32, nil, nil, WithDSCPMapping(qos.DefaultDSCPTable())).Translate(pkt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gtp4[1] != tos {
+		t.Errorf("Unexpected TOS: %#x", gtp4[1])
+	}
+
+	// H.M.GTP4.D
+	gtp4[1] = 0xb9
+	policy := SRv6Policy{DstPrefix: netip.MustParsePrefix("2001:db8::/32")}
+	selector := headend.StaticPrefix(netip.MustParsePrefix("2001:db8:1::/48"))
+	for _, tc := range []struct {
+		name string
+		opts []Option
+		want uint8
+	}{
+		{"default", nil, 0xb9},
+		{"mapped", []Option{WithDSCPMapping(qos.DefaultDSCPTable())}, tos},
+		{"not mapped", []Option{WithDSCPMapping(&qos.DSCPTable{})}, 0xb9},
+	} {
+		out, err := NewHMGTP4D(policy, selector, nil, tc.opts...).Translate(gtp4)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		p, err := parseIPv6(out)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if p.trafficClass != tc.want {
+			t.Errorf("%s: unexpected Traffic Class: %#x", tc.name, p.trafficClass)
+		}
+	}
+}
+
+func TestWithECNMode(t *testing.T) {
+	sid, src := mgtp4eAddrs(t)
+	notECT := append([]byte(nil), innerIPv4...)
+	binary.BigEndian.PutUint16(notECT[10:12], ipv4.Checksum(notECT))
+	ect0 := withECN(notECT, qos.ECT0)
+	ce := withECN(notECT, qos.CE)
+	if ipv4.Checksum(ce) != 0 {
+		t.Fatal("Invalid checksum of the inner packet")
+	}
+	for _, tc := range []struct {
+		name     string
+		opts     []Option
+		outer    uint8 // ECN field of the incoming outer header
+		inner    []byte
+		want     uint8 // ECN field of the outgoing outer header
+		wantPkt  []byte
+		wantDrop bool
+	}{
+		{"default", nil, qos.CE, notECT, qos.CE, notECT, false},
+		{"normal", []Option{WithECNMode(qos.ECNNormal)}, qos.CE, ect0, qos.CE, ect0, false},
+		{"normal ECT(1)", []Option{WithECNMode(qos.ECNNormal)}, qos.ECT1, ect0, qos.ECT1, ect0, false},
+		{"normal Not-ECT", []Option{WithECNMode(qos.ECNNormal)}, qos.ECT0, notECT, qos.NotECT, notECT, false},
+		{"normal drop", []Option{WithECNMode(qos.ECNNormal)}, qos.CE, notECT, 0, nil, true},
+		{"compatibility", []Option{WithECNMode(qos.ECNCompatibility)}, qos.CE, ect0, qos.NotECT, ce, false},
+		{"compatibility drop", []Option{WithECNMode(qos.ECNCompatibility)}, qos.CE, notECT, 0, nil, true},
+	} {
+		pkt := buildIPv6(t, 0xb8|tc.outer, src, sid, nil, nhIPv4, tc.inner)
+		out, err := NewMGTP4E(32, nil, nil, tc.opts...).Translate(pkt)
+		if tc.wantDrop {
+			if !errors.Is(err, ErrECNDrop) {
+				t.Errorf("%s: expected ErrECNDrop, got %v", tc.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if out[1] != 0xb8|tc.want {
+			t.Errorf("%s: unexpected TOS: %#x", tc.name, out[1])
+		}
+		if diff := cmp.Diff(out[len(out)-len(tc.wantPkt):], tc.wantPkt); diff != "" {
+			t.Errorf("%s: %s", tc.name, diff)
+		}
+		if tc.name == "compatibility" && cmp.Diff(pkt[ipv6HeaderLen:], ect0) != "" {
+			t.Errorf("%s: the incoming packet should not be modified", tc.name)
+		}
+	}
+}
+
+func TestWithHopLimitPolicy(t *testing.T) {
+	sid, src := mgtp4eAddrs(t)
+	inner := append([]byte(nil), innerIPv4...)
+	inner[8] = 30 // TTL
+	for _, tc := range []struct {
+		name     string
+		opts     []Option
+		incoming uint8
+		want     uint8 // TTL of the GTP4 packet
+		wantSRv6 uint8 // Hop Limit of the SRv6 packet built back from the GTP4 packet
+		err      error
+	}{
+		{"default", nil, 10, ipv4.DefaultTTL, DefaultHopLimit, nil},
+		{"fixed", []Option{WithHopLimitPolicy(HopLimitPolicy{Mode: HopLimitFixed, Value: 5})}, 10, 5, 5, nil},
+		{"fixed default", []Option{WithHopLimitPolicy(HopLimitPolicy{Mode: HopLimitFixed})}, 10, ipv4.DefaultTTL, DefaultHopLimit, nil},
+		{"copy inner", []Option{WithHopLimitPolicy(HopLimitPolicy{Mode: HopLimitCopyInner})}, 10, 30, 30, nil},
+		{"decrement", []Option{WithHopLimitPolicy(HopLimitPolicy{Mode: HopLimitDecrement})}, 10, 9, 8, nil},
+		{"decrement exceeded", []Option{WithHopLimitPolicy(HopLimitPolicy{Mode: HopLimitDecrement})}, 1, 0, 0, ErrHopLimitExceeded},
+	} {
+		pkt := buildIPv6(t, 0, src, sid, nil, nhIPv4, inner)
+		pkt[7] = tc.incoming
+		out, err := NewMGTP4E(32, nil, nil, tc.opts...).Translate(pkt)
+		if tc.err != nil {
+			if !errors.Is(err, tc.err) {
+				t.Errorf("%s: expected %v, got %v", tc.name, tc.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if out[8] != tc.want {
+			t.Errorf("%s: unexpected TTL: %d", tc.name, out[8])
+		}
+		if ipv4.Checksum(out[:ipv4MinHeaderLen]) != 0 {
+			t.Errorf("%s: invalid checksum", tc.name)
+		}
+
+		// H.M.GTP4.D, back to SRv6
+		out, err = NewHMGTP4D(SRv6Policy{DstPrefix: netip.MustParsePrefix("2001:db8::/32")},
+			headend.StaticPrefix(netip.MustParsePrefix("2001:db8:1::/48")), nil, tc.opts...).Translate(out)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if out[7] != tc.wantSRv6 {
+			t.Errorf("%s: unexpected Hop Limit: %d", tc.name, out[7])
+		}
+	}
+}
+
+func TestWithSourcePortGenerator(t *testing.T) {
+	sid, src := mgtp4eAddrs(t)
+	gtp4, err := NewMGTP4E(32, nil, nil).Translate(buildIPv6(t, 0, src, sid, nil, nhIPv4, innerIPv4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g, err := headend.NewSourcePortGenerator(flowhash.New(flowhash.Key{1}, false), headend.PortRange{Min: 40000, Max: 40009})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := NewHMGTP4D(SRv6Policy{DstPrefix: netip.MustParsePrefix("2001:db8::/32")},
+		headend.StaticPrefix(netip.MustParsePrefix("2001:db8:1::/48")), nil, WithSourcePortGenerator(g)).Translate(gtp4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := parseIPv6(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sa, err := encoding.ParseMGTP4IPv6SrcWithScheme(p.src, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := g.PortForPacket(innerIPv4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sa.UDPPortNumber() != want || want < 40000 || want > 40009 {
+		t.Errorf("Unexpected UDP Source Port: %d instead of %d", sa.UDPPortNumber(), want)
+	}
+}
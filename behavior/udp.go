@@ -0,0 +1,29 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import "encoding/binary"
+
+// parseUDP parses an UDP datagram. The checksum is not verified.
+func parseUDP(b []byte) (srcPort uint16, dstPort uint16, payload []byte, err error) {
+	if len(b) < udpHeaderLen {
+		return 0, 0, nil, ErrTooShortToParse
+	}
+	l := int(binary.BigEndian.Uint16(b[4:6]))
+	if l < udpHeaderLen || l > len(b) {
+		return 0, 0, nil, ErrMalformedPacket
+	}
+	return binary.BigEndian.Uint16(b[0:2]), binary.BigEndian.Uint16(b[2:4]), b[udpHeaderLen:l], nil
+}
+
+// putUDPHeader puts an UDP header in b. The checksum is set to zero.
+func putUDPHeader(b []byte, srcPort uint16, dstPort uint16, payloadLen int) {
+	binary.BigEndian.PutUint16(b[0:2], srcPort)
+	binary.BigEndian.PutUint16(b[2:4], dstPort)
+	binary.BigEndian.PutUint16(b[4:6], uint16(udpHeaderLen+payloadLen))
+	b[6] = 0
+	b[7] = 0
+}
@@ -0,0 +1,33 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package behavior
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseUDP(t *testing.T) {
+	b := []byte{0x04, 0xd2, 0x08, 0x68, 0x00, 0x0a, 0x00, 0x00, 0xca, 0xfe, 0xff}
+	srcPort, dstPort, payload, err := parseUDP(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if srcPort != 1234 || dstPort != 2152 {
+		t.Errorf("Unexpected ports: %d %d", srcPort, dstPort)
+	}
+	if diff := cmp.Diff(payload, []byte{0xca, 0xfe}); diff != "" {
+		t.Error(diff)
+	}
+	if _, _, _, err := parseUDP(b[:7]); !errors.Is(err, ErrTooShortToParse) {
+		t.Errorf("Expected ErrTooShortToParse, got %v", err)
+	}
+	if _, _, _, err := parseUDP(b[:9]); !errors.Is(err, ErrMalformedPacket) {
+		t.Errorf("Expected ErrMalformedPacket, got %v", err)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2023 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package bitfield reads and writes bit fields of 128 bits addresses (e.g. IPv6 addresses),
+// which is needed to implement SID layouts.
+// Offsets are in bits, from the most significant bit of the address.
+//
+// A SID layout can be declared with a struct whose fields are tagged with
+// `bitfield:"offset,width"`. Offsets are relative to the start of the struct
+// in the address; width is optional and defaults to the size of the field.
+// Supported field types are bool, unsigned integers and byte arrays
+// (which are always fully encoded). Fields without tag are ignored.
+//
+//	type ArgsMobSession struct {
+//		QFI  uint8  `bitfield:"0,6"`
+//		R    bool   `bitfield:"6"`
+//		U    bool   `bitfield:"7"`
+//		TEID uint32 `bitfield:"8"`
+//	}
+package bitfield
+
+import (
+	"encoding/binary"
+
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+// ExtractBits returns the width bits (at most 64) of addr starting at offset.
+func ExtractBits(addr [16]byte, offset uint, width uint) (uint64, error) {
+	if width > 64 || offset+width > 128 {
+		return 0, errors.ErrOutOfRange
+	}
+	if width == 0 {
+		return 0, nil
+	}
+	hi, lo := Shl128(binary.BigEndian.Uint64(addr[:8]), binary.BigEndian.Uint64(addr[8:]), offset)
+	_, lo = Shr128(hi, lo, 128-width)
+	return lo, nil
+}
+
+// InsertBits sets the width bits (at most 64) of addr starting at offset to value.
+func InsertBits(addr *[16]byte, offset uint, width uint, value uint64) error {
+	if width > 64 || offset+width > 128 {
+		return errors.ErrOutOfRange
+	}
+	if width == 0 {
+		return nil
+	}
+	mask := ^uint64(0) >> (64 - width)
+	if value&^mask != 0 {
+		return errors.ErrOutOfRange
+	}
+	mHi, mLo := Shl128(0, mask, 128-offset-width)
+	vHi, vLo := Shl128(0, value, 128-offset-width)
+	binary.BigEndian.PutUint64(addr[:8], binary.BigEndian.Uint64(addr[:8])&^mHi|vHi)
+	binary.BigEndian.PutUint64(addr[8:], binary.BigEndian.Uint64(addr[8:])&^mLo|vLo)
+	return nil
+}
+
+// ExtractBytes returns the length bytes of addr starting at offset.
+func ExtractBytes(addr [16]byte, offset uint, length uint) ([]byte, error) {
+	if length > 16 {
+		return nil, errors.ErrTooShortToParse
+	}
+	if offset+length*8 > 128 {
+		return nil, errors.ErrOutOfRange
+	}
+	hi, lo := Shl128(binary.BigEndian.Uint64(addr[:8]), binary.BigEndian.Uint64(addr[8:]), offset)
+	var w [16]byte
+	binary.BigEndian.PutUint64(w[:8], hi)
+	binary.BigEndian.PutUint64(w[8:], lo)
+	ret := make([]byte, length)
+	copy(ret, w[:])
+	return ret, nil
+}
+
+// InsertBytes writes data in b starting at offset.
+// The bits of b where data is written must be zero: they are not reset.
+func InsertBytes(b []byte, offset uint, data []byte) error {
+	endByte := offset / 8
+	shift := offset % 8
+	isOffset := 0
+	if shift > 0 {
+		isOffset = 1
+	}
+	if isOffset+int(endByte)+len(data) > len(b) {
+		return errors.ErrTooShortToMarshal
+	}
+	if len(b) < 16 || isOffset+int(endByte)+len(data) > 16 {
+		// not a 128 bits word
+		insertBytesPerByte(b, endByte, shift, data)
+		return nil
+	}
+	// 128 bits word operations
+	var a [16]byte
+	copy(a[:], data)
+	aHi, aLo := Shr128(binary.BigEndian.Uint64(a[:8]), binary.BigEndian.Uint64(a[8:]), offset)
+	binary.BigEndian.PutUint64(b[:8], binary.BigEndian.Uint64(b[:8])|aHi)
+	binary.BigEndian.PutUint64(b[8:16], binary.BigEndian.Uint64(b[8:16])|aLo)
+	return nil
+}
+
+// insertBytesPerByte is InsertBytes using per-byte operations.
+func insertBytesPerByte(b []byte, endByte uint, shift uint, data []byte) {
+	if shift == 0 {
+		// concatenate slices
+		copy(b[endByte:], data[:])
+		return
+	}
+	//  add right part of bytes
+	for i, v := range data {
+		b[int(endByte)+i] |= v >> shift
+	}
+	// add left part of bytes
+	for i, v := range data {
+		b[int(endByte)+1+i] |= v << (8 - shift)
+	}
+}
+
+// Shl128 shifts the 128 bits value hi:lo to the left by n bits.
+func Shl128(hi uint64, lo uint64, n uint) (uint64, uint64) {
+	switch {
+	case n >= 128:
+		return 0, 0
+	case n >= 64:
+		return lo << (n - 64), 0
+	case n == 0:
+		return hi, lo
+	default:
+		return hi<<n | lo>>(64-n), lo << n
+	}
+}
+
+// Shr128 shifts the 128 bits value hi:lo to the right by n bits.
+func Shr128(hi uint64, lo uint64, n uint) (uint64, uint64) {
+	switch {
+	case n >= 128:
+		return 0, 0
+	case n >= 64:
+		return 0, hi >> (n - 64)
+	case n == 0:
+		return hi, lo
+	default:
+		return hi >> n, lo>>n | hi<<(64-n)
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2023 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package bitfield
+
+import (
+	"fmt"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+func TestExtractBytes(t *testing.T) {
+	res, err := ExtractBytes(netip.MustParseAddr("::ff:192.168.0.1").As16(), 128-8*4, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(res, []byte{192, 168, 0, 1}); diff != "" {
+		t.Error(diff)
+	}
+	res, err = ExtractBytes(netip.MustParseAddr("ff00::").As16(), 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(res, []byte{0xFE}); diff != "" {
+		t.Error(diff)
+	}
+	res, err = ExtractBytes(netip.MustParseAddr("ff55::").As16(), 2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(res, []byte{0xFD, 0x54}); diff != "" {
+		t.Error(diff)
+	}
+	res, err = ExtractBytes(netip.MustParseAddr("3fff:0cb0:0710:1600::").As16(), 20, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(res, []byte{203, 0, 113, 1}); diff != "" {
+		t.Error(diff)
+	}
+	res, err = ExtractBytes(netip.MustParseAddr("::a:bcde:f123").As16(), 100, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(res, []byte{0xCD, 0xEF, 0x12}); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestInsertBytes(t *testing.T) {
+	b1 := []byte{0xFF, 0x00, 0x00, 0x00}
+	if err := InsertBytes(b1, 8, []byte{0x00, 0xAA}); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(b1, []byte{0xFF, 0x00, 0xAA, 0x00}); diff != "" {
+		t.Error(diff)
+	}
+	b2 := []byte{0xE0, 0x00, 0x00, 0x00}
+	if err := InsertBytes(b2, 3, []byte{0x00, 0xAA, 0xFF}); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(b2, []byte{0xE0, 0x15, 0x5F, 0xE0}); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestInsertBytesLong(t *testing.T) {
+	b := make([]byte, 20)
+	if err := InsertBytes(b, 8*14+1, []byte{0xFF, 0xFF, 0xFF}); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(b[14:], []byte{0x7F, 0xFF, 0xFF, 0x80, 0x00, 0x00}); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestWordOperations(t *testing.T) {
+	appendThis := []byte{0xA5, 0x5A, 0xFF, 0x01, 0x80}
+	for endBit := uint(0); endBit+8*uint(len(appendThis)) <= 128-8; endBit++ {
+		word := make([]byte, 16)
+		if err := InsertBytes(word, endBit, appendThis); err != nil {
+			t.Fatal(err)
+		}
+		bytes := make([]byte, 16)
+		insertBytesPerByte(bytes, endBit/8, endBit%8, appendThis)
+		if diff := cmp.Diff(word, bytes); diff != "" {
+			t.Errorf("InsertBytes at bit %d: %s", endBit, diff)
+		}
+		res, err := ExtractBytes([16]byte(word), endBit, uint(len(appendThis)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(res, appendThis); diff != "" {
+			t.Errorf("ExtractBytes at bit %d: %s", endBit, diff)
+		}
+	}
+}
+
+func TestShift128(t *testing.T) {
+	tests := []struct {
+		n     uint
+		shlHi uint64
+		shlLo uint64
+		shrHi uint64
+		shrLo uint64
+	}{
+		{n: 0, shlHi: 0x0123456789abcdef, shlLo: 0xfedcba9876543210, shrHi: 0x0123456789abcdef, shrLo: 0xfedcba9876543210},
+		{n: 4, shlHi: 0x123456789abcdeff, shlLo: 0xedcba98765432100, shrHi: 0x00123456789abcde, shrLo: 0xffedcba987654321},
+		{n: 64, shlHi: 0xfedcba9876543210, shlLo: 0, shrHi: 0, shrLo: 0x0123456789abcdef},
+		{n: 68, shlHi: 0xedcba98765432100, shlLo: 0, shrHi: 0, shrLo: 0x00123456789abcde},
+		{n: 128, shlHi: 0, shlLo: 0, shrHi: 0, shrLo: 0},
+	}
+	for _, tc := range tests {
+		if hi, lo := Shl128(0x0123456789abcdef, 0xfedcba9876543210, tc.n); hi != tc.shlHi || lo != tc.shlLo {
+			t.Errorf("Shl128(%d) = %x %x", tc.n, hi, lo)
+		}
+		if hi, lo := Shr128(0x0123456789abcdef, 0xfedcba9876543210, tc.n); hi != tc.shrHi || lo != tc.shrLo {
+			t.Errorf("Shr128(%d) = %x %x", tc.n, hi, lo)
+		}
+	}
+}
+
+func ExampleInsertBits() {
+	var addr [16]byte
+	// 16 bits UDP port at offset 80
+	if err := InsertBits(&addr, 80, 16, 2152); err != nil {
+		return
+	}
+	port, _ := ExtractBits(addr, 80, 16)
+	fmt.Println(port)
+	// Output: 2152
+}
+
+func TestBits(t *testing.T) {
+	addr := netip.MustParseAddr("ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff").As16()
+	if err := InsertBits(&addr, 60, 8, 0); err != nil {
+		t.Fatal(err)
+	}
+	if a := netip.AddrFrom16(addr); a != netip.MustParseAddr("ffff:ffff:ffff:fff0:0fff:ffff:ffff:ffff") {
+		t.Errorf("Unexpected address: %s", a)
+	}
+	if err := InsertBits(&addr, 60, 8, 0xA5); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := ExtractBits(addr, 60, 8); err != nil || v != 0xA5 {
+		t.Errorf("Unexpected value: %x %v", v, err)
+	}
+	if v, err := ExtractBits(addr, 64, 64); err != nil || v != 0x5fffffffffffffff {
+		t.Errorf("Unexpected value: %x %v", v, err)
+	}
+	if err := InsertBits(&addr, 0, 4, 0x10); err != errors.ErrOutOfRange {
+		t.Errorf("Value too large should be rejected: %v", err)
+	}
+	if _, err := ExtractBits(addr, 100, 29); err != errors.ErrOutOfRange {
+		t.Errorf("Field out of the address should be rejected: %v", err)
+	}
+	if _, err := ExtractBits(addr, 0, 65); err != errors.ErrOutOfRange {
+		t.Errorf("Field too large should be rejected: %v", err)
+	}
+}
+
+func BenchmarkExtractBytes(b *testing.B) {
+	addr := netip.MustParseAddr("fd00:1:1:a00:401:400:0:100").As16()
+	for i := 0; i < b.N; i++ {
+		ExtractBytes(addr, 49, 5)
+	}
+}
+
+func BenchmarkInsertBytes(b *testing.B) {
+	buf := make([]byte, 16)
+	args := []byte{0x04, 0x00, 0x00, 0x01, 0x00}
+	for i := 0; i < b.N; i++ {
+		InsertBytes(buf, 49+32, args)
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package bitfield
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+// field is a tagged struct field.
+type field struct {
+	index  int
+	kind   reflect.Kind
+	offset uint
+	width  uint
+}
+
+// layouts caches the fields of each struct type.
+var layouts sync.Map // map[reflect.Type][]field
+
+// Marshal returns an address with the fields of the struct v.
+func Marshal(v any) ([16]byte, error) {
+	var addr [16]byte
+	if err := InsertFields(&addr, 0, v); err != nil {
+		return [16]byte{}, err
+	}
+	return addr, nil
+}
+
+// Unmarshal sets the fields of the struct pointed to by v from addr.
+func Unmarshal(addr [16]byte, v any) error {
+	return ExtractFields(addr, 0, v)
+}
+
+// InsertFields writes the fields of the struct v (or pointer to struct) in addr, starting at offset.
+func InsertFields(addr *[16]byte, offset uint, v any) error {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return errors.ErrUnsupportedType
+	}
+	fields, err := layoutOf(rv.Type())
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		fv := rv.Field(f.index)
+		switch f.kind {
+		case reflect.Bool:
+			var b uint64
+			if fv.Bool() {
+				b = 1
+			}
+			if err := InsertBits(addr, offset+f.offset, f.width, b); err != nil {
+				return err
+			}
+		case reflect.Array:
+			for i := 0; i < fv.Len(); i++ {
+				if err := InsertBits(addr, offset+f.offset+uint(8*i), 8, fv.Index(i).Uint()); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := InsertBits(addr, offset+f.offset, f.width, fv.Uint()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ExtractFields sets the fields of the struct pointed to by v from addr, starting at offset.
+func ExtractFields(addr [16]byte, offset uint, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.ErrUnsupportedType
+	}
+	rv = rv.Elem()
+	fields, err := layoutOf(rv.Type())
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		fv := rv.Field(f.index)
+		switch f.kind {
+		case reflect.Bool:
+			b, err := ExtractBits(addr, offset+f.offset, f.width)
+			if err != nil {
+				return err
+			}
+			fv.SetBool(b != 0)
+		case reflect.Array:
+			for i := 0; i < fv.Len(); i++ {
+				b, err := ExtractBits(addr, offset+f.offset+uint(8*i), 8)
+				if err != nil {
+					return err
+				}
+				fv.Index(i).SetUint(b)
+			}
+		default:
+			b, err := ExtractBits(addr, offset+f.offset, f.width)
+			if err != nil {
+				return err
+			}
+			fv.SetUint(b)
+		}
+	}
+	return nil
+}
+
+// layoutOf returns the tagged fields of the struct type t.
+func layoutOf(t reflect.Type) ([]field, error) {
+	if l, ok := layouts.Load(t); ok {
+		return l.([]field), nil
+	}
+	fields := make([]field, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, ok := sf.Tag.Lookup("bitfield")
+		if !ok || tag == "-" {
+			continue
+		}
+		if !sf.IsExported() {
+			return nil, errors.ErrUnsupportedType
+		}
+		f := field{index: i, kind: sf.Type.Kind()}
+		var size uint
+		switch f.kind {
+		case reflect.Bool:
+			size = 1
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			size = uint(sf.Type.Bits())
+		case reflect.Array:
+			if sf.Type.Elem().Kind() != reflect.Uint8 {
+				return nil, errors.ErrUnsupportedType
+			}
+			size = uint(8 * sf.Type.Len())
+		default:
+			return nil, errors.ErrUnsupportedType
+		}
+		offset, width, hasWidth := strings.Cut(tag, ",")
+		o, err := strconv.ParseUint(offset, 10, 8)
+		if err != nil {
+			return nil, errors.ErrSyntax
+		}
+		f.offset = uint(o)
+		f.width = size
+		if hasWidth {
+			w, err := strconv.ParseUint(width, 10, 8)
+			if err != nil {
+				return nil, errors.ErrSyntax
+			}
+			f.width = uint(w)
+		}
+		if f.width > size || (f.kind == reflect.Array && f.width != size) || f.offset+f.width > 128 {
+			return nil, errors.ErrOutOfRange
+		}
+		fields = append(fields, f)
+	}
+	l, _ := layouts.LoadOrStore(t, fields)
+	return l.([]field), nil
+}
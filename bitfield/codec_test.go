@@ -0,0 +1,100 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package bitfield
+
+import (
+	"fmt"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+type testArgsMobSession struct {
+	QFI  uint8  `bitfield:"0,6"`
+	R    bool   `bitfield:"6"`
+	U    bool   `bitfield:"7"`
+	TEID uint32 `bitfield:"8"`
+}
+
+type testMGTP4IPv6Src struct {
+	IPv4      [4]byte `bitfield:"64"`
+	UDP       uint16  `bitfield:"96"`
+	PrefixLen uint8   `bitfield:"121,7"`
+	Ignored   uint64
+}
+
+func ExampleMarshal() {
+	addr, err := Marshal(testArgsMobSession{QFI: 9, TEID: 0xCAFE})
+	if err != nil {
+		return
+	}
+	fmt.Println(netip.AddrFrom16(addr))
+	// Output: 2400:ca:fe00::
+}
+
+func TestCodec(t *testing.T) {
+	src := testMGTP4IPv6Src{
+		IPv4:      [4]byte{10, 0, 4, 1},
+		UDP:       2152,
+		PrefixLen: 64,
+	}
+	addr := netip.MustParseAddr("fd00:1:1:1::").As16()
+	if err := InsertFields(&addr, 0, &src); err != nil {
+		t.Fatal(err)
+	}
+	if a := netip.AddrFrom16(addr); a != netip.MustParseAddr("fd00:1:1:1:a00:401:868:40") {
+		t.Errorf("Unexpected address: %s", a)
+	}
+	var res testMGTP4IPv6Src
+	if err := Unmarshal(addr, &res); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(src, res); diff != "" {
+		t.Error(diff)
+	}
+
+	args := testArgsMobSession{QFI: 63, R: true, TEID: 0xFFFFFFFF}
+	if err := InsertFields(&addr, 40, args); err != nil {
+		t.Fatal(err)
+	}
+	var resArgs testArgsMobSession
+	if err := ExtractFields(addr, 40, &resArgs); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(args, resArgs); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestCodecErrors(t *testing.T) {
+	if _, err := Marshal(testArgsMobSession{QFI: 64}); err != errors.ErrOutOfRange {
+		t.Errorf("Value too large should be rejected: %v", err)
+	}
+	var addr [16]byte
+	if err := InsertFields(&addr, 100, testArgsMobSession{}); err != errors.ErrOutOfRange {
+		t.Errorf("Fields out of the address should be rejected: %v", err)
+	}
+	if err := Unmarshal(addr, testArgsMobSession{}); err != errors.ErrUnsupportedType {
+		t.Errorf("Non-pointer should be rejected: %v", err)
+	}
+	if _, err := Marshal(struct {
+		A int `bitfield:"0"`
+	}{}); err != errors.ErrUnsupportedType {
+		t.Errorf("Signed integer should be rejected: %v", err)
+	}
+	if _, err := Marshal(struct {
+		A uint8 `bitfield:"0,9"`
+	}{}); err != errors.ErrOutOfRange {
+		t.Errorf("Width larger than the field should be rejected: %v", err)
+	}
+	if _, err := Marshal(struct {
+		A uint8 `bitfield:"a"`
+	}{}); err != errors.ErrSyntax {
+		t.Errorf("Invalid tag should be rejected: %v", err)
+	}
+}
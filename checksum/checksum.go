@@ -0,0 +1,96 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package checksum
+
+import "encoding/binary"
+
+const protoUDP = 17
+
+// Sum adds the 16-bit words of b to sum (one's complement sum).
+// Only the last chunk of data can have an odd length.
+func Sum(sum uint32, b []byte) uint32 {
+	s := uint64(sum)
+	for ; len(b) >= 8; b = b[8:] {
+		s += uint64(binary.BigEndian.Uint32(b[0:4])) + uint64(binary.BigEndian.Uint32(b[4:8]))
+	}
+	for ; len(b) >= 2; b = b[2:] {
+		s += uint64(binary.BigEndian.Uint16(b))
+	}
+	if len(b) == 1 {
+		s += uint64(b[0]) << 8
+	}
+	for s > 0xFFFF {
+		s = s>>16 + s&0xFFFF
+	}
+	return uint32(s)
+}
+
+// Fold returns the checksum corresponding to a one's complement sum.
+func Fold(sum uint32) uint16 {
+	for sum > 0xFFFF {
+		sum = sum>>16 + sum&0xFFFF
+	}
+	return ^uint16(sum)
+}
+
+// Checksum returns the Internet Checksum of b.
+// The checksum field in b must be zero, or the result is zero if the checksum is valid.
+func Checksum(b []byte) uint16 {
+	return Fold(Sum(0, b))
+}
+
+// PseudoHeaderIPv4 returns the sum of the IPv4 pseudo-header (RFC 768)
+// for an upper-layer packet of the given length.
+func PseudoHeaderIPv4(src [4]byte, dst [4]byte, protocol uint8, length int) uint32 {
+	sum := Sum(0, src[:])
+	sum = Sum(sum, dst[:])
+	return Sum(sum, []byte{0, protocol, byte(length >> 8), byte(length)})
+}
+
+// PseudoHeaderIPv6 returns the sum of the IPv6 pseudo-header (RFC 8200, section 8.1)
+// for an upper-layer packet of the given length.
+func PseudoHeaderIPv6(src [16]byte, dst [16]byte, nextHeader uint8, length int) uint32 {
+	sum := Sum(0, src[:])
+	sum = Sum(sum, dst[:])
+	return Sum(sum, []byte{byte(length >> 24), byte(length >> 16), byte(length >> 8), byte(length), 0, 0, 0, nextHeader})
+}
+
+// UDPIPv4 returns the checksum of the UDP datagram (header and payload) carried in an IPv4 packet.
+// The checksum field of the datagram must be zero.
+// A computed checksum of zero is returned as all ones, since zero means no checksum.
+func UDPIPv4(src [4]byte, dst [4]byte, datagram []byte) uint16 {
+	return udp(Sum(PseudoHeaderIPv4(src, dst, protoUDP, len(datagram)), datagram))
+}
+
+// UDPIPv6 returns the checksum of the UDP datagram (header and payload) carried in an IPv6 packet.
+// The checksum field of the datagram must be zero.
+// A computed checksum of zero is returned as all ones.
+func UDPIPv6(src [16]byte, dst [16]byte, datagram []byte) uint16 {
+	return udp(Sum(PseudoHeaderIPv6(src, dst, protoUDP, len(datagram)), datagram))
+}
+
+// udp returns the UDP checksum corresponding to a one's complement sum.
+func udp(sum uint32) uint16 {
+	if c := Fold(sum); c != 0 {
+		return c
+	}
+	return 0xFFFF
+}
+
+// Update returns the checksum updated after the data old has been replaced by new (RFC 1624, equation 3),
+// e.g. addresses of a pseudo-header covered by an inner TCP or UDP checksum.
+// old and new must have the same length, and start at an even offset of the checksummed data.
+// A zero UDP checksum (no checksum) must not be updated.
+func Update(checksum uint16, old []byte, new []byte) uint16 {
+	sum := uint32(^checksum)
+	for i := 0; i+1 < len(old); i += 2 {
+		sum += uint32(^binary.BigEndian.Uint16(old[i:])) + uint32(binary.BigEndian.Uint16(new[i:]))
+	}
+	if len(old)%2 == 1 {
+		sum += uint32(^(uint16(old[len(old)-1]) << 8)) + uint32(new[len(new)-1])<<8
+	}
+	return Fold(sum)
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package checksum
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func TestChecksum(t *testing.T) {
+	// RFC 1071, section 3
+	b := []byte{0x00, 0x01, 0xf2, 0x03, 0xf4, 0xf5, 0xf6, 0xf7}
+	if s := Sum(0, b); s != 0xddf2 {
+		t.Errorf("Unexpected sum: %x", s)
+	}
+	if c := Checksum(b); c != ^uint16(0xddf2) {
+		t.Errorf("Unexpected checksum: %x", c)
+	}
+	// chunks
+	if s := Sum(Sum(0, b[:2]), b[2:]); s != 0xddf2 {
+		t.Errorf("Unexpected sum: %x", s)
+	}
+	// odd length
+	if s := Sum(0, []byte{0x01, 0x02, 0x03}); s != 0x0402 {
+		t.Errorf("Unexpected sum: %x", s)
+	}
+
+	// IPv4 header
+	h := []byte{
+		0x45, 0x00, 0x00, 0x73, 0x00, 0x00, 0x40, 0x00, 0x40, 0x11, 0x00, 0x00,
+		0xc0, 0xa8, 0x00, 0x01, 0xc0, 0xa8, 0x00, 0xc7,
+	}
+	c := Checksum(h)
+	if c != 0xb861 {
+		t.Errorf("Unexpected checksum: %x", c)
+	}
+	binary.BigEndian.PutUint16(h[10:12], c)
+	if Checksum(h) != 0 {
+		t.Error("Valid checksum should verify to zero")
+	}
+}
+
+func TestUDP(t *testing.T) {
+	udp := []byte{0x04, 0xd2, 0x08, 0x68, 0x00, 0x0b, 0x00, 0x00, 0xca, 0xfe, 0x01}
+	src6 := [16]byte{0x20, 0x01, 0x0d, 0xb8, 15: 1}
+	dst6 := [16]byte{0x20, 0x01, 0x0d, 0xb8, 15: 2}
+	if c := UDPIPv6(src6, dst6, udp); c != 0xcb2a {
+		t.Errorf("Unexpected checksum: %x", c)
+	}
+	src4 := [4]byte{198, 51, 100, 1}
+	dst4 := [4]byte{203, 0, 113, 1}
+	c := UDPIPv4(src4, dst4, udp)
+	binary.BigEndian.PutUint16(udp[6:8], c)
+	if Fold(Sum(PseudoHeaderIPv4(src4, dst4, protoUDP, len(udp)), udp)) != 0 {
+		t.Errorf("Invalid checksum: %x", c)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	udp := []byte{0x04, 0xd2, 0x08, 0x68, 0x00, 0x0b, 0x00, 0x00, 0xca, 0xfe, 0x01}
+	src := [4]byte{198, 51, 100, 1}
+	dst := [4]byte{203, 0, 113, 1}
+	newSrc := [4]byte{192, 0, 2, 42}
+	c := UDPIPv4(src, dst, udp)
+	if u := Update(c, src[:], newSrc[:]); u != UDPIPv4(newSrc, dst, udp) {
+		t.Errorf("Unexpected updated checksum: %x", u)
+	}
+	// odd length
+	b := []byte{0x12, 0x34, 0x56}
+	if u := Update(Checksum(b), b[2:], []byte{0x78}); u != Checksum([]byte{0x12, 0x34, 0x78}) {
+		t.Errorf("Unexpected updated checksum: %x", u)
+	}
+}
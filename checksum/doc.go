@@ -0,0 +1,9 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package checksum provides the Internet Checksum (RFC 1071) computations
+// needed when translating packets: IPv4 header, UDP over IPv4 and IPv6,
+// and incremental updates (RFC 1624).
+package checksum
@@ -0,0 +1,72 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Command rfc9433-cheader generates a C header describing the layouts of the IPv6 addresses
+// encoded by the encoding package, for data planes written in C (e.g. eBPF or DPDK).
+//
+// Usage:
+//
+//	rfc9433-cheader [-o encoding.h] [-dst 32,48] [-src 32,48]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/nextmn/rfc9433/encoding"
+)
+
+// prefixLens is a comma separated list of prefix lengths.
+type prefixLens []uint
+
+func (p *prefixLens) String() string {
+	s := make([]string, len(*p))
+	for i, l := range *p {
+		s[i] = strconv.FormatUint(uint64(l), 10)
+	}
+	return strings.Join(s, ",")
+}
+
+func (p *prefixLens) Set(v string) error {
+	*p = nil
+	for _, s := range strings.Split(v, ",") {
+		l, err := strconv.ParseUint(strings.TrimSpace(s), 10, 8)
+		if err != nil {
+			return err
+		}
+		*p = append(*p, uint(l))
+	}
+	return nil
+}
+
+func main() {
+	out := flag.String("o", "", "output file (default: standard output)")
+	opts := encoding.CHeaderOptions{}
+	flag.Var((*prefixLens)(&opts.DstPrefixLens), "dst", "prefix lengths of End.M.GTP4.E SIDs")
+	flag.Var((*prefixLens)(&opts.SrcPrefixLens), "src", "prefix lengths of IPv6 SAs of End.M.GTP4.E")
+	flag.Parse()
+	if err := run(*out, opts); err != nil {
+		fmt.Fprintln(os.Stderr, "rfc9433-cheader:", err)
+		os.Exit(1)
+	}
+}
+
+func run(out string, opts encoding.CHeaderOptions) error {
+	if out == "" {
+		return encoding.WriteCHeader(os.Stdout, opts)
+	}
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	err = encoding.WriteCHeader(f, opts)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Command rfc9433-generate renders a gateway configuration, in the JSON form used by the /config
+// endpoint of the REST API, into a deployment artifact: a shell script of iproute2 commands (script),
+// nftables rules (nftables), or a systemd unit applying both (systemd).
+//
+// Usage:
+//
+//	rfc9433-generate [-c gateway.json] [-o file] [-dev srgw0] [-table 0] [-exec command] [-script path] [-nft path] script|nftables|systemd
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/nextmn/rfc9433/control"
+	"github.com/nextmn/rfc9433/deploy"
+	"github.com/nextmn/rfc9433/rest"
+)
+
+func main() {
+	in := flag.String("c", "", "configuration file (default: standard input)")
+	out := flag.String("o", "", "output file (default: standard output)")
+	opts := deploy.Options{}
+	flag.StringVar(&opts.Device, "dev", "srgw0", "device of the datapath")
+	flag.IntVar(&opts.Table, "table", 0, "routing table of the routes (0 for the main table)")
+	flag.StringVar(&opts.Exec, "exec", "", "command line running the datapath (systemd, required)")
+	flag.StringVar(&opts.Script, "script", "/etc/rfc9433/routes.sh", "path of the installed script (systemd)")
+	flag.StringVar(&opts.Nftables, "nft", "/etc/rfc9433/rules.nft", "path of the installed nftables rules (systemd)")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(flag.Arg(0), *in, *out, opts); err != nil {
+		fmt.Fprintln(os.Stderr, "rfc9433-generate:", err)
+		os.Exit(1)
+	}
+}
+
+func run(artifact string, in string, out string, opts deploy.Options) error {
+	var write func(w io.Writer, cfg control.Config) error
+	switch artifact {
+	case "script":
+		write = func(w io.Writer, cfg control.Config) error { return deploy.WriteScript(w, cfg, opts) }
+	case "nftables":
+		write = func(w io.Writer, cfg control.Config) error { return deploy.WriteNftables(w, cfg, opts) }
+	case "systemd":
+		write = func(w io.Writer, cfg control.Config) error { return deploy.WriteSystemdUnit(w, opts) }
+	default:
+		return fmt.Errorf("unknown artifact %q", artifact)
+	}
+	cfg := control.Config{}
+	if artifact != "systemd" {
+		var err error
+		if cfg, err = readConfig(in); err != nil {
+			return err
+		}
+	}
+	if out == "" {
+		return write(os.Stdout, cfg)
+	}
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	err = write(f, cfg)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// readConfig reads the configuration from the file, or from the standard input if file is empty.
+func readConfig(file string) (control.Config, error) {
+	if file == "" {
+		return rest.DecodeConfig(os.Stdin)
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return control.Config{}, err
+	}
+	defer f.Close()
+	return rest.DecodeConfig(f)
+}
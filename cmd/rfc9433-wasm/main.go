@@ -0,0 +1,20 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build js && wasm
+
+// Command rfc9433-wasm exposes the encoders to JavaScript as the global object "rfc9433".
+//
+// Build with:
+//
+//	GOOS=js GOARCH=wasm go build -o rfc9433.wasm ./cmd/rfc9433-wasm
+package main
+
+import "github.com/nextmn/rfc9433/jsbind"
+
+func main() {
+	jsbind.Register("rfc9433")
+	select {}
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package control
+
+import (
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/behavior"
+	"github.com/nextmn/rfc9433/headend"
+	"github.com/nextmn/rfc9433/iproute2"
+)
+
+// BehaviorSpec describes a behavior bound to a SID.
+// Fields other than SID and Action are only used by some actions.
+type BehaviorSpec struct {
+	SID       netip.Prefix    // SID, or IPv4 prefix for H.M.GTP4.D
+	Action    iproute2.Action // e.g. iproute2.ActionEndMGTP4E
+	Source    netip.Addr      // IPv6 SA of the GTP-U packets (End.M.GTP6.E)
+	SrcPrefix netip.Prefix    // Source UPF Prefix (H.M.GTP4.D)
+	DstPrefix netip.Prefix    // Destination UPF Prefix (H.M.GTP4.D)
+	Segments  []netip.Addr    // segments visited before the last segment (H.M.GTP4.D)
+	Reduced   bool            // omit the first segment from the SRH (H.M.GTP4.D)
+	HopLimit  uint8           // 0 means behavior.DefaultHopLimit (End.M.GTP6.E, H.M.GTP4.D)
+}
+
+// BehaviorFactory creates the Behavior described by a BehaviorSpec.
+type BehaviorFactory interface {
+	NewBehavior(spec BehaviorSpec) (behavior.Behavior, error)
+}
+
+// BehaviorFactoryFunc is an adapter to allow the use of ordinary functions as BehaviorFactory.
+type BehaviorFactoryFunc func(spec BehaviorSpec) (behavior.Behavior, error)
+
+// NewBehavior calls f(spec).
+func (f BehaviorFactoryFunc) NewBehavior(spec BehaviorSpec) (behavior.Behavior, error) {
+	return f(spec)
+}
+
+// defaultFactories returns the factories of the behaviors which can be created from a BehaviorSpec alone.
+func defaultFactories() map[iproute2.Action]BehaviorFactory {
+	return map[iproute2.Action]BehaviorFactory{
+		iproute2.ActionEndMGTP4E: BehaviorFactoryFunc(newMGTP4E),
+		iproute2.ActionEndMGTP6E: BehaviorFactoryFunc(newMGTP6E),
+		iproute2.ActionHMGTP4D:   BehaviorFactoryFunc(newHMGTP4D),
+	}
+}
+
+// newMGTP4E creates an End.M.GTP4.E behavior for SIDs with the prefix length of spec.SID.
+func newMGTP4E(spec BehaviorSpec) (behavior.Behavior, error) {
+	if !spec.SID.Addr().Is6() {
+		return nil, ErrInvalidSpec
+	}
+	return behavior.NewMGTP4E(uint(spec.SID.Bits()), nil, nil), nil
+}
+
+// newMGTP6E creates an End.M.GTP6.E behavior for SIDs with the prefix length of spec.SID.
+func newMGTP6E(spec BehaviorSpec) (behavior.Behavior, error) {
+	if !spec.SID.Addr().Is6() || !spec.Source.Is6() {
+		return nil, ErrInvalidSpec
+	}
+	return behavior.NewMGTP6E(uint(spec.SID.Bits()), spec.Source, spec.HopLimit), nil
+}
+
+// newHMGTP4D creates a H.M.GTP4.D behavior for the IPv4 prefix spec.SID.
+func newHMGTP4D(spec BehaviorSpec) (behavior.Behavior, error) {
+	if !spec.SID.Addr().Is4() || !spec.SrcPrefix.Addr().Is6() || !spec.DstPrefix.Addr().Is6() {
+		return nil, ErrInvalidSpec
+	}
+	segments := make([][16]byte, len(spec.Segments))
+	for i, s := range spec.Segments {
+		if !s.Is6() {
+			return nil, ErrInvalidSpec
+		}
+		segments[i] = s.As16()
+	}
+	policy := behavior.SRv6Policy{
+		DstPrefix: spec.DstPrefix,
+		Segments:  segments,
+		Reduced:   spec.Reduced,
+		HopLimit:  spec.HopLimit,
+	}
+	return behavior.NewHMGTP4D(policy, headend.StaticPrefix(spec.SrcPrefix), nil), nil
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package control
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/nextmn/rfc9433/iproute2"
+)
+
+func TestDefaultFactories(t *testing.T) {
+	sid := netip.MustParsePrefix("2001:db8:4::/48")
+	ipv4 := netip.MustParsePrefix("10.0.0.0/24")
+	src := netip.MustParseAddr("2001:db8::1")
+	srcPrefix := netip.MustParsePrefix("2001:db8:1::/48")
+	for _, tc := range []struct {
+		spec BehaviorSpec
+		err  error
+	}{
+		{BehaviorSpec{SID: sid, Action: iproute2.ActionEndMGTP4E}, nil},
+		{BehaviorSpec{SID: ipv4, Action: iproute2.ActionEndMGTP4E}, ErrInvalidSpec},
+		{BehaviorSpec{SID: sid, Action: iproute2.ActionEndMGTP6E, Source: src}, nil},
+		{BehaviorSpec{SID: sid, Action: iproute2.ActionEndMGTP6E}, ErrInvalidSpec},
+		{BehaviorSpec{SID: ipv4, Action: iproute2.ActionHMGTP4D, SrcPrefix: srcPrefix, DstPrefix: sid, Segments: []netip.Addr{src}}, nil},
+		{BehaviorSpec{SID: ipv4, Action: iproute2.ActionHMGTP4D, SrcPrefix: srcPrefix}, ErrInvalidSpec},
+		{BehaviorSpec{SID: ipv4, Action: iproute2.ActionHMGTP4D, SrcPrefix: srcPrefix, DstPrefix: sid, Segments: []netip.Addr{netip.MustParseAddr("10.0.0.1")}}, ErrInvalidSpec},
+		{BehaviorSpec{SID: sid, Action: iproute2.ActionHMGTP4D, SrcPrefix: srcPrefix, DstPrefix: sid}, ErrInvalidSpec},
+	} {
+		b, err := defaultFactories()[tc.spec.Action].NewBehavior(tc.spec)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%s (%s): unexpected error: %v", tc.spec.Action, tc.spec.SID, err)
+		}
+		if err == nil && b == nil {
+			t.Errorf("%s (%s): nil behavior", tc.spec.Action, tc.spec.SID)
+		}
+	}
+}
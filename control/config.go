@@ -0,0 +1,264 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package control
+
+import (
+	"errors"
+	"net/netip"
+	"slices"
+
+	"github.com/nextmn/rfc9433/session"
+)
+
+// Config is a desired configuration of a Service.
+type Config struct {
+	Locators  []Locator
+	Behaviors []BehaviorSpec
+	Sessions  []SessionEntry
+}
+
+// Diff is the minimal set of changes converging the running state of a Service to a Config.
+type Diff struct {
+	AddLocators     []Locator
+	UpdateLocators  []Locator // owner changes
+	DeleteLocators  []netip.Prefix
+	SetBehaviors    []BehaviorSpec // new or changed behaviors
+	DeleteBehaviors []netip.Prefix
+	CreateSessions  []SessionEntry
+	UpdateSessions  []SessionEntry
+	DeleteSessions  []session.Key
+}
+
+// Empty returns true if the Diff has no changes.
+func (d *Diff) Empty() bool {
+	return len(d.AddLocators) == 0 && len(d.UpdateLocators) == 0 && len(d.DeleteLocators) == 0 &&
+		len(d.SetBehaviors) == 0 && len(d.DeleteBehaviors) == 0 &&
+		len(d.CreateSessions) == 0 && len(d.UpdateSessions) == 0 && len(d.DeleteSessions) == 0
+}
+
+// Config returns the running configuration.
+func (s *Service) Config() Config {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.config()
+}
+
+func (s *Service) config() Config {
+	return Config{
+		Locators:  s.sortedLocators(),
+		Behaviors: s.sortedBehaviors(),
+		Sessions:  s.Sessions(),
+	}
+}
+
+// Diff compares a Config against the running state.
+// Prefixes of the Config are masked; a Config with duplicate keys is invalid.
+func (s *Service) Diff(cfg Config) (*Diff, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.diff(cfg)
+}
+
+func (s *Service) diff(cfg Config) (*Diff, error) {
+	locators := make(map[netip.Prefix]Locator, len(cfg.Locators))
+	for _, l := range cfg.Locators {
+		if !l.Prefix.IsValid() {
+			return nil, ErrInvalidLocator
+		}
+		l.Prefix = l.Prefix.Masked()
+		if _, ok := locators[l.Prefix]; ok {
+			return nil, ErrInvalidConfig
+		}
+		locators[l.Prefix] = l
+	}
+	behaviors := make(map[netip.Prefix]BehaviorSpec, len(cfg.Behaviors))
+	for _, spec := range cfg.Behaviors {
+		if !spec.SID.IsValid() {
+			return nil, ErrInvalidSpec
+		}
+		spec.SID = spec.SID.Masked()
+		if _, ok := behaviors[spec.SID]; ok {
+			return nil, ErrInvalidConfig
+		}
+		behaviors[spec.SID] = spec
+	}
+	sessions := make(map[session.Key]session.Session, len(cfg.Sessions))
+	for _, e := range cfg.Sessions {
+		if _, ok := sessions[e.Key]; ok {
+			return nil, ErrInvalidConfig
+		}
+		sessions[e.Key] = e.Session
+	}
+
+	running := s.config()
+	d := &Diff{}
+	for _, l := range running.Locators {
+		want, ok := locators[l.Prefix]
+		switch {
+		case !ok:
+			d.DeleteLocators = append(d.DeleteLocators, l.Prefix)
+		case want.Owner != l.Owner:
+			d.UpdateLocators = append(d.UpdateLocators, want)
+		}
+		delete(locators, l.Prefix)
+	}
+	for _, l := range locators {
+		d.AddLocators = append(d.AddLocators, l)
+	}
+	slices.SortFunc(d.AddLocators, func(a, b Locator) int {
+		return comparePrefixes(a.Prefix, b.Prefix)
+	})
+
+	for _, spec := range running.Behaviors {
+		want, ok := behaviors[spec.SID]
+		switch {
+		case !ok:
+			d.DeleteBehaviors = append(d.DeleteBehaviors, spec.SID)
+		case !equalSpecs(want, spec):
+			d.SetBehaviors = append(d.SetBehaviors, want)
+		}
+		delete(behaviors, spec.SID)
+	}
+	for _, spec := range behaviors {
+		d.SetBehaviors = append(d.SetBehaviors, spec)
+	}
+	slices.SortFunc(d.SetBehaviors, func(a, b BehaviorSpec) int {
+		return comparePrefixes(a.SID, b.SID)
+	})
+
+	for _, e := range running.Sessions {
+		want, ok := sessions[e.Key]
+		switch {
+		case !ok:
+			d.DeleteSessions = append(d.DeleteSessions, e.Key)
+		case !equalSessions(want, e.Session):
+			d.UpdateSessions = append(d.UpdateSessions, SessionEntry{Key: e.Key, Session: want})
+		}
+		delete(sessions, e.Key)
+	}
+	for k, sess := range sessions {
+		d.CreateSessions = append(d.CreateSessions, SessionEntry{Key: k, Session: sess})
+	}
+	slices.SortFunc(d.CreateSessions, compareSessionEntries)
+	return d, nil
+}
+
+// Apply applies a Diff: sessions and behaviors are removed before locators,
+// and locators are added before behaviors and sessions.
+// If a change fails, the changes already applied are undone in reverse order,
+// and the returned error joins the failure and the errors of the rollback, if any.
+// Counters of the deleted sessions are not restored.
+func (s *Service) Apply(d *Diff) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.apply(d)
+}
+
+func (s *Service) apply(d *Diff) error {
+	var undo []func() error
+	rollback := func(err error) error {
+		errs := []error{err}
+		for i := len(undo) - 1; i >= 0; i-- {
+			if err := undo[i](); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	}
+	for _, k := range d.DeleteSessions {
+		old, _ := s.sessions.Lookup(k)
+		if err := s.deleteSession(k); err != nil {
+			return rollback(err)
+		}
+		undo = append(undo, func() error { return s.createSession(k, old) })
+	}
+	for _, sid := range d.DeleteBehaviors {
+		old := s.behaviors[sid.Masked()]
+		if err := s.deleteBehavior(sid); err != nil {
+			return rollback(err)
+		}
+		undo = append(undo, func() error { return s.setBehavior(old) })
+	}
+	for _, prefix := range d.DeleteLocators {
+		old := s.locators[prefix.Masked()]
+		if err := s.deleteLocator(prefix); err != nil {
+			return rollback(err)
+		}
+		undo = append(undo, func() error { return s.addLocator(old) })
+	}
+	for _, l := range d.UpdateLocators {
+		old := s.locators[l.Prefix.Masked()]
+		if err := s.updateLocator(l); err != nil {
+			return rollback(err)
+		}
+		undo = append(undo, func() error { return s.updateLocator(old) })
+	}
+	for _, l := range d.AddLocators {
+		if err := s.addLocator(l); err != nil {
+			return rollback(err)
+		}
+		undo = append(undo, func() error { return s.deleteLocator(l.Prefix) })
+	}
+	for _, spec := range d.SetBehaviors {
+		old, ok := s.behaviors[spec.SID.Masked()]
+		if err := s.setBehavior(spec); err != nil {
+			return rollback(err)
+		}
+		if ok {
+			undo = append(undo, func() error { return s.setBehavior(old) })
+		} else {
+			undo = append(undo, func() error { return s.deleteBehavior(spec.SID) })
+		}
+	}
+	for _, e := range d.UpdateSessions {
+		old, _ := s.sessions.Lookup(e.Key)
+		if err := s.updateSession(e.Key, e.Session); err != nil {
+			return rollback(err)
+		}
+		undo = append(undo, func() error { return s.updateSession(e.Key, old) })
+	}
+	for _, e := range d.CreateSessions {
+		if err := s.createSession(e.Key, e.Session); err != nil {
+			return rollback(err)
+		}
+		undo = append(undo, func() error { return s.deleteSession(e.Key) })
+	}
+	return nil
+}
+
+// ApplyConfig converges the running state to a Config, and returns the applied Diff.
+// The service is locked from the computation of the Diff until it is applied,
+// and the running state is left unchanged on failure (see Apply).
+func (s *Service) ApplyConfig(cfg Config) (*Diff, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d, err := s.diff(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.apply(d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// equalSpecs returns true if two BehaviorSpec describe the same behavior.
+func equalSpecs(a, b BehaviorSpec) bool {
+	return a.SID == b.SID && a.Action == b.Action && a.Source == b.Source &&
+		a.SrcPrefix == b.SrcPrefix && a.DstPrefix == b.DstPrefix &&
+		slices.Equal(a.Segments, b.Segments) && a.Reduced == b.Reduced && a.HopLimit == b.HopLimit
+}
+
+// equalSessions returns true if two sessions are equal.
+func equalSessions(a, b session.Session) bool {
+	if a.SID != b.SID || !slices.Equal(a.Segments, b.Segments) {
+		return false
+	}
+	if a.Args == nil || b.Args == nil {
+		return a.Args == b.Args
+	}
+	return a.Args.Equal(b.Args)
+}
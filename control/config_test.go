@@ -0,0 +1,132 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package control
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/behavior"
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/iproute2"
+	"github.com/nextmn/rfc9433/session"
+)
+
+func TestServiceApplyConfig(t *testing.T) {
+	s := NewService(session.NewTable(), behavior.NewRegistry())
+	l1 := Locator{Prefix: netip.MustParsePrefix("2001:db8:1::/48"), Owner: "a"}
+	l2 := Locator{Prefix: netip.MustParsePrefix("2001:db8:2::/48"), Owner: "b"}
+	b1 := BehaviorSpec{SID: netip.MustParsePrefix("2001:db8:1::/64"), Action: iproute2.ActionEndMGTP4E}
+	b2 := BehaviorSpec{SID: netip.MustParsePrefix("2001:db8:2::/64"), Action: iproute2.ActionEndMGTP4E}
+	k1 := session.Key{Peer: netip.MustParseAddr("10.0.0.1"), TEID: 1}
+	k2 := session.Key{Peer: netip.MustParseAddr("10.0.0.1"), TEID: 2}
+	s1 := session.Session{SID: netip.MustParseAddr("2001:db8::1"), Args: encoding.NewArgsMobSession(1, false, false, 1)}
+	s2 := session.Session{SID: netip.MustParseAddr("2001:db8::2")}
+	cfg := Config{
+		Locators:  []Locator{l2, l1},
+		Behaviors: []BehaviorSpec{b1, b2},
+		Sessions:  []SessionEntry{{k1, s1}, {k2, s2}},
+	}
+	opts := cmp.Options{
+		cmp.Comparer(func(a, b netip.Addr) bool { return a == b }),
+		cmp.Comparer(func(a, b netip.Prefix) bool { return a == b }),
+		cmp.Comparer(func(a, b *encoding.ArgsMobSession) bool { return a.Equal(b) }),
+	}
+	d, err := s.ApplyConfig(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(d, &Diff{
+		AddLocators:    []Locator{l1, l2},
+		SetBehaviors:   []BehaviorSpec{b1, b2},
+		CreateSessions: []SessionEntry{{k1, s1}, {k2, s2}},
+	}, opts); diff != "" {
+		t.Error(diff)
+	}
+	d, err = s.Diff(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !d.Empty() {
+		t.Errorf("Running state should match the configuration: %+v", d)
+	}
+
+	// remove l2 and its behavior, change the owner of l1, update and delete sessions
+	l1.Owner = "c"
+	s1.Args = encoding.NewArgsMobSession(2, false, false, 1)
+	cfg = Config{
+		Locators:  []Locator{l1},
+		Behaviors: []BehaviorSpec{b1},
+		Sessions:  []SessionEntry{{k1, s1}},
+	}
+	d, err = s.ApplyConfig(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(d, &Diff{
+		UpdateLocators:  []Locator{l1},
+		DeleteLocators:  []netip.Prefix{l2.Prefix},
+		DeleteBehaviors: []netip.Prefix{b2.SID},
+		UpdateSessions:  []SessionEntry{{k1, s1}},
+		DeleteSessions:  []session.Key{k2},
+	}, opts); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(s.Config(), cfg, opts); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestServiceDiffInvalid(t *testing.T) {
+	s := NewService(session.NewTable(), behavior.NewRegistry())
+	l := Locator{Prefix: netip.MustParsePrefix("2001:db8:1::/48")}
+	// duplicate prefixes once masked
+	l2 := Locator{Prefix: netip.MustParsePrefix("2001:db8:1::1/48")}
+	if _, err := s.Diff(Config{Locators: []Locator{l, l2}}); !errors.Is(err, ErrInvalidConfig) {
+		t.Errorf("Duplicate locators should be rejected: %v", err)
+	}
+	if _, err := s.Diff(Config{Locators: []Locator{{}}}); !errors.Is(err, ErrInvalidLocator) {
+		t.Errorf("Invalid locator should be rejected: %v", err)
+	}
+	if _, err := s.ApplyConfig(Config{Behaviors: []BehaviorSpec{{SID: netip.MustParsePrefix("2001:db8:1::/64"), Action: iproute2.ActionEndMGTP4E}}}); !errors.Is(err, ErrNoLocator) {
+		t.Errorf("Behavior outside of a locator should be rejected: %v", err)
+	}
+}
+
+func TestServiceApplyConfigRollback(t *testing.T) {
+	s := NewService(session.NewTable(), behavior.NewRegistry())
+	l1 := Locator{Prefix: netip.MustParsePrefix("2001:db8:1::/48"), Owner: "a"}
+	b1 := BehaviorSpec{SID: netip.MustParsePrefix("2001:db8:1::/64"), Action: iproute2.ActionEndMGTP4E}
+	k1 := session.Key{Peer: netip.MustParseAddr("10.0.0.1"), TEID: 1}
+	s1 := session.Session{SID: netip.MustParseAddr("2001:db8::1")}
+	cfg := Config{
+		Locators:  []Locator{l1},
+		Behaviors: []BehaviorSpec{b1},
+		Sessions:  []SessionEntry{{k1, s1}},
+	}
+	if _, err := s.ApplyConfig(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	// the last change fails: the previous ones must be undone
+	l2 := Locator{Prefix: netip.MustParsePrefix("2001:db8:2::/48"), Owner: "b"}
+	if _, err := s.ApplyConfig(Config{
+		Locators:  []Locator{{Prefix: l1.Prefix, Owner: "c"}, l2},
+		Behaviors: []BehaviorSpec{{SID: netip.MustParsePrefix("2001:db8:2::/64"), Action: "unknown"}},
+	}); !errors.Is(err, ErrUnknownAction) {
+		t.Fatalf("Expected ErrUnknownAction, got %v", err)
+	}
+	opts := cmp.Options{
+		cmp.Comparer(func(a, b netip.Addr) bool { return a == b }),
+		cmp.Comparer(func(a, b netip.Prefix) bool { return a == b }),
+		cmp.Comparer(func(a, b *encoding.ArgsMobSession) bool { return a.Equal(b) }),
+	}
+	if diff := cmp.Diff(s.Config(), cfg, opts); diff != "" {
+		t.Error(diff)
+	}
+}
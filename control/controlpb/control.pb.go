@@ -0,0 +1,2915 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        (unknown)
+// source: control/control.proto
+
+package controlpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SessionKey identifies a GTP-U tunnel.
+type SessionKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Peer string `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"` // IPv4 or IPv6 address of the gNB or UPF
+	Teid uint32 `protobuf:"varint,2,opt,name=teid,proto3" json:"teid,omitempty"`
+}
+
+func (x *SessionKey) Reset() {
+	*x = SessionKey{}
+	mi := &file_control_control_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionKey) ProtoMessage() {}
+
+func (x *SessionKey) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionKey.ProtoReflect.Descriptor instead.
+func (*SessionKey) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SessionKey) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *SessionKey) GetTeid() uint32 {
+	if x != nil {
+		return x.Teid
+	}
+	return 0
+}
+
+// ArgsMobSession is the Args.Mob.Session of RFC 9433, section 6.1.
+type ArgsMobSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Qfi          uint32 `protobuf:"varint,1,opt,name=qfi,proto3" json:"qfi,omitempty"`
+	R            bool   `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	U            bool   `protobuf:"varint,3,opt,name=u,proto3" json:"u,omitempty"`
+	PduSessionId uint32 `protobuf:"varint,4,opt,name=pdu_session_id,json=pduSessionId,proto3" json:"pdu_session_id,omitempty"`
+}
+
+func (x *ArgsMobSession) Reset() {
+	*x = ArgsMobSession{}
+	mi := &file_control_control_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArgsMobSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArgsMobSession) ProtoMessage() {}
+
+func (x *ArgsMobSession) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArgsMobSession.ProtoReflect.Descriptor instead.
+func (*ArgsMobSession) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ArgsMobSession) GetQfi() uint32 {
+	if x != nil {
+		return x.Qfi
+	}
+	return 0
+}
+
+func (x *ArgsMobSession) GetR() bool {
+	if x != nil {
+		return x.R
+	}
+	return false
+}
+
+func (x *ArgsMobSession) GetU() bool {
+	if x != nil {
+		return x.U
+	}
+	return false
+}
+
+func (x *ArgsMobSession) GetPduSessionId() uint32 {
+	if x != nil {
+		return x.PduSessionId
+	}
+	return 0
+}
+
+type Session struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      *SessionKey     `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Sid      string          `protobuf:"bytes,2,opt,name=sid,proto3" json:"sid,omitempty"`
+	Args     *ArgsMobSession `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`         // optional
+	Segments []string        `protobuf:"bytes,4,rep,name=segments,proto3" json:"segments,omitempty"` // segments visited before the SID
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_control_control_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Session) GetKey() *SessionKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *Session) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+func (x *Session) GetArgs() *ArgsMobSession {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *Session) GetSegments() []string {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+type CreateSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Session *Session `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (x *CreateSessionRequest) Reset() {
+	*x = CreateSessionRequest{}
+	mi := &file_control_control_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSessionRequest) ProtoMessage() {}
+
+func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateSessionRequest) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type UpdateSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Session *Session `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (x *UpdateSessionRequest) Reset() {
+	*x = UpdateSessionRequest{}
+	mi := &file_control_control_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSessionRequest) ProtoMessage() {}
+
+func (x *UpdateSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSessionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSessionRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateSessionRequest) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type DeleteSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key *SessionKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *DeleteSessionRequest) Reset() {
+	*x = DeleteSessionRequest{}
+	mi := &file_control_control_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSessionRequest) ProtoMessage() {}
+
+func (x *DeleteSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSessionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSessionRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteSessionRequest) GetKey() *SessionKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type DeleteSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteSessionResponse) Reset() {
+	*x = DeleteSessionResponse{}
+	mi := &file_control_control_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSessionResponse) ProtoMessage() {}
+
+func (x *DeleteSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSessionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSessionResponse) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{6}
+}
+
+type GetSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key *SessionKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *GetSessionRequest) Reset() {
+	*x = GetSessionRequest{}
+	mi := &file_control_control_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionRequest) ProtoMessage() {}
+
+func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetSessionRequest) GetKey() *SessionKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_control_control_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{8}
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_control_control_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type GetSessionStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key *SessionKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *GetSessionStatsRequest) Reset() {
+	*x = GetSessionStatsRequest{}
+	mi := &file_control_control_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionStatsRequest) ProtoMessage() {}
+
+func (x *GetSessionStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionStatsRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetSessionStatsRequest) GetKey() *SessionKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+// FlowStats are the counters of a QoS flow of a session.
+type FlowStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PduSessionId uint32 `protobuf:"varint,1,opt,name=pdu_session_id,json=pduSessionId,proto3" json:"pdu_session_id,omitempty"`
+	Qfi          uint32 `protobuf:"varint,2,opt,name=qfi,proto3" json:"qfi,omitempty"`
+	Packets      uint64 `protobuf:"varint,3,opt,name=packets,proto3" json:"packets,omitempty"` // forwarded packets
+	Bytes        uint64 `protobuf:"varint,4,opt,name=bytes,proto3" json:"bytes,omitempty"`     // forwarded bytes
+	Drops        uint64 `protobuf:"varint,5,opt,name=drops,proto3" json:"drops,omitempty"`     // dropped packets
+}
+
+func (x *FlowStats) Reset() {
+	*x = FlowStats{}
+	mi := &file_control_control_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlowStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowStats) ProtoMessage() {}
+
+func (x *FlowStats) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowStats.ProtoReflect.Descriptor instead.
+func (*FlowStats) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FlowStats) GetPduSessionId() uint32 {
+	if x != nil {
+		return x.PduSessionId
+	}
+	return 0
+}
+
+func (x *FlowStats) GetQfi() uint32 {
+	if x != nil {
+		return x.Qfi
+	}
+	return 0
+}
+
+func (x *FlowStats) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *FlowStats) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *FlowStats) GetDrops() uint64 {
+	if x != nil {
+		return x.Drops
+	}
+	return 0
+}
+
+type GetSessionStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flows []*FlowStats `protobuf:"bytes,1,rep,name=flows,proto3" json:"flows,omitempty"`
+}
+
+func (x *GetSessionStatsResponse) Reset() {
+	*x = GetSessionStatsResponse{}
+	mi := &file_control_control_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionStatsResponse) ProtoMessage() {}
+
+func (x *GetSessionStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionStatsResponse) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetSessionStatsResponse) GetFlows() []*FlowStats {
+	if x != nil {
+		return x.Flows
+	}
+	return nil
+}
+
+type Locator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Owner  string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *Locator) Reset() {
+	*x = Locator{}
+	mi := &file_control_control_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Locator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Locator) ProtoMessage() {}
+
+func (x *Locator) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Locator.ProtoReflect.Descriptor instead.
+func (*Locator) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Locator) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *Locator) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+type AddLocatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locator *Locator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator,omitempty"`
+}
+
+func (x *AddLocatorRequest) Reset() {
+	*x = AddLocatorRequest{}
+	mi := &file_control_control_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddLocatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLocatorRequest) ProtoMessage() {}
+
+func (x *AddLocatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLocatorRequest.ProtoReflect.Descriptor instead.
+func (*AddLocatorRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AddLocatorRequest) GetLocator() *Locator {
+	if x != nil {
+		return x.Locator
+	}
+	return nil
+}
+
+type UpdateLocatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locator *Locator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator,omitempty"`
+}
+
+func (x *UpdateLocatorRequest) Reset() {
+	*x = UpdateLocatorRequest{}
+	mi := &file_control_control_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLocatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLocatorRequest) ProtoMessage() {}
+
+func (x *UpdateLocatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLocatorRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLocatorRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateLocatorRequest) GetLocator() *Locator {
+	if x != nil {
+		return x.Locator
+	}
+	return nil
+}
+
+type DeleteLocatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (x *DeleteLocatorRequest) Reset() {
+	*x = DeleteLocatorRequest{}
+	mi := &file_control_control_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLocatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLocatorRequest) ProtoMessage() {}
+
+func (x *DeleteLocatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLocatorRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLocatorRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteLocatorRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+type DeleteLocatorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteLocatorResponse) Reset() {
+	*x = DeleteLocatorResponse{}
+	mi := &file_control_control_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLocatorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLocatorResponse) ProtoMessage() {}
+
+func (x *DeleteLocatorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLocatorResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLocatorResponse) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{17}
+}
+
+type ListLocatorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListLocatorsRequest) Reset() {
+	*x = ListLocatorsRequest{}
+	mi := &file_control_control_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLocatorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLocatorsRequest) ProtoMessage() {}
+
+func (x *ListLocatorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLocatorsRequest.ProtoReflect.Descriptor instead.
+func (*ListLocatorsRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{18}
+}
+
+type ListLocatorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locators []*Locator `protobuf:"bytes,1,rep,name=locators,proto3" json:"locators,omitempty"`
+}
+
+func (x *ListLocatorsResponse) Reset() {
+	*x = ListLocatorsResponse{}
+	mi := &file_control_control_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLocatorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLocatorsResponse) ProtoMessage() {}
+
+func (x *ListLocatorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLocatorsResponse.ProtoReflect.Descriptor instead.
+func (*ListLocatorsResponse) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListLocatorsResponse) GetLocators() []*Locator {
+	if x != nil {
+		return x.Locators
+	}
+	return nil
+}
+
+// Behavior is a behavior bound to a SID (control.BehaviorSpec).
+type Behavior struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sid       string   `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`                              // SID, or IPv4 prefix for H.M.GTP4.D
+	Action    string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`                        // e.g. "End.M.GTP4.E"
+	Source    string   `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`                        // End.M.GTP6.E
+	SrcPrefix string   `protobuf:"bytes,4,opt,name=src_prefix,json=srcPrefix,proto3" json:"src_prefix,omitempty"` // H.M.GTP4.D
+	DstPrefix string   `protobuf:"bytes,5,opt,name=dst_prefix,json=dstPrefix,proto3" json:"dst_prefix,omitempty"` // H.M.GTP4.D
+	Segments  []string `protobuf:"bytes,6,rep,name=segments,proto3" json:"segments,omitempty"`                    // H.M.GTP4.D
+	Reduced   bool     `protobuf:"varint,7,opt,name=reduced,proto3" json:"reduced,omitempty"`                     // H.M.GTP4.D
+	HopLimit  uint32   `protobuf:"varint,8,opt,name=hop_limit,json=hopLimit,proto3" json:"hop_limit,omitempty"`   // End.M.GTP6.E, H.M.GTP4.D
+}
+
+func (x *Behavior) Reset() {
+	*x = Behavior{}
+	mi := &file_control_control_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Behavior) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Behavior) ProtoMessage() {}
+
+func (x *Behavior) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Behavior.ProtoReflect.Descriptor instead.
+func (*Behavior) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Behavior) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+func (x *Behavior) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Behavior) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Behavior) GetSrcPrefix() string {
+	if x != nil {
+		return x.SrcPrefix
+	}
+	return ""
+}
+
+func (x *Behavior) GetDstPrefix() string {
+	if x != nil {
+		return x.DstPrefix
+	}
+	return ""
+}
+
+func (x *Behavior) GetSegments() []string {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+func (x *Behavior) GetReduced() bool {
+	if x != nil {
+		return x.Reduced
+	}
+	return false
+}
+
+func (x *Behavior) GetHopLimit() uint32 {
+	if x != nil {
+		return x.HopLimit
+	}
+	return 0
+}
+
+type SetBehaviorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Behavior *Behavior `protobuf:"bytes,1,opt,name=behavior,proto3" json:"behavior,omitempty"`
+}
+
+func (x *SetBehaviorRequest) Reset() {
+	*x = SetBehaviorRequest{}
+	mi := &file_control_control_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBehaviorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBehaviorRequest) ProtoMessage() {}
+
+func (x *SetBehaviorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBehaviorRequest.ProtoReflect.Descriptor instead.
+func (*SetBehaviorRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetBehaviorRequest) GetBehavior() *Behavior {
+	if x != nil {
+		return x.Behavior
+	}
+	return nil
+}
+
+type DeleteBehaviorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sid string `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
+}
+
+func (x *DeleteBehaviorRequest) Reset() {
+	*x = DeleteBehaviorRequest{}
+	mi := &file_control_control_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBehaviorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBehaviorRequest) ProtoMessage() {}
+
+func (x *DeleteBehaviorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBehaviorRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBehaviorRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteBehaviorRequest) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+type DeleteBehaviorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteBehaviorResponse) Reset() {
+	*x = DeleteBehaviorResponse{}
+	mi := &file_control_control_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBehaviorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBehaviorResponse) ProtoMessage() {}
+
+func (x *DeleteBehaviorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBehaviorResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBehaviorResponse) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{23}
+}
+
+type ListBehaviorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListBehaviorsRequest) Reset() {
+	*x = ListBehaviorsRequest{}
+	mi := &file_control_control_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBehaviorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBehaviorsRequest) ProtoMessage() {}
+
+func (x *ListBehaviorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBehaviorsRequest.ProtoReflect.Descriptor instead.
+func (*ListBehaviorsRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{24}
+}
+
+type ListBehaviorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Behaviors []*Behavior `protobuf:"bytes,1,rep,name=behaviors,proto3" json:"behaviors,omitempty"`
+}
+
+func (x *ListBehaviorsResponse) Reset() {
+	*x = ListBehaviorsResponse{}
+	mi := &file_control_control_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBehaviorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBehaviorsResponse) ProtoMessage() {}
+
+func (x *ListBehaviorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBehaviorsResponse.ProtoReflect.Descriptor instead.
+func (*ListBehaviorsResponse) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListBehaviorsResponse) GetBehaviors() []*Behavior {
+	if x != nil {
+		return x.Behaviors
+	}
+	return nil
+}
+
+type GetTraceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sid string `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
+}
+
+func (x *GetTraceRequest) Reset() {
+	*x = GetTraceRequest{}
+	mi := &file_control_control_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTraceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTraceRequest) ProtoMessage() {}
+
+func (x *GetTraceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTraceRequest.ProtoReflect.Descriptor instead.
+func (*GetTraceRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetTraceRequest) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+// TraceRecord contains the metadata of a packet processed by a behavior, and the decision taken.
+type TraceRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time     int64  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`        // Unix time in nanoseconds
+	Src      string `protobuf:"bytes,2,opt,name=src,proto3" json:"src,omitempty"`           // source address of the received packet
+	Dst      string `protobuf:"bytes,3,opt,name=dst,proto3" json:"dst,omitempty"`           // destination address of the received packet
+	Len      uint32 `protobuf:"varint,4,opt,name=len,proto3" json:"len,omitempty"`          // length of the received packet
+	Decision string `protobuf:"bytes,5,opt,name=decision,proto3" json:"decision,omitempty"` // "forward" or "drop"
+	Reason   string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`     // reason of the decision, if any
+}
+
+func (x *TraceRecord) Reset() {
+	*x = TraceRecord{}
+	mi := &file_control_control_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TraceRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceRecord) ProtoMessage() {}
+
+func (x *TraceRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceRecord.ProtoReflect.Descriptor instead.
+func (*TraceRecord) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TraceRecord) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *TraceRecord) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *TraceRecord) GetDst() string {
+	if x != nil {
+		return x.Dst
+	}
+	return ""
+}
+
+func (x *TraceRecord) GetLen() uint32 {
+	if x != nil {
+		return x.Len
+	}
+	return 0
+}
+
+func (x *TraceRecord) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *TraceRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetTraceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*TraceRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"` // from the oldest to the most recent
+}
+
+func (x *GetTraceResponse) Reset() {
+	*x = GetTraceResponse{}
+	mi := &file_control_control_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTraceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTraceResponse) ProtoMessage() {}
+
+func (x *GetTraceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTraceResponse.ProtoReflect.Descriptor instead.
+func (*GetTraceResponse) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetTraceResponse) GetRecords() []*TraceRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type SIDAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sid   string `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"` // prefix of the SID (locator and value)
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *SIDAllocation) Reset() {
+	*x = SIDAllocation{}
+	mi := &file_control_control_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SIDAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SIDAllocation) ProtoMessage() {}
+
+func (x *SIDAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SIDAllocation.ProtoReflect.Descriptor instead.
+func (*SIDAllocation) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SIDAllocation) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+func (x *SIDAllocation) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+type AllocateSIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Sid   string `protobuf:"bytes,2,opt,name=sid,proto3" json:"sid,omitempty"` // optional, SID to reserve
+}
+
+func (x *AllocateSIDRequest) Reset() {
+	*x = AllocateSIDRequest{}
+	mi := &file_control_control_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocateSIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocateSIDRequest) ProtoMessage() {}
+
+func (x *AllocateSIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocateSIDRequest.ProtoReflect.Descriptor instead.
+func (*AllocateSIDRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AllocateSIDRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *AllocateSIDRequest) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+type ReleaseSIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sid string `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
+}
+
+func (x *ReleaseSIDRequest) Reset() {
+	*x = ReleaseSIDRequest{}
+	mi := &file_control_control_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSIDRequest) ProtoMessage() {}
+
+func (x *ReleaseSIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSIDRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseSIDRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ReleaseSIDRequest) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+type ReleaseSIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReleaseSIDResponse) Reset() {
+	*x = ReleaseSIDResponse{}
+	mi := &file_control_control_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSIDResponse) ProtoMessage() {}
+
+func (x *ReleaseSIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSIDResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseSIDResponse) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{32}
+}
+
+type GetSIDAllocationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sid string `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
+}
+
+func (x *GetSIDAllocationRequest) Reset() {
+	*x = GetSIDAllocationRequest{}
+	mi := &file_control_control_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSIDAllocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSIDAllocationRequest) ProtoMessage() {}
+
+func (x *GetSIDAllocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSIDAllocationRequest.ProtoReflect.Descriptor instead.
+func (*GetSIDAllocationRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetSIDAllocationRequest) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+type ListSIDAllocationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSIDAllocationsRequest) Reset() {
+	*x = ListSIDAllocationsRequest{}
+	mi := &file_control_control_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSIDAllocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSIDAllocationsRequest) ProtoMessage() {}
+
+func (x *ListSIDAllocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSIDAllocationsRequest.ProtoReflect.Descriptor instead.
+func (*ListSIDAllocationsRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{34}
+}
+
+type ListSIDAllocationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Allocations []*SIDAllocation `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations,omitempty"`
+}
+
+func (x *ListSIDAllocationsResponse) Reset() {
+	*x = ListSIDAllocationsResponse{}
+	mi := &file_control_control_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSIDAllocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSIDAllocationsResponse) ProtoMessage() {}
+
+func (x *ListSIDAllocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSIDAllocationsResponse.ProtoReflect.Descriptor instead.
+func (*ListSIDAllocationsResponse) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListSIDAllocationsResponse) GetAllocations() []*SIDAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+type DecodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address      string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Layout       string `protobuf:"bytes,2,opt,name=layout,proto3" json:"layout,omitempty"`                                  // "mgtp4-dst" or "mgtp4-src"
+	PrefixLength uint32 `protobuf:"varint,3,opt,name=prefix_length,json=prefixLength,proto3" json:"prefix_length,omitempty"` // mgtp4-dst
+}
+
+func (x *DecodeRequest) Reset() {
+	*x = DecodeRequest{}
+	mi := &file_control_control_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeRequest) ProtoMessage() {}
+
+func (x *DecodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeRequest.ProtoReflect.Descriptor instead.
+func (*DecodeRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DecodeRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DecodeRequest) GetLayout() string {
+	if x != nil {
+		return x.Layout
+	}
+	return ""
+}
+
+func (x *DecodeRequest) GetPrefixLength() uint32 {
+	if x != nil {
+		return x.PrefixLength
+	}
+	return 0
+}
+
+type MGTP4Dst struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix string          `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Ipv4   string          `protobuf:"bytes,2,opt,name=ipv4,proto3" json:"ipv4,omitempty"`
+	Args   *ArgsMobSession `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
+}
+
+func (x *MGTP4Dst) Reset() {
+	*x = MGTP4Dst{}
+	mi := &file_control_control_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MGTP4Dst) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MGTP4Dst) ProtoMessage() {}
+
+func (x *MGTP4Dst) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MGTP4Dst.ProtoReflect.Descriptor instead.
+func (*MGTP4Dst) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MGTP4Dst) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *MGTP4Dst) GetIpv4() string {
+	if x != nil {
+		return x.Ipv4
+	}
+	return ""
+}
+
+func (x *MGTP4Dst) GetArgs() *ArgsMobSession {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+type MGTP4Src struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix  string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Ipv4    string `protobuf:"bytes,2,opt,name=ipv4,proto3" json:"ipv4,omitempty"`
+	UdpPort uint32 `protobuf:"varint,3,opt,name=udp_port,json=udpPort,proto3" json:"udp_port,omitempty"`
+}
+
+func (x *MGTP4Src) Reset() {
+	*x = MGTP4Src{}
+	mi := &file_control_control_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MGTP4Src) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MGTP4Src) ProtoMessage() {}
+
+func (x *MGTP4Src) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MGTP4Src.ProtoReflect.Descriptor instead.
+func (*MGTP4Src) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MGTP4Src) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *MGTP4Src) GetIpv4() string {
+	if x != nil {
+		return x.Ipv4
+	}
+	return ""
+}
+
+func (x *MGTP4Src) GetUdpPort() uint32 {
+	if x != nil {
+		return x.UdpPort
+	}
+	return 0
+}
+
+type DecodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*DecodeResponse_Mgtp4Dst
+	//	*DecodeResponse_Mgtp4Src
+	Result isDecodeResponse_Result `protobuf_oneof:"result"`
+}
+
+func (x *DecodeResponse) Reset() {
+	*x = DecodeResponse{}
+	mi := &file_control_control_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeResponse) ProtoMessage() {}
+
+func (x *DecodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeResponse.ProtoReflect.Descriptor instead.
+func (*DecodeResponse) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{39}
+}
+
+func (m *DecodeResponse) GetResult() isDecodeResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *DecodeResponse) GetMgtp4Dst() *MGTP4Dst {
+	if x, ok := x.GetResult().(*DecodeResponse_Mgtp4Dst); ok {
+		return x.Mgtp4Dst
+	}
+	return nil
+}
+
+func (x *DecodeResponse) GetMgtp4Src() *MGTP4Src {
+	if x, ok := x.GetResult().(*DecodeResponse_Mgtp4Src); ok {
+		return x.Mgtp4Src
+	}
+	return nil
+}
+
+type isDecodeResponse_Result interface {
+	isDecodeResponse_Result()
+}
+
+type DecodeResponse_Mgtp4Dst struct {
+	Mgtp4Dst *MGTP4Dst `protobuf:"bytes,1,opt,name=mgtp4_dst,json=mgtp4Dst,proto3,oneof"`
+}
+
+type DecodeResponse_Mgtp4Src struct {
+	Mgtp4Src *MGTP4Src `protobuf:"bytes,2,opt,name=mgtp4_src,json=mgtp4Src,proto3,oneof"`
+}
+
+func (*DecodeResponse_Mgtp4Dst) isDecodeResponse_Result() {}
+
+func (*DecodeResponse_Mgtp4Src) isDecodeResponse_Result() {}
+
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locators  []*Locator  `protobuf:"bytes,1,rep,name=locators,proto3" json:"locators,omitempty"`
+	Behaviors []*Behavior `protobuf:"bytes,2,rep,name=behaviors,proto3" json:"behaviors,omitempty"`
+	Sessions  []*Session  `protobuf:"bytes,3,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	mi := &file_control_control_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Config) GetLocators() []*Locator {
+	if x != nil {
+		return x.Locators
+	}
+	return nil
+}
+
+func (x *Config) GetBehaviors() []*Behavior {
+	if x != nil {
+		return x.Behaviors
+	}
+	return nil
+}
+
+func (x *Config) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type GetConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetConfigRequest) Reset() {
+	*x = GetConfigRequest{}
+	mi := &file_control_control_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigRequest) ProtoMessage() {}
+
+func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{41}
+}
+
+type DiffConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *DiffConfigRequest) Reset() {
+	*x = DiffConfigRequest{}
+	mi := &file_control_control_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffConfigRequest) ProtoMessage() {}
+
+func (x *DiffConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffConfigRequest.ProtoReflect.Descriptor instead.
+func (*DiffConfigRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DiffConfigRequest) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ApplyConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *ApplyConfigRequest) Reset() {
+	*x = ApplyConfigRequest{}
+	mi := &file_control_control_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyConfigRequest) ProtoMessage() {}
+
+func (x *ApplyConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyConfigRequest.ProtoReflect.Descriptor instead.
+func (*ApplyConfigRequest) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ApplyConfigRequest) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// Diff is the minimal set of changes converging the running state to a configuration.
+type Diff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AddLocators     []*Locator    `protobuf:"bytes,1,rep,name=add_locators,json=addLocators,proto3" json:"add_locators,omitempty"`
+	UpdateLocators  []*Locator    `protobuf:"bytes,2,rep,name=update_locators,json=updateLocators,proto3" json:"update_locators,omitempty"` // owner changes
+	DeleteLocators  []string      `protobuf:"bytes,3,rep,name=delete_locators,json=deleteLocators,proto3" json:"delete_locators,omitempty"`
+	SetBehaviors    []*Behavior   `protobuf:"bytes,4,rep,name=set_behaviors,json=setBehaviors,proto3" json:"set_behaviors,omitempty"` // new or changed behaviors
+	DeleteBehaviors []string      `protobuf:"bytes,5,rep,name=delete_behaviors,json=deleteBehaviors,proto3" json:"delete_behaviors,omitempty"`
+	CreateSessions  []*Session    `protobuf:"bytes,6,rep,name=create_sessions,json=createSessions,proto3" json:"create_sessions,omitempty"`
+	UpdateSessions  []*Session    `protobuf:"bytes,7,rep,name=update_sessions,json=updateSessions,proto3" json:"update_sessions,omitempty"`
+	DeleteSessions  []*SessionKey `protobuf:"bytes,8,rep,name=delete_sessions,json=deleteSessions,proto3" json:"delete_sessions,omitempty"`
+}
+
+func (x *Diff) Reset() {
+	*x = Diff{}
+	mi := &file_control_control_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Diff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Diff) ProtoMessage() {}
+
+func (x *Diff) ProtoReflect() protoreflect.Message {
+	mi := &file_control_control_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Diff.ProtoReflect.Descriptor instead.
+func (*Diff) Descriptor() ([]byte, []int) {
+	return file_control_control_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Diff) GetAddLocators() []*Locator {
+	if x != nil {
+		return x.AddLocators
+	}
+	return nil
+}
+
+func (x *Diff) GetUpdateLocators() []*Locator {
+	if x != nil {
+		return x.UpdateLocators
+	}
+	return nil
+}
+
+func (x *Diff) GetDeleteLocators() []string {
+	if x != nil {
+		return x.DeleteLocators
+	}
+	return nil
+}
+
+func (x *Diff) GetSetBehaviors() []*Behavior {
+	if x != nil {
+		return x.SetBehaviors
+	}
+	return nil
+}
+
+func (x *Diff) GetDeleteBehaviors() []string {
+	if x != nil {
+		return x.DeleteBehaviors
+	}
+	return nil
+}
+
+func (x *Diff) GetCreateSessions() []*Session {
+	if x != nil {
+		return x.CreateSessions
+	}
+	return nil
+}
+
+func (x *Diff) GetUpdateSessions() []*Session {
+	if x != nil {
+		return x.UpdateSessions
+	}
+	return nil
+}
+
+func (x *Diff) GetDeleteSessions() []*SessionKey {
+	if x != nil {
+		return x.DeleteSessions
+	}
+	return nil
+}
+
+var File_control_control_proto protoreflect.FileDescriptor
+
+var file_control_control_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e,
+	0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
+	0x76, 0x31, 0x22, 0x34, 0x0a, 0x0a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x65, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x74, 0x65, 0x69, 0x64, 0x22, 0x64, 0x0a, 0x0e, 0x41, 0x72, 0x67, 0x73,
+	0x4d, 0x6f, 0x62, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x66,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x71, 0x66, 0x69, 0x12, 0x0c, 0x0a, 0x01,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x01, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x75, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x01, 0x75, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x64, 0x75, 0x5f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x70, 0x64, 0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xaf,
+	0x01, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e,
+	0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63,
+	0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x72, 0x67, 0x73, 0x4d, 0x6f, 0x62, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x04,
+	0x61, 0x72, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x54, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x78, 0x74,
+	0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c,
+	0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x14,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34,
+	0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x17, 0x0a,
+	0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d,
+	0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x56, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72,
+	0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x51, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x65, 0x78,
+	0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x65,
+	0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x89, 0x01, 0x0a, 0x09, 0x46, 0x6c, 0x6f, 0x77, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x64, 0x75, 0x5f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x64,
+	0x75, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x66,
+	0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x71, 0x66, 0x69, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x72, 0x6f,
+	0x70, 0x73, 0x22, 0x55, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
+	0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e,
+	0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x22, 0x37, 0x0a, 0x07, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x14, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x22, 0x51, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d,
+	0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x54, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a,
+	0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x2e, 0x0a, 0x14, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x17, 0x0a, 0x15, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x56, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72,
+	0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x08, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x72, 0x63, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x72, 0x63, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x73, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x64, 0x75, 0x63, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72,
+	0x65, 0x64, 0x75, 0x63, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x70, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x68, 0x6f, 0x70, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x55, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x62, 0x65, 0x68,
+	0x61, 0x76, 0x69, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x65,
+	0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72,
+	0x52, 0x08, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x22, 0x29, 0x0a, 0x15, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x73, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5a, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x41, 0x0a, 0x09, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63,
+	0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x09, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69,
+	0x6f, 0x72, 0x73, 0x22, 0x23, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x72, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x10,
+	0x0a, 0x03, 0x64, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6c,
+	0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x65,
+	0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x37, 0x0a, 0x0d,
+	0x53, 0x49, 0x44, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x3c, 0x0a, 0x12, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x65, 0x53, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x73, 0x69, 0x64, 0x22, 0x25, 0x0a, 0x11, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x49, 0x44, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x64, 0x22, 0x1b, 0x0a,
+	0x19, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x49, 0x44, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x68, 0x0a, 0x1a, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x49, 0x44, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x49, 0x44, 0x41, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x66, 0x0a, 0x0d, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x75, 0x0a, 0x08,
+	0x4d, 0x47, 0x54, 0x50, 0x34, 0x44, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x12, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x69, 0x70, 0x76, 0x34, 0x12, 0x3d, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39,
+	0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x72, 0x67, 0x73, 0x4d, 0x6f, 0x62, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61,
+	0x72, 0x67, 0x73, 0x22, 0x51, 0x0a, 0x08, 0x4d, 0x47, 0x54, 0x50, 0x34, 0x53, 0x72, 0x63, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x34, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x70, 0x76, 0x34, 0x12, 0x19, 0x0a, 0x08, 0x75,
+	0x64, 0x70, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x75,
+	0x64, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x22, 0xa2, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x6d, 0x67, 0x74,
+	0x70, 0x34, 0x5f, 0x64, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e,
+	0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x47, 0x54, 0x50, 0x34, 0x44, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x67, 0x74, 0x70, 0x34, 0x44, 0x73, 0x74, 0x12, 0x42, 0x0a,
+	0x09, 0x6d, 0x67, 0x74, 0x70, 0x34, 0x5f, 0x73, 0x72, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33,
+	0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x47, 0x54,
+	0x50, 0x34, 0x53, 0x72, 0x63, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x67, 0x74, 0x70, 0x34, 0x53, 0x72,
+	0x63, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xcb, 0x01, 0x0a, 0x06,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d,
+	0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x41, 0x0a, 0x09, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69,
+	0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x65, 0x78, 0x74,
+	0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x09,
+	0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x73, 0x12, 0x3e, 0x0a, 0x08, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x65,
+	0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a,
+	0x11, 0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39,
+	0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x4f, 0x0a,
+	0x12, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63,
+	0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xa2,
+	0x04, 0x0a, 0x04, 0x44, 0x69, 0x66, 0x66, 0x12, 0x45, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x5f, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x4b,
+	0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e,
+	0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0e, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x65, 0x68, 0x61,
+	0x76, 0x69, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x65,
+	0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72,
+	0x52, 0x0c, 0x73, 0x65, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x73, 0x12, 0x29,
+	0x0a, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f,
+	0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x73, 0x12, 0x4b, 0x0a, 0x0f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39,
+	0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4b, 0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x4e, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e,
+	0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x4b, 0x65, 0x79, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x32, 0xb3, 0x12, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12,
+	0x64, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x2f, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33,
+	0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34,
+	0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x64, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e,
+	0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e,
+	0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x72, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x6e,
+	0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
+	0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5e, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e,
+	0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x65,
+	0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x6f, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x2e, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2f, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x78, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x31, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63,
+	0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e,
+	0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0a, 0x41, 0x64,
+	0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2c, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d,
+	0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e,
+	0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x64, 0x0a, 0x0d, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2f, 0x2e, 0x6e, 0x65,
+	0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e,
+	0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x72, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x2f, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34,
+	0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39,
+	0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x12, 0x2e, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66,
+	0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66,
+	0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x42, 0x65, 0x68, 0x61,
+	0x76, 0x69, 0x6f, 0x72, 0x12, 0x2d, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66,
+	0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63,
+	0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x75, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x30, 0x2e, 0x6e, 0x65, 0x78,
+	0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x68,
+	0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6e,
+	0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x72, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x73,
+	0x12, 0x2f, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33,
+	0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x30, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34,
+	0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12,
+	0x2a, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6e, 0x65,
+	0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x0b, 0x41, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x65, 0x53, 0x49, 0x44, 0x12, 0x2d, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e,
+	0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x53, 0x49, 0x44, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e,
+	0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x49, 0x44, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x69, 0x0a, 0x0a, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x49, 0x44, 0x12, 0x2c,
+	0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x53, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6e,
+	0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x53, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x53, 0x49, 0x44, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x32, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x49, 0x44, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63,
+	0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x49, 0x44, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x81, 0x01,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x49, 0x44, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66,
+	0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x49, 0x44, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6e, 0x65, 0x78,
+	0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x49, 0x44, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5d, 0x0a, 0x06, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x2e, 0x6e, 0x65,
+	0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72,
+	0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5b, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x2e,
+	0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e, 0x65, 0x78,
+	0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x5b, 0x0a,
+	0x0a, 0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2c, 0x2e, 0x6e, 0x65,
+	0x78, 0x74, 0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x65, 0x78, 0x74,
+	0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x12, 0x5d, 0x0a, 0x0b, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2d, 0x2e, 0x6e, 0x65, 0x78, 0x74,
+	0x6d, 0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x65, 0x78, 0x74, 0x6d,
+	0x6e, 0x2e, 0x72, 0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x65, 0x78, 0x74, 0x6d, 0x6e, 0x2f, 0x72,
+	0x66, 0x63, 0x39, 0x34, 0x33, 0x33, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_control_control_proto_rawDescOnce sync.Once
+	file_control_control_proto_rawDescData = file_control_control_proto_rawDesc
+)
+
+func file_control_control_proto_rawDescGZIP() []byte {
+	file_control_control_proto_rawDescOnce.Do(func() {
+		file_control_control_proto_rawDescData = protoimpl.X.CompressGZIP(file_control_control_proto_rawDescData)
+	})
+	return file_control_control_proto_rawDescData
+}
+
+var file_control_control_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_control_control_proto_goTypes = []any{
+	(*SessionKey)(nil),                 // 0: nextmn.rfc9433.control.v1.SessionKey
+	(*ArgsMobSession)(nil),             // 1: nextmn.rfc9433.control.v1.ArgsMobSession
+	(*Session)(nil),                    // 2: nextmn.rfc9433.control.v1.Session
+	(*CreateSessionRequest)(nil),       // 3: nextmn.rfc9433.control.v1.CreateSessionRequest
+	(*UpdateSessionRequest)(nil),       // 4: nextmn.rfc9433.control.v1.UpdateSessionRequest
+	(*DeleteSessionRequest)(nil),       // 5: nextmn.rfc9433.control.v1.DeleteSessionRequest
+	(*DeleteSessionResponse)(nil),      // 6: nextmn.rfc9433.control.v1.DeleteSessionResponse
+	(*GetSessionRequest)(nil),          // 7: nextmn.rfc9433.control.v1.GetSessionRequest
+	(*ListSessionsRequest)(nil),        // 8: nextmn.rfc9433.control.v1.ListSessionsRequest
+	(*ListSessionsResponse)(nil),       // 9: nextmn.rfc9433.control.v1.ListSessionsResponse
+	(*GetSessionStatsRequest)(nil),     // 10: nextmn.rfc9433.control.v1.GetSessionStatsRequest
+	(*FlowStats)(nil),                  // 11: nextmn.rfc9433.control.v1.FlowStats
+	(*GetSessionStatsResponse)(nil),    // 12: nextmn.rfc9433.control.v1.GetSessionStatsResponse
+	(*Locator)(nil),                    // 13: nextmn.rfc9433.control.v1.Locator
+	(*AddLocatorRequest)(nil),          // 14: nextmn.rfc9433.control.v1.AddLocatorRequest
+	(*UpdateLocatorRequest)(nil),       // 15: nextmn.rfc9433.control.v1.UpdateLocatorRequest
+	(*DeleteLocatorRequest)(nil),       // 16: nextmn.rfc9433.control.v1.DeleteLocatorRequest
+	(*DeleteLocatorResponse)(nil),      // 17: nextmn.rfc9433.control.v1.DeleteLocatorResponse
+	(*ListLocatorsRequest)(nil),        // 18: nextmn.rfc9433.control.v1.ListLocatorsRequest
+	(*ListLocatorsResponse)(nil),       // 19: nextmn.rfc9433.control.v1.ListLocatorsResponse
+	(*Behavior)(nil),                   // 20: nextmn.rfc9433.control.v1.Behavior
+	(*SetBehaviorRequest)(nil),         // 21: nextmn.rfc9433.control.v1.SetBehaviorRequest
+	(*DeleteBehaviorRequest)(nil),      // 22: nextmn.rfc9433.control.v1.DeleteBehaviorRequest
+	(*DeleteBehaviorResponse)(nil),     // 23: nextmn.rfc9433.control.v1.DeleteBehaviorResponse
+	(*ListBehaviorsRequest)(nil),       // 24: nextmn.rfc9433.control.v1.ListBehaviorsRequest
+	(*ListBehaviorsResponse)(nil),      // 25: nextmn.rfc9433.control.v1.ListBehaviorsResponse
+	(*GetTraceRequest)(nil),            // 26: nextmn.rfc9433.control.v1.GetTraceRequest
+	(*TraceRecord)(nil),                // 27: nextmn.rfc9433.control.v1.TraceRecord
+	(*GetTraceResponse)(nil),           // 28: nextmn.rfc9433.control.v1.GetTraceResponse
+	(*SIDAllocation)(nil),              // 29: nextmn.rfc9433.control.v1.SIDAllocation
+	(*AllocateSIDRequest)(nil),         // 30: nextmn.rfc9433.control.v1.AllocateSIDRequest
+	(*ReleaseSIDRequest)(nil),          // 31: nextmn.rfc9433.control.v1.ReleaseSIDRequest
+	(*ReleaseSIDResponse)(nil),         // 32: nextmn.rfc9433.control.v1.ReleaseSIDResponse
+	(*GetSIDAllocationRequest)(nil),    // 33: nextmn.rfc9433.control.v1.GetSIDAllocationRequest
+	(*ListSIDAllocationsRequest)(nil),  // 34: nextmn.rfc9433.control.v1.ListSIDAllocationsRequest
+	(*ListSIDAllocationsResponse)(nil), // 35: nextmn.rfc9433.control.v1.ListSIDAllocationsResponse
+	(*DecodeRequest)(nil),              // 36: nextmn.rfc9433.control.v1.DecodeRequest
+	(*MGTP4Dst)(nil),                   // 37: nextmn.rfc9433.control.v1.MGTP4Dst
+	(*MGTP4Src)(nil),                   // 38: nextmn.rfc9433.control.v1.MGTP4Src
+	(*DecodeResponse)(nil),             // 39: nextmn.rfc9433.control.v1.DecodeResponse
+	(*Config)(nil),                     // 40: nextmn.rfc9433.control.v1.Config
+	(*GetConfigRequest)(nil),           // 41: nextmn.rfc9433.control.v1.GetConfigRequest
+	(*DiffConfigRequest)(nil),          // 42: nextmn.rfc9433.control.v1.DiffConfigRequest
+	(*ApplyConfigRequest)(nil),         // 43: nextmn.rfc9433.control.v1.ApplyConfigRequest
+	(*Diff)(nil),                       // 44: nextmn.rfc9433.control.v1.Diff
+}
+var file_control_control_proto_depIdxs = []int32{
+	0,  // 0: nextmn.rfc9433.control.v1.Session.key:type_name -> nextmn.rfc9433.control.v1.SessionKey
+	1,  // 1: nextmn.rfc9433.control.v1.Session.args:type_name -> nextmn.rfc9433.control.v1.ArgsMobSession
+	2,  // 2: nextmn.rfc9433.control.v1.CreateSessionRequest.session:type_name -> nextmn.rfc9433.control.v1.Session
+	2,  // 3: nextmn.rfc9433.control.v1.UpdateSessionRequest.session:type_name -> nextmn.rfc9433.control.v1.Session
+	0,  // 4: nextmn.rfc9433.control.v1.DeleteSessionRequest.key:type_name -> nextmn.rfc9433.control.v1.SessionKey
+	0,  // 5: nextmn.rfc9433.control.v1.GetSessionRequest.key:type_name -> nextmn.rfc9433.control.v1.SessionKey
+	2,  // 6: nextmn.rfc9433.control.v1.ListSessionsResponse.sessions:type_name -> nextmn.rfc9433.control.v1.Session
+	0,  // 7: nextmn.rfc9433.control.v1.GetSessionStatsRequest.key:type_name -> nextmn.rfc9433.control.v1.SessionKey
+	11, // 8: nextmn.rfc9433.control.v1.GetSessionStatsResponse.flows:type_name -> nextmn.rfc9433.control.v1.FlowStats
+	13, // 9: nextmn.rfc9433.control.v1.AddLocatorRequest.locator:type_name -> nextmn.rfc9433.control.v1.Locator
+	13, // 10: nextmn.rfc9433.control.v1.UpdateLocatorRequest.locator:type_name -> nextmn.rfc9433.control.v1.Locator
+	13, // 11: nextmn.rfc9433.control.v1.ListLocatorsResponse.locators:type_name -> nextmn.rfc9433.control.v1.Locator
+	20, // 12: nextmn.rfc9433.control.v1.SetBehaviorRequest.behavior:type_name -> nextmn.rfc9433.control.v1.Behavior
+	20, // 13: nextmn.rfc9433.control.v1.ListBehaviorsResponse.behaviors:type_name -> nextmn.rfc9433.control.v1.Behavior
+	27, // 14: nextmn.rfc9433.control.v1.GetTraceResponse.records:type_name -> nextmn.rfc9433.control.v1.TraceRecord
+	29, // 15: nextmn.rfc9433.control.v1.ListSIDAllocationsResponse.allocations:type_name -> nextmn.rfc9433.control.v1.SIDAllocation
+	1,  // 16: nextmn.rfc9433.control.v1.MGTP4Dst.args:type_name -> nextmn.rfc9433.control.v1.ArgsMobSession
+	37, // 17: nextmn.rfc9433.control.v1.DecodeResponse.mgtp4_dst:type_name -> nextmn.rfc9433.control.v1.MGTP4Dst
+	38, // 18: nextmn.rfc9433.control.v1.DecodeResponse.mgtp4_src:type_name -> nextmn.rfc9433.control.v1.MGTP4Src
+	13, // 19: nextmn.rfc9433.control.v1.Config.locators:type_name -> nextmn.rfc9433.control.v1.Locator
+	20, // 20: nextmn.rfc9433.control.v1.Config.behaviors:type_name -> nextmn.rfc9433.control.v1.Behavior
+	2,  // 21: nextmn.rfc9433.control.v1.Config.sessions:type_name -> nextmn.rfc9433.control.v1.Session
+	40, // 22: nextmn.rfc9433.control.v1.DiffConfigRequest.config:type_name -> nextmn.rfc9433.control.v1.Config
+	40, // 23: nextmn.rfc9433.control.v1.ApplyConfigRequest.config:type_name -> nextmn.rfc9433.control.v1.Config
+	13, // 24: nextmn.rfc9433.control.v1.Diff.add_locators:type_name -> nextmn.rfc9433.control.v1.Locator
+	13, // 25: nextmn.rfc9433.control.v1.Diff.update_locators:type_name -> nextmn.rfc9433.control.v1.Locator
+	20, // 26: nextmn.rfc9433.control.v1.Diff.set_behaviors:type_name -> nextmn.rfc9433.control.v1.Behavior
+	2,  // 27: nextmn.rfc9433.control.v1.Diff.create_sessions:type_name -> nextmn.rfc9433.control.v1.Session
+	2,  // 28: nextmn.rfc9433.control.v1.Diff.update_sessions:type_name -> nextmn.rfc9433.control.v1.Session
+	0,  // 29: nextmn.rfc9433.control.v1.Diff.delete_sessions:type_name -> nextmn.rfc9433.control.v1.SessionKey
+	3,  // 30: nextmn.rfc9433.control.v1.Control.CreateSession:input_type -> nextmn.rfc9433.control.v1.CreateSessionRequest
+	4,  // 31: nextmn.rfc9433.control.v1.Control.UpdateSession:input_type -> nextmn.rfc9433.control.v1.UpdateSessionRequest
+	5,  // 32: nextmn.rfc9433.control.v1.Control.DeleteSession:input_type -> nextmn.rfc9433.control.v1.DeleteSessionRequest
+	7,  // 33: nextmn.rfc9433.control.v1.Control.GetSession:input_type -> nextmn.rfc9433.control.v1.GetSessionRequest
+	8,  // 34: nextmn.rfc9433.control.v1.Control.ListSessions:input_type -> nextmn.rfc9433.control.v1.ListSessionsRequest
+	10, // 35: nextmn.rfc9433.control.v1.Control.GetSessionStats:input_type -> nextmn.rfc9433.control.v1.GetSessionStatsRequest
+	14, // 36: nextmn.rfc9433.control.v1.Control.AddLocator:input_type -> nextmn.rfc9433.control.v1.AddLocatorRequest
+	15, // 37: nextmn.rfc9433.control.v1.Control.UpdateLocator:input_type -> nextmn.rfc9433.control.v1.UpdateLocatorRequest
+	16, // 38: nextmn.rfc9433.control.v1.Control.DeleteLocator:input_type -> nextmn.rfc9433.control.v1.DeleteLocatorRequest
+	18, // 39: nextmn.rfc9433.control.v1.Control.ListLocators:input_type -> nextmn.rfc9433.control.v1.ListLocatorsRequest
+	21, // 40: nextmn.rfc9433.control.v1.Control.SetBehavior:input_type -> nextmn.rfc9433.control.v1.SetBehaviorRequest
+	22, // 41: nextmn.rfc9433.control.v1.Control.DeleteBehavior:input_type -> nextmn.rfc9433.control.v1.DeleteBehaviorRequest
+	24, // 42: nextmn.rfc9433.control.v1.Control.ListBehaviors:input_type -> nextmn.rfc9433.control.v1.ListBehaviorsRequest
+	26, // 43: nextmn.rfc9433.control.v1.Control.GetTrace:input_type -> nextmn.rfc9433.control.v1.GetTraceRequest
+	30, // 44: nextmn.rfc9433.control.v1.Control.AllocateSID:input_type -> nextmn.rfc9433.control.v1.AllocateSIDRequest
+	31, // 45: nextmn.rfc9433.control.v1.Control.ReleaseSID:input_type -> nextmn.rfc9433.control.v1.ReleaseSIDRequest
+	33, // 46: nextmn.rfc9433.control.v1.Control.GetSIDAllocation:input_type -> nextmn.rfc9433.control.v1.GetSIDAllocationRequest
+	34, // 47: nextmn.rfc9433.control.v1.Control.ListSIDAllocations:input_type -> nextmn.rfc9433.control.v1.ListSIDAllocationsRequest
+	36, // 48: nextmn.rfc9433.control.v1.Control.Decode:input_type -> nextmn.rfc9433.control.v1.DecodeRequest
+	41, // 49: nextmn.rfc9433.control.v1.Control.GetConfig:input_type -> nextmn.rfc9433.control.v1.GetConfigRequest
+	42, // 50: nextmn.rfc9433.control.v1.Control.DiffConfig:input_type -> nextmn.rfc9433.control.v1.DiffConfigRequest
+	43, // 51: nextmn.rfc9433.control.v1.Control.ApplyConfig:input_type -> nextmn.rfc9433.control.v1.ApplyConfigRequest
+	2,  // 52: nextmn.rfc9433.control.v1.Control.CreateSession:output_type -> nextmn.rfc9433.control.v1.Session
+	2,  // 53: nextmn.rfc9433.control.v1.Control.UpdateSession:output_type -> nextmn.rfc9433.control.v1.Session
+	6,  // 54: nextmn.rfc9433.control.v1.Control.DeleteSession:output_type -> nextmn.rfc9433.control.v1.DeleteSessionResponse
+	2,  // 55: nextmn.rfc9433.control.v1.Control.GetSession:output_type -> nextmn.rfc9433.control.v1.Session
+	9,  // 56: nextmn.rfc9433.control.v1.Control.ListSessions:output_type -> nextmn.rfc9433.control.v1.ListSessionsResponse
+	12, // 57: nextmn.rfc9433.control.v1.Control.GetSessionStats:output_type -> nextmn.rfc9433.control.v1.GetSessionStatsResponse
+	13, // 58: nextmn.rfc9433.control.v1.Control.AddLocator:output_type -> nextmn.rfc9433.control.v1.Locator
+	13, // 59: nextmn.rfc9433.control.v1.Control.UpdateLocator:output_type -> nextmn.rfc9433.control.v1.Locator
+	17, // 60: nextmn.rfc9433.control.v1.Control.DeleteLocator:output_type -> nextmn.rfc9433.control.v1.DeleteLocatorResponse
+	19, // 61: nextmn.rfc9433.control.v1.Control.ListLocators:output_type -> nextmn.rfc9433.control.v1.ListLocatorsResponse
+	20, // 62: nextmn.rfc9433.control.v1.Control.SetBehavior:output_type -> nextmn.rfc9433.control.v1.Behavior
+	23, // 63: nextmn.rfc9433.control.v1.Control.DeleteBehavior:output_type -> nextmn.rfc9433.control.v1.DeleteBehaviorResponse
+	25, // 64: nextmn.rfc9433.control.v1.Control.ListBehaviors:output_type -> nextmn.rfc9433.control.v1.ListBehaviorsResponse
+	28, // 65: nextmn.rfc9433.control.v1.Control.GetTrace:output_type -> nextmn.rfc9433.control.v1.GetTraceResponse
+	29, // 66: nextmn.rfc9433.control.v1.Control.AllocateSID:output_type -> nextmn.rfc9433.control.v1.SIDAllocation
+	32, // 67: nextmn.rfc9433.control.v1.Control.ReleaseSID:output_type -> nextmn.rfc9433.control.v1.ReleaseSIDResponse
+	29, // 68: nextmn.rfc9433.control.v1.Control.GetSIDAllocation:output_type -> nextmn.rfc9433.control.v1.SIDAllocation
+	35, // 69: nextmn.rfc9433.control.v1.Control.ListSIDAllocations:output_type -> nextmn.rfc9433.control.v1.ListSIDAllocationsResponse
+	39, // 70: nextmn.rfc9433.control.v1.Control.Decode:output_type -> nextmn.rfc9433.control.v1.DecodeResponse
+	40, // 71: nextmn.rfc9433.control.v1.Control.GetConfig:output_type -> nextmn.rfc9433.control.v1.Config
+	44, // 72: nextmn.rfc9433.control.v1.Control.DiffConfig:output_type -> nextmn.rfc9433.control.v1.Diff
+	44, // 73: nextmn.rfc9433.control.v1.Control.ApplyConfig:output_type -> nextmn.rfc9433.control.v1.Diff
+	52, // [52:74] is the sub-list for method output_type
+	30, // [30:52] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
+}
+
+func init() { file_control_control_proto_init() }
+func file_control_control_proto_init() {
+	if File_control_control_proto != nil {
+		return
+	}
+	file_control_control_proto_msgTypes[39].OneofWrappers = []any{
+		(*DecodeResponse_Mgtp4Dst)(nil),
+		(*DecodeResponse_Mgtp4Src)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_control_control_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   45,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_control_control_proto_goTypes,
+		DependencyIndexes: file_control_control_proto_depIdxs,
+		MessageInfos:      file_control_control_proto_msgTypes,
+	}.Build()
+	File_control_control_proto = out.File
+	file_control_control_proto_rawDesc = nil
+	file_control_control_proto_goTypes = nil
+	file_control_control_proto_depIdxs = nil
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package control
+
+import (
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+// Layout is the layout of an IPv6 address decoded by Decode.
+type Layout string
+
+const (
+	LayoutMGTP4Dst Layout = "mgtp4-dst" // End.M.GTP4.E SID (encoding.MGTP4IPv6Dst)
+	LayoutMGTP4Src Layout = "mgtp4-src" // IPv6 SA of End.M.GTP4.E, NextMN scheme (encoding.MGTP4IPv6Src)
+)
+
+// Decoded is the result of Decode: only the field of the decoded layout is set.
+type Decoded struct {
+	MGTP4Dst *encoding.MGTP4IPv6Dst
+	MGTP4Src *encoding.MGTP4IPv6Src
+}
+
+// Decode parses an IPv6 address with the given layout.
+// prefixLen is the prefix length of LayoutMGTP4Dst, and is ignored by LayoutMGTP4Src.
+func Decode(addr netip.Addr, layout Layout, prefixLen uint) (*Decoded, error) {
+	if !addr.Is6() {
+		return nil, errors.ErrInvalidAddress
+	}
+	switch layout {
+	case LayoutMGTP4Dst:
+		dst, err := encoding.ParseMGTP4IPv6Dst(addr.As16(), prefixLen)
+		if err != nil {
+			return nil, err
+		}
+		return &Decoded{MGTP4Dst: dst}, nil
+	case LayoutMGTP4Src:
+		src, err := encoding.ParseMGTP4IPv6SrcNextMN(addr.As16())
+		if err != nil {
+			return nil, err
+		}
+		return &Decoded{MGTP4Src: src}, nil
+	default:
+		return nil, ErrUnknownLayout
+	}
+}
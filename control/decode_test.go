@@ -0,0 +1,54 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package control
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+func TestDecode(t *testing.T) {
+	b, err := encoding.NewMGTP4IPv6Dst(netip.MustParsePrefix("2001:db8::/32"), [4]byte{10, 0, 0, 1}, encoding.NewArgsMobSession(5, true, false, 0xcafe)).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := Decode(netip.AddrFrom16([16]byte(b)), LayoutMGTP4Dst, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.MGTP4Src != nil || d.MGTP4Dst.IPv4() != netip.MustParseAddr("10.0.0.1") || d.MGTP4Dst.PDUSessionID() != 0xcafe || d.MGTP4Dst.QFI() != 5 {
+		t.Errorf("Unexpected result: %+v", d)
+	}
+	b, err = encoding.NewMGTP4IPv6Dst(netip.MustParsePrefix("2001:db8::/32"), [4]byte{}, encoding.NewArgsMobSession(5, true, false, 0xcafe)).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d, err := Decode(netip.AddrFrom16([16]byte(b)), LayoutMGTP4Dst, 32); err != nil || !d.MGTP4Dst.IPv4().IsUnspecified() {
+		t.Errorf("Unspecified IPv4 address should be decoded: %+v, %v", d, err)
+	}
+
+	b, err = encoding.NewMGTP4IPv6Src(netip.MustParsePrefix("2001:db8:1::/48"), [4]byte{10, 0, 0, 2}, 5000).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err = Decode(netip.AddrFrom16([16]byte(b)), LayoutMGTP4Src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.MGTP4Dst != nil || d.MGTP4Src.IPv4() != netip.MustParseAddr("10.0.0.2") || d.MGTP4Src.UDPPortNumber() != 5000 {
+		t.Errorf("Unexpected result: %+v", d)
+	}
+
+	if _, err := Decode(netip.AddrFrom16([16]byte(b)), "unknown", 0); !errors.Is(err, ErrUnknownLayout) {
+		t.Errorf("Unknown layout should be rejected: %v", err)
+	}
+	if _, err := Decode(netip.MustParseAddr("10.0.0.1"), LayoutMGTP4Src, 0); !errors.Is(err, errors.ErrInvalidAddress) {
+		t.Errorf("IPv4 address should be rejected: %v", err)
+	}
+}
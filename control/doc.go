@@ -0,0 +1,15 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package control provides the runtime control of a gateway built from this module:
+// sessions, locators, and behaviors bound to SIDs can be created, modified and deleted
+// by an external controller (e.g. an SMF).
+//
+// Service implements the operations independently of the transport.
+// Their gRPC definition is control.proto, whose generated Go code is package controlpb;
+// package grpcserver serves a Service with it, and package rest exposes it as a REST API.
+package control
+
+//go:generate protoc -I.. --go_out=.. --go_opt=module=github.com/nextmn/rfc9433 --go-grpc_out=.. --go-grpc_opt=module=github.com/nextmn/rfc9433 control/control.proto
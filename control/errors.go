@@ -0,0 +1,22 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package control
+
+import "errors"
+
+var (
+	ErrUnknownAction    = errors.New("no behavior factory for this action")
+	ErrInvalidSpec      = errors.New("invalid behavior specification")
+	ErrInvalidLocator   = errors.New("invalid locator")
+	ErrNoLocator        = errors.New("SID is not in a locator")
+	ErrLocatorNotFound  = errors.New("locator not found")
+	ErrLocatorInUse     = errors.New("locator has behaviors")
+	ErrBehaviorNotFound = errors.New("behavior not found")
+	ErrNoAllocator      = errors.New("SID allocation is not supported")
+	ErrNoRecorder       = errors.New("packet tracing is not enabled")
+	ErrUnknownLayout    = errors.New("unknown address layout")
+	ErrInvalidConfig    = errors.New("invalid configuration")
+)
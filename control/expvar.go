@@ -0,0 +1,54 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package control
+
+import "expvar"
+
+// usageVar is the expvar form of locator.Usage.
+type usageVar struct {
+	Locator   string `json:"locator"`
+	Allocated uint64 `json:"allocated"`
+	Held      uint64 `json:"held"`
+	Size      uint64 `json:"size"`
+}
+
+// serviceVars are the variables published by Service.Var.
+type serviceVars struct {
+	Sessions  int        `json:"sessions"`  // entries of the session.Table
+	Behaviors int        `json:"behaviors"` // prefixes of the behavior.Registry
+	Locators  int        `json:"locators"`
+	Allocator []usageVar `json:"allocator,omitempty"` // usage of each locator of the Allocator
+}
+
+// Var returns an expvar.Var reporting the sizes of the tables and the occupancy of the Allocator,
+// to be published with expvar.Publish.
+func (s *Service) Var() expvar.Var {
+	return expvar.Func(func() any {
+		return s.vars()
+	})
+}
+
+// vars returns the current serviceVars.
+func (s *Service) vars() serviceVars {
+	v := serviceVars{
+		Sessions:  s.sessions.Len(),
+		Behaviors: s.registry.Len(),
+	}
+	s.mu.Lock()
+	v.Locators = len(s.locators)
+	s.mu.Unlock()
+	if s.allocator != nil {
+		for _, u := range s.allocator.Usage() {
+			v.Allocator = append(v.Allocator, usageVar{
+				Locator:   u.Locator.String(),
+				Allocated: u.Allocated,
+				Held:      u.Held,
+				Size:      u.Size,
+			})
+		}
+	}
+	return v
+}
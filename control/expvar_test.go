@@ -0,0 +1,46 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package control
+
+import (
+	"encoding/json"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/behavior"
+	"github.com/nextmn/rfc9433/locator"
+	"github.com/nextmn/rfc9433/session"
+)
+
+func TestServiceVar(t *testing.T) {
+	a, err := locator.NewAllocator(16, 0, netip.MustParsePrefix("2001:db8:1::/48"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewService(session.NewTable(), behavior.NewRegistry(), WithAllocator(a))
+	if err := s.AddLocator(Locator{Prefix: netip.MustParsePrefix("2001:db8::/32")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateSession(session.Key{Peer: netip.MustParseAddr("10.0.0.1"), TEID: 1}, session.Session{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.AllocateSID("smf"); err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]any
+	if err := json.Unmarshal([]byte(s.Var().String()), &got); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(got, map[string]any{
+		"sessions":  1.0,
+		"behaviors": 0.0,
+		"locators":  1.0,
+		"allocator": []any{map[string]any{"locator": "2001:db8:1::/48", "allocated": 1.0, "held": 0.0, "size": 65536.0}},
+	}); diff != "" {
+		t.Error(diff)
+	}
+}
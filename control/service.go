@@ -0,0 +1,401 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package control
+
+import (
+	"cmp"
+	"fmt"
+	"net/netip"
+	"slices"
+	"sync"
+
+	"github.com/nextmn/rfc9433/behavior"
+	"github.com/nextmn/rfc9433/iproute2"
+	"github.com/nextmn/rfc9433/locator"
+	"github.com/nextmn/rfc9433/session"
+)
+
+// Locator is a locator of the gateway, containing the SIDs bound to behaviors.
+type Locator struct {
+	Prefix netip.Prefix
+	Owner  string
+}
+
+// SessionEntry is a session with its key.
+type SessionEntry struct {
+	Key     session.Key
+	Session session.Session
+}
+
+// FlowStats are the counters of a QoS flow of a session.
+type FlowStats struct {
+	Flow  session.QoSFlow
+	Stats session.Stats
+}
+
+// Option configures a Service.
+type Option func(*Service)
+
+// WithBehaviorFactory sets the BehaviorFactory of an action, replacing the default one if any.
+// By default, End.M.GTP4.E, End.M.GTP6.E and H.M.GTP4.D are supported.
+func WithBehaviorFactory(action iproute2.Action, f BehaviorFactory) Option {
+	return func(s *Service) {
+		s.factories[action] = f
+	}
+}
+
+// WithAllocator sets the Allocator of the SIDs allocated through the Service.
+// Allocated SIDs are checked for collisions with the locators and the sessions of the Service.
+// By default, SID allocations are not supported.
+func WithAllocator(a SIDAllocator) Option {
+	return func(s *Service) {
+		s.allocator = a
+	}
+}
+
+// Service controls the sessions of a SessionStore, and the behaviors of a behavior.Registry.
+// Behaviors bound to IPv6 SIDs must be in a locator.
+// Service is safe for concurrent use.
+type Service struct {
+	sessions  SessionStore
+	registry  *behavior.Registry
+	detector  *locator.Detector
+	allocator SIDAllocator
+	factories map[iproute2.Action]BehaviorFactory
+
+	mu        sync.Mutex
+	locators  map[netip.Prefix]Locator
+	behaviors map[netip.Prefix]BehaviorSpec
+}
+
+// NewService creates a Service.
+func NewService(sessions SessionStore, registry *behavior.Registry, opts ...Option) *Service {
+	s := &Service{
+		sessions:  sessions,
+		registry:  registry,
+		detector:  locator.NewDetector(),
+		factories: defaultFactories(),
+		locators:  make(map[netip.Prefix]Locator),
+		behaviors: make(map[netip.Prefix]BehaviorSpec),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.allocator != nil {
+		s.allocator.SetDetector(s.detector)
+	}
+	return s
+}
+
+// CreateSession adds a session.
+func (s *Service) CreateSession(k session.Key, sess session.Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.createSession(k, sess)
+}
+
+func (s *Service) createSession(k session.Key, sess session.Session) error {
+	if err := s.sessions.Add(k, sess); err != nil {
+		return err
+	}
+	if err := s.addSessionSID(k, sess.SID); err != nil {
+		s.sessions.Delete(k)
+		return err
+	}
+	return nil
+}
+
+// UpdateSession replaces an existing session.
+func (s *Service) UpdateSession(k session.Key, sess session.Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.updateSession(k, sess)
+}
+
+func (s *Service) updateSession(k session.Key, sess session.Session) error {
+	old, ok := s.sessions.Lookup(k)
+	if !ok {
+		return session.ErrNotFound
+	}
+	if old.SID == sess.SID {
+		return s.sessions.Update(k, sess)
+	}
+	if err := s.sessions.Update(k, sess); err != nil {
+		return err
+	}
+	if err := s.addSessionSID(k, sess.SID); err != nil {
+		// restore the previous session, whose SID is still provisioned
+		s.sessions.Update(k, old)
+		return err
+	}
+	s.removeSessionSID(old.SID)
+	return nil
+}
+
+// DeleteSession removes a session.
+func (s *Service) DeleteSession(k session.Key) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.deleteSession(k)
+}
+
+func (s *Service) deleteSession(k session.Key) error {
+	sess, ok := s.sessions.Lookup(k)
+	if !ok {
+		return session.ErrNotFound
+	}
+	if err := s.sessions.Delete(k); err != nil {
+		return err
+	}
+	s.removeSessionSID(sess.SID)
+	return nil
+}
+
+// addSessionSID provisions the SID of a session in the Detector, unless it is an allocated SID
+// (which is provisioned by the Allocator). A SID which is not in a locator (e.g. a SID of a remote node
+// pushed by H.M.GTP4.D) is owned by the session, so that no locator containing it can be added.
+func (s *Service) addSessionSID(k session.Key, sid netip.Addr) error {
+	if !sid.IsValid() || s.allocated(sid) {
+		return nil
+	}
+	owner := ""
+	if !s.inLocator(netip.PrefixFrom(sid, sid.BitLen())) {
+		owner = fmt.Sprintf("session %s/%d", k.Peer.Unmap(), k.TEID)
+	}
+	return s.detector.AddSID(sid, owner)
+}
+
+// removeSessionSID removes the SID of a session from the Detector.
+func (s *Service) removeSessionSID(sid netip.Addr) {
+	if !sid.IsValid() || s.allocated(sid) {
+		return
+	}
+	s.detector.RemoveSID(sid)
+}
+
+// allocated returns true if the SID has been allocated by the Allocator.
+func (s *Service) allocated(sid netip.Addr) bool {
+	if s.allocator == nil {
+		return false
+	}
+	_, ok := s.allocator.Owner(sid)
+	return ok
+}
+
+// GetSession returns a session.
+func (s *Service) GetSession(k session.Key) (session.Session, error) {
+	sess, ok := s.sessions.Lookup(k)
+	if !ok {
+		return session.Session{}, session.ErrNotFound
+	}
+	return sess, nil
+}
+
+// SessionStats returns the counters of the QoS flows of a session, sorted by PDU Session ID, then by QFI.
+func (s *Service) SessionStats(k session.Key) ([]FlowStats, error) {
+	stats, ok := s.sessions.Stats(k)
+	if !ok {
+		return nil, session.ErrNotFound
+	}
+	flows := make([]FlowStats, 0, len(stats))
+	for f, st := range stats {
+		flows = append(flows, FlowStats{Flow: f, Stats: st})
+	}
+	slices.SortFunc(flows, func(a, b FlowStats) int {
+		if c := cmp.Compare(a.Flow.PDUSessionID, b.Flow.PDUSessionID); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Flow.QFI, b.Flow.QFI)
+	})
+	return flows, nil
+}
+
+// Sessions returns the sessions, sorted by key.
+func (s *Service) Sessions() []SessionEntry {
+	entries := make([]SessionEntry, 0, s.sessions.Len())
+	s.sessions.Range(func(k session.Key, sess session.Session) bool {
+		entries = append(entries, SessionEntry{Key: k, Session: sess})
+		return true
+	})
+	slices.SortFunc(entries, compareSessionEntries)
+	return entries
+}
+
+// compareSessionEntries orders session entries by peer, then by TEID.
+func compareSessionEntries(a, b SessionEntry) int {
+	if c := a.Key.Peer.Compare(b.Key.Peer); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.Key.TEID, b.Key.TEID)
+}
+
+// AddLocator adds a locator, unless it overlaps an existing one.
+func (s *Service) AddLocator(l Locator) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addLocator(l)
+}
+
+func (s *Service) addLocator(l Locator) error {
+	if !l.Prefix.IsValid() || !l.Prefix.Addr().Is6() {
+		return ErrInvalidLocator
+	}
+	l.Prefix = l.Prefix.Masked()
+	if err := s.detector.AddLocator(l.Prefix, l.Owner); err != nil {
+		return err
+	}
+	s.locators[l.Prefix] = l
+	return nil
+}
+
+// UpdateLocator changes the owner of an existing locator.
+func (s *Service) UpdateLocator(l Locator) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.updateLocator(l)
+}
+
+func (s *Service) updateLocator(l Locator) error {
+	l.Prefix = l.Prefix.Masked()
+	old, ok := s.locators[l.Prefix]
+	if !ok {
+		return ErrLocatorNotFound
+	}
+	s.detector.RemoveLocator(l.Prefix)
+	if err := s.detector.AddLocator(l.Prefix, l.Owner); err != nil {
+		// restore the previous owner, which cannot collide
+		s.detector.AddLocator(old.Prefix, old.Owner)
+		return err
+	}
+	s.locators[l.Prefix] = l
+	return nil
+}
+
+// DeleteLocator removes a locator, unless behaviors are bound to SIDs of this locator.
+func (s *Service) DeleteLocator(prefix netip.Prefix) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.deleteLocator(prefix)
+}
+
+func (s *Service) deleteLocator(prefix netip.Prefix) error {
+	prefix = prefix.Masked()
+	if _, ok := s.locators[prefix]; !ok {
+		return ErrLocatorNotFound
+	}
+	for sid := range s.behaviors {
+		if prefix.Overlaps(sid) {
+			return ErrLocatorInUse
+		}
+	}
+	s.detector.RemoveLocator(prefix)
+	delete(s.locators, prefix)
+	return nil
+}
+
+// Locators returns the locators, sorted by prefix.
+func (s *Service) Locators() []Locator {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sortedLocators()
+}
+
+func (s *Service) sortedLocators() []Locator {
+	locators := make([]Locator, 0, len(s.locators))
+	for _, l := range s.locators {
+		locators = append(locators, l)
+	}
+	slices.SortFunc(locators, func(a, b Locator) int {
+		return comparePrefixes(a.Prefix, b.Prefix)
+	})
+	return locators
+}
+
+// SetBehavior binds a behavior to spec.SID, replacing the existing one if any.
+// IPv6 SIDs must be in a locator.
+func (s *Service) SetBehavior(spec BehaviorSpec) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.setBehavior(spec)
+}
+
+func (s *Service) setBehavior(spec BehaviorSpec) error {
+	if !spec.SID.IsValid() {
+		return ErrInvalidSpec
+	}
+	spec.SID = spec.SID.Masked()
+	spec.Segments = slices.Clone(spec.Segments)
+	f, ok := s.factories[spec.Action]
+	if !ok {
+		return ErrUnknownAction
+	}
+	if spec.SID.Addr().Is6() && !s.inLocator(spec.SID) {
+		return ErrNoLocator
+	}
+	b, err := f.NewBehavior(spec)
+	if err != nil {
+		return err
+	}
+	if err := s.registry.Register(spec.SID, b); err != nil {
+		return err
+	}
+	s.behaviors[spec.SID] = spec
+	return nil
+}
+
+// inLocator returns true if the SID is in a locator.
+func (s *Service) inLocator(sid netip.Prefix) bool {
+	for prefix := range s.locators {
+		if prefix.Bits() <= sid.Bits() && prefix.Contains(sid.Addr()) {
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteBehavior removes the behavior bound to the SID.
+func (s *Service) DeleteBehavior(sid netip.Prefix) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.deleteBehavior(sid)
+}
+
+func (s *Service) deleteBehavior(sid netip.Prefix) error {
+	sid = sid.Masked()
+	if _, ok := s.behaviors[sid]; !ok {
+		return ErrBehaviorNotFound
+	}
+	s.registry.Unregister(sid)
+	delete(s.behaviors, sid)
+	return nil
+}
+
+// Behaviors returns the specifications of the behaviors, sorted by SID.
+func (s *Service) Behaviors() []BehaviorSpec {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sortedBehaviors()
+}
+
+func (s *Service) sortedBehaviors() []BehaviorSpec {
+	specs := make([]BehaviorSpec, 0, len(s.behaviors))
+	for _, spec := range s.behaviors {
+		spec.Segments = slices.Clone(spec.Segments)
+		specs = append(specs, spec)
+	}
+	slices.SortFunc(specs, func(a, b BehaviorSpec) int {
+		return comparePrefixes(a.SID, b.SID)
+	})
+	return specs
+}
+
+// comparePrefixes orders prefixes by address, then by length.
+func comparePrefixes(a, b netip.Prefix) int {
+	if c := a.Addr().Compare(b.Addr()); c != 0 {
+		return c
+	}
+	return a.Bits() - b.Bits()
+}
@@ -0,0 +1,233 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package control
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/behavior"
+	"github.com/nextmn/rfc9433/iproute2"
+	"github.com/nextmn/rfc9433/locator"
+	"github.com/nextmn/rfc9433/session"
+)
+
+func TestServiceSessions(t *testing.T) {
+	s := NewService(session.NewTable(), behavior.NewRegistry())
+	k1 := session.Key{Peer: netip.MustParseAddr("10.0.0.2"), TEID: 2}
+	k2 := session.Key{Peer: netip.MustParseAddr("10.0.0.2"), TEID: 1}
+	s1 := session.Session{SID: netip.MustParseAddr("2001:db8::1")}
+	s2 := session.Session{SID: netip.MustParseAddr("2001:db8::2")}
+	if err := s.CreateSession(k1, s1); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateSession(k2, s2); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateSession(k1, s1); !errors.Is(err, session.ErrExists) {
+		t.Errorf("Duplicated session should be rejected: %v", err)
+	}
+	if diff := cmp.Diff(s.Sessions(), []SessionEntry{{k2, s2}, {k1, s1}}, cmp.Comparer(func(a, b netip.Addr) bool { return a == b })); diff != "" {
+		t.Error(diff)
+	}
+	s1.SID = netip.MustParseAddr("2001:db8::3")
+	if err := s.UpdateSession(k1, s1); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := s.GetSession(k1); err != nil || got.SID != s1.SID {
+		t.Errorf("Unexpected session: %+v, %v", got, err)
+	}
+	if err := s.DeleteSession(k1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.GetSession(k1); !errors.Is(err, session.ErrNotFound) {
+		t.Errorf("Deleted session should not be found: %v", err)
+	}
+}
+
+func TestServiceSessionCollisions(t *testing.T) {
+	a, err := locator.NewAllocator(16, 0, netip.MustParsePrefix("2001:db8:1::/48"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewService(session.NewTable(), behavior.NewRegistry(), WithAllocator(a))
+	if err := s.AddLocator(Locator{Prefix: netip.MustParsePrefix("2001:db8::/32")}); err != nil {
+		t.Fatal(err)
+	}
+	// SID of a remote node
+	k1 := session.Key{Peer: netip.MustParseAddr("10.0.0.1"), TEID: 1}
+	if err := s.CreateSession(k1, session.Session{SID: netip.MustParseAddr("2001:db9::1")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.AddLocator(Locator{Prefix: netip.MustParsePrefix("2001:db9::/32"), Owner: "upf"}); !errors.Is(err, locator.ErrCollision) {
+		t.Errorf("Locator containing the SID of a session should be rejected: %v", err)
+	}
+	// allocated SID
+	sid, err := s.AllocateSID("smf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	k2 := session.Key{Peer: netip.MustParseAddr("10.0.0.1"), TEID: 2}
+	if err := s.CreateSession(k2, session.Session{SID: sid.Addr()}); err != nil {
+		t.Errorf("Session using an allocated SID should be accepted: %v", err)
+	}
+	if err := s.ReserveSID(netip.MustParseAddr("2001:db8:1:1::"), "smf"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.UpdateSession(k1, session.Session{SID: netip.MustParseAddr("2001:db8:1:1::")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.AddLocator(Locator{Prefix: netip.MustParsePrefix("2001:db9::/32"), Owner: "upf"}); err != nil {
+		t.Errorf("Previous SID of the session should be removed: %v", err)
+	}
+	// SID in a locator of the Service, which can change owner
+	if err := s.AddLocator(Locator{Prefix: netip.MustParsePrefix("2001:dba::/32")}); err != nil {
+		t.Fatal(err)
+	}
+	k3 := session.Key{Peer: netip.MustParseAddr("10.0.0.1"), TEID: 3}
+	if err := s.CreateSession(k3, session.Session{SID: netip.MustParseAddr("2001:dba::1")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.UpdateLocator(Locator{Prefix: netip.MustParsePrefix("2001:dba::/32"), Owner: "gw"}); err != nil {
+		t.Errorf("Owner of a locator containing sessions should be changed: %v", err)
+	}
+	if err := s.DeleteSession(k1); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.ReleaseSID(netip.MustParseAddr("2001:db8:1:1::")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestServiceLocators(t *testing.T) {
+	s := NewService(session.NewTable(), behavior.NewRegistry())
+	l1 := Locator{Prefix: netip.MustParsePrefix("2001:db8:1::/48"), Owner: "a"}
+	l2 := Locator{Prefix: netip.MustParsePrefix("2001:db8::/48"), Owner: "b"}
+	for _, l := range []Locator{l1, l2} {
+		if err := s.AddLocator(l); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.AddLocator(Locator{Prefix: netip.MustParsePrefix("2001:db8:1:1::/64")}); !errors.Is(err, locator.ErrCollision) {
+		t.Errorf("Overlapping locator should be rejected: %v", err)
+	}
+	if err := s.AddLocator(Locator{Prefix: netip.MustParsePrefix("10.0.0.0/8")}); !errors.Is(err, ErrInvalidLocator) {
+		t.Errorf("IPv4 locator should be rejected: %v", err)
+	}
+	if diff := cmp.Diff(s.Locators(), []Locator{l2, l1}, cmp.Comparer(func(a, b netip.Prefix) bool { return a == b })); diff != "" {
+		t.Error(diff)
+	}
+	if err := s.DeleteLocator(l1.Prefix); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.DeleteLocator(l1.Prefix); !errors.Is(err, ErrLocatorNotFound) {
+		t.Errorf("Deleted locator should not be found: %v", err)
+	}
+}
+
+func TestServiceBehaviors(t *testing.T) {
+	registry := behavior.NewRegistry()
+	s := NewService(session.NewTable(), registry, WithBehaviorFactory(iproute2.ActionEndMAP, BehaviorFactoryFunc(func(spec BehaviorSpec) (behavior.Behavior, error) {
+		return behavior.NewEndMAP(behavior.StaticMapTable{}), nil
+	})))
+	loc := netip.MustParsePrefix("2001:db8::/32")
+	mgtp4e := BehaviorSpec{SID: netip.MustParsePrefix("2001:db8:4::/48"), Action: iproute2.ActionEndMGTP4E}
+	hmgtp4d := BehaviorSpec{
+		SID:       netip.MustParsePrefix("10.0.0.0/24"),
+		Action:    iproute2.ActionHMGTP4D,
+		SrcPrefix: netip.MustParsePrefix("2001:db8:1::/48"),
+		DstPrefix: netip.MustParsePrefix("2001:db8:4::/48"),
+	}
+	endMAP := BehaviorSpec{SID: netip.MustParsePrefix("2001:db8:5::1/128"), Action: iproute2.ActionEndMAP}
+
+	if err := s.SetBehavior(mgtp4e); !errors.Is(err, ErrNoLocator) {
+		t.Errorf("SID outside of locators should be rejected: %v", err)
+	}
+	if err := s.AddLocator(Locator{Prefix: loc}); err != nil {
+		t.Fatal(err)
+	}
+	for _, spec := range []BehaviorSpec{mgtp4e, hmgtp4d, endMAP} {
+		if err := s.SetBehavior(spec); err != nil {
+			t.Fatalf("%s: %v", spec.Action, err)
+		}
+		if _, _, ok := registry.Lookup(spec.SID.Addr()); !ok {
+			t.Errorf("%s is not registered", spec.Action)
+		}
+	}
+	if err := s.SetBehavior(BehaviorSpec{SID: mgtp4e.SID, Action: iproute2.ActionEnd}); !errors.Is(err, ErrUnknownAction) {
+		t.Errorf("Unknown action should be rejected: %v", err)
+	}
+	if diff := cmp.Diff(s.Behaviors(), []BehaviorSpec{hmgtp4d, mgtp4e, endMAP},
+		cmp.Comparer(func(a, b netip.Prefix) bool { return a == b }),
+		cmp.Comparer(func(a, b netip.Addr) bool { return a == b }),
+	); diff != "" {
+		t.Error(diff)
+	}
+	if err := s.DeleteLocator(loc); !errors.Is(err, ErrLocatorInUse) {
+		t.Errorf("Locator with behaviors should not be deleted: %v", err)
+	}
+
+	for _, spec := range []BehaviorSpec{mgtp4e, endMAP} {
+		if err := s.DeleteBehavior(spec.SID); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, ok := registry.Lookup(spec.SID.Addr()); ok {
+			t.Errorf("%s is still registered", spec.Action)
+		}
+	}
+	if err := s.DeleteBehavior(mgtp4e.SID); !errors.Is(err, ErrBehaviorNotFound) {
+		t.Errorf("Deleted behavior should not be found: %v", err)
+	}
+	if err := s.DeleteLocator(loc); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestServiceUpdateLocator(t *testing.T) {
+	s := NewService(session.NewTable(), behavior.NewRegistry())
+	l := Locator{Prefix: netip.MustParsePrefix("2001:db8:1::/48"), Owner: "a"}
+	if err := s.UpdateLocator(l); !errors.Is(err, ErrLocatorNotFound) {
+		t.Errorf("Missing locator should not be updated: %v", err)
+	}
+	if err := s.AddLocator(l); err != nil {
+		t.Fatal(err)
+	}
+	l.Owner = "b"
+	if err := s.UpdateLocator(l); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.Locators(); len(got) != 1 || got[0].Owner != "b" {
+		t.Errorf("Unexpected locators: %+v", got)
+	}
+}
+
+func TestServiceSessionStats(t *testing.T) {
+	table := session.NewTable()
+	s := NewService(table, behavior.NewRegistry())
+	k := session.Key{Peer: netip.MustParseAddr("10.0.0.2"), TEID: 1}
+	if _, err := s.SessionStats(k); !errors.Is(err, session.ErrNotFound) {
+		t.Errorf("Stats of a missing session should not be found: %v", err)
+	}
+	if err := s.CreateSession(k, session.Session{SID: netip.MustParseAddr("2001:db8::1")}); err != nil {
+		t.Fatal(err)
+	}
+	table.Count(k, session.QoSFlow{PDUSessionID: 2, QFI: 1}, 10)
+	table.Count(k, session.QoSFlow{PDUSessionID: 1, QFI: 9}, 20)
+	table.Drop(k, session.QoSFlow{PDUSessionID: 1, QFI: 5})
+	stats, err := s.SessionStats(k)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(stats, []FlowStats{
+		{Flow: session.QoSFlow{PDUSessionID: 1, QFI: 5}, Stats: session.Stats{Drops: 1}},
+		{Flow: session.QoSFlow{PDUSessionID: 1, QFI: 9}, Stats: session.Stats{Packets: 1, Bytes: 20}},
+		{Flow: session.QoSFlow{PDUSessionID: 2, QFI: 1}, Stats: session.Stats{Packets: 1, Bytes: 10}},
+	}); diff != "" {
+		t.Error(diff)
+	}
+}
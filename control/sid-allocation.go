@@ -0,0 +1,57 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package control
+
+import (
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/locator"
+)
+
+// AllocateSID allocates a free SID to the owner, and returns its prefix.
+func (s *Service) AllocateSID(owner string) (netip.Prefix, error) {
+	if s.allocator == nil {
+		return netip.Prefix{}, ErrNoAllocator
+	}
+	return s.allocator.Allocate(owner)
+}
+
+// ReserveSID allocates the given SID to the owner.
+func (s *Service) ReserveSID(sid netip.Addr, owner string) error {
+	if s.allocator == nil {
+		return ErrNoAllocator
+	}
+	return s.allocator.Reserve(sid, owner)
+}
+
+// ReleaseSID releases an allocated SID.
+func (s *Service) ReleaseSID(sid netip.Addr) error {
+	if s.allocator == nil {
+		return ErrNoAllocator
+	}
+	return s.allocator.Release(sid)
+}
+
+// SIDAllocation returns the allocation of a SID.
+func (s *Service) SIDAllocation(sid netip.Addr) (locator.Allocation, error) {
+	if s.allocator == nil {
+		return locator.Allocation{}, ErrNoAllocator
+	}
+	o, ok := s.allocator.Owner(sid)
+	if !ok {
+		return locator.Allocation{}, locator.ErrNotAllocated
+	}
+	p, _ := s.allocator.Prefix(sid)
+	return locator.Allocation{SID: p, Owner: o}, nil
+}
+
+// SIDAllocations returns the allocated SIDs.
+func (s *Service) SIDAllocations() ([]locator.Allocation, error) {
+	if s.allocator == nil {
+		return nil, ErrNoAllocator
+	}
+	return s.allocator.Allocations(), nil
+}
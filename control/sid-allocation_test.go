@@ -0,0 +1,56 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package control
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/behavior"
+	"github.com/nextmn/rfc9433/locator"
+	"github.com/nextmn/rfc9433/session"
+)
+
+func TestServiceSIDAllocations(t *testing.T) {
+	if _, err := NewService(session.NewTable(), behavior.NewRegistry()).AllocateSID("smf"); !errors.Is(err, ErrNoAllocator) {
+		t.Errorf("Allocation without Allocator should be rejected: %v", err)
+	}
+
+	a, err := locator.NewAllocator(16, 0, netip.MustParsePrefix("2001:db8::/48"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewService(session.NewTable(), behavior.NewRegistry(), WithAllocator(a))
+	p, err := s.AllocateSID("smf1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sid := netip.MustParseAddr("2001:db8:0:ff::")
+	if err := s.ReserveSID(sid, "smf2"); err != nil {
+		t.Fatal(err)
+	}
+	if a, err := s.SIDAllocation(sid); err != nil || a != (locator.Allocation{SID: netip.PrefixFrom(sid, 64), Owner: "smf2"}) {
+		t.Errorf("Unexpected allocation: %+v, %v", a, err)
+	}
+	allocations, err := s.SIDAllocations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(allocations, []locator.Allocation{
+		{SID: p, Owner: "smf1"},
+		{SID: netip.PrefixFrom(sid, 64), Owner: "smf2"},
+	}, cmp.Comparer(func(a, b netip.Prefix) bool { return a == b })); diff != "" {
+		t.Error(diff)
+	}
+	if err := s.ReleaseSID(sid); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.SIDAllocation(sid); !errors.Is(err, locator.ErrNotAllocated) {
+		t.Errorf("Released SID should not have an owner: %v", err)
+	}
+}
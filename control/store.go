@@ -0,0 +1,37 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package control
+
+import (
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/locator"
+	"github.com/nextmn/rfc9433/session"
+)
+
+// SessionStore stores the sessions controlled by a Service (e.g. a session.Table).
+type SessionStore interface {
+	Add(k session.Key, s session.Session) error
+	Update(k session.Key, s session.Session) error
+	Delete(k session.Key) error
+	Lookup(k session.Key) (session.Session, bool)
+	Stats(k session.Key) (map[session.QoSFlow]session.Stats, bool)
+	Len() int
+	Range(fn func(k session.Key, s session.Session) bool)
+}
+
+// SIDAllocator allocates the SIDs of a Service (e.g. a locator.Allocator).
+type SIDAllocator interface {
+	Allocate(owner string) (netip.Prefix, error)
+	Reserve(sid netip.Addr, owner string) error
+	Release(sid netip.Addr) error
+	Owner(sid netip.Addr) (string, bool)
+	Prefix(sid netip.Addr) (netip.Prefix, bool)
+	Allocations() []locator.Allocation
+	Usage() []locator.Usage
+	// SetDetector provisions the allocated SIDs in d, which is the Detector of the Service.
+	SetDetector(d *locator.Detector)
+}
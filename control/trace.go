@@ -0,0 +1,32 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package control
+
+import (
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/trace"
+)
+
+// Trace returns the most recent packets processed by the behavior bound to the SID,
+// from the oldest to the most recent. The behavior.Registry must have a trace.Recorder (behavior.WithRecorder).
+func (s *Service) Trace(sid netip.Prefix) ([]trace.Record, error) {
+	rec := s.registry.Recorder()
+	if rec == nil {
+		return nil, ErrNoRecorder
+	}
+	if !sid.IsValid() {
+		return nil, ErrInvalidSpec
+	}
+	sid = sid.Masked()
+	s.mu.Lock()
+	_, ok := s.behaviors[sid]
+	s.mu.Unlock()
+	if !ok {
+		return nil, ErrBehaviorNotFound
+	}
+	return rec.Snapshot(sid.String()), nil
+}
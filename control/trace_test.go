@@ -0,0 +1,51 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package control
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/nextmn/rfc9433/behavior"
+	"github.com/nextmn/rfc9433/iproute2"
+	"github.com/nextmn/rfc9433/session"
+	"github.com/nextmn/rfc9433/trace"
+)
+
+func TestServiceTrace(t *testing.T) {
+	sid := netip.MustParsePrefix("2001:db8:1::/64")
+	if _, err := NewService(session.NewTable(), behavior.NewRegistry()).Trace(sid); !errors.Is(err, ErrNoRecorder) {
+		t.Errorf("Trace without Recorder should be rejected: %v", err)
+	}
+
+	r := behavior.NewRegistry(behavior.WithRecorder(trace.NewRecorder(8)))
+	s := NewService(session.NewTable(), r)
+	if _, err := s.Trace(sid); !errors.Is(err, ErrBehaviorNotFound) {
+		t.Errorf("Expected ErrBehaviorNotFound, got %v", err)
+	}
+	if err := s.AddLocator(Locator{Prefix: netip.MustParsePrefix("2001:db8:1::/48")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetBehavior(BehaviorSpec{SID: sid, Action: iproute2.ActionEndMGTP4E}); err != nil {
+		t.Fatal(err)
+	}
+	// IPv6 header without payload, dropped by End.M.GTP4.E
+	pkt := make([]byte, 40)
+	pkt[0] = 0x60
+	pkt[6] = 59
+	copy(pkt[24:40], netip.MustParseAddr("2001:db8:1::1").AsSlice())
+	if _, err := r.Process(pkt, nil); err == nil {
+		t.Fatal("Packet should be dropped")
+	}
+	records, err := s.Trace(sid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].Decision != trace.DecisionDrop || records[0].Len != len(pkt) {
+		t.Errorf("Unexpected records: %+v", records)
+	}
+}
@@ -0,0 +1,15 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package datapath provides the datapath of a user-space SR Gateway:
+// packets received on a Device are processed by a Behavior (e.g. a behavior.Registry),
+// and the resulting packets are sent back on the Device.
+//
+// On Linux, the Device is either a TUN interface (TUN), an AF_PACKET socket
+// with a ring buffer (Packet) when the performance of TUN is insufficient,
+// or an AF_XDP socket (XDP) on interfaces supporting XDP.
+//
+// GTPUHandler connects a gtpu.Server to a Behavior, for GTP4 packets received on an UDP socket.
+package datapath
@@ -0,0 +1,23 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package datapath
+
+import "errors"
+
+var (
+	ErrInvalidBufferSize = errors.New("invalid buffer size")
+	ErrInvalidPrefix     = errors.New("invalid prefix")
+	ErrInvalidFilter     = errors.New("invalid socket filter")
+	ErrFilterTooLong     = errors.New("socket filter is too long")
+	ErrInvalidRing       = errors.New("invalid ring configuration")
+	ErrClosed            = errors.New("device is closed")
+	ErrMalformedPacket   = errors.New("malformed packet")
+	ErrTooLong           = errors.New("packet is too long")
+	ErrInvalidGateway    = errors.New("invalid gateway MAC address")
+	ErrNoFreeFrame       = errors.New("no free frame")
+	ErrInvalidLocalAddr  = errors.New("local address is not a specified IPv4 address")
+	ErrShutdown          = errors.New("forwarder is shut down")
+)
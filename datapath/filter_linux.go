@@ -0,0 +1,164 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package datapath
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"strconv"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	filterAccept = "accept"
+	filterReject = "reject"
+
+	// snapshot length of accepted packets
+	filterSnapLen = 0x40000
+)
+
+// instruction is a classic BPF instruction whose jumps target labels.
+type instruction struct {
+	label  string // label of the instruction, if any
+	code   uint16
+	jt, jf string // targets of conditional jumps; empty for the next instruction
+	ja     string // target of unconditional jumps
+	k      uint32
+}
+
+// NewFilter compiles a classic BPF program for sockets receiving packets starting
+// with the IP header (e.g. AF_PACKET sockets of type SOCK_DGRAM). It accepts:
+//   - IPv6 packets whose DA matches an IPv6 prefix (locators),
+//   - IPv4 UDP packets (first fragment) whose destination port is gtpuPort,
+//     and whose DA matches an IPv4 prefix, if any.
+func NewFilter(prefixes []netip.Prefix, gtpuPort uint16) ([]unix.SockFilter, error) {
+	var v4, v6 []netip.Prefix
+	for _, p := range prefixes {
+		if !p.IsValid() {
+			return nil, ErrInvalidPrefix
+		}
+		if p.Addr().Is4() {
+			v4 = append(v4, p.Masked())
+		} else {
+			v6 = append(v6, p.Masked())
+		}
+	}
+	prog := []instruction{
+		{code: unix.BPF_LD | unix.BPF_B | unix.BPF_ABS, k: 0},
+		{code: unix.BPF_ALU | unix.BPF_RSH | unix.BPF_K, k: 4},
+		{code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, k: 6, jt: "ipv6.0"},
+		{code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, k: 4, jt: "ipv4", jf: filterReject},
+	}
+	prog = append(prog, matchPrefixes("ipv6", v6, 24, filterAccept)...)
+	prog = append(prog,
+		instruction{label: "ipv4", code: unix.BPF_LD | unix.BPF_B | unix.BPF_ABS, k: 9},
+		instruction{code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, k: unix.IPPROTO_UDP, jf: filterReject},
+		instruction{code: unix.BPF_LD | unix.BPF_H | unix.BPF_ABS, k: 6},
+		instruction{code: unix.BPF_JMP | unix.BPF_JSET | unix.BPF_K, k: 0x1FFF, jt: filterReject},
+		instruction{code: unix.BPF_LDX | unix.BPF_B | unix.BPF_MSH, k: 0},
+		instruction{code: unix.BPF_LD | unix.BPF_H | unix.BPF_IND, k: 2},
+	)
+	if len(v4) == 0 {
+		prog = append(prog, instruction{code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, k: uint32(gtpuPort), jt: filterAccept, jf: filterReject})
+	} else {
+		prog = append(prog, instruction{code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, k: uint32(gtpuPort), jt: "ipv4.0", jf: filterReject})
+		prog = append(prog, matchPrefixes("ipv4", v4, 16, filterAccept)...)
+	}
+	prog = append(prog,
+		instruction{label: filterAccept, code: unix.BPF_RET | unix.BPF_K, k: filterSnapLen},
+		instruction{label: filterReject, code: unix.BPF_RET | unix.BPF_K, k: 0},
+	)
+	return assemble(prog)
+}
+
+// matchPrefixes returns the instructions jumping to match if the address at offset
+// matches one of the prefixes, or to filterReject otherwise.
+// The instruction matching the i-th prefix is labelled name.i.
+func matchPrefixes(name string, prefixes []netip.Prefix, offset uint32, match string) []instruction {
+	var prog []instruction
+	for i, p := range prefixes {
+		label := name + "." + strconv.Itoa(i)
+		next := filterReject
+		if i+1 < len(prefixes) {
+			next = name + "." + strconv.Itoa(i+1)
+		}
+		addr := p.Addr().AsSlice()
+		if p.Bits() == 0 {
+			prog = append(prog, instruction{label: label, code: unix.BPF_JMP | unix.BPF_JA, ja: match})
+			continue
+		}
+		for w := 0; w*32 < p.Bits(); w++ {
+			value := binary.BigEndian.Uint32(addr[4*w : 4*w+4])
+			prog = append(prog, instruction{code: unix.BPF_LD | unix.BPF_W | unix.BPF_ABS, k: offset + uint32(4*w)})
+			if w == 0 {
+				prog[len(prog)-1].label = label
+			}
+			if bits := p.Bits() - 32*w; bits < 32 {
+				prog = append(prog, instruction{code: unix.BPF_ALU | unix.BPF_AND | unix.BPF_K, k: ^uint32(0) << (32 - bits)})
+			}
+			jt := ""
+			if (w+1)*32 >= p.Bits() {
+				jt = match
+			}
+			prog = append(prog, instruction{code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, k: value, jt: jt, jf: next})
+		}
+	}
+	if len(prefixes) == 0 {
+		prog = append(prog, instruction{label: name + ".0", code: unix.BPF_JMP | unix.BPF_JA, ja: filterReject})
+	}
+	return prog
+}
+
+// assemble resolves the labels of the program.
+func assemble(prog []instruction) ([]unix.SockFilter, error) {
+	labels := make(map[string]int, len(prog))
+	for i, ins := range prog {
+		if ins.label != "" {
+			labels[ins.label] = i
+		}
+	}
+	offset := func(i int, label string) (int, error) {
+		if label == "" {
+			return 0, nil
+		}
+		target, ok := labels[label]
+		if !ok || target <= i {
+			return 0, ErrInvalidFilter
+		}
+		return target - i - 1, nil
+	}
+	if len(prog) > unix.BPF_MAXINSNS {
+		return nil, ErrFilterTooLong
+	}
+	filter := make([]unix.SockFilter, len(prog))
+	for i, ins := range prog {
+		f := unix.SockFilter{Code: ins.code, K: ins.k}
+		if ins.ja != "" {
+			ja, err := offset(i, ins.ja)
+			if err != nil {
+				return nil, err
+			}
+			f.K = uint32(ja)
+		}
+		jt, err := offset(i, ins.jt)
+		if err != nil {
+			return nil, err
+		}
+		jf, err := offset(i, ins.jf)
+		if err != nil {
+			return nil, err
+		}
+		if jt > 0xFF || jf > 0xFF {
+			return nil, ErrFilterTooLong
+		}
+		f.Jt, f.Jf = uint8(jt), uint8(jf)
+		filter[i] = f
+	}
+	return filter, nil
+}
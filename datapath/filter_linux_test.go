@@ -0,0 +1,143 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package datapath
+
+import (
+	"encoding/binary"
+	"errors"
+	"net/netip"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+// runFilter runs the subset of classic BPF used by NewFilter.
+func runFilter(t *testing.T, filter []unix.SockFilter, pkt []byte) uint32 {
+	t.Helper()
+	var a, x uint32
+	load := func(off uint32, size int) (uint32, bool) {
+		if int(off)+size > len(pkt) {
+			return 0, false
+		}
+		switch size {
+		case 1:
+			return uint32(pkt[off]), true
+		case 2:
+			return uint32(binary.BigEndian.Uint16(pkt[off:])), true
+		}
+		return binary.BigEndian.Uint32(pkt[off:]), true
+	}
+	sizes := map[uint16]int{unix.BPF_B: 1, unix.BPF_H: 2, unix.BPF_W: 4}
+	for pc := 0; pc < len(filter); pc++ {
+		ins := filter[pc]
+		var ok bool
+		switch {
+		case ins.Code&0x07 == unix.BPF_LD && ins.Code&0xe0 == unix.BPF_ABS:
+			if a, ok = load(ins.K, sizes[ins.Code&0x18]); !ok {
+				return 0
+			}
+		case ins.Code&0x07 == unix.BPF_LD && ins.Code&0xe0 == unix.BPF_IND:
+			if a, ok = load(x+ins.K, sizes[ins.Code&0x18]); !ok {
+				return 0
+			}
+		case ins.Code == unix.BPF_LDX|unix.BPF_B|unix.BPF_MSH:
+			if x, ok = load(ins.K, 1); !ok {
+				return 0
+			}
+			x = 4 * (x & 0x0F)
+		case ins.Code == unix.BPF_ALU|unix.BPF_RSH|unix.BPF_K:
+			a >>= ins.K
+		case ins.Code == unix.BPF_ALU|unix.BPF_AND|unix.BPF_K:
+			a &= ins.K
+		case ins.Code == unix.BPF_JMP|unix.BPF_JA:
+			pc += int(ins.K)
+		case ins.Code == unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K:
+			if a == ins.K {
+				pc += int(ins.Jt)
+			} else {
+				pc += int(ins.Jf)
+			}
+		case ins.Code == unix.BPF_JMP|unix.BPF_JSET|unix.BPF_K:
+			if a&ins.K != 0 {
+				pc += int(ins.Jt)
+			} else {
+				pc += int(ins.Jf)
+			}
+		case ins.Code == unix.BPF_RET|unix.BPF_K:
+			return ins.K
+		default:
+			t.Fatalf("unexpected instruction %#v", ins)
+		}
+	}
+	t.Fatal("end of program reached")
+	return 0
+}
+
+// testIPv4UDP returns an IPv4/UDP packet.
+func testIPv4UDP(dst string, port uint16, fragOffset uint16) []byte {
+	pkt := make([]byte, 28)
+	pkt[0] = 0x45
+	binary.BigEndian.PutUint16(pkt[2:4], uint16(len(pkt)))
+	binary.BigEndian.PutUint16(pkt[6:8], fragOffset)
+	pkt[8] = 64
+	pkt[9] = unix.IPPROTO_UDP
+	copy(pkt[12:16], []byte{10, 0, 0, 1})
+	a := netip.MustParseAddr(dst).As4()
+	copy(pkt[16:20], a[:])
+	binary.BigEndian.PutUint16(pkt[20:22], 40000)
+	binary.BigEndian.PutUint16(pkt[22:24], port)
+	binary.BigEndian.PutUint16(pkt[24:26], 8)
+	return pkt
+}
+
+// testIPv6 returns an IPv6 packet without payload.
+func testIPv6(dst string) []byte {
+	pkt := make([]byte, 40)
+	pkt[0] = 0x60
+	pkt[6] = unix.IPPROTO_NONE
+	pkt[7] = 64
+	a := netip.MustParseAddr(dst).As16()
+	copy(pkt[24:40], a[:])
+	return pkt
+}
+
+func TestNewFilter(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		prefixes []netip.Prefix
+		pkt      []byte
+		accept   bool
+	}{
+		{name: "locator", prefixes: []netip.Prefix{netip.MustParsePrefix("fd00:1::/48")}, pkt: testIPv6("fd00:1::1"), accept: true},
+		{name: "locator partial word", prefixes: []netip.Prefix{netip.MustParsePrefix("fd00:1:a000::/36")}, pkt: testIPv6("fd00:1:afff::1"), accept: true},
+		{name: "other locator", prefixes: []netip.Prefix{netip.MustParsePrefix("fd00:2::/48"), netip.MustParsePrefix("fd00:1::/48")}, pkt: testIPv6("fd00:1::1"), accept: true},
+		{name: "no locator", prefixes: []netip.Prefix{netip.MustParsePrefix("fd00:2::/48")}, pkt: testIPv6("fd00:1::1")},
+		{name: "no prefix", pkt: testIPv6("fd00:1::1")},
+		{name: "gtp4 any", pkt: testIPv4UDP("10.0.0.2", 2152, 0), accept: true},
+		{name: "gtp4 prefix", prefixes: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/24")}, pkt: testIPv4UDP("10.0.0.2", 2152, 0), accept: true},
+		{name: "gtp4 other prefix", prefixes: []netip.Prefix{netip.MustParsePrefix("10.1.0.0/24")}, pkt: testIPv4UDP("10.0.0.2", 2152, 0)},
+		{name: "gtp4 other port", pkt: testIPv4UDP("10.0.0.2", 2153, 0)},
+		{name: "gtp4 fragment", pkt: testIPv4UDP("10.0.0.2", 2152, 0x0010)},
+		{name: "gtp4 df", pkt: testIPv4UDP("10.0.0.2", 2152, 0x4000), accept: true},
+		{name: "truncated", pkt: testIPv4UDP("10.0.0.2", 2152, 0)[:21]},
+		{name: "not ip", pkt: []byte{0x10}},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			filter, err := NewFilter(c.prefixes, 2152)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := runFilter(t, filter, c.pkt) != 0; got != c.accept {
+				t.Errorf("expected accept=%t, got %t", c.accept, got)
+			}
+		})
+	}
+	if _, err := NewFilter([]netip.Prefix{{}}, 2152); !errors.Is(err, ErrInvalidPrefix) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package datapath
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/nextmn/rfc9433/behavior"
+)
+
+// defaultBufferSize is large enough for any IPv4 or IPv6 packet without jumbogram.
+const defaultBufferSize = 0xFFFF + 40
+
+// Device reads and writes IP packets (e.g. a TUN device).
+type Device interface {
+	// ReadPacket reads a single packet into b, and returns its length.
+	ReadPacket(b []byte) (int, error)
+	// WritePacket writes a single packet.
+	WritePacket(pkt []byte) error
+	Close() error
+}
+
+// Drainer is implemented by Devices whose queued packets can be forwarded on Shutdown (e.g. TUN).
+type Drainer interface {
+	// Interrupt makes the pending and later calls of ReadPacket return an error, without closing the Device.
+	Interrupt() error
+	// ReadQueuedPacket reads a single packet already queued into b without blocking, and returns its length.
+	// It returns an error (e.g. EAGAIN) if no packet is queued.
+	ReadQueuedPacket(b []byte) (int, error)
+}
+
+// StatsFlusher finalizes the statistics of the forwarded packets on Shutdown
+// (e.g. exports the counters of a session.Table).
+type StatsFlusher interface {
+	FlushStats() error
+}
+
+// StatsFlusherFunc is an adapter to allow the use of ordinary functions as StatsFlusher.
+type StatsFlusherFunc func() error
+
+// FlushStats calls f().
+func (f StatsFlusherFunc) FlushStats() error {
+	return f()
+}
+
+// ErrorHandler is notified of the packets dropped by a Forwarder.
+// pkt is only valid until HandleError returns.
+type ErrorHandler interface {
+	HandleError(pkt []byte, err error)
+}
+
+// ErrorHandlerFunc is an adapter to allow the use of ordinary functions as ErrorHandler.
+type ErrorHandlerFunc func(pkt []byte, err error)
+
+// HandleError calls f(pkt, err).
+func (f ErrorHandlerFunc) HandleError(pkt []byte, err error) {
+	f(pkt, err)
+}
+
+// Option configures a Forwarder.
+type Option func(*Forwarder)
+
+// WithErrorHandler sets the ErrorHandler of the Forwarder.
+// By default, errors are ignored.
+//
+// Errors returned by a behavior.MTUGuard are *behavior.PacketTooBigError,
+// whose ICMP message can be sent from the handler (e.g. using a raw socket).
+func WithErrorHandler(h ErrorHandler) Option {
+	return func(f *Forwarder) {
+		f.handler = h
+	}
+}
+
+// WithStatsFlusher sets the StatsFlusher called by Shutdown once the last packet is forwarded.
+func WithStatsFlusher(fl StatsFlusher) Option {
+	return func(f *Forwarder) {
+		f.flusher = fl
+	}
+}
+
+// WithBufferSize sets the size of the buffer receiving packets, which must be at least the MTU of the Device.
+func WithBufferSize(size int) Option {
+	return func(f *Forwarder) {
+		f.bufferSize = size
+	}
+}
+
+// Forwarder processes the packets read from a Device with a Behavior,
+// and writes the resulting packets (and the fragments set in behavior.Metadata) back to the Device.
+type Forwarder struct {
+	dev        Device
+	b          behavior.Behavior
+	handler    ErrorHandler
+	flusher    StatsFlusher
+	bufferSize int
+
+	mu       sync.RWMutex // read-locked while a packet is processed
+	shutdown bool
+	running  sync.WaitGroup // calls of Run
+}
+
+// NewForwarder creates a Forwarder.
+func NewForwarder(dev Device, b behavior.Behavior, opts ...Option) (*Forwarder, error) {
+	f := &Forwarder{
+		dev:        dev,
+		b:          b,
+		bufferSize: defaultBufferSize,
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	if f.bufferSize <= 0 {
+		return nil, ErrInvalidBufferSize
+	}
+	return f, nil
+}
+
+// Run forwards packets until ctx is done, the Forwarder is shut down, or the Device fails.
+// The Device is closed when ctx is done, and Run returns ctx.Err(): the packet being processed may be lost.
+// After Shutdown, Run returns ErrShutdown.
+// Run may be called from several goroutines to process packets in parallel.
+func (f *Forwarder) Run(ctx context.Context) error {
+	f.mu.RLock()
+	if f.shutdown {
+		f.mu.RUnlock()
+		return ErrShutdown
+	}
+	f.running.Add(1)
+	f.mu.RUnlock()
+	defer f.running.Done()
+	stop := context.AfterFunc(ctx, func() {
+		f.dev.Close()
+	})
+	defer stop()
+	buf := make([]byte, f.bufferSize)
+	meta := &behavior.Metadata{}
+	for {
+		n, err := f.dev.ReadPacket(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if f.isShutdown() {
+				return ErrShutdown
+			}
+			return err
+		}
+		f.mu.RLock()
+		*meta = behavior.Metadata{}
+		f.forward(buf[:n], meta)
+		f.mu.RUnlock()
+	}
+}
+
+// Shutdown stops the Forwarder gracefully: the packets being processed (and their fragments) are written,
+// then Shutdown waits for Run to return. If the Device is a Drainer, the packets queued in the Device
+// are then forwarded until none is left or ctx is done; otherwise they are dropped.
+// Finally, the Device is closed and the StatsFlusher is called, if any.
+// If ctx is done before Run returns, the Device is closed and ctx.Err() is returned.
+func (f *Forwarder) Shutdown(ctx context.Context) error {
+	f.mu.Lock()
+	if f.shutdown {
+		f.mu.Unlock()
+		return ErrShutdown
+	}
+	f.shutdown = true
+	d, ok := f.dev.(Drainer)
+	var err error
+	if ok {
+		err = d.Interrupt()
+	}
+	if !ok || err != nil {
+		d = nil
+		err = f.dev.Close()
+	}
+	f.mu.Unlock()
+	done := make(chan struct{})
+	go func() {
+		f.running.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		if d != nil {
+			f.dev.Close()
+		}
+		return ctx.Err()
+	}
+	if d != nil {
+		f.drain(ctx, d)
+		err = f.dev.Close()
+	}
+	if f.flusher != nil {
+		err = errors.Join(err, f.flusher.FlushStats())
+	}
+	return err
+}
+
+// isShutdown returns true if Shutdown has been called.
+func (f *Forwarder) isShutdown() bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.shutdown
+}
+
+// drain forwards the packets queued in the Device, until none is left or ctx is done.
+func (f *Forwarder) drain(ctx context.Context, d Drainer) {
+	buf := make([]byte, f.bufferSize)
+	meta := &behavior.Metadata{}
+	for ctx.Err() == nil {
+		n, err := d.ReadQueuedPacket(buf)
+		if err != nil {
+			return
+		}
+		*meta = behavior.Metadata{}
+		f.forward(buf[:n], meta)
+	}
+}
+
+// forward processes a single packet, and writes the result.
+func (f *Forwarder) forward(pkt []byte, meta *behavior.Metadata) {
+	out, err := f.b.Process(pkt, meta)
+	if err != nil {
+		f.handleError(pkt, err)
+		return
+	}
+	if err := f.dev.WritePacket(out); err != nil {
+		f.handleError(pkt, err)
+		return
+	}
+	for _, fragment := range meta.Fragments {
+		if err := f.dev.WritePacket(fragment); err != nil {
+			f.handleError(pkt, err)
+			return
+		}
+	}
+}
+
+// handleError notifies the ErrorHandler, if any.
+func (f *Forwarder) handleError(pkt []byte, err error) {
+	if f.handler != nil {
+		f.handler.HandleError(pkt, err)
+	}
+}
@@ -0,0 +1,305 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package datapath
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/behavior"
+)
+
+// fakeDevice returns the packets of in, and stores the written packets.
+type fakeDevice struct {
+	mu      sync.Mutex
+	in      chan []byte
+	out     [][]byte
+	written chan struct{}
+	closed  chan struct{}
+	once    sync.Once
+}
+
+func newFakeDevice() *fakeDevice {
+	return &fakeDevice{
+		in:      make(chan []byte),
+		written: make(chan struct{}, 16),
+		closed:  make(chan struct{}),
+	}
+}
+
+func (d *fakeDevice) ReadPacket(b []byte) (int, error) {
+	select {
+	case pkt := <-d.in:
+		return copy(b, pkt), nil
+	case <-d.closed:
+		return 0, io.EOF
+	}
+}
+
+func (d *fakeDevice) WritePacket(pkt []byte) error {
+	d.mu.Lock()
+	d.out = append(d.out, append([]byte(nil), pkt...))
+	d.mu.Unlock()
+	d.written <- struct{}{}
+	return nil
+}
+
+func (d *fakeDevice) Close() error {
+	d.once.Do(func() { close(d.closed) })
+	return nil
+}
+
+// drainDevice is a fakeDevice whose queued packets can be drained.
+type drainDevice struct {
+	*fakeDevice
+	interrupted chan struct{}
+}
+
+func newDrainDevice() *drainDevice {
+	d := &drainDevice{
+		fakeDevice:  newFakeDevice(),
+		interrupted: make(chan struct{}),
+	}
+	d.in = make(chan []byte, 4)
+	return d
+}
+
+func (d *drainDevice) ReadPacket(b []byte) (int, error) {
+	select {
+	case <-d.interrupted:
+		return 0, os.ErrDeadlineExceeded
+	default:
+	}
+	select {
+	case pkt := <-d.in:
+		return copy(b, pkt), nil
+	case <-d.interrupted:
+		return 0, os.ErrDeadlineExceeded
+	case <-d.closed:
+		return 0, io.EOF
+	}
+}
+
+func (d *drainDevice) Interrupt() error {
+	close(d.interrupted)
+	return nil
+}
+
+func (d *drainDevice) ReadQueuedPacket(b []byte) (int, error) {
+	select {
+	case pkt := <-d.in:
+		return copy(b, pkt), nil
+	default:
+		return 0, syscall.EAGAIN
+	}
+}
+
+func TestForwarder(t *testing.T) {
+	errProcess := errors.New("process")
+	b := behavior.BehaviorFunc(func(pkt []byte, meta *behavior.Metadata) ([]byte, error) {
+		switch pkt[0] {
+		case 0:
+			return nil, errProcess
+		case 1:
+			meta.Fragments = [][]byte{{1, 'b'}, {1, 'c'}}
+			return []byte{1, 'a'}, nil
+		}
+		return pkt, nil
+	})
+	dropped := make(chan error, 1)
+	dev := newFakeDevice()
+	f, err := NewForwarder(dev, b, WithErrorHandler(ErrorHandlerFunc(func(pkt []byte, err error) {
+		dropped <- err
+	})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- f.Run(ctx)
+	}()
+
+	dev.in <- []byte{0}
+	if err := <-dropped; !errors.Is(err, errProcess) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	dev.in <- []byte{1}
+	for range 3 {
+		<-dev.written
+	}
+	dev.in <- []byte{2, 'd'}
+	<-dev.written
+
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([][]byte{{1, 'a'}, {1, 'b'}, {1, 'c'}, {2, 'd'}}, dev.out); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestForwarderDeviceError(t *testing.T) {
+	dev := newFakeDevice()
+	dev.Close()
+	f, err := NewForwarder(dev, behavior.NewRegistry())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Run(context.Background()); !errors.Is(err, io.EOF) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := NewForwarder(dev, behavior.NewRegistry(), WithBufferSize(0)); !errors.Is(err, ErrInvalidBufferSize) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestForwarderShutdown(t *testing.T) {
+	processing := make(chan struct{})
+	release := make(chan struct{})
+	b := behavior.BehaviorFunc(func(pkt []byte, meta *behavior.Metadata) ([]byte, error) {
+		close(processing)
+		<-release
+		return pkt, nil
+	})
+	dev := newFakeDevice()
+	f, err := NewForwarder(dev, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	go func() {
+		done <- f.Run(context.Background())
+	}()
+	dev.in <- []byte{1}
+	<-processing
+	shutdown := make(chan error)
+	go func() {
+		shutdown <- f.Shutdown(context.Background())
+	}()
+	select {
+	case err := <-shutdown:
+		t.Fatalf("Shutdown should wait for the packet being processed: %v", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+	close(release)
+	if err := <-shutdown; err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; !errors.Is(err, ErrShutdown) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([][]byte{{1}}, dev.out); diff != "" {
+		t.Error(diff)
+	}
+	if err := f.Run(context.Background()); !errors.Is(err, ErrShutdown) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := f.Shutdown(context.Background()); !errors.Is(err, ErrShutdown) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestForwarderShutdownDrain(t *testing.T) {
+	processing := make(chan struct{}, 1)
+	release := make(chan struct{})
+	b := behavior.BehaviorFunc(func(pkt []byte, meta *behavior.Metadata) ([]byte, error) {
+		if pkt[0] == 1 {
+			processing <- struct{}{}
+			<-release
+		}
+		return pkt, nil
+	})
+	dev := newDrainDevice()
+	flushed := 0
+	f, err := NewForwarder(dev, b, WithStatsFlusher(StatsFlusherFunc(func() error {
+		dev.mu.Lock()
+		defer dev.mu.Unlock()
+		flushed = len(dev.out)
+		return nil
+	})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	go func() {
+		done <- f.Run(context.Background())
+	}()
+	dev.in <- []byte{1}
+	<-processing
+	// queued while the first packet is processed
+	dev.in <- []byte{2}
+	dev.in <- []byte{3}
+	shutdown := make(chan error)
+	go func() {
+		shutdown <- f.Shutdown(context.Background())
+	}()
+	close(release)
+	if err := <-shutdown; err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; !errors.Is(err, ErrShutdown) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([][]byte{{1}, {2}, {3}}, dev.out); diff != "" {
+		t.Error(diff)
+	}
+	if flushed != 3 {
+		t.Errorf("Statistics should be flushed after the last packet, got %d packets", flushed)
+	}
+	select {
+	case <-dev.closed:
+	default:
+		t.Error("Device should be closed")
+	}
+}
+
+func TestForwarderParallelRun(t *testing.T) {
+	processing := make(chan struct{}, 2)
+	release := make(chan struct{})
+	b := behavior.BehaviorFunc(func(pkt []byte, meta *behavior.Metadata) ([]byte, error) {
+		processing <- struct{}{}
+		<-release
+		return pkt, nil
+	})
+	dev := newFakeDevice()
+	f, err := NewForwarder(dev, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	for range 2 {
+		go func() {
+			done <- f.Run(context.Background())
+		}()
+	}
+	dev.in <- []byte{1}
+	dev.in <- []byte{2}
+	for range 2 {
+		select {
+		case <-processing:
+		case <-time.After(time.Second):
+			t.Fatal("Packets should be processed in parallel")
+		}
+	}
+	close(release)
+	if err := f.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	for range 2 {
+		if err := <-done; !errors.Is(err, ErrShutdown) {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package datapath
+
+import (
+	"encoding/binary"
+
+	"github.com/nextmn/rfc9433/behavior"
+	"github.com/nextmn/rfc9433/gtpu"
+	"github.com/nextmn/rfc9433/ipv4"
+)
+
+const (
+	udpHeaderLen = 8
+	protoUDP     = 17
+)
+
+// GTPUHandler is a gtpu.Handler processing the G-PDUs received by a gtpu.Server with a Behavior
+// (e.g. a behavior.HMGTP4D), and writing the resulting packets to a Device.
+//
+// Since the socket of the Server only provides the GTP-U message, the Behavior receives an IPv4/UDP/GTP-U packet
+// rebuilt from the addresses of the message, with a TTL of behavior.DefaultHopLimit and a zero TOS.
+// The Server must listen on a specified IPv4 address, used as IPv4 DA.
+type GTPUHandler struct {
+	dev Device
+	b   behavior.Behavior
+}
+
+// NewGTPUHandler creates a GTPUHandler.
+func NewGTPUHandler(dev Device, b behavior.Behavior) *GTPUHandler {
+	return &GTPUHandler{
+		dev: dev,
+		b:   b,
+	}
+}
+
+// ServeGTPU implements gtpu.Handler.
+func (h *GTPUHandler) ServeGTPU(m *gtpu.Message) error {
+	src := m.Remote.Addr().Unmap()
+	dst := m.Local.Addr().Unmap()
+	if !dst.Is4() || dst.IsUnspecified() {
+		return ErrInvalidLocalAddr
+	}
+	if !src.Is4() {
+		return ErrMalformedPacket
+	}
+	ip := ipv4.Header{
+		TTL:        behavior.DefaultHopLimit,
+		Protocol:   protoUDP,
+		Src:        src.As4(),
+		Dst:        dst.As4(),
+		PayloadLen: udpHeaderLen + len(m.Data),
+	}
+	ipLen := ip.MarshalLen()
+	pkt := make([]byte, ipLen+udpHeaderLen+len(m.Data))
+	if err := ip.MarshalTo(pkt); err != nil {
+		return ErrTooLong
+	}
+	udp := pkt[ipLen:]
+	binary.BigEndian.PutUint16(udp[0:2], m.Remote.Port())
+	binary.BigEndian.PutUint16(udp[2:4], gtpu.Port)
+	binary.BigEndian.PutUint16(udp[4:6], uint16(udpHeaderLen+len(m.Data)))
+	copy(udp[udpHeaderLen:], m.Data)
+
+	meta := &behavior.Metadata{}
+	out, err := h.b.Process(pkt, meta)
+	if err != nil {
+		return err
+	}
+	if err := h.dev.WritePacket(out); err != nil {
+		return err
+	}
+	for _, fragment := range meta.Fragments {
+		if err := h.dev.WritePacket(fragment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
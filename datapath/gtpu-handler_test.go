@@ -0,0 +1,60 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package datapath
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/behavior"
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/gtpu"
+	"github.com/nextmn/rfc9433/headend"
+)
+
+func TestGTPUHandler(t *testing.T) {
+	inner := []byte{0x45, 0x00, 0x00, 0x14, 0, 0, 0, 0, 64, 17, 0, 0, 10, 45, 0, 1, 10, 45, 0, 2}
+	b, err := (&gtpu.Header{MessageType: gtpu.MessageTypeGPDU, TEID: 0xcafe, PayloadLen: len(inner)}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &gtpu.Message{
+		Local:  netip.MustParseAddrPort("10.0.0.1:2152"),
+		Remote: netip.MustParseAddrPort("10.0.0.2:5000"),
+		Data:   append(b, inner...),
+	}
+	srcPrefix := netip.MustParsePrefix("2001:db8:1::/48")
+	dstPrefix := netip.MustParsePrefix("2001:db8::/32")
+	dev := newFakeDevice()
+	h := NewGTPUHandler(dev, behavior.NewHMGTP4D(behavior.SRv6Policy{DstPrefix: dstPrefix, Reduced: true}, headend.StaticPrefix(srcPrefix), nil))
+	if err := h.ServeGTPU(m); err != nil {
+		t.Fatal(err)
+	}
+	if len(dev.out) != 1 || len(dev.out[0]) != 40+len(inner) {
+		t.Fatalf("Unexpected packets: %x", dev.out)
+	}
+	src, err := encoding.NewMGTP4IPv6Src(srcPrefix, [4]byte{10, 0, 0, 2}, 5000).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dst, err := encoding.NewHMGTP4IPv6Dst(dstPrefix, [4]byte{10, 0, 0, 1}, encoding.NewArgsMobSession(0, false, false, 0xcafe)).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(dev.out[0][8:40], append(src, dst...)); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(dev.out[0][40:], inner); diff != "" {
+		t.Error(diff)
+	}
+
+	m.Local = netip.MustParseAddrPort("0.0.0.0:2152")
+	if err := h.ServeGTPU(m); !errors.Is(err, ErrInvalidLocalAddr) {
+		t.Errorf("Unspecified local address should be rejected: %v", err)
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package datapath
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"net/netip"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"github.com/nextmn/rfc9433/gtpu"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	defaultBlockSize    = 1 << 20
+	defaultBlockCount   = 64
+	defaultBlockTimeout = 10 * time.Millisecond
+	frameSize           = 1 << 11 // only used by the kernel to check the ring configuration
+
+	// Byte positions in struct tpacket_block_desc
+	blockStatusPosByte   = 8
+	blockNumPktsPosByte  = 12
+	blockFirstPktPosByte = 16
+
+	// Byte positions in struct tpacket3_hdr
+	pktNextOffsetPosByte = 0
+	pktSnapLenPosByte    = 12
+	pktLenPosByte        = 16
+	pktNetPosByte        = 26
+)
+
+// PacketConfig is the configuration of a Packet device.
+type PacketConfig struct {
+	Interface    string         // interface receiving the packets
+	Prefixes     []netip.Prefix // locators (IPv6), and prefixes of the GTP4 packets (IPv4); see NewFilter
+	GTPUPort     uint16         // UDP destination port of the GTP4 packets; zero for gtpu.Port
+	BlockSize    int            // size of the blocks of the ring, a multiple of the page size; zero for 1 MiB
+	BlockCount   int            // number of blocks of the ring; zero for 64
+	BlockTimeout time.Duration  // delay before a block that is not full is handed over; zero for 10 ms
+}
+
+// Packet is a Device receiving packets on an AF_PACKET socket with a TPACKET_V3 ring buffer,
+// filtered in the kernel by a BPF socket filter (see NewFilter).
+// Packets are sent using raw IP sockets, and routed by the kernel.
+//
+// Received packets are still processed by the kernel: their prefixes should be
+// routed to a blackhole (e.g. `ip -6 route add blackhole <locator>`).
+// ReadPacket must not be called concurrently.
+type Packet struct {
+	mu         sync.RWMutex // write-locked to release the resources
+	closed     atomic.Bool
+	fd         int
+	event      int // eventfd interrupting ReadPacket on Close
+	raw4, raw6 int
+	ring       []byte
+	blockSize  int
+	blockCount int
+
+	// current position in the ring
+	block     int
+	remaining uint32 // packets not yet read in the current block
+	offset    int    // offset of the next packet in the ring
+}
+
+// OpenPacket creates a Packet device. CAP_NET_RAW is required.
+func OpenPacket(config PacketConfig) (*Packet, error) {
+	if config.GTPUPort == 0 {
+		config.GTPUPort = gtpu.Port
+	}
+	if config.BlockSize == 0 {
+		config.BlockSize = defaultBlockSize
+	}
+	if config.BlockCount == 0 {
+		config.BlockCount = defaultBlockCount
+	}
+	if config.BlockTimeout == 0 {
+		config.BlockTimeout = defaultBlockTimeout
+	}
+	if config.BlockSize <= 0 || config.BlockSize%os.Getpagesize() != 0 || config.BlockCount <= 0 || config.BlockTimeout < time.Millisecond {
+		return nil, ErrInvalidRing
+	}
+	filter, err := NewFilter(config.Prefixes, config.GTPUPort)
+	if err != nil {
+		return nil, err
+	}
+	iface, err := net.InterfaceByName(config.Interface)
+	if err != nil {
+		return nil, err
+	}
+	p := &Packet{
+		fd:         -1,
+		event:      -1,
+		raw4:       -1,
+		raw6:       -1,
+		blockSize:  config.BlockSize,
+		blockCount: config.BlockCount,
+	}
+	if err := p.open(iface.Index, filter, config.BlockTimeout); err != nil {
+		return nil, errors.Join(err, p.release())
+	}
+	return p, nil
+}
+
+// open creates the sockets and the ring.
+func (p *Packet) open(ifindex int, filter []unix.SockFilter, timeout time.Duration) error {
+	var err error
+	// protocol 0: no packet is received before the socket is bound, once the filter is attached
+	if p.fd, err = unix.Socket(unix.AF_PACKET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0); err != nil {
+		return os.NewSyscallError("socket", err)
+	}
+	prog := unix.SockFprog{Len: uint16(len(filter)), Filter: &filter[0]}
+	if err := unix.SetsockoptSockFprog(p.fd, unix.SOL_SOCKET, unix.SO_ATTACH_FILTER, &prog); err != nil {
+		return os.NewSyscallError("setsockopt", err)
+	}
+	if err := unix.SetsockoptInt(p.fd, unix.SOL_PACKET, unix.PACKET_IGNORE_OUTGOING, 1); err != nil {
+		return os.NewSyscallError("setsockopt", err)
+	}
+	if err := unix.SetsockoptInt(p.fd, unix.SOL_PACKET, unix.PACKET_VERSION, unix.TPACKET_V3); err != nil {
+		return os.NewSyscallError("setsockopt", err)
+	}
+	req := unix.TpacketReq3{
+		Block_size:     uint32(p.blockSize),
+		Block_nr:       uint32(p.blockCount),
+		Frame_size:     frameSize,
+		Frame_nr:       uint32(p.blockSize / frameSize * p.blockCount),
+		Retire_blk_tov: uint32(timeout.Milliseconds()),
+	}
+	if err := unix.SetsockoptTpacketReq3(p.fd, unix.SOL_PACKET, unix.PACKET_RX_RING, &req); err != nil {
+		return os.NewSyscallError("setsockopt", err)
+	}
+	if p.ring, err = unix.Mmap(p.fd, 0, p.blockSize*p.blockCount, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_SHARED); err != nil {
+		return os.NewSyscallError("mmap", err)
+	}
+	if err := unix.Bind(p.fd, &unix.SockaddrLinklayer{Protocol: htons(unix.ETH_P_ALL), Ifindex: ifindex}); err != nil {
+		return os.NewSyscallError("bind", err)
+	}
+	if p.event, err = unix.Eventfd(0, unix.EFD_CLOEXEC|unix.EFD_NONBLOCK); err != nil {
+		return os.NewSyscallError("eventfd", err)
+	}
+	// IPPROTO_RAW implies IP_HDRINCL (IPv4) and IPV6_HDRINCL (IPv6)
+	if p.raw4, err = unix.Socket(unix.AF_INET, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.IPPROTO_RAW); err != nil {
+		return os.NewSyscallError("socket", err)
+	}
+	if p.raw6, err = unix.Socket(unix.AF_INET6, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.IPPROTO_RAW); err != nil {
+		return os.NewSyscallError("socket", err)
+	}
+	return nil
+}
+
+// ReadPacket reads a single packet into b, and returns its length.
+// Packets longer than b are dropped.
+func (p *Packet) ReadPacket(b []byte) (int, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for {
+		if p.closed.Load() {
+			return 0, ErrClosed
+		}
+		if p.remaining > 0 {
+			hdr := p.ring[p.offset:]
+			next := binary.NativeEndian.Uint32(hdr[pktNextOffsetPosByte:])
+			snapLen := int(binary.NativeEndian.Uint32(hdr[pktSnapLenPosByte:]))
+			length := int(binary.NativeEndian.Uint32(hdr[pktLenPosByte:]))
+			start := int(binary.NativeEndian.Uint16(hdr[pktNetPosByte:]))
+			n := 0
+			if snapLen == length && length <= len(b) {
+				n = copy(b, hdr[start:start+snapLen])
+			}
+			p.offset += int(next)
+			p.remaining--
+			if p.remaining == 0 {
+				p.releaseBlock()
+			}
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+		block := p.ring[p.block*p.blockSize:]
+		if atomic.LoadUint32(p.blockStatus())&unix.TP_STATUS_USER == 0 {
+			if err := p.wait(); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		p.remaining = binary.NativeEndian.Uint32(block[blockNumPktsPosByte:])
+		p.offset = p.block*p.blockSize + int(binary.NativeEndian.Uint32(block[blockFirstPktPosByte:]))
+		if p.remaining == 0 {
+			p.releaseBlock()
+		}
+	}
+}
+
+// blockStatus returns the status of the current block.
+func (p *Packet) blockStatus() *uint32 {
+	return (*uint32)(unsafe.Pointer(&p.ring[p.block*p.blockSize+blockStatusPosByte]))
+}
+
+// releaseBlock hands over the current block to the kernel, and moves to the next block.
+func (p *Packet) releaseBlock() {
+	atomic.StoreUint32(p.blockStatus(), unix.TP_STATUS_KERNEL)
+	p.block = (p.block + 1) % p.blockCount
+}
+
+// wait waits until a block is handed over by the kernel, or the device is closed.
+func (p *Packet) wait() error {
+	fds := []unix.PollFd{
+		{Fd: int32(p.fd), Events: unix.POLLIN | unix.POLLERR},
+		{Fd: int32(p.event), Events: unix.POLLIN},
+	}
+	for {
+		_, err := unix.Poll(fds, -1)
+		if err == unix.EINTR {
+			continue
+		}
+		if err != nil {
+			return os.NewSyscallError("poll", err)
+		}
+		return nil
+	}
+}
+
+// WritePacket writes a single IPv4 or IPv6 packet.
+func (p *Packet) WritePacket(pkt []byte) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed.Load() {
+		return ErrClosed
+	}
+	if len(pkt) == 0 {
+		return ErrMalformedPacket
+	}
+	var err error
+	switch pkt[0] >> 4 {
+	case 4:
+		if len(pkt) < 20 {
+			return ErrMalformedPacket
+		}
+		err = unix.Sendto(p.raw4, pkt, 0, &unix.SockaddrInet4{Addr: [4]byte(pkt[16:20])})
+	case 6:
+		if len(pkt) < 40 {
+			return ErrMalformedPacket
+		}
+		err = unix.Sendto(p.raw6, pkt, 0, &unix.SockaddrInet6{Addr: [16]byte(pkt[24:40])})
+	default:
+		return ErrMalformedPacket
+	}
+	if err != nil {
+		return os.NewSyscallError("sendto", err)
+	}
+	return nil
+}
+
+// Close interrupts ReadPacket, and releases the sockets and the ring.
+func (p *Packet) Close() error {
+	if p.closed.Swap(true) {
+		return ErrClosed
+	}
+	var one [8]byte
+	binary.NativeEndian.PutUint64(one[:], 1)
+	unix.Write(p.event, one[:])
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.release()
+}
+
+// release releases the sockets and the ring.
+func (p *Packet) release() error {
+	var errs []error
+	if p.ring != nil {
+		errs = append(errs, unix.Munmap(p.ring))
+		p.ring = nil
+	}
+	for _, fd := range []*int{&p.fd, &p.event, &p.raw4, &p.raw6} {
+		if *fd >= 0 {
+			errs = append(errs, unix.Close(*fd))
+			*fd = -1
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// htons converts a short from host to network byte order.
+func htons(v uint16) uint16 {
+	return binary.BigEndian.Uint16(binary.NativeEndian.AppendUint16(nil, v))
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package datapath
+
+import (
+	"errors"
+	"net/netip"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestPacket(t *testing.T) {
+	p, err := OpenPacket(PacketConfig{
+		Interface: "lo",
+		Prefixes:  []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")},
+	})
+	if errors.Is(err, os.ErrPermission) {
+		t.Skip("CAP_NET_RAW is required")
+	} else if err != nil {
+		t.Fatal(err)
+	}
+	// filtered out
+	if err := p.WritePacket(testIPv4UDP("127.0.0.1", 2153, 0)); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.WritePacket(testIPv4UDP("127.0.0.1", 2152, 0)); err != nil {
+		t.Fatal(err)
+	}
+	type result struct {
+		pkt []byte
+		err error
+	}
+	read := make(chan result)
+	go func() {
+		b := make([]byte, 1500)
+		n, err := p.ReadPacket(b)
+		read <- result{pkt: b[:n], err: err}
+		_, err = p.ReadPacket(b)
+		read <- result{err: err}
+	}()
+	select {
+	case r := <-read:
+		if r.err != nil {
+			t.Fatal(r.err)
+		}
+		if len(r.pkt) != 28 || r.pkt[23] != 2152&0xFF {
+			t.Errorf("unexpected packet: %x", r.pkt)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no packet received")
+	}
+	if err := p.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if r := <-read; !errors.Is(r.err, ErrClosed) {
+		t.Errorf("unexpected error: %v", r.err)
+	}
+}
+
+func TestOpenPacketInvalidRing(t *testing.T) {
+	if _, err := OpenPacket(PacketConfig{Interface: "lo", BlockSize: 1000}); !errors.Is(err, ErrInvalidRing) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package datapath
+
+import (
+	"errors"
+	"net"
+	"net/netip"
+	"os"
+	"time"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+const tunPath = "/dev/net/tun"
+
+// TUNConfig is the configuration of a TUN device.
+type TUNConfig struct {
+	Name      string         // name of the interface; if empty, the name is chosen by the kernel
+	MTU       int            // MTU of the interface; zero keeps the default MTU
+	Addresses []netip.Prefix // addresses of the interface
+}
+
+// TUN is a Device using a Linux TUN interface, without packet information header.
+type TUN struct {
+	file *os.File
+	name string
+}
+
+// OpenTUN creates (or attaches to) the TUN interface, configures it, and sets it up.
+// CAP_NET_ADMIN is required.
+func OpenTUN(config TUNConfig) (*TUN, error) {
+	ifr, err := unix.NewIfreq(config.Name)
+	if err != nil {
+		return nil, err
+	}
+	ifr.SetUint16(unix.IFF_TUN | unix.IFF_NO_PI)
+	fd, err := unix.Open(tunPath, unix.O_RDWR|unix.O_CLOEXEC|unix.O_NONBLOCK, 0)
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: tunPath, Err: err}
+	}
+	if err := unix.IoctlIfreq(fd, unix.TUNSETIFF, ifr); err != nil {
+		unix.Close(fd)
+		return nil, os.NewSyscallError("ioctl", err)
+	}
+	// the file descriptor is non-blocking: Close interrupts pending reads
+	t := &TUN{
+		file: os.NewFile(uintptr(fd), tunPath),
+		name: ifr.Name(),
+	}
+	if err := t.configure(config); err != nil {
+		return nil, errors.Join(err, t.Close())
+	}
+	return t, nil
+}
+
+// configure sets the MTU and the addresses of the interface, and sets it up.
+func (t *TUN) configure(config TUNConfig) error {
+	link, err := netlink.LinkByName(t.name)
+	if err != nil {
+		return err
+	}
+	if config.MTU > 0 {
+		if err := netlink.LinkSetMTU(link, config.MTU); err != nil {
+			return err
+		}
+	}
+	for _, prefix := range config.Addresses {
+		addr := &netlink.Addr{
+			IPNet: &net.IPNet{
+				IP:   prefix.Addr().AsSlice(),
+				Mask: net.CIDRMask(prefix.Bits(), prefix.Addr().BitLen()),
+			},
+		}
+		if prefix.Addr().Is6() {
+			// no neighbor on a TUN interface
+			addr.Flags = unix.IFA_F_NODAD
+		}
+		if err := netlink.AddrReplace(link, addr); err != nil {
+			return err
+		}
+	}
+	return netlink.LinkSetUp(link)
+}
+
+// Name returns the name of the interface.
+func (t *TUN) Name() string {
+	return t.name
+}
+
+// ReadPacket reads a single packet into b, and returns its length.
+func (t *TUN) ReadPacket(b []byte) (int, error) {
+	return t.file.Read(b)
+}
+
+// Interrupt makes the pending and later calls of ReadPacket return os.ErrDeadlineExceeded.
+func (t *TUN) Interrupt() error {
+	return t.file.SetReadDeadline(time.Now())
+}
+
+// ReadQueuedPacket reads a single packet already queued into b without blocking, and returns its length.
+// It returns EAGAIN if no packet is queued.
+func (t *TUN) ReadQueuedPacket(b []byte) (int, error) {
+	conn, err := t.file.SyscallConn()
+	if err != nil {
+		return 0, err
+	}
+	var n int
+	var errRead error
+	if err := conn.Control(func(fd uintptr) {
+		n, errRead = unix.Read(int(fd), b)
+	}); err != nil {
+		return 0, err
+	}
+	if errRead != nil {
+		return 0, os.NewSyscallError("read", errRead)
+	}
+	return n, nil
+}
+
+// WritePacket writes a single packet.
+func (t *TUN) WritePacket(pkt []byte) error {
+	_, err := t.file.Write(pkt)
+	return err
+}
+
+// Close closes the TUN device. A non-persistent interface is removed by the kernel.
+func (t *TUN) Close() error {
+	return t.file.Close()
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package datapath
+
+import (
+	"errors"
+	"net/netip"
+	"os"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestOpenTUN(t *testing.T) {
+	tun, err := OpenTUN(TUNConfig{
+		Name:      "rfc9433test0",
+		MTU:       1400,
+		Addresses: []netip.Prefix{netip.MustParsePrefix("fd00:db8:9433::1/64")},
+	})
+	if errors.Is(err, os.ErrPermission) || errors.Is(err, os.ErrNotExist) {
+		t.Skip("CAP_NET_ADMIN and /dev/net/tun are required")
+	} else if err != nil {
+		t.Fatal(err)
+	}
+	if tun.Name() != "rfc9433test0" {
+		t.Errorf("unexpected name: %s", tun.Name())
+	}
+	// packets sent by the kernel (e.g. MLD reports) may be queued
+	buf := make([]byte, 1500)
+	for range 100 {
+		if _, err = tun.ReadQueuedPacket(buf); err != nil {
+			break
+		}
+	}
+	if !errors.Is(err, unix.EAGAIN) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := tun.Interrupt(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tun.ReadPacket(buf); !errors.Is(err, os.ErrDeadlineExceeded) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := tun.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package datapath
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"strconv"
+
+	"github.com/nextmn/rfc9433/ebpf"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	ethHeaderLen      = 14
+	ethTypePosByte    = 12
+	ethTypeIPv4       = 0x0800
+	ethTypeIPv6       = 0x86DD
+	xdpPass           = 2
+	helperRedirectMap = 51 // bpf_redirect_map
+
+	// Byte positions in struct xdp_md
+	xdpMDDataPosByte         = 0
+	xdpMDDataEndPosByte      = 4
+	xdpMDRxQueueIndexPosByte = 16
+)
+
+// xdpProgram returns an XDP program redirecting to the AF_XDP socket of the receive queue
+// (in the XSKMAP xsks) the packets matched by NewFilter; other packets are passed to the kernel.
+// Only IPv4 headers without options are matched.
+func xdpProgram(xsks *ebpf.Map, prefixes []netip.Prefix, gtpuPort uint16) []ebpf.Instruction {
+	var v4, v6 []netip.Prefix
+	for _, p := range prefixes {
+		if p.Addr().Is4() {
+			v4 = append(v4, p.Masked())
+		} else {
+			v6 = append(v6, p.Masked())
+		}
+	}
+	prog := []ebpf.Instruction{
+		ebpf.LoadMem(ebpf.SizeW, ebpf.R7, ebpf.R1, xdpMDRxQueueIndexPosByte),
+		ebpf.LoadMem(ebpf.SizeW, ebpf.R2, ebpf.R1, xdpMDDataPosByte),
+		ebpf.LoadMem(ebpf.SizeW, ebpf.R3, ebpf.R1, xdpMDDataEndPosByte),
+	}
+	prog = append(prog, xdpBoundsCheck(ethHeaderLen)...)
+	prog = append(prog,
+		ebpf.LoadMem(ebpf.SizeH, ebpf.R5, ebpf.R2, ethTypePosByte),
+		ebpf.Jump32Imm(ebpf.OpJEQ, ebpf.R5, network16(ethTypeIPv6), "ipv6"),
+		ebpf.Jump32Imm(ebpf.OpJEQ, ebpf.R5, network16(ethTypeIPv4), "ipv4"),
+		ebpf.Ja("pass"),
+	)
+	ipv6 := xdpBoundsCheck(ethHeaderLen + 40)
+	ipv6[0] = ipv6[0].WithLabel("ipv6")
+	prog = append(prog, ipv6...)
+	prog = append(prog, xdpMatchPrefixes("ipv6", v6, ethHeaderLen+24)...)
+	ipv4 := xdpBoundsCheck(ethHeaderLen + 28)
+	ipv4[0] = ipv4[0].WithLabel("ipv4")
+	prog = append(prog, ipv4...)
+	prog = append(prog,
+		ebpf.LoadMem(ebpf.SizeB, ebpf.R5, ebpf.R2, ethHeaderLen),
+		ebpf.Jump32Imm(ebpf.OpJNE, ebpf.R5, 0x45, "pass"),
+		ebpf.LoadMem(ebpf.SizeB, ebpf.R5, ebpf.R2, ethHeaderLen+9),
+		ebpf.Jump32Imm(ebpf.OpJNE, ebpf.R5, unix.IPPROTO_UDP, "pass"),
+		ebpf.LoadMem(ebpf.SizeH, ebpf.R5, ebpf.R2, ethHeaderLen+6),
+		ebpf.ALU32Imm(ebpf.OpAnd, ebpf.R5, network16(0x1FFF)),
+		ebpf.Jump32Imm(ebpf.OpJNE, ebpf.R5, 0, "pass"),
+		ebpf.LoadMem(ebpf.SizeH, ebpf.R5, ebpf.R2, ethHeaderLen+20+2),
+		ebpf.Jump32Imm(ebpf.OpJNE, ebpf.R5, network16(gtpuPort), "pass"),
+	)
+	if len(v4) == 0 {
+		prog = append(prog, ebpf.Ja("redirect"))
+	} else {
+		prog = append(prog, xdpMatchPrefixes("ipv4", v4, ethHeaderLen+16)...)
+	}
+	return append(prog,
+		ebpf.LoadMapFD(ebpf.R1, xsks.FD()).WithLabel("redirect"),
+		ebpf.Mov64Reg(ebpf.R2, ebpf.R7),
+		ebpf.Mov64Imm(ebpf.R3, xdpPass), // action if no socket is bound to the queue
+		ebpf.Call(helperRedirectMap),
+		ebpf.Exit(),
+		ebpf.Mov64Imm(ebpf.R0, xdpPass).WithLabel("pass"),
+		ebpf.Exit(),
+	)
+}
+
+// xdpBoundsCheck returns the instructions passing the packet to the kernel
+// if it is shorter than n bytes (R2: data, R3: data_end).
+func xdpBoundsCheck(n int32) []ebpf.Instruction {
+	return []ebpf.Instruction{
+		ebpf.Mov64Reg(ebpf.R4, ebpf.R2),
+		ebpf.ALU64Imm(ebpf.OpAdd, ebpf.R4, n),
+		ebpf.JumpReg(ebpf.OpJGT, ebpf.R4, ebpf.R3, "pass"),
+	}
+}
+
+// xdpMatchPrefixes returns the instructions jumping to "redirect" if the address at offset
+// of the packet matches one of the prefixes, or to "pass" otherwise.
+func xdpMatchPrefixes(name string, prefixes []netip.Prefix, offset int16) []ebpf.Instruction {
+	var prog []ebpf.Instruction
+	for i, p := range prefixes {
+		next := "pass"
+		if i+1 < len(prefixes) {
+			next = name + "." + strconv.Itoa(i+1)
+		}
+		addr := p.Addr().AsSlice()
+		start := len(prog)
+		for w := 0; w*32 < p.Bits(); w++ {
+			word := addr[4*w : 4*w+4]
+			prog = append(prog, ebpf.LoadMem(ebpf.SizeW, ebpf.R5, ebpf.R2, offset+int16(4*w)))
+			if bits := p.Bits() - 32*w; bits < 32 {
+				var mask [4]byte
+				binary.BigEndian.PutUint32(mask[:], ^uint32(0)<<(32-bits))
+				prog = append(prog, ebpf.ALU32Imm(ebpf.OpAnd, ebpf.R5, int32(binary.NativeEndian.Uint32(mask[:]))))
+			}
+			prog = append(prog, ebpf.Jump32Imm(ebpf.OpJNE, ebpf.R5, int32(binary.NativeEndian.Uint32(word)), next))
+		}
+		prog = append(prog, ebpf.Ja("redirect"))
+		prog[start] = prog[start].WithLabel(name + "." + strconv.Itoa(i))
+	}
+	if len(prefixes) == 0 {
+		prog = append(prog, ebpf.Ja("pass"))
+	}
+	return prog
+}
+
+// network16 returns the value loaded from a 16 bits field in network byte order.
+func network16(v uint16) int32 {
+	return int32(binary.NativeEndian.Uint16(binary.BigEndian.AppendUint16(nil, v)))
+}
@@ -0,0 +1,44 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package datapath
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/nextmn/rfc9433/ebpf"
+	"golang.org/x/sys/unix"
+)
+
+func TestXDPProgram(t *testing.T) {
+	xsks, err := ebpf.NewMap(ebpf.MapSpec{Type: ebpf.MapTypeXSKMap, KeySize: 4, ValueSize: 4, MaxEntries: 1})
+	if errors.Is(err, unix.EPERM) {
+		t.Skip("CAP_BPF is required")
+	} else if err != nil {
+		t.Fatal(err)
+	}
+	defer xsks.Close()
+	for _, c := range []struct {
+		name     string
+		prefixes []netip.Prefix
+	}{
+		{name: "none"},
+		{name: "ipv6", prefixes: []netip.Prefix{netip.MustParsePrefix("fd00:1::/48"), netip.MustParsePrefix("fd00:2:8000::/33")}},
+		{name: "ipv4", prefixes: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("192.0.2.1/32")}},
+		{name: "default", prefixes: []netip.Prefix{netip.MustParsePrefix("::/0"), netip.MustParsePrefix("0.0.0.0/0")}},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			p, err := ebpf.LoadProgram("rfc9433_test", ebpf.ProgramTypeXDP, xdpProgram(xsks, c.prefixes, 2152), xdpLicense)
+			if err != nil {
+				t.Fatal(err)
+			}
+			p.Close()
+		})
+	}
+}
@@ -0,0 +1,457 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package datapath
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"net/netip"
+	"os"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/nextmn/rfc9433/ebpf"
+	"github.com/nextmn/rfc9433/egress"
+	"github.com/nextmn/rfc9433/gtpu"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	defaultFrameCount = 4096
+	defaultFrameSize  = 4096
+	xdpDescLen        = 16 // size of struct xdp_desc
+	xdpAddrLen        = 8  // size of the descriptors of the fill and completion rings
+	xdpLicense        = "Dual MIT/GPL"
+)
+
+// XDPConfig is the configuration of an XDP device.
+type XDPConfig struct {
+	Interface  string           // interface receiving and sending the packets
+	Queue      int              // receive and transmit queue of the interface
+	Gateway    net.HardwareAddr // destination MAC address of the sent packets (next-hop), unless GatewayAddr is set
+	Prefixes   []netip.Prefix   // locators (IPv6), and prefixes of the GTP4 packets (IPv4); see NewFilter
+	GTPUPort   uint16           // UDP destination port of the GTP4 packets; zero for gtpu.Port
+	FrameCount int              // number of frames of the UMEM, a power of two; zero for 4096
+	FrameSize  int              // size of the frames of the UMEM, 2048 or 4096; zero for 4096
+	ZeroCopy   bool             // require the zero-copy mode of the driver; otherwise, the copy mode may be used
+	SKBMode    bool             // attach the XDP program in generic mode, for drivers without native XDP support
+
+	// GatewayAddr is the address of the next-hop, whose MAC address is resolved by Neighbors for each sent packet.
+	// Neighbors is nil for a NeighborCache of the kernel neighbors of the interface (ARP and NDP).
+	GatewayAddr netip.Addr
+	Neighbors   egress.NeighborResolver
+}
+
+// xdpRing is a ring shared with the kernel.
+type xdpRing struct {
+	mem      []byte
+	producer *uint32
+	consumer *uint32
+	flags    *uint32
+	desc     []byte
+	mask     uint32
+	cached   uint32 // cached producer (consumer side) or consumer (producer side)
+	local    uint32 // local consumer (consumer side) or producer (producer side)
+}
+
+// newXDPRing maps a ring of n descriptors of size descLen.
+func newXDPRing(fd int, pgoff int64, off unix.XDPRingOffset, n int, descLen int) (*xdpRing, error) {
+	mem, err := unix.Mmap(fd, pgoff, int(off.Desc)+n*descLen, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_SHARED|unix.MAP_POPULATE)
+	if err != nil {
+		return nil, os.NewSyscallError("mmap", err)
+	}
+	return &xdpRing{
+		mem:      mem,
+		producer: (*uint32)(unsafe.Pointer(&mem[off.Producer])),
+		consumer: (*uint32)(unsafe.Pointer(&mem[off.Consumer])),
+		flags:    (*uint32)(unsafe.Pointer(&mem[off.Flags])),
+		desc:     mem[off.Desc:],
+		mask:     uint32(n - 1),
+	}, nil
+}
+
+// needWakeup returns true if the kernel must be woken up to process the ring.
+func (r *xdpRing) needWakeup() bool {
+	return atomic.LoadUint32(r.flags)&unix.XDP_RING_NEED_WAKEUP != 0
+}
+
+// XDP is a Device using an AF_XDP socket bound to a queue of an interface,
+// and an XDP program redirecting to the socket the packets matched by NewFilter.
+// Other packets are passed to the kernel.
+//
+// Received frames are copied into the buffer given to ReadPacket, without their Ethernet header;
+// sent packets are copied into a frame, with an Ethernet header toward the Gateway
+// (or toward the MAC address of GatewayAddr).
+// With the zero-copy mode of the driver, frames are not copied by the kernel.
+// ReadPacket must not be called concurrently, nor WritePacket.
+type XDP struct {
+	mu       sync.RWMutex // write-locked to release the resources
+	closed   atomic.Bool
+	link     netlink.Link
+	attached bool
+	xdpFlags int
+	prog     *ebpf.Program
+	xsks     *ebpf.Map
+	fd       int
+	event    int // eventfd interrupting ReadPacket on Close
+	umem     []byte
+
+	frameSize int
+	header    [ethHeaderLen]byte // Ethernet header of sent packets, without EtherType
+	fill      *xdpRing
+	comp      *xdpRing
+	rx        *xdpRing
+	tx        *xdpRing
+	free      []uint64 // frames available for transmission
+
+	gatewayAddr netip.Addr
+	neighbors   egress.NeighborResolver // nil if the destination MAC address is static
+}
+
+// OpenXDP creates an XDP device. CAP_NET_ADMIN, CAP_NET_RAW and CAP_BPF are required.
+// Half of the frames are used for reception, and the other half for transmission.
+func OpenXDP(config XDPConfig) (*XDP, error) {
+	if config.GTPUPort == 0 {
+		config.GTPUPort = gtpu.Port
+	}
+	if config.FrameCount == 0 {
+		config.FrameCount = defaultFrameCount
+	}
+	if config.FrameSize == 0 {
+		config.FrameSize = defaultFrameSize
+	}
+	if config.FrameCount < 2 || config.FrameCount&(config.FrameCount-1) != 0 ||
+		(config.FrameSize != 2048 && config.FrameSize != 4096) || config.Queue < 0 {
+		return nil, ErrInvalidRing
+	}
+	if !config.GatewayAddr.IsValid() && len(config.Gateway) != 6 {
+		return nil, ErrInvalidGateway
+	}
+	for _, p := range config.Prefixes {
+		if !p.IsValid() {
+			return nil, ErrInvalidPrefix
+		}
+	}
+	link, err := netlink.LinkByName(config.Interface)
+	if err != nil {
+		return nil, err
+	}
+	if len(link.Attrs().HardwareAddr) != 6 {
+		return nil, ErrInvalidGateway
+	}
+	x := &XDP{
+		link:        link,
+		fd:          -1,
+		event:       -1,
+		frameSize:   config.FrameSize,
+		gatewayAddr: config.GatewayAddr,
+		neighbors:   config.Neighbors,
+	}
+	if x.gatewayAddr.IsValid() && x.neighbors == nil {
+		kernel, err := egress.NewKernelNeighbors(config.Interface)
+		if err != nil {
+			return nil, err
+		}
+		x.neighbors = egress.NewNeighborCache(kernel, 0)
+	}
+	copy(x.header[0:6], config.Gateway)
+	copy(x.header[6:12], link.Attrs().HardwareAddr)
+	if err := x.open(config); err != nil {
+		return nil, errors.Join(err, x.release())
+	}
+	return x, nil
+}
+
+// open creates the UMEM, the socket, the rings, and attaches the XDP program.
+func (x *XDP) open(config XDPConfig) error {
+	var err error
+	n := config.FrameCount / 2
+	if x.umem, err = unix.Mmap(-1, 0, config.FrameCount*config.FrameSize, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_PRIVATE|unix.MAP_ANONYMOUS|unix.MAP_POPULATE); err != nil {
+		return os.NewSyscallError("mmap", err)
+	}
+	if x.fd, err = unix.Socket(unix.AF_XDP, unix.SOCK_RAW|unix.SOCK_CLOEXEC, 0); err != nil {
+		return os.NewSyscallError("socket", err)
+	}
+	reg := unix.XDPUmemReg{
+		Addr: uint64(uintptr(unsafe.Pointer(&x.umem[0]))),
+		Len:  uint64(len(x.umem)),
+		Size: uint32(config.FrameSize),
+	}
+	if err := setsockopt(x.fd, unix.SOL_XDP, unix.XDP_UMEM_REG, unsafe.Pointer(&reg), unsafe.Sizeof(reg)); err != nil {
+		return err
+	}
+	for _, opt := range []int{unix.XDP_UMEM_FILL_RING, unix.XDP_UMEM_COMPLETION_RING, unix.XDP_RX_RING, unix.XDP_TX_RING} {
+		if err := unix.SetsockoptInt(x.fd, unix.SOL_XDP, opt, n); err != nil {
+			return os.NewSyscallError("setsockopt", err)
+		}
+	}
+	var off unix.XDPMmapOffsets
+	if err := getsockopt(x.fd, unix.SOL_XDP, unix.XDP_MMAP_OFFSETS, unsafe.Pointer(&off), unsafe.Sizeof(off)); err != nil {
+		return err
+	}
+	if x.fill, err = newXDPRing(x.fd, unix.XDP_UMEM_PGOFF_FILL_RING, off.Fr, n, xdpAddrLen); err != nil {
+		return err
+	}
+	if x.comp, err = newXDPRing(x.fd, unix.XDP_UMEM_PGOFF_COMPLETION_RING, off.Cr, n, xdpAddrLen); err != nil {
+		return err
+	}
+	if x.rx, err = newXDPRing(x.fd, unix.XDP_PGOFF_RX_RING, off.Rx, n, xdpDescLen); err != nil {
+		return err
+	}
+	if x.tx, err = newXDPRing(x.fd, unix.XDP_PGOFF_TX_RING, off.Tx, n, xdpDescLen); err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		x.refill(uint64(i * config.FrameSize))
+		x.free = append(x.free, uint64((n+i)*config.FrameSize))
+	}
+	var bindFlags uint16 = unix.XDP_USE_NEED_WAKEUP
+	if config.ZeroCopy {
+		bindFlags |= unix.XDP_ZEROCOPY
+	}
+	if err := unix.Bind(x.fd, &unix.SockaddrXDP{Flags: bindFlags, Ifindex: uint32(x.link.Attrs().Index), QueueID: uint32(config.Queue)}); err != nil {
+		return os.NewSyscallError("bind", err)
+	}
+	if x.event, err = unix.Eventfd(0, unix.EFD_CLOEXEC|unix.EFD_NONBLOCK); err != nil {
+		return os.NewSyscallError("eventfd", err)
+	}
+	if x.xsks, err = ebpf.NewMap(ebpf.MapSpec{
+		Name:       "rfc9433_xsks",
+		Type:       ebpf.MapTypeXSKMap,
+		KeySize:    4,
+		ValueSize:  4,
+		MaxEntries: uint32(config.Queue + 1),
+	}); err != nil {
+		return err
+	}
+	if err := x.xsks.Update(binary.NativeEndian.AppendUint32(nil, uint32(config.Queue)), binary.NativeEndian.AppendUint32(nil, uint32(x.fd)), ebpf.UpdateAny); err != nil {
+		return err
+	}
+	if x.prog, err = ebpf.LoadProgram("rfc9433_xdp", ebpf.ProgramTypeXDP, xdpProgram(x.xsks, config.Prefixes, config.GTPUPort), xdpLicense); err != nil {
+		return err
+	}
+	if config.SKBMode {
+		x.xdpFlags = unix.XDP_FLAGS_SKB_MODE
+	}
+	if err := netlink.LinkSetXdpFdWithFlags(x.link, x.prog.FD(), x.xdpFlags|unix.XDP_FLAGS_UPDATE_IF_NOEXIST); err != nil {
+		return err
+	}
+	x.attached = true
+	return nil
+}
+
+// refill gives a frame to the kernel for reception.
+// The fill ring is large enough for all the frames used for reception.
+func (x *XDP) refill(addr uint64) {
+	addr -= addr % uint64(x.frameSize)
+	binary.NativeEndian.PutUint64(x.fill.desc[(x.fill.local&x.fill.mask)*xdpAddrLen:], addr)
+	x.fill.local++
+	atomic.StoreUint32(x.fill.producer, x.fill.local)
+}
+
+// ReadPacket reads a single packet into b, and returns its length.
+// Packets longer than b are dropped.
+func (x *XDP) ReadPacket(b []byte) (int, error) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	for {
+		if x.closed.Load() {
+			return 0, ErrClosed
+		}
+		if x.rx.local == x.rx.cached {
+			x.rx.cached = atomic.LoadUint32(x.rx.producer)
+			if x.rx.local == x.rx.cached {
+				if err := x.wait(); err != nil {
+					return 0, err
+				}
+				continue
+			}
+		}
+		desc := x.rx.desc[(x.rx.local&x.rx.mask)*xdpDescLen:]
+		addr := binary.NativeEndian.Uint64(desc[0:8])
+		frame := x.umem[addr : addr+uint64(binary.NativeEndian.Uint32(desc[8:12]))]
+		n := 0
+		if len(frame) > ethHeaderLen && len(frame)-ethHeaderLen <= len(b) {
+			n = copy(b, frame[ethHeaderLen:])
+		}
+		x.rx.local++
+		atomic.StoreUint32(x.rx.consumer, x.rx.local)
+		x.refill(addr)
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+// wait waits until packets are received, or the device is closed.
+func (x *XDP) wait() error {
+	fds := []unix.PollFd{
+		{Fd: int32(x.fd), Events: unix.POLLIN},
+		{Fd: int32(x.event), Events: unix.POLLIN},
+	}
+	for {
+		_, err := unix.Poll(fds, -1)
+		if err == unix.EINTR {
+			continue
+		}
+		if err != nil {
+			return os.NewSyscallError("poll", err)
+		}
+		return nil
+	}
+}
+
+// reclaim moves the frames whose transmission is completed to the free list.
+func (x *XDP) reclaim() {
+	x.comp.cached = atomic.LoadUint32(x.comp.producer)
+	for ; x.comp.local != x.comp.cached; x.comp.local++ {
+		x.free = append(x.free, binary.NativeEndian.Uint64(x.comp.desc[(x.comp.local&x.comp.mask)*xdpAddrLen:]))
+	}
+	atomic.StoreUint32(x.comp.consumer, x.comp.local)
+}
+
+// kick wakes up the kernel to process the transmit ring.
+func (x *XDP) kick() error {
+	err := unix.Sendto(x.fd, nil, unix.MSG_DONTWAIT, nil)
+	switch {
+	case err == nil, errors.Is(err, unix.EAGAIN), errors.Is(err, unix.EBUSY), errors.Is(err, unix.ENOBUFS):
+		return nil
+	default:
+		return os.NewSyscallError("sendto", err)
+	}
+}
+
+// WritePacket writes a single IPv4 or IPv6 packet.
+func (x *XDP) WritePacket(pkt []byte) error {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	if x.closed.Load() {
+		return ErrClosed
+	}
+	var ethType uint16
+	switch {
+	case len(pkt) >= 20 && pkt[0]>>4 == 4:
+		ethType = ethTypeIPv4
+	case len(pkt) >= 40 && pkt[0]>>4 == 6:
+		ethType = ethTypeIPv6
+	default:
+		return ErrMalformedPacket
+	}
+	if ethHeaderLen+len(pkt) > x.frameSize {
+		return ErrTooLong
+	}
+	var gateway net.HardwareAddr
+	if x.neighbors != nil {
+		mac, err := x.neighbors.ResolveNeighbor(x.gatewayAddr)
+		if err != nil {
+			return err
+		}
+		if len(mac) != 6 {
+			return ErrInvalidGateway
+		}
+		gateway = mac
+	}
+	x.reclaim()
+	if len(x.free) == 0 {
+		if err := x.kick(); err != nil {
+			return err
+		}
+		x.reclaim()
+		if len(x.free) == 0 {
+			return ErrNoFreeFrame
+		}
+	}
+	addr := x.free[len(x.free)-1]
+	x.free = x.free[:len(x.free)-1]
+	frame := x.umem[addr : addr+uint64(ethHeaderLen+len(pkt))]
+	copy(frame, x.header[:])
+	if gateway != nil {
+		copy(frame[0:6], gateway)
+	}
+	binary.BigEndian.PutUint16(frame[ethTypePosByte:], ethType)
+	copy(frame[ethHeaderLen:], pkt)
+	desc := x.tx.desc[(x.tx.local&x.tx.mask)*xdpDescLen:]
+	binary.NativeEndian.PutUint64(desc[0:8], addr)
+	binary.NativeEndian.PutUint32(desc[8:12], uint32(len(frame)))
+	binary.NativeEndian.PutUint32(desc[12:16], 0)
+	x.tx.local++
+	atomic.StoreUint32(x.tx.producer, x.tx.local)
+	if x.tx.needWakeup() {
+		return x.kick()
+	}
+	return nil
+}
+
+// Close interrupts ReadPacket, detaches the XDP program, and releases the socket and the UMEM.
+func (x *XDP) Close() error {
+	if x.closed.Swap(true) {
+		return ErrClosed
+	}
+	var one [8]byte
+	binary.NativeEndian.PutUint64(one[:], 1)
+	unix.Write(x.event, one[:])
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return x.release()
+}
+
+// release detaches the XDP program, and releases the resources.
+func (x *XDP) release() error {
+	var errs []error
+	if x.attached {
+		errs = append(errs, netlink.LinkSetXdpFdWithFlags(x.link, -1, x.xdpFlags))
+		x.attached = false
+	}
+	if x.prog != nil {
+		errs = append(errs, x.prog.Close())
+		x.prog = nil
+	}
+	if x.xsks != nil {
+		errs = append(errs, x.xsks.Close())
+		x.xsks = nil
+	}
+	for _, r := range []**xdpRing{&x.fill, &x.comp, &x.rx, &x.tx} {
+		if *r != nil {
+			errs = append(errs, unix.Munmap((*r).mem))
+			*r = nil
+		}
+	}
+	for _, fd := range []*int{&x.fd, &x.event} {
+		if *fd >= 0 {
+			errs = append(errs, unix.Close(*fd))
+			*fd = -1
+		}
+	}
+	if x.umem != nil {
+		errs = append(errs, unix.Munmap(x.umem))
+		x.umem = nil
+	}
+	return errors.Join(errs...)
+}
+
+// setsockopt sets a socket option whose value is a structure.
+func setsockopt(fd, level, opt int, value unsafe.Pointer, size uintptr) error {
+	_, _, errno := unix.Syscall6(unix.SYS_SETSOCKOPT, uintptr(fd), uintptr(level), uintptr(opt), uintptr(value), size, 0)
+	if errno != 0 {
+		return os.NewSyscallError("setsockopt", errno)
+	}
+	return nil
+}
+
+// getsockopt gets a socket option whose value is a structure.
+func getsockopt(fd, level, opt int, value unsafe.Pointer, size uintptr) error {
+	l := uint32(size)
+	_, _, errno := unix.Syscall6(unix.SYS_GETSOCKOPT, uintptr(fd), uintptr(level), uintptr(opt), uintptr(value), uintptr(unsafe.Pointer(&l)), 0)
+	if errno != 0 {
+		return os.NewSyscallError("getsockopt", errno)
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package datapath
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"net/netip"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/egress"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// newTestVeth creates a pair of veth interfaces, and returns the AF_PACKET socket of the first one.
+func newTestVeth(t *testing.T, name, peer string) (netlink.Link, netlink.Link, int) {
+	t.Helper()
+	veth := &netlink.Veth{LinkAttrs: netlink.LinkAttrs{Name: name}, PeerName: peer}
+	if err := netlink.LinkAdd(veth); errors.Is(err, os.ErrPermission) {
+		t.Skip("CAP_NET_ADMIN is required")
+	} else if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { netlink.LinkDel(veth) })
+	links := make([]netlink.Link, 2)
+	for i, n := range []string{name, peer} {
+		link, err := netlink.LinkByName(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := netlink.LinkSetUp(link); err != nil {
+			t.Fatal(err)
+		}
+		links[i] = link
+	}
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_RAW|unix.SOCK_CLOEXEC, int(htons(unix.ETH_P_ALL)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { unix.Close(fd) })
+	if err := unix.Bind(fd, &unix.SockaddrLinklayer{Protocol: htons(unix.ETH_P_ALL), Ifindex: links[0].Attrs().Index}); err != nil {
+		t.Fatal(err)
+	}
+	if err := unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &unix.Timeval{Sec: 5}); err != nil {
+		t.Fatal(err)
+	}
+	return links[0], links[1], fd
+}
+
+func TestXDP(t *testing.T) {
+	local, peer, fd := newTestVeth(t, "rfc9433xdp0", "rfc9433xdp1")
+	x, err := OpenXDP(XDPConfig{
+		Interface:  peer.Attrs().Name,
+		Gateway:    local.Attrs().HardwareAddr,
+		Prefixes:   []netip.Prefix{netip.MustParsePrefix("fd00:db8:9433::/48")},
+		FrameCount: 64,
+		SKBMode:    true,
+	})
+	if errors.Is(err, os.ErrPermission) {
+		t.Skip("CAP_NET_ADMIN, CAP_NET_RAW and CAP_BPF are required")
+	} else if err != nil {
+		t.Fatal(err)
+	}
+	defer x.Close()
+
+	// reception: the first packet is passed to the kernel
+	frame := func(pkt []byte) []byte {
+		b := append([]byte(nil), peer.Attrs().HardwareAddr...)
+		b = append(b, local.Attrs().HardwareAddr...)
+		b = binary.BigEndian.AppendUint16(b, ethTypeIPv6)
+		return append(b, pkt...)
+	}
+	pkt := testIPv6("fd00:db8:9433::1")
+	for _, p := range [][]byte{testIPv6("fd00:db8:9434::1"), pkt} {
+		if err := unix.Sendto(fd, frame(p), 0, &unix.SockaddrLinklayer{Ifindex: local.Attrs().Index, Halen: 6}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	type result struct {
+		pkt []byte
+		err error
+	}
+	read := make(chan result, 1)
+	go func() {
+		b := make([]byte, 1500)
+		n, err := x.ReadPacket(b)
+		read <- result{pkt: b[:n], err: err}
+	}()
+	select {
+	case r := <-read:
+		if r.err != nil {
+			t.Fatal(r.err)
+		}
+		if diff := cmp.Diff(pkt, r.pkt); diff != "" {
+			t.Error(diff)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no packet received")
+	}
+
+	// transmission
+	out := testIPv4UDP("10.0.0.2", 2152, 0)
+	if err := x.WritePacket(out); err != nil {
+		t.Fatal(err)
+	}
+	want := append(append(append([]byte(nil), local.Attrs().HardwareAddr...), peer.Attrs().HardwareAddr...), 0x08, 0x00)
+	want = append(want, out...)
+	b := make([]byte, 1500)
+	for {
+		n, _, err := unix.Recvfrom(fd, b, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Equal(b[:n], want) {
+			break
+		}
+	}
+}
+
+func TestXDPNeighbors(t *testing.T) {
+	local, peer, fd := newTestVeth(t, "rfc9433xdp2", "rfc9433xdp3")
+	gateway := netip.MustParseAddr("fe80::1")
+	neighbors := egress.NewNeighborCache(nil, 0)
+	x, err := OpenXDP(XDPConfig{
+		Interface:   peer.Attrs().Name,
+		GatewayAddr: gateway,
+		Neighbors:   neighbors,
+		FrameCount:  64,
+		SKBMode:     true,
+	})
+	if errors.Is(err, os.ErrPermission) {
+		t.Skip("CAP_NET_ADMIN, CAP_NET_RAW and CAP_BPF are required")
+	} else if err != nil {
+		t.Fatal(err)
+	}
+	defer x.Close()
+
+	out := testIPv4UDP("10.0.0.2", 2152, 0)
+	if err := x.WritePacket(out); !errors.Is(err, egress.ErrNeighborUnresolved) {
+		t.Errorf("Packet to an unresolved gateway should be dropped: %v", err)
+	}
+	if err := neighbors.SetStatic(gateway, local.Attrs().HardwareAddr); err != nil {
+		t.Fatal(err)
+	}
+	if err := x.WritePacket(out); err != nil {
+		t.Fatal(err)
+	}
+	want := append(append(append([]byte(nil), local.Attrs().HardwareAddr...), peer.Attrs().HardwareAddr...), 0x08, 0x00)
+	want = append(want, out...)
+	b := make([]byte, 1500)
+	for {
+		n, _, err := unix.Recvfrom(fd, b, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Equal(b[:n], want) {
+			break
+		}
+	}
+}
@@ -0,0 +1,10 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package deploy renders a control.Config into deployment artifacts of the SR Gateway:
+// a shell script of iproute2 commands routing the SIDs toward the device of the datapath,
+// nftables rules letting this traffic bypass connection tracking, and a systemd unit running
+// the datapath and applying both, so testbeds can be brought up from a single configuration.
+package deploy
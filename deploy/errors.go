@@ -0,0 +1,14 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package deploy
+
+import "errors"
+
+var (
+	ErrInvalidDevice = errors.New("invalid device name")
+	ErrInvalidPath   = errors.New("path must be absolute")
+	ErrMissingExec   = errors.New("missing datapath command")
+)
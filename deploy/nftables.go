@@ -0,0 +1,72 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package deploy
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/nextmn/rfc9433/control"
+	"github.com/nextmn/rfc9433/gtpu"
+)
+
+// prefixes returns the SIDs (or IPv4 prefixes) of the behaviors of the configuration, by address family.
+func prefixes(cfg control.Config) (ipv6 []string, ipv4 []string) {
+	for _, spec := range cfg.Behaviors {
+		p := spec.SID.Masked()
+		if p.Addr().Is4() {
+			ipv4 = append(ipv4, p.String())
+		} else {
+			ipv6 = append(ipv6, p.String())
+		}
+	}
+	return ipv6, ipv4
+}
+
+// WriteNftables writes the nftables rules of the SR Gateway, in their own table:
+// packets toward the behaviors and packets emitted by the datapath bypass connection tracking,
+// and are accepted by the forward hook. IPv4 packets toward H.M.GTP4.D prefixes must be GTP-U packets.
+// The rules replace the previous ones when loaded again with nft -f.
+func WriteNftables(w io.Writer, cfg control.Config, o Options) error {
+	if err := o.checkDevice(); err != nil {
+		return err
+	}
+	ipv6, ipv4 := prefixes(cfg)
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, `#!/usr/sbin/nft -f
+# Code generated by rfc9433-generate. DO NOT EDIT.
+
+table inet %[1]s
+delete table inet %[1]s
+
+table inet %[1]s {
+	chain prerouting {
+		type filter hook prerouting priority raw; policy accept;
+		iifname "%[2]s" notrack
+`, nftTable, o.Device)
+	if len(ipv6) > 0 {
+		fmt.Fprintf(b, "\t\tip6 daddr { %s } notrack\n", strings.Join(ipv6, ", "))
+	}
+	if len(ipv4) > 0 {
+		fmt.Fprintf(b, "\t\tip daddr { %s } udp dport %d notrack\n", strings.Join(ipv4, ", "), gtpu.Port)
+	}
+	fmt.Fprintf(b, `	}
+
+	chain forward {
+		type filter hook forward priority filter; policy accept;
+		iifname "%[1]s" accept
+`, o.Device)
+	if len(ipv6) > 0 {
+		fmt.Fprintf(b, "\t\toifname \"%s\" ip6 daddr { %s } accept\n", o.Device, strings.Join(ipv6, ", "))
+	}
+	if len(ipv4) > 0 {
+		fmt.Fprintf(b, "\t\toifname \"%s\" ip daddr { %s } udp dport %d accept\n", o.Device, strings.Join(ipv4, ", "), gtpu.Port)
+	}
+	fmt.Fprintf(b, "\t\toifname \"%s\" drop\n\t}\n}\n", o.Device)
+	return b.Flush()
+}
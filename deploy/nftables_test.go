@@ -0,0 +1,55 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package deploy
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/control"
+)
+
+func TestWriteNftables(t *testing.T) {
+	var b bytes.Buffer
+	if err := WriteNftables(&b, testConfig(), Options{Device: "srgw0"}); err != nil {
+		t.Fatal(err)
+	}
+	_, body, _ := strings.Cut(b.String(), "DO NOT EDIT.\n")
+	if diff := cmp.Diff(body, `
+table inet rfc9433
+delete table inet rfc9433
+
+table inet rfc9433 {
+	chain prerouting {
+		type filter hook prerouting priority raw; policy accept;
+		iifname "srgw0" notrack
+		ip6 daddr { 2001:db8::/64, 2001:db8:0:1::/64, 2001:db8:0:2::/64 } notrack
+		ip daddr { 10.0.0.0/24 } udp dport 2152 notrack
+	}
+
+	chain forward {
+		type filter hook forward priority filter; policy accept;
+		iifname "srgw0" accept
+		oifname "srgw0" ip6 daddr { 2001:db8::/64, 2001:db8:0:1::/64, 2001:db8:0:2::/64 } accept
+		oifname "srgw0" ip daddr { 10.0.0.0/24 } udp dport 2152 accept
+		oifname "srgw0" drop
+	}
+}
+`); diff != "" {
+		t.Error(diff)
+	}
+
+	// empty sets are not valid
+	b.Reset()
+	if err := WriteNftables(&b, control.Config{}, Options{Device: "srgw0"}); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(b.String(), "{  }") || strings.Contains(b.String(), "daddr") {
+		t.Errorf("Unexpected rules without behaviors: %s", b.String())
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package deploy
+
+import (
+	"path"
+	"strings"
+)
+
+const (
+	// maxDeviceLen is the maximum length of a Linux interface name (IFNAMSIZ - 1).
+	maxDeviceLen = 15
+	// nftTable is the name of the nftables table of the SR Gateway.
+	nftTable = "rfc9433"
+)
+
+// Options are the parameters of the deployment.
+type Options struct {
+	Device   string // device of the datapath (e.g. a TUN device), created by the datapath
+	Table    int    // routing table of the routes, 0 for the main table
+	Exec     string // command line running the datapath (systemd unit)
+	Script   string // absolute path of the script written by WriteScript (systemd unit)
+	Nftables string // absolute path of the rules written by WriteNftables (systemd unit)
+}
+
+// checkDevice returns an error if the device name cannot be used unquoted by iproute2, nftables and sh.
+func (o *Options) checkDevice() error {
+	if o.Device == "" || len(o.Device) > maxDeviceLen {
+		return ErrInvalidDevice
+	}
+	if strings.IndexFunc(o.Device, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' || r == '.')
+	}) != -1 {
+		return ErrInvalidDevice
+	}
+	return nil
+}
+
+// checkPath returns an error if p is not an absolute path usable unquoted in a systemd unit.
+func checkPath(p string) error {
+	if !path.IsAbs(p) || strings.ContainsAny(p, " \t\n\"'\\") {
+		return ErrInvalidPath
+	}
+	return nil
+}
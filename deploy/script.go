@@ -0,0 +1,93 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package deploy
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/netip"
+	"slices"
+
+	"github.com/nextmn/rfc9433/control"
+	"github.com/nextmn/rfc9433/iproute2"
+)
+
+// Routes returns the routes binding the behaviors of the configuration to their SIDs (or IPv4 prefixes),
+// in the order of the configuration.
+func Routes(cfg control.Config, o Options) ([]iproute2.Route, error) {
+	if err := o.checkDevice(); err != nil {
+		return nil, err
+	}
+	routes := make([]iproute2.Route, 0, len(cfg.Behaviors))
+	for _, spec := range cfg.Behaviors {
+		routes = append(routes, iproute2.Route{
+			Prefix:   spec.SID.Masked(),
+			Action:   spec.Action,
+			Device:   o.Device,
+			Table:    o.Table,
+			Segments: spec.Segments,
+		})
+	}
+	return routes, nil
+}
+
+// sources returns the sorted IPv6 SAs of the GTP-U packets emitted by the behaviors (End.M.GTP6.E),
+// which must be local addresses.
+func sources(cfg control.Config) []netip.Addr {
+	var addrs []netip.Addr
+	for _, spec := range cfg.Behaviors {
+		if spec.Action == iproute2.ActionEndMGTP6E && spec.Source.IsValid() && !slices.Contains(addrs, spec.Source) {
+			addrs = append(addrs, spec.Source)
+		}
+	}
+	slices.SortFunc(addrs, netip.Addr.Compare)
+	return addrs
+}
+
+// WriteScript writes a shell script waiting for the device of the datapath, then bringing it up,
+// adding the local addresses used by the behaviors, and installing the Routes.
+// The script can be run again after a change of the configuration.
+func WriteScript(w io.Writer, cfg control.Config, o Options) error {
+	routes, err := Routes(cfg, o)
+	if err != nil {
+		return err
+	}
+	cmds, err := iproute2.Commands(routes, iproute2.OpReplace)
+	if err != nil {
+		return err
+	}
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, `#!/bin/sh
+# Code generated by rfc9433-generate. DO NOT EDIT.
+set -e
+
+# wait for the datapath to create its device
+i=0
+while ! ip link show dev %[1]s >/dev/null 2>&1; do
+	i=$((i + 1))
+	if [ "$i" -ge 10 ]; then
+		echo "device %[1]s not found" >&2
+		exit 1
+	fi
+	sleep 1
+done
+ip link set dev %[1]s up
+`, o.Device)
+	if addrs := sources(cfg); len(addrs) > 0 {
+		fmt.Fprint(b, "\n# source addresses of End.M.GTP6.E\n")
+		for _, a := range addrs {
+			fmt.Fprintf(b, "ip -6 address replace %s dev lo\n", netip.PrefixFrom(a, a.BitLen()))
+		}
+	}
+	if len(cmds) > 0 {
+		fmt.Fprint(b, "\n# behaviors\n")
+		for _, cmd := range cmds {
+			fmt.Fprintln(b, cmd)
+		}
+	}
+	return b.Flush()
+}
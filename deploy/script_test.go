@@ -0,0 +1,63 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package deploy
+
+import (
+	"bytes"
+	"errors"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/control"
+	"github.com/nextmn/rfc9433/iproute2"
+)
+
+// testConfig returns a configuration with a behavior of each default action.
+func testConfig() control.Config {
+	return control.Config{
+		Locators: []control.Locator{{Prefix: netip.MustParsePrefix("2001:db8::/48")}},
+		Behaviors: []control.BehaviorSpec{
+			{SID: netip.MustParsePrefix("2001:db8::/64"), Action: iproute2.ActionEndMGTP4E},
+			{SID: netip.MustParsePrefix("2001:db8:0:1::/64"), Action: iproute2.ActionEndMGTP6E, Source: netip.MustParseAddr("2001:db8:ffff::1")},
+			{SID: netip.MustParsePrefix("2001:db8:0:2::/64"), Action: iproute2.ActionEndMGTP6E, Source: netip.MustParseAddr("2001:db8:ffff::1")},
+			{SID: netip.MustParsePrefix("10.0.0.0/24"), Action: iproute2.ActionHMGTP4D},
+		},
+	}
+}
+
+func TestWriteScript(t *testing.T) {
+	var b bytes.Buffer
+	if err := WriteScript(&b, testConfig(), Options{Device: "srgw0", Table: 100}); err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+	if !strings.HasPrefix(out, "#!/bin/sh\n") || !strings.Contains(out, "ip link set dev srgw0 up\n") {
+		t.Errorf("Unexpected script header: %s", out)
+	}
+	_, body, _ := strings.Cut(out, "# source addresses of End.M.GTP6.E\n")
+	if diff := cmp.Diff(body, `ip -6 address replace 2001:db8:ffff::1/128 dev lo
+
+# behaviors
+ip -6 route replace 2001:db8::/64 dev srgw0 table 100
+ip -6 route replace 2001:db8:0:1::/64 dev srgw0 table 100
+ip -6 route replace 2001:db8:0:2::/64 dev srgw0 table 100
+ip -4 route replace 10.0.0.0/24 dev srgw0 table 100
+`); diff != "" {
+		t.Error(diff)
+	}
+
+	for _, dev := range []string{"", "srgw0; reboot", "a-very-long-device"} {
+		if err := WriteScript(&b, testConfig(), Options{Device: dev}); !errors.Is(err, ErrInvalidDevice) {
+			t.Errorf("Device %q should be rejected: %v", dev, err)
+		}
+	}
+	cfg := control.Config{Behaviors: []control.BehaviorSpec{{SID: netip.MustParsePrefix("2001:db8::/64"), Action: "End.Unknown"}}}
+	if err := WriteScript(&b, cfg, Options{Device: "srgw0"}); !errors.Is(err, iproute2.ErrUnsupportedAction) {
+		t.Errorf("Unknown action should be rejected: %v", err)
+	}
+}
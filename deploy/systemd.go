@@ -0,0 +1,49 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package deploy
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteSystemdUnit writes a systemd service unit running the datapath (Exec),
+// then loading the rules written by WriteNftables (Nftables) and running the script written by WriteScript (Script).
+// The rules are removed when the datapath stops; the routes are removed with its device.
+func WriteSystemdUnit(w io.Writer, o Options) error {
+	if err := o.checkDevice(); err != nil {
+		return err
+	}
+	if strings.TrimSpace(o.Exec) == "" || strings.Contains(o.Exec, "\n") {
+		return ErrMissingExec
+	}
+	if err := checkPath(o.Script); err != nil {
+		return err
+	}
+	if err := checkPath(o.Nftables); err != nil {
+		return err
+	}
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, `# Code generated by rfc9433-generate. DO NOT EDIT.
+[Unit]
+Description=RFC 9433 SR Gateway (%[1]s)
+Wants=network-online.target
+After=network-online.target
+
+[Service]
+ExecStart=%[2]s
+ExecStartPost=/usr/sbin/nft -f %[3]s
+ExecStartPost=/bin/sh %[4]s
+ExecStopPost=-/usr/sbin/nft delete table inet %[5]s
+Restart=on-failure
+
+[Install]
+WantedBy=multi-user.target
+`, o.Device, o.Exec, o.Nftables, o.Script, nftTable)
+	return b.Flush()
+}
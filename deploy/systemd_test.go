@@ -0,0 +1,48 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package deploy
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestWriteSystemdUnit(t *testing.T) {
+	o := Options{
+		Device:   "srgw0",
+		Exec:     "/usr/local/bin/srgw -c /etc/rfc9433/gateway.json",
+		Script:   "/etc/rfc9433/routes.sh",
+		Nftables: "/etc/rfc9433/rules.nft",
+	}
+	var b bytes.Buffer
+	if err := WriteSystemdUnit(&b, o); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		"ExecStart=/usr/local/bin/srgw -c /etc/rfc9433/gateway.json\n",
+		"ExecStartPost=/usr/sbin/nft -f /etc/rfc9433/rules.nft\nExecStartPost=/bin/sh /etc/rfc9433/routes.sh\n",
+		"ExecStopPost=-/usr/sbin/nft delete table inet rfc9433\n",
+	} {
+		if !strings.Contains(b.String(), line) {
+			t.Errorf("Missing %q in unit: %s", line, b.String())
+		}
+	}
+
+	for _, tc := range []struct {
+		o   Options
+		err error
+	}{
+		{Options{Device: "srgw0", Script: o.Script, Nftables: o.Nftables}, ErrMissingExec},
+		{Options{Device: "srgw0", Exec: o.Exec, Script: "routes.sh", Nftables: o.Nftables}, ErrInvalidPath},
+		{Options{Device: "srgw0", Exec: o.Exec, Script: o.Script, Nftables: "/etc/my rules.nft"}, ErrInvalidPath},
+	} {
+		if err := WriteSystemdUnit(&b, tc.o); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v, got %v", tc.err, err)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package dryrun runs packets through a behavior.Registry without transmitting them,
+// and returns the packets which would be sent along with the decisions taken,
+// for safe troubleshooting of the running configuration.
+// Packets can be given in hexadecimal, or as a record of a pcap or pcapng capture.
+package dryrun
@@ -0,0 +1,51 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package dryrun
+
+import (
+	"net/netip"
+	"slices"
+
+	"github.com/nextmn/rfc9433/behavior"
+	"github.com/nextmn/rfc9433/trace"
+)
+
+// Result is the outcome of a dry run.
+type Result struct {
+	Out       []byte         // packet which would be transmitted, nil if dropped
+	Fragments [][]byte       // fragments which would follow Out
+	SID       netip.Prefix   // prefix of the Registry entry matching the packet, if any
+	Trace     []trace.Record // decisions taken
+	Err       error          // reason of the drop, if any
+}
+
+// Dropped returns true if the packet would have been dropped.
+func (r *Result) Dropped() bool {
+	return r.Err != nil
+}
+
+// Run processes a copy of pkt (starting with the IP header) with the Registry, as the datapath.Forwarder would,
+// without transmitting the result. The decisions are recorded in the Result instead of the trace.Recorder of the Registry.
+// Stateful behaviors (e.g. rate limiters and session counters) account the packet as if it had been forwarded.
+func Run(r *behavior.Registry, pkt []byte) *Result {
+	rec := trace.NewRecorder(1)
+	meta := &behavior.Metadata{
+		Recorder: rec,
+	}
+	out, err := r.Process(slices.Clone(pkt), meta)
+	res := &Result{
+		SID: meta.SID,
+		Err: err,
+	}
+	if meta.SID.IsValid() {
+		res.Trace = rec.Snapshot(meta.SID.String())
+	}
+	if err == nil {
+		res.Out = out
+		res.Fragments = meta.Fragments
+	}
+	return res
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package dryrun
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/behavior"
+	"github.com/nextmn/rfc9433/trace"
+)
+
+// ipv6 returns an IPv6 header without payload.
+func ipv6(dst netip.Addr) []byte {
+	pkt := make([]byte, 40)
+	pkt[0] = 0x60
+	pkt[6] = 59
+	copy(pkt[8:24], netip.MustParseAddr("2001:db8::1").AsSlice())
+	copy(pkt[24:40], dst.AsSlice())
+	return pkt
+}
+
+func TestRun(t *testing.T) {
+	errDrop := errors.New("drop")
+	rec := trace.NewRecorder(4)
+	r := behavior.NewRegistry(behavior.WithRecorder(rec))
+	if err := r.Register(netip.MustParsePrefix("2001:db8:1::/48"), behavior.BehaviorFunc(func(pkt []byte, meta *behavior.Metadata) ([]byte, error) {
+		pkt[7] -= 1 // decrement the Hop Limit in place
+		return pkt, nil
+	})); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Register(netip.MustParsePrefix("2001:db8:2::/48"), behavior.BehaviorFunc(func(pkt []byte, meta *behavior.Metadata) ([]byte, error) {
+		return nil, errDrop
+	})); err != nil {
+		t.Fatal(err)
+	}
+
+	pkt := ipv6(netip.MustParseAddr("2001:db8:1::1"))
+	pkt[7] = 64
+	res := Run(r, pkt)
+	if res.Dropped() {
+		t.Fatal(res.Err)
+	}
+	if pkt[7] != 64 {
+		t.Error("Run should not modify the packet")
+	}
+	want := ipv6(netip.MustParseAddr("2001:db8:1::1"))
+	want[7] = 63
+	if diff := cmp.Diff(want, res.Out); diff != "" {
+		t.Error(diff)
+	}
+	if res.SID != netip.MustParsePrefix("2001:db8:1::/48") {
+		t.Errorf("Unexpected SID: %s", res.SID)
+	}
+	if len(res.Trace) != 1 || res.Trace[0].Decision != trace.DecisionForward || res.Trace[0].Len != len(pkt) {
+		t.Errorf("Unexpected trace: %+v", res.Trace)
+	}
+
+	res = Run(r, ipv6(netip.MustParseAddr("2001:db8:2::1")))
+	if !errors.Is(res.Err, errDrop) || res.Out != nil {
+		t.Errorf("Expected errDrop, got %v", res.Err)
+	}
+	if len(res.Trace) != 1 || res.Trace[0].Decision != trace.DecisionDrop || res.Trace[0].Reason != errDrop.Error() {
+		t.Errorf("Unexpected trace: %+v", res.Trace)
+	}
+
+	res = Run(r, ipv6(netip.MustParseAddr("2001:db8:3::1")))
+	if !errors.Is(res.Err, behavior.ErrNoBehavior) || res.SID.IsValid() || len(res.Trace) != 0 {
+		t.Errorf("Expected ErrNoBehavior, got %+v", res)
+	}
+
+	if len(rec.Behaviors()) != 0 {
+		t.Error("Run should not record in the Recorder of the Registry")
+	}
+}
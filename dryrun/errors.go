@@ -0,0 +1,16 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package dryrun
+
+import "errors"
+
+var (
+	ErrInvalidHex          = errors.New("invalid hexadecimal packet")
+	ErrNoPacket            = errors.New("no such packet in the capture")
+	ErrUnsupportedCapture  = errors.New("unsupported capture format")
+	ErrUnsupportedLinkType = errors.New("unsupported link type")
+	ErrNotIP               = errors.New("not an IP packet")
+)
@@ -0,0 +1,112 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package dryrun
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+	"github.com/nextmn/rfc9433/pcapng"
+)
+
+const (
+	ethernetHeaderLen = 14
+	dot1QHeaderLen    = 4
+	etherTypeIPv4     = 0x0800
+	etherTypeIPv6     = 0x86dd
+	etherTypeDot1Q    = 0x8100
+	etherTypeDot1AD   = 0x88a8
+	pcapngMagic       = 0x0a0d0d0a
+)
+
+// ParseHex decodes a packet written in hexadecimal, as printed by tcpdump -xx or Wireshark.
+// Whitespace, colons and an optional 0x prefix are ignored.
+func ParseHex(s string) ([]byte, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "0x")
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == ':' {
+			return -1
+		}
+		return r
+	}, s)
+	pkt, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidHex
+	}
+	return pkt, nil
+}
+
+// packetReader is implemented by pcapgo.Reader and pcapgo.NgReader.
+type packetReader interface {
+	gopacket.PacketDataSource
+	LinkType() layers.LinkType
+}
+
+// ReadCapture returns the IP packet of the n-th record (starting at 0) of a pcap or pcapng capture,
+// e.g. written by pcapng.Sink. Raw IP and Ethernet (optionally VLAN tagged) link types are supported.
+func ReadCapture(r io.Reader, n int) ([]byte, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(4)
+	if err != nil {
+		return nil, ErrUnsupportedCapture
+	}
+	var pr packetReader
+	if binary.LittleEndian.Uint32(magic) == pcapngMagic {
+		pr, err = pcapgo.NewNgReader(br, pcapgo.DefaultNgReaderOptions)
+	} else {
+		pr, err = pcapgo.NewReader(br)
+	}
+	if err != nil {
+		return nil, ErrUnsupportedCapture
+	}
+	for i := 0; ; i++ {
+		data, _, err := pr.ReadPacketData()
+		if errors.Is(err, io.EOF) {
+			return nil, ErrNoPacket
+		}
+		if err != nil {
+			return nil, err
+		}
+		if i == n {
+			return linkPayload(pr.LinkType(), data)
+		}
+	}
+}
+
+// linkPayload returns the IP packet of a frame.
+func linkPayload(linkType layers.LinkType, frame []byte) ([]byte, error) {
+	switch linkType {
+	case pcapng.LinkTypeRaw, layers.LinkTypeIPv4, layers.LinkTypeIPv6:
+		return frame, nil
+	case pcapng.LinkTypeEthernet:
+		if len(frame) < ethernetHeaderLen {
+			return nil, ErrNotIP
+		}
+		etherType := binary.BigEndian.Uint16(frame[12:14])
+		frame = frame[ethernetHeaderLen:]
+		for etherType == etherTypeDot1Q || etherType == etherTypeDot1AD {
+			if len(frame) < dot1QHeaderLen {
+				return nil, ErrNotIP
+			}
+			etherType = binary.BigEndian.Uint16(frame[2:4])
+			frame = frame[dot1QHeaderLen:]
+		}
+		if etherType != etherTypeIPv4 && etherType != etherTypeIPv6 {
+			return nil, ErrNotIP
+		}
+		return frame, nil
+	default:
+		return nil, ErrUnsupportedLinkType
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package dryrun
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+	"github.com/nextmn/rfc9433/pcapng"
+)
+
+func TestParseHex(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want []byte
+		err  error
+	}{
+		{"6000", []byte{0x60, 0x00}, nil},
+		{"0x60 00\n11", []byte{0x60, 0x00, 0x11}, nil},
+		{"60:00:11", []byte{0x60, 0x00, 0x11}, nil},
+		{"600", nil, ErrInvalidHex},
+		{"60zz", nil, ErrInvalidHex},
+	} {
+		pkt, err := ParseHex(tc.in)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%q: expected %v, got %v", tc.in, tc.err, err)
+			continue
+		}
+		if diff := cmp.Diff(tc.want, pkt); diff != "" {
+			t.Errorf("%q: %s", tc.in, diff)
+		}
+	}
+}
+
+func TestReadCapturePcapng(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := pcapng.NewWriter(&buf, pcapng.LinkTypeRaw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pkt := range [][]byte{{0x60, 0x01}, {0x60, 0x02}} {
+		if err := w.WritePacket(time.Unix(0, 0), pkt, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pkt, err := ReadCapture(bytes.NewReader(buf.Bytes()), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]byte{0x60, 0x02}, pkt); diff != "" {
+		t.Error(diff)
+	}
+	if _, err := ReadCapture(bytes.NewReader(buf.Bytes()), 2); !errors.Is(err, ErrNoPacket) {
+		t.Errorf("Expected ErrNoPacket, got %v", err)
+	}
+}
+
+func TestReadCapturePcap(t *testing.T) {
+	var buf bytes.Buffer
+	w := pcapgo.NewWriter(&buf)
+	if err := w.WriteFileHeader(65535, layers.LinkTypeEthernet); err != nil {
+		t.Fatal(err)
+	}
+	for _, frame := range [][]byte{
+		// Ethernet, IPv6
+		{0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 2, 0x86, 0xdd, 0x60, 0x01},
+		// Ethernet, 802.1Q, IPv4
+		{0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 2, 0x81, 0x00, 0x00, 0x0a, 0x08, 0x00, 0x45, 0x02},
+		// Ethernet, ARP
+		{0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 2, 0x08, 0x06, 0x00, 0x01},
+	} {
+		if err := w.WritePacket(gopacket.CaptureInfo{CaptureLength: len(frame), Length: len(frame)}, frame); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i, tc := range []struct {
+		want []byte
+		err  error
+	}{
+		{[]byte{0x60, 0x01}, nil},
+		{[]byte{0x45, 0x02}, nil},
+		{nil, ErrNotIP},
+	} {
+		pkt, err := ReadCapture(bytes.NewReader(buf.Bytes()), i)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Record %d: expected %v, got %v", i, tc.err, err)
+			continue
+		}
+		if diff := cmp.Diff(tc.want, pkt); diff != "" {
+			t.Errorf("Record %d: %s", i, diff)
+		}
+	}
+	if _, err := ReadCapture(bytes.NewReader([]byte("not a capture")), 0); !errors.Is(err, ErrUnsupportedCapture) {
+		t.Errorf("Expected ErrUnsupportedCapture, got %v", err)
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package ebpf provides a minimal access to eBPF through the bpf(2) system call:
+// maps (including pinned maps), and loading of small hand-assembled programs.
+package ebpf
@@ -0,0 +1,19 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package ebpf
+
+import "errors"
+
+var (
+	ErrEmptyProgram     = errors.New("empty program")
+	ErrUnknownLabel     = errors.New("unknown label")
+	ErrDuplicateLabel   = errors.New("duplicate label")
+	ErrJumpOutOfRange   = errors.New("jump out of range")
+	ErrInvalidKeySize   = errors.New("invalid key size")
+	ErrInvalidValueSize = errors.New("invalid value size")
+	ErrKeyNotExist      = errors.New("key does not exist")
+	ErrVerifier         = errors.New("program rejected by the verifier")
+)
@@ -0,0 +1,222 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package ebpf
+
+import "encoding/binary"
+
+// Register is an eBPF register.
+type Register uint8
+
+const (
+	R0 Register = iota // return value
+	R1                 // first argument (context of the program)
+	R2
+	R3
+	R4
+	R5
+	R6 // callee saved
+	R7
+	R8
+	R9
+	R10 // read-only frame pointer
+)
+
+// Instruction classes.
+const (
+	ClassLD    = 0x00
+	ClassLDX   = 0x01
+	ClassST    = 0x02
+	ClassSTX   = 0x03
+	ClassALU   = 0x04
+	ClassJMP   = 0x05
+	ClassJMP32 = 0x06
+	ClassALU64 = 0x07
+)
+
+// Sizes and modes of load and store instructions.
+const (
+	SizeW  = 0x00
+	SizeH  = 0x08
+	SizeB  = 0x10
+	SizeDW = 0x18
+
+	ModeIMM = 0x00
+	ModeMEM = 0x60
+)
+
+// Sources of ALU and jump instructions.
+const (
+	SourceK = 0x00 // immediate
+	SourceX = 0x08 // register
+)
+
+// ALU operations.
+const (
+	OpAdd = 0x00
+	OpSub = 0x10
+	OpOr  = 0x40
+	OpAnd = 0x50
+	OpLsh = 0x60
+	OpRsh = 0x70
+	OpMov = 0xb0
+)
+
+// Jump operations.
+const (
+	OpJA   = 0x00
+	OpJEQ  = 0x10
+	OpJGT  = 0x20
+	OpJGE  = 0x30
+	OpJSET = 0x40
+	OpJNE  = 0x50
+	OpCall = 0x80
+	OpExit = 0x90
+)
+
+// PseudoMapFD is the source register of a 64-bit immediate load of a map file descriptor.
+const PseudoMapFD = 1
+
+const instructionLen = 8 // size of an instruction in bytes
+
+// Instruction is an eBPF instruction.
+// 64-bit immediate loads (ClassLD | SizeDW | ModeIMM) use two instruction slots.
+type Instruction struct {
+	Label  string // label of the instruction, if any
+	OpCode uint8
+	Dst    Register
+	Src    Register
+	Off    int16
+	Imm    int64  // 32-bit signed immediate, except for 64-bit immediate loads
+	Target string // label of the target of a jump, overriding Off
+}
+
+// Mov64Imm returns dst = imm.
+func Mov64Imm(dst Register, imm int32) Instruction {
+	return Instruction{OpCode: ClassALU64 | OpMov | SourceK, Dst: dst, Imm: int64(imm)}
+}
+
+// Mov64Reg returns dst = src.
+func Mov64Reg(dst, src Register) Instruction {
+	return Instruction{OpCode: ClassALU64 | OpMov | SourceX, Dst: dst, Src: src}
+}
+
+// ALU64Imm returns dst = dst op imm.
+func ALU64Imm(op uint8, dst Register, imm int32) Instruction {
+	return Instruction{OpCode: ClassALU64 | op | SourceK, Dst: dst, Imm: int64(imm)}
+}
+
+// ALU32Imm returns dst = uint32(dst op imm).
+func ALU32Imm(op uint8, dst Register, imm int32) Instruction {
+	return Instruction{OpCode: ClassALU | op | SourceK, Dst: dst, Imm: int64(imm)}
+}
+
+// LoadMem returns dst = *(size *)(src + off).
+func LoadMem(size uint8, dst, src Register, off int16) Instruction {
+	return Instruction{OpCode: ClassLDX | size | ModeMEM, Dst: dst, Src: src, Off: off}
+}
+
+// LoadMapFD returns dst = map (the map file descriptor is replaced by the map by the kernel).
+func LoadMapFD(dst Register, fd int) Instruction {
+	return Instruction{OpCode: ClassLD | SizeDW | ModeIMM, Dst: dst, Src: PseudoMapFD, Imm: int64(fd)}
+}
+
+// JumpReg returns: if dst op src goto target.
+func JumpReg(op uint8, dst, src Register, target string) Instruction {
+	return Instruction{OpCode: ClassJMP | op | SourceX, Dst: dst, Src: src, Target: target}
+}
+
+// Jump32Imm returns: if uint32(dst) op uint32(imm) goto target.
+func Jump32Imm(op uint8, dst Register, imm int32, target string) Instruction {
+	return Instruction{OpCode: ClassJMP32 | op | SourceK, Dst: dst, Imm: int64(imm), Target: target}
+}
+
+// Ja returns: goto target.
+func Ja(target string) Instruction {
+	return Instruction{OpCode: ClassJMP | OpJA, Target: target}
+}
+
+// Call returns a call of the helper function fn.
+func Call(fn int32) Instruction {
+	return Instruction{OpCode: ClassJMP | OpCall, Imm: int64(fn)}
+}
+
+// Exit returns the exit instruction, whose return value is R0.
+func Exit() Instruction {
+	return Instruction{OpCode: ClassJMP | OpExit}
+}
+
+// WithLabel returns the instruction with the given label.
+func (ins Instruction) WithLabel(label string) Instruction {
+	ins.Label = label
+	return ins
+}
+
+// isLoadImm64 returns true if the instruction uses two instruction slots.
+func (ins *Instruction) isLoadImm64() bool {
+	return ins.OpCode == ClassLD|SizeDW|ModeIMM
+}
+
+// slots returns the number of instruction slots used by the instruction.
+func (ins *Instruction) slots() int {
+	if ins.isLoadImm64() {
+		return 2
+	}
+	return 1
+}
+
+// Assemble resolves the labels of the program, and returns its byte sequence
+// in host byte order, as expected by the kernel.
+func Assemble(prog []Instruction) ([]byte, error) {
+	if len(prog) == 0 {
+		return nil, ErrEmptyProgram
+	}
+	labels := make(map[string]int, len(prog))
+	slot := 0
+	for _, ins := range prog {
+		if ins.Label != "" {
+			if _, ok := labels[ins.Label]; ok {
+				return nil, ErrDuplicateLabel
+			}
+			labels[ins.Label] = slot
+		}
+		slot += ins.slots()
+	}
+	b := make([]byte, 0, slot*instructionLen)
+	slot = 0
+	for _, ins := range prog {
+		off := int(ins.Off)
+		if ins.Target != "" {
+			target, ok := labels[ins.Target]
+			if !ok {
+				return nil, ErrUnknownLabel
+			}
+			off = target - slot - 1
+			if off < -0x8000 || off > 0x7FFF {
+				return nil, ErrJumpOutOfRange
+			}
+		}
+		b = appendInstruction(b, ins.OpCode, ins.Dst, ins.Src, int16(off), int32(ins.Imm))
+		if ins.isLoadImm64() {
+			b = appendInstruction(b, 0, 0, 0, 0, int32(ins.Imm>>32))
+		}
+		slot += ins.slots()
+	}
+	return b, nil
+}
+
+// appendInstruction appends the byte sequence of an instruction slot to b.
+func appendInstruction(b []byte, opCode uint8, dst, src Register, off int16, imm int32) []byte {
+	regs := uint8(dst&0x0F) | uint8(src&0x0F)<<4
+	if bigEndian {
+		regs = uint8(dst&0x0F)<<4 | uint8(src&0x0F)
+	}
+	b = append(b, opCode, regs)
+	b = binary.NativeEndian.AppendUint16(b, uint16(off))
+	return binary.NativeEndian.AppendUint32(b, uint32(imm))
+}
+
+// bigEndian is true if the host byte order is big endian.
+var bigEndian = binary.NativeEndian.Uint16([]byte{0, 1}) == 1
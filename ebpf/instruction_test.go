@@ -0,0 +1,82 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package ebpf
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAssemble(t *testing.T) {
+	b, err := Assemble([]Instruction{
+		LoadMem(SizeW, R2, R1, 4),
+		Jump32Imm(OpJEQ, R2, 1, "pass"),
+		LoadMapFD(R1, 3),
+		Ja("exit"),
+		Mov64Imm(R0, 2).WithLabel("pass"),
+		Exit().WithLabel("exit"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) != 7*instructionLen {
+		t.Fatalf("unexpected length: %d", len(b))
+	}
+	type slot struct {
+		op   uint8
+		regs uint8
+		off  int16
+		imm  int32
+	}
+	var got []slot
+	for i := 0; i < len(b); i += instructionLen {
+		got = append(got, slot{
+			op:   b[i],
+			regs: b[i+1],
+			off:  int16(binary.NativeEndian.Uint16(b[i+2:])),
+			imm:  int32(binary.NativeEndian.Uint32(b[i+4:])),
+		})
+	}
+	regs := func(dst, src uint8) uint8 {
+		if bigEndian {
+			return dst<<4 | src
+		}
+		return src<<4 | dst
+	}
+	want := []slot{
+		{op: 0x61, regs: regs(2, 1), off: 4},
+		{op: 0x16, regs: regs(2, 0), off: 3, imm: 1},
+		{op: 0x18, regs: regs(1, 1), imm: 3},
+		{},
+		{op: 0x05, off: 1},
+		{op: 0xb7, imm: 2},
+		{op: 0x95},
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(slot{})); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestAssembleErrors(t *testing.T) {
+	for _, c := range []struct {
+		name string
+		prog []Instruction
+		err  error
+	}{
+		{name: "empty", err: ErrEmptyProgram},
+		{name: "unknown", prog: []Instruction{Ja("nowhere"), Exit()}, err: ErrUnknownLabel},
+		{name: "duplicate", prog: []Instruction{Exit().WithLabel("a"), Exit().WithLabel("a")}, err: ErrDuplicateLabel},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := Assemble(c.prog); !errors.Is(err, c.err) {
+				t.Errorf("expected %v, got %v", c.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package ebpf
+
+import (
+	"errors"
+	"runtime"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// MapType is the type of an eBPF map.
+type MapType uint32
+
+const (
+	MapTypeHash   MapType = 1
+	MapTypeArray  MapType = 2
+	MapTypeLRU    MapType = 9
+	MapTypeXSKMap MapType = 17
+)
+
+// Flags of Map.Update.
+const (
+	UpdateAny     = 0 // create or update the element
+	UpdateNoExist = 1 // create the element, which must not exist
+	UpdateExist   = 2 // update the element, which must exist
+)
+
+// MapSpec describes an eBPF map.
+type MapSpec struct {
+	Name       string // at most 15 characters are kept
+	Type       MapType
+	KeySize    uint32
+	ValueSize  uint32
+	MaxEntries uint32
+	Flags      uint32
+}
+
+// Map is an eBPF map.
+type Map struct {
+	fd   int
+	spec MapSpec
+}
+
+// NewMap creates a map. CAP_BPF (or CAP_SYS_ADMIN) is required.
+func NewMap(spec MapSpec) (*Map, error) {
+	attr := mapCreateAttr{
+		mapType:    uint32(spec.Type),
+		keySize:    spec.KeySize,
+		valueSize:  spec.ValueSize,
+		maxEntries: spec.MaxEntries,
+		flags:      spec.Flags,
+		name:       objName(spec.Name),
+	}
+	fd, err := bpf(cmdMapCreate, unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+	if err != nil {
+		return nil, err
+	}
+	return &Map{fd: fd, spec: spec}, nil
+}
+
+// OpenPinnedMap opens a map pinned in the BPF file system (e.g. /sys/fs/bpf/<name>).
+func OpenPinnedMap(path string) (*Map, error) {
+	p, err := unix.BytePtrFromString(path)
+	if err != nil {
+		return nil, err
+	}
+	attr := objAttr{pathname: uint64(uintptr(unsafe.Pointer(p)))}
+	fd, err := bpf(cmdObjGet, unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+	runtime.KeepAlive(p)
+	if err != nil {
+		return nil, err
+	}
+	m := &Map{fd: fd}
+	if err := m.loadSpec(); err != nil {
+		return nil, errors.Join(err, m.Close())
+	}
+	return m, nil
+}
+
+// loadSpec retrieves the description of the map from the kernel.
+func (m *Map) loadSpec() error {
+	var info mapInfo
+	attr := infoAttr{
+		fd:      uint32(m.fd),
+		infoLen: uint32(unsafe.Sizeof(info)),
+		info:    uint64(uintptr(unsafe.Pointer(&info))),
+	}
+	_, err := bpf(cmdObjGetInfoByFD, unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+	runtime.KeepAlive(&info)
+	if err != nil {
+		return err
+	}
+	m.spec = MapSpec{
+		Name:       unix.ByteSliceToString(info.name[:]),
+		Type:       MapType(info.mapType),
+		KeySize:    info.keySize,
+		ValueSize:  info.valueSize,
+		MaxEntries: info.maxEntries,
+		Flags:      info.flags,
+	}
+	return nil
+}
+
+// Pin pins the map in the BPF file system, so it outlives the process.
+func (m *Map) Pin(path string) error {
+	p, err := unix.BytePtrFromString(path)
+	if err != nil {
+		return err
+	}
+	attr := objAttr{pathname: uint64(uintptr(unsafe.Pointer(p))), fd: uint32(m.fd)}
+	_, err = bpf(cmdObjPin, unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+	runtime.KeepAlive(p)
+	return err
+}
+
+// FD returns the file descriptor of the map.
+func (m *Map) FD() int {
+	return m.fd
+}
+
+// Spec returns the description of the map.
+func (m *Map) Spec() MapSpec {
+	return m.spec
+}
+
+// check checks the sizes of a key and a value.
+func (m *Map) check(key, value []byte) error {
+	if len(key) != int(m.spec.KeySize) {
+		return ErrInvalidKeySize
+	}
+	if value != nil && len(value) != int(m.spec.ValueSize) {
+		return ErrInvalidValueSize
+	}
+	return nil
+}
+
+// elem calls a BPF_MAP_*_ELEM command.
+func (m *Map) elem(cmd int, key, value []byte, flags uint64) error {
+	attr := mapElemAttr{
+		mapFD: uint32(m.fd),
+		key:   pointer(key),
+		value: pointer(value),
+		flags: flags,
+	}
+	_, err := bpf(cmd, unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+	runtime.KeepAlive(key)
+	runtime.KeepAlive(value)
+	if errors.Is(err, unix.ENOENT) {
+		return ErrKeyNotExist
+	}
+	return err
+}
+
+// Update creates or updates an element, according to flags (UpdateAny, UpdateNoExist, UpdateExist).
+func (m *Map) Update(key, value []byte, flags uint64) error {
+	if err := m.check(key, value); err != nil {
+		return err
+	}
+	return m.elem(cmdMapUpdateElem, key, value, flags)
+}
+
+// Lookup copies the value of an element into value.
+func (m *Map) Lookup(key, value []byte) error {
+	if err := m.check(key, value); err != nil {
+		return err
+	}
+	if value == nil {
+		return ErrInvalidValueSize
+	}
+	return m.elem(cmdMapLookupElem, key, value, 0)
+}
+
+// Delete deletes an element.
+func (m *Map) Delete(key []byte) error {
+	if err := m.check(key, nil); err != nil {
+		return err
+	}
+	return m.elem(cmdMapDeleteElem, key, nil, 0)
+}
+
+// Close closes the file descriptor of the map. A map that is neither pinned
+// nor used by a program is destroyed.
+func (m *Map) Close() error {
+	return unix.Close(m.fd)
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package ebpf
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/sys/unix"
+)
+
+const bpfFSPath = "/sys/fs/bpf"
+
+// newTestMap creates a hash map, or skips the test if eBPF is not available.
+func newTestMap(t *testing.T) *Map {
+	t.Helper()
+	m, err := NewMap(MapSpec{Name: "rfc9433_test", Type: MapTypeHash, KeySize: 4, ValueSize: 8, MaxEntries: 16})
+	if errors.Is(err, unix.EPERM) || errors.Is(err, unix.ENOSYS) {
+		t.Skip("CAP_BPF is required")
+	} else if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { m.Close() })
+	return m
+}
+
+func TestMap(t *testing.T) {
+	m := newTestMap(t)
+	key := []byte{1, 2, 3, 4}
+	value := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	if err := m.Update(key, value, UpdateExist); !errors.Is(err, ErrKeyNotExist) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := m.Update(key, value, UpdateNoExist); err != nil {
+		t.Fatal(err)
+	}
+	got := make([]byte, 8)
+	if err := m.Lookup(key, got); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(value, got); diff != "" {
+		t.Error(diff)
+	}
+	if err := m.Delete(key); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Lookup(key, got); !errors.Is(err, ErrKeyNotExist) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := m.Update(key[:3], value, UpdateAny); !errors.Is(err, ErrInvalidKeySize) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := m.Update(key, value[:7], UpdateAny); !errors.Is(err, ErrInvalidValueSize) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestMapPin(t *testing.T) {
+	var fs unix.Statfs_t
+	if err := unix.Statfs(bpfFSPath, &fs); err != nil || uint32(fs.Type) != unix.BPF_FS_MAGIC {
+		t.Skip("BPF file system is not mounted")
+	}
+	m := newTestMap(t)
+	path := filepath.Join(bpfFSPath, "rfc9433_test_"+strconv.Itoa(os.Getpid()))
+	if err := m.Pin(path); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(path)
+	if err := m.Update([]byte{1, 2, 3, 4}, make([]byte, 8), UpdateAny); err != nil {
+		t.Fatal(err)
+	}
+	pinned, err := OpenPinnedMap(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pinned.Close()
+	if diff := cmp.Diff(m.Spec(), pinned.Spec()); diff != "" {
+		t.Error(diff)
+	}
+	if err := pinned.Lookup([]byte{1, 2, 3, 4}, make([]byte, 8)); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package ebpf
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// ProgramType is the type of an eBPF program.
+type ProgramType uint32
+
+const (
+	ProgramTypeSchedCLS ProgramType = 3
+	ProgramTypeXDP      ProgramType = 6
+)
+
+const verifierLogSize = 1 << 16
+
+// VerifierError is returned when a program is rejected by the verifier.
+type VerifierError struct {
+	Err error
+	Log string // log of the verifier
+}
+
+// Error returns a description of the error.
+func (e *VerifierError) Error() string {
+	return fmt.Sprintf("%s: %s\n%s", ErrVerifier, e.Err, e.Log)
+}
+
+// Is allows the use of errors.Is(err, ErrVerifier).
+func (e *VerifierError) Is(target error) bool {
+	return target == ErrVerifier
+}
+
+// Unwrap returns the error of the system call.
+func (e *VerifierError) Unwrap() error {
+	return e.Err
+}
+
+// Program is a loaded eBPF program.
+type Program struct {
+	fd int
+}
+
+// LoadProgram assembles and loads a program. CAP_BPF (or CAP_SYS_ADMIN) is required.
+// The license must be GPL-compatible for the program to call GPL-only helpers.
+func LoadProgram(name string, typ ProgramType, prog []Instruction, license string) (*Program, error) {
+	insns, err := Assemble(prog)
+	if err != nil {
+		return nil, err
+	}
+	lic, err := unix.BytePtrFromString(license)
+	if err != nil {
+		return nil, err
+	}
+	attr := progLoadAttr{
+		progType:  uint32(typ),
+		insnCount: uint32(len(insns) / instructionLen),
+		insns:     pointer(insns),
+		license:   uint64(uintptr(unsafe.Pointer(lic))),
+		name:      objName(name),
+	}
+	fd, err := bpf(cmdProgLoad, unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+	if err == nil {
+		runtime.KeepAlive(insns)
+		runtime.KeepAlive(lic)
+		return &Program{fd: fd}, nil
+	}
+	// load again with the log of the verifier
+	log := make([]byte, verifierLogSize)
+	attr.logLevel = 1
+	attr.logSize = uint32(len(log))
+	attr.logBuf = pointer(log)
+	fd, retry := bpf(cmdProgLoad, unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+	runtime.KeepAlive(insns)
+	runtime.KeepAlive(lic)
+	runtime.KeepAlive(log)
+	if retry == nil {
+		return &Program{fd: fd}, nil
+	}
+	if !errors.Is(err, unix.EACCES) && !errors.Is(err, unix.EINVAL) {
+		return nil, err
+	}
+	return nil, &VerifierError{Err: err, Log: unix.ByteSliceToString(log)}
+}
+
+// FD returns the file descriptor of the program.
+func (p *Program) FD() int {
+	return p.fd
+}
+
+// Close closes the file descriptor of the program. A program that is neither pinned
+// nor attached is unloaded.
+func (p *Program) Close() error {
+	return unix.Close(p.fd)
+}
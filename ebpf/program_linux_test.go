@@ -0,0 +1,36 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package ebpf
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestLoadProgram(t *testing.T) {
+	p, err := LoadProgram("rfc9433_test", ProgramTypeXDP, []Instruction{
+		Mov64Imm(R0, 2), // XDP_PASS
+		Exit(),
+	}, "Dual MIT/GPL")
+	if errors.Is(err, unix.EPERM) || errors.Is(err, unix.ENOSYS) {
+		t.Skip("CAP_BPF is required")
+	} else if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Close(); err != nil {
+		t.Fatal(err)
+	}
+	// R0 is not initialized
+	_, err = LoadProgram("rfc9433_test", ProgramTypeXDP, []Instruction{Exit()}, "Dual MIT/GPL")
+	var verr *VerifierError
+	if !errors.As(err, &verr) || !errors.Is(err, ErrVerifier) || verr.Log == "" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package ebpf
+
+import (
+	"os"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// Commands of the bpf(2) system call.
+const (
+	cmdMapCreate      = 0
+	cmdMapLookupElem  = 1
+	cmdMapUpdateElem  = 2
+	cmdMapDeleteElem  = 3
+	cmdProgLoad       = 5
+	cmdObjPin         = 6
+	cmdObjGet         = 7
+	cmdObjGetInfoByFD = 15
+)
+
+// mapCreateAttr is the bpf_attr of BPF_MAP_CREATE.
+type mapCreateAttr struct {
+	mapType    uint32
+	keySize    uint32
+	valueSize  uint32
+	maxEntries uint32
+	flags      uint32
+	innerMapFD uint32
+	numaNode   uint32
+	name       [16]byte
+}
+
+// mapElemAttr is the bpf_attr of BPF_MAP_*_ELEM.
+type mapElemAttr struct {
+	mapFD uint32
+	_     uint32
+	key   uint64
+	value uint64
+	flags uint64
+}
+
+// progLoadAttr is the bpf_attr of BPF_PROG_LOAD.
+type progLoadAttr struct {
+	progType    uint32
+	insnCount   uint32
+	insns       uint64
+	license     uint64
+	logLevel    uint32
+	logSize     uint32
+	logBuf      uint64
+	kernVersion uint32
+	flags       uint32
+	name        [16]byte
+}
+
+// objAttr is the bpf_attr of BPF_OBJ_PIN and BPF_OBJ_GET.
+type objAttr struct {
+	pathname uint64
+	fd       uint32
+	flags    uint32
+}
+
+// infoAttr is the bpf_attr of BPF_OBJ_GET_INFO_BY_FD.
+type infoAttr struct {
+	fd      uint32
+	infoLen uint32
+	info    uint64
+}
+
+// mapInfo is the beginning of struct bpf_map_info.
+type mapInfo struct {
+	mapType    uint32
+	id         uint32
+	keySize    uint32
+	valueSize  uint32
+	maxEntries uint32
+	flags      uint32
+	name       [16]byte
+}
+
+// bpf calls the bpf(2) system call. Pointers in attr must be kept alive by the caller.
+func bpf(cmd int, attr unsafe.Pointer, size uintptr) (int, error) {
+	r, _, errno := unix.Syscall(unix.SYS_BPF, uintptr(cmd), uintptr(attr), size)
+	if errno != 0 {
+		return -1, os.NewSyscallError("bpf", errno)
+	}
+	return int(r), nil
+}
+
+// pointer returns the address of the first byte of b, as used in bpf_attr.
+func pointer(b []byte) uint64 {
+	if len(b) == 0 {
+		return 0
+	}
+	return uint64(uintptr(unsafe.Pointer(&b[0])))
+}
+
+// objName returns the NUL-terminated object name, truncated to 15 characters.
+func objName(s string) [16]byte {
+	var n [16]byte
+	copy(n[:15], s)
+	return n
+}
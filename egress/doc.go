@@ -0,0 +1,8 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package egress provides next-hop selection and neighbor resolution (ARP and NDP)
+// for packets emitted by the SR Gateway.
+package egress
@@ -0,0 +1,16 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package egress
+
+import "errors"
+
+var (
+	ErrNoRoute            = errors.New("no route to destination")
+	ErrUnknownPeer        = errors.New("unknown peer")
+	ErrNeighborUnresolved = errors.New("neighbor is not resolved")
+	ErrInvalidNeighbor    = errors.New("invalid neighbor")
+	ErrInvalidPrefix      = errors.New("invalid prefix")
+)
@@ -0,0 +1,46 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package egress
+
+import (
+	"net"
+	"net/netip"
+
+	"github.com/vishvananda/netlink"
+)
+
+// KernelFIB is a RouteLookup using the FIB of the kernel, via netlink.
+type KernelFIB struct{}
+
+// NewKernelFIB creates a KernelFIB.
+func NewKernelFIB() *KernelFIB {
+	return &KernelFIB{}
+}
+
+// Lookup returns the route chosen by the kernel for this destination.
+func (f *KernelFIB) Lookup(dst netip.Addr) (Route, error) {
+	routes, err := netlink.RouteGet(net.IP(dst.AsSlice()))
+	if err != nil {
+		return Route{}, err
+	}
+	if len(routes) == 0 {
+		return Route{}, ErrNoRoute
+	}
+	link, err := netlink.LinkByIndex(routes[0].LinkIndex)
+	if err != nil {
+		return Route{}, err
+	}
+	// invalid addresses are returned when Gw or Src are not set
+	nextHop, _ := netip.AddrFromSlice(routes[0].Gw)
+	src, _ := netip.AddrFromSlice(routes[0].Src)
+	return Route{
+		Interface: link.Attrs().Name,
+		NextHop:   nextHop.Unmap(),
+		Src:       src.Unmap(),
+	}, nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package egress
+
+import (
+	"net"
+	"net/netip"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultNeighborTTL is the default lifetime of resolved neighbors in a NeighborCache.
+	DefaultNeighborTTL = 30 * time.Second
+	// negativeTTL is the lifetime of failed resolutions in a NeighborCache,
+	// so the resolver is not called for each packet sent to an unresolved neighbor.
+	negativeTTL = 100 * time.Millisecond
+)
+
+// NeighborResolver resolves the link-layer address of a neighbor (ARP for IPv4, NDP for IPv6).
+type NeighborResolver interface {
+	ResolveNeighbor(addr netip.Addr) (net.HardwareAddr, error)
+}
+
+// NeighborResolverFunc is an adapter to allow the use of ordinary functions as NeighborResolver.
+type NeighborResolverFunc func(addr netip.Addr) (net.HardwareAddr, error)
+
+// ResolveNeighbor calls f(addr).
+func (f NeighborResolverFunc) ResolveNeighbor(addr netip.Addr) (net.HardwareAddr, error) {
+	return f(addr)
+}
+
+type neighborEntry struct {
+	mac     net.HardwareAddr // nil if the resolution failed
+	err     error
+	expires time.Time
+}
+
+// NeighborCache is a NeighborResolver caching the link-layer addresses resolved by another NeighborResolver.
+// Static entries override the resolution. NeighborCache is safe for concurrent use.
+type NeighborCache struct {
+	mu       sync.RWMutex
+	resolver NeighborResolver
+	ttl      time.Duration
+	static   map[netip.Addr]net.HardwareAddr
+	entries  map[netip.Addr]neighborEntry
+	now      func() time.Time
+}
+
+// NewNeighborCache creates a NeighborCache. resolver may be nil if only static entries are used.
+// If ttl is zero, DefaultNeighborTTL is used.
+func NewNeighborCache(resolver NeighborResolver, ttl time.Duration) *NeighborCache {
+	if ttl == 0 {
+		ttl = DefaultNeighborTTL
+	}
+	return &NeighborCache{
+		resolver: resolver,
+		ttl:      ttl,
+		static:   make(map[netip.Addr]net.HardwareAddr),
+		entries:  make(map[netip.Addr]neighborEntry),
+		now:      time.Now,
+	}
+}
+
+// SetStatic sets a static entry, which is never resolved nor aged out.
+func (c *NeighborCache) SetStatic(addr netip.Addr, mac net.HardwareAddr) error {
+	if !addr.IsValid() || len(mac) == 0 {
+		return ErrInvalidNeighbor
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.static[addr.Unmap()] = append(net.HardwareAddr(nil), mac...)
+	return nil
+}
+
+// DeleteStatic removes a static entry.
+func (c *NeighborCache) DeleteStatic(addr netip.Addr) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.static, addr.Unmap())
+}
+
+// Flush removes the resolved entries, so they are resolved again on their next use.
+func (c *NeighborCache) Flush() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+}
+
+// ResolveNeighbor returns the link-layer address of the neighbor.
+// The returned address must not be modified.
+func (c *NeighborCache) ResolveNeighbor(addr netip.Addr) (net.HardwareAddr, error) {
+	addr = addr.Unmap()
+	c.mu.RLock()
+	if mac, ok := c.static[addr]; ok {
+		c.mu.RUnlock()
+		return mac, nil
+	}
+	e, ok := c.entries[addr]
+	c.mu.RUnlock()
+	if ok && c.now().Before(e.expires) {
+		return e.mac, e.err
+	}
+	if c.resolver == nil {
+		return nil, ErrNeighborUnresolved
+	}
+	mac, err := c.resolver.ResolveNeighbor(addr)
+	e = neighborEntry{mac: mac, err: err, expires: c.now().Add(c.ttl)}
+	if err != nil {
+		e.mac = nil
+		e.expires = c.now().Add(min(c.ttl, negativeTTL))
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[addr] = e
+	return e.mac, e.err
+}
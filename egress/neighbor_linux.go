@@ -0,0 +1,71 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package egress
+
+import (
+	"errors"
+	"net"
+	"net/netip"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// resolvedStates are the states of the kernel neighbors whose link-layer address can be used.
+const resolvedStates = netlink.NUD_REACHABLE | netlink.NUD_STALE | netlink.NUD_DELAY | netlink.NUD_PROBE | netlink.NUD_PERMANENT
+
+// KernelNeighbors is a NeighborResolver using the neighbor table of the kernel (ARP and NDP) for an interface, via netlink.
+// When a neighbor is not resolved, the kernel is asked to resolve it, and ErrNeighborUnresolved is returned;
+// it should be wrapped in a NeighborCache.
+type KernelNeighbors struct {
+	linkIndex int
+}
+
+// NewKernelNeighbors creates a KernelNeighbors for the given interface.
+func NewKernelNeighbors(iface string) (*KernelNeighbors, error) {
+	link, err := netlink.LinkByName(iface)
+	if err != nil {
+		return nil, err
+	}
+	return &KernelNeighbors{
+		linkIndex: link.Attrs().Index,
+	}, nil
+}
+
+// ResolveNeighbor returns the link-layer address of the neighbor in the kernel neighbor table.
+func (k *KernelNeighbors) ResolveNeighbor(addr netip.Addr) (net.HardwareAddr, error) {
+	addr = addr.Unmap()
+	family := unix.AF_INET6
+	if addr.Is4() {
+		family = unix.AF_INET
+	} else if !addr.Is6() {
+		return nil, ErrInvalidNeighbor
+	}
+	neighs, err := netlink.NeighList(k.linkIndex, family)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range neighs {
+		if a, ok := netip.AddrFromSlice(n.IP); !ok || a.Unmap() != addr {
+			continue
+		}
+		if n.State&resolvedStates != 0 && len(n.HardwareAddr) > 0 {
+			return n.HardwareAddr, nil
+		}
+	}
+	// NTF_USE: the kernel creates the entry if needed, and sends an ARP request or a Neighbor Solicitation
+	if err := netlink.NeighSet(&netlink.Neigh{
+		LinkIndex: k.linkIndex,
+		Family:    family,
+		IP:        net.IP(addr.AsSlice()),
+		Flags:     netlink.NTF_USE,
+	}); err != nil {
+		return nil, errors.Join(ErrNeighborUnresolved, err)
+	}
+	return nil, ErrNeighborUnresolved
+}
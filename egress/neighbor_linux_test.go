@@ -0,0 +1,116 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package egress
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"net"
+	"net/netip"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// answerARP answers the first ARP request received on the interface with mac.
+func answerARP(t *testing.T, link netlink.Link, mac net.HardwareAddr) {
+	t.Helper()
+	proto := uint16(unix.ETH_P_ARP&0xFF<<8 | unix.ETH_P_ARP>>8) // network byte order
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_RAW|unix.SOCK_CLOEXEC, int(proto))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := unix.Bind(fd, &unix.SockaddrLinklayer{Protocol: proto, Ifindex: link.Attrs().Index}); err != nil {
+		unix.Close(fd)
+		t.Fatal(err)
+	}
+	if err := unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &unix.Timeval{Sec: 5}); err != nil {
+		unix.Close(fd)
+		t.Fatal(err)
+	}
+	go func() {
+		defer unix.Close(fd)
+		b := make([]byte, 1500)
+		for {
+			n, _, err := unix.Recvfrom(fd, b, 0)
+			if err != nil {
+				return
+			}
+			// Ethernet header (14 bytes), then ARP for IPv4 over Ethernet (28 bytes)
+			if n < 42 || binary.BigEndian.Uint16(b[20:22]) != 1 {
+				continue
+			}
+			req := b[14:42]
+			reply := append(append(append([]byte(nil), req[8:14]...), mac...), 0x08, 0x06) // Ethernet header
+			reply = append(reply, req[0:6]...)
+			reply = binary.BigEndian.AppendUint16(reply, 2)
+			reply = append(reply, mac...)
+			reply = append(reply, req[24:28]...) // sender: target of the request
+			reply = append(reply, req[8:18]...)  // target: sender of the request
+			unix.Sendto(fd, reply, 0, &unix.SockaddrLinklayer{Ifindex: link.Attrs().Index, Halen: 6})
+			return
+		}
+	}()
+}
+
+func TestKernelNeighbors(t *testing.T) {
+	veth := &netlink.Veth{LinkAttrs: netlink.LinkAttrs{Name: "rfc9433nb0"}, PeerName: "rfc9433nb1"}
+	if err := netlink.LinkAdd(veth); errors.Is(err, os.ErrPermission) {
+		t.Skip("CAP_NET_ADMIN is required")
+	} else if err != nil {
+		t.Fatal(err)
+	}
+	defer netlink.LinkDel(veth)
+	links := make([]netlink.Link, 2)
+	for i, name := range []string{"rfc9433nb0", "rfc9433nb1"} {
+		link, err := netlink.LinkByName(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := netlink.LinkSetUp(link); err != nil {
+			t.Fatal(err)
+		}
+		links[i] = link
+	}
+	addr, err := netlink.ParseAddr("10.94.33.1/24")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := netlink.AddrAdd(links[0], addr); err != nil {
+		t.Fatal(err)
+	}
+	mac := net.HardwareAddr{0x02, 0x94, 0x33, 0, 0, 2}
+	answerARP(t, links[1], mac)
+
+	k, err := NewKernelNeighbors("rfc9433nb0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	peer := netip.MustParseAddr("10.94.33.2")
+	if _, err := k.ResolveNeighbor(peer); !errors.Is(err, ErrNeighborUnresolved) {
+		t.Fatalf("Neighbor should not be resolved yet: %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		got, err := k.ResolveNeighbor(peer)
+		if err == nil {
+			if !bytes.Equal(got, mac) {
+				t.Errorf("Unexpected neighbor: %v", got)
+			}
+			break
+		}
+		if !errors.Is(err, ErrNeighborUnresolved) || time.Now().After(deadline) {
+			t.Fatal(err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package egress
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestNeighborCache(t *testing.T) {
+	mac := net.HardwareAddr{0x02, 0, 0, 0, 0, 1}
+	calls := 0
+	resolved := false
+	c := NewNeighborCache(NeighborResolverFunc(func(addr netip.Addr) (net.HardwareAddr, error) {
+		calls++
+		if !resolved {
+			return nil, ErrNeighborUnresolved
+		}
+		return mac, nil
+	}), time.Minute)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+	gw := netip.MustParseAddr("10.0.0.1")
+
+	// failed resolutions are cached for a short time
+	for range 2 {
+		if _, err := c.ResolveNeighbor(gw); !errors.Is(err, ErrNeighborUnresolved) {
+			t.Errorf("Neighbor should not be resolved: %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Unexpected number of resolutions: %d", calls)
+	}
+	resolved = true
+	now = now.Add(negativeTTL)
+	for range 2 {
+		if got, err := c.ResolveNeighbor(netip.AddrFrom16(gw.As16())); err != nil || !bytes.Equal(got, mac) {
+			t.Errorf("Unexpected neighbor: %v, %v", got, err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("Unexpected number of resolutions: %d", calls)
+	}
+	now = now.Add(time.Minute)
+	c.ResolveNeighbor(gw)
+	if calls != 3 {
+		t.Errorf("Aged out neighbor should be resolved again: %d", calls)
+	}
+	c.Flush()
+	c.ResolveNeighbor(gw)
+	if calls != 4 {
+		t.Errorf("Flushed neighbor should be resolved again: %d", calls)
+	}
+
+	// static entries override the resolution
+	static := net.HardwareAddr{0x02, 0, 0, 0, 0, 2}
+	if err := c.SetStatic(gw, static); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := c.ResolveNeighbor(gw); err != nil || !bytes.Equal(got, static) || calls != 4 {
+		t.Errorf("Unexpected neighbor: %v, %v", got, err)
+	}
+	c.DeleteStatic(gw)
+	if got, err := c.ResolveNeighbor(gw); err != nil || !bytes.Equal(got, mac) {
+		t.Errorf("Unexpected neighbor: %v, %v", got, err)
+	}
+	if err := c.SetStatic(gw, nil); !errors.Is(err, ErrInvalidNeighbor) {
+		t.Errorf("Empty MAC address should be rejected: %v", err)
+	}
+	if _, err := NewNeighborCache(nil, 0).ResolveNeighbor(gw); !errors.Is(err, ErrNeighborUnresolved) {
+		t.Errorf("Neighbor without static entry should not be resolved: %v", err)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package egress
+
+import (
+	"hash/fnv"
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/lpm"
+)
+
+// NextHop is a possible next-hop toward a destination.
+type NextHop struct {
+	Interface string     // egress interface
+	Addr      netip.Addr // address of the next-hop, invalid if the destination is directly connected
+}
+
+// Resolver resolves the next-hops toward a destination.
+type Resolver interface {
+	Resolve(dst netip.Addr) ([]NextHop, error)
+}
+
+// StaticResolver is a Resolver using a static list of routes.
+// The longest prefix matching the destination is used.
+type StaticResolver struct {
+	routes *lpm.Table[[]NextHop]
+}
+
+// NewStaticResolver creates a StaticResolver.
+// Routes without next-hops are ignored.
+func NewStaticResolver(routes map[netip.Prefix][]NextHop) (*StaticResolver, error) {
+	nonEmpty := make(map[netip.Prefix][]NextHop, len(routes))
+	for prefix, nextHops := range routes {
+		if len(nextHops) > 0 {
+			nonEmpty[prefix] = nextHops
+		}
+	}
+	t, err := newStaticTable(nonEmpty)
+	if err != nil {
+		return nil, err
+	}
+	return &StaticResolver{routes: t}, nil
+}
+
+// Resolve returns the next-hops of the longest prefix matching the destination.
+func (r *StaticResolver) Resolve(dst netip.Addr) ([]NextHop, error) {
+	_, nextHops, ok := r.routes.Lookup(dst)
+	if !ok {
+		return nil, ErrNoRoute
+	}
+	return nextHops, nil
+}
+
+// ECMP spreads flows across the next-hops returned by a Resolver.
+//
+// Next-hop selection uses Rendezvous Hashing on the entropy generated for the flow
+// (e.g. UDP Source Port, or IPv6 Flow Label): packets of a flow always use the same next-hop,
+// and when a next-hop is added or removed, only the flows using this next-hop are moved.
+type ECMP struct {
+	resolver Resolver
+}
+
+// NewECMP creates an ECMP.
+func NewECMP(resolver Resolver) *ECMP {
+	return &ECMP{
+		resolver: resolver,
+	}
+}
+
+// Select returns the next-hop toward the destination for the flow with the given entropy.
+func (e *ECMP) Select(dst netip.Addr, entropy uint32) (NextHop, error) {
+	nextHops, err := e.resolver.Resolve(dst)
+	if err != nil {
+		return NextHop{}, err
+	}
+	switch len(nextHops) {
+	case 0:
+		return NextHop{}, ErrNoRoute
+	case 1:
+		return nextHops[0], nil
+	}
+	best := 0
+	var bestScore uint64
+	for i, nh := range nextHops {
+		if score := rendezvousScore(nh, entropy); i == 0 || score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+	return nextHops[best], nil
+}
+
+// rendezvousScore returns the weight of the next-hop for this entropy.
+func rendezvousScore(nh NextHop, entropy uint32) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte{byte(entropy >> 24), byte(entropy >> 16), byte(entropy >> 8), byte(entropy)})
+	b, _ := nh.Addr.MarshalBinary()
+	h.Write(b)
+	h.Write([]byte(nh.Interface))
+	return mix(h.Sum64())
+}
+
+// mix is the finalizer of splitmix64, improving the distribution of FNV for small inputs.
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
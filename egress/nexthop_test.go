@@ -0,0 +1,67 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package egress
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestECMP(t *testing.T) {
+	nh1 := NextHop{Interface: "eth0", Addr: netip.MustParseAddr("fe80::1")}
+	nh2 := NextHop{Interface: "eth0", Addr: netip.MustParseAddr("fe80::2")}
+	nh3 := NextHop{Interface: "eth1", Addr: netip.MustParseAddr("fe80::3")}
+	allResolver, err := NewStaticResolver(map[netip.Prefix][]NextHop{
+		netip.MustParsePrefix("fd00::/16"):     {nh1, nh2, nh3},
+		netip.MustParsePrefix("fd00:2:2::/48"): {nh3},
+		netip.MustParsePrefix("fd00:1::/32"):   {}, // ignored
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	reducedResolver, err := NewStaticResolver(map[netip.Prefix][]NextHop{
+		netip.MustParsePrefix("fd00::/16"): {nh1, nh2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	all := NewECMP(allResolver)
+	reduced := NewECMP(reducedResolver)
+
+	dst := netip.MustParseAddr("fd00:1:1::1")
+	count := make(map[NextHop]int)
+	for entropy := uint32(0); entropy < 3000; entropy++ {
+		nh, err := all.Select(dst, entropy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if again, _ := all.Select(dst, entropy); again != nh {
+			t.Fatalf("Selection is not consistent for entropy %d", entropy)
+		}
+		count[nh]++
+		// removing a next-hop must only move flows using this next-hop
+		if nh != nh3 {
+			if r, _ := reduced.Select(dst, entropy); r != nh {
+				t.Fatalf("Flow with entropy %d has been moved", entropy)
+			}
+		}
+	}
+	for _, nh := range []NextHop{nh1, nh2, nh3} {
+		if count[nh] < 800 {
+			t.Errorf("Flows are not spread evenly: %v", count)
+		}
+	}
+
+	if nh, err := all.Select(netip.MustParseAddr("fd00:2:2::1"), 0); err != nil || nh != nh3 {
+		t.Errorf("Longest prefix should be used: %v (%v)", nh, err)
+	}
+	if _, err := all.Select(netip.MustParseAddr("2001:db8::1"), 0); err != ErrNoRoute {
+		t.Errorf("Unknown destination should fail: %v", err)
+	}
+	if _, err := NewStaticResolver(map[netip.Prefix][]NextHop{{}: {nh1}}); err != ErrInvalidPrefix {
+		t.Errorf("Invalid prefix should be rejected: %v", err)
+	}
+}
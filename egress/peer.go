@@ -0,0 +1,72 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package egress
+
+import (
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/gtpu"
+	"github.com/nextmn/rfc9433/lpm"
+)
+
+// Peer contains the information required to finalize packets
+// sent by End.M.GTP4.E toward a gNB or UPF.
+type Peer struct {
+	Interface string     // egress interface, empty to use the routing table
+	Src       netip.Addr // IPv4 source address, invalid to use the one encoded in the IPv6 SA
+	Port      uint16     // GTP-U destination port
+}
+
+// PeerResolver resolves the Peer corresponding to the IPv4 DA decoded from an End.M.GTP4.E SID.
+type PeerResolver interface {
+	ResolvePeer(ipv4 netip.Addr) (Peer, error)
+}
+
+// PeerResolverFunc is an adapter to allow the use of ordinary functions as PeerResolver.
+type PeerResolverFunc func(ipv4 netip.Addr) (Peer, error)
+
+// ResolvePeer calls f(ipv4).
+func (f PeerResolverFunc) ResolvePeer(ipv4 netip.Addr) (Peer, error) {
+	return f(ipv4)
+}
+
+// StaticPeers is a PeerResolver using a static list of IPv4 prefixes.
+// The longest prefix matching the IPv4 DA is used.
+type StaticPeers struct {
+	peers *lpm.Table[Peer]
+}
+
+// NewStaticPeers creates a StaticPeers.
+// Peers with a zero Port use gtpu.Port.
+func NewStaticPeers(peers map[netip.Prefix]Peer) (*StaticPeers, error) {
+	withPorts := make(map[netip.Prefix]Peer, len(peers))
+	for prefix, peer := range peers {
+		if peer.Port == 0 {
+			peer.Port = gtpu.Port
+		}
+		withPorts[prefix] = peer
+	}
+	t, err := newStaticTable(withPorts)
+	if err != nil {
+		return nil, err
+	}
+	return &StaticPeers{peers: t}, nil
+}
+
+// ResolvePeer returns the Peer of the longest prefix matching the IPv4 DA.
+func (p *StaticPeers) ResolvePeer(ipv4 netip.Addr) (Peer, error) {
+	_, peer, ok := p.peers.Lookup(ipv4)
+	if !ok {
+		return Peer{}, ErrUnknownPeer
+	}
+	return peer, nil
+}
+
+// ResolveMGTP4IPv6Dst returns the Peer corresponding to the IPv4 DA encoded in the End.M.GTP4.E SID.
+func ResolveMGTP4IPv6Dst(resolver PeerResolver, dst *encoding.MGTP4IPv6Dst) (Peer, error) {
+	return resolver.ResolvePeer(dst.IPv4())
+}
@@ -0,0 +1,40 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package egress
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/gtpu"
+)
+
+func TestStaticPeers(t *testing.T) {
+	gnb := Peer{Interface: "n3", Src: netip.MustParseAddr("192.0.2.254")}
+	upf := Peer{Interface: "n9", Port: 2153}
+	peers, err := NewStaticPeers(map[netip.Prefix]Peer{
+		netip.MustParsePrefix("198.51.100.0/24"): gnb,
+		netip.MustParsePrefix("198.51.100.7/32"): upf,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dst := encoding.NewMGTP4IPv6Dst(netip.MustParsePrefix("fd00:2:2::/48"), [4]byte{198, 51, 100, 1}, encoding.NewArgsMobSession(0, false, false, 1))
+	p, err := ResolveMGTP4IPv6Dst(peers, dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Interface != gnb.Interface || p.Src != gnb.Src || p.Port != gtpu.Port {
+		t.Errorf("Unexpected peer: %v", p)
+	}
+	if p, err := peers.ResolvePeer(netip.MustParseAddr("198.51.100.7")); err != nil || p != upf {
+		t.Errorf("Longest prefix should be used: %v (%v)", p, err)
+	}
+	if _, err := peers.ResolvePeer(netip.MustParseAddr("203.0.113.1")); err != ErrUnknownPeer {
+		t.Errorf("Unknown peer should fail: %v", err)
+	}
+}
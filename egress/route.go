@@ -0,0 +1,77 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package egress
+
+import (
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/lpm"
+)
+
+// Route is the result of a routing decision.
+type Route struct {
+	Interface string     // egress interface
+	NextHop   netip.Addr // address of the next-hop, invalid if the destination is directly connected
+	Src       netip.Addr // preferred source address, invalid if unspecified
+}
+
+// RouteLookup takes routing decisions for packets emitted by the SR Gateway.
+type RouteLookup interface {
+	Lookup(dst netip.Addr) (Route, error)
+}
+
+// RouteLookupFunc is an adapter to allow the use of ordinary functions as RouteLookup.
+type RouteLookupFunc func(dst netip.Addr) (Route, error)
+
+// Lookup calls f(dst).
+func (f RouteLookupFunc) Lookup(dst netip.Addr) (Route, error) {
+	return f(dst)
+}
+
+// StaticTable is a RouteLookup using a static routing table.
+// The longest prefix matching the destination is used.
+type StaticTable struct {
+	routes *lpm.Table[Route]
+}
+
+// NewStaticTable creates a StaticTable.
+func NewStaticTable(routes map[netip.Prefix]Route) (*StaticTable, error) {
+	t, err := newStaticTable(routes)
+	if err != nil {
+		return nil, err
+	}
+	return &StaticTable{routes: t}, nil
+}
+
+// Lookup returns the route of the longest prefix matching the destination.
+func (t *StaticTable) Lookup(dst netip.Addr) (Route, error) {
+	_, route, ok := t.routes.Lookup(dst)
+	if !ok {
+		return Route{}, ErrNoRoute
+	}
+	return route, nil
+}
+
+// LookupResolver is a Resolver returning the single next-hop chosen by a RouteLookup.
+type LookupResolver struct {
+	lookup RouteLookup
+}
+
+// NewLookupResolver creates a LookupResolver.
+func NewLookupResolver(lookup RouteLookup) *LookupResolver {
+	return &LookupResolver{
+		lookup: lookup,
+	}
+}
+
+// Resolve returns the next-hop chosen by the RouteLookup.
+func (r *LookupResolver) Resolve(dst netip.Addr) ([]NextHop, error) {
+	route, err := r.lookup.Lookup(dst)
+	if err != nil {
+		return nil, err
+	}
+	return []NextHop{{Interface: route.Interface, Addr: route.NextHop}}, nil
+}
@@ -0,0 +1,40 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package egress
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestStaticTable(t *testing.T) {
+	def := Route{Interface: "eth0", NextHop: netip.MustParseAddr("fe80::1")}
+	sr := Route{Interface: "eth1", NextHop: netip.MustParseAddr("fe80::2"), Src: netip.MustParseAddr("fd00:1::1")}
+	table, err := NewStaticTable(map[netip.Prefix]Route{
+		netip.MustParsePrefix("::/0"):          def,
+		netip.MustParsePrefix("fd00:2:2::/48"): sr,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, err := table.Lookup(netip.MustParseAddr("fd00:2:2::1")); err != nil || r != sr {
+		t.Errorf("Longest prefix should be used: %v (%v)", r, err)
+	}
+	if r, err := table.Lookup(netip.MustParseAddr("2001:db8::1")); err != nil || r != def {
+		t.Errorf("Default route should be used: %v (%v)", r, err)
+	}
+	if _, err := table.Lookup(netip.MustParseAddr("192.0.2.1")); err != ErrNoRoute {
+		t.Errorf("Lookup of an IPv4 destination should fail: %v", err)
+	}
+
+	nh, err := NewECMP(NewLookupResolver(table)).Select(netip.MustParseAddr("fd00:2:2::1"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nh.Interface != sr.Interface || nh.Addr != sr.NextHop {
+		t.Errorf("Unexpected next-hop: %v", nh)
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package egress
+
+import (
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/lpm"
+)
+
+// newStaticTable returns an lpm.Table of the entries of a static configuration.
+func newStaticTable[V any](entries map[netip.Prefix]V) (*lpm.Table[V], error) {
+	t := lpm.NewTable[V]()
+	for prefix, v := range entries {
+		if err := t.Insert(prefix, v); err != nil {
+			return nil, ErrInvalidPrefix
+		}
+	}
+	return t, nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+// ArgsMobSessionBuilder builds an ArgsMobSession.
+// The first invalid value is reported by Build.
+//
+//	a, err := NewArgsMobSessionBuilder().QFI(5).R(true).PDUSessionID(1).Build()
+type ArgsMobSessionBuilder struct {
+	a   ArgsMobSession
+	err error
+}
+
+// NewArgsMobSessionBuilder creates a new ArgsMobSessionBuilder with all fields set to zero.
+func NewArgsMobSessionBuilder() *ArgsMobSessionBuilder {
+	return &ArgsMobSessionBuilder{}
+}
+
+// QFI sets the Qos Flow Identifier.
+func (b *ArgsMobSessionBuilder) QFI(qfi uint8) *ArgsMobSessionBuilder {
+	if err := b.a.SetQFI(qfi); err != nil && b.err == nil {
+		b.err = err
+	}
+	return b
+}
+
+// R sets the Reflective QoS Indication.
+func (b *ArgsMobSessionBuilder) R(r bool) *ArgsMobSessionBuilder {
+	b.a.SetR(r)
+	return b
+}
+
+// U sets the U bit.
+func (b *ArgsMobSessionBuilder) U(u bool) *ArgsMobSessionBuilder {
+	b.a.SetU(u)
+	return b
+}
+
+// PDUSessionID sets the PDU Session Identifier.
+func (b *ArgsMobSessionBuilder) PDUSessionID(pduSessionID uint32) *ArgsMobSessionBuilder {
+	b.a.SetPDUSessionID(pduSessionID)
+	return b
+}
+
+// Build returns a new ArgsMobSession.
+func (b *ArgsMobSessionBuilder) Build() (*ArgsMobSession, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	a := b.a
+	return &a, nil
+}
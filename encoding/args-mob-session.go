@@ -5,8 +5,12 @@
 
 package encoding
 
-import "encoding/binary"
-import "github.com/nextmn/rfc9433/encoding/errors"
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
 
 const (
 	// Field TEID
@@ -46,9 +50,12 @@ type ArgsMobSession struct {
 	r            uint8  // Reflective QoS Indication (1 bit)
 	u            uint8  // Unused and for future use (1 bit)
 	pduSessionID uint32 // Identifier of PDU Session. The GTP-U equivalent is TEID (32 bits)
+	gen          uint64 // generation, updated on modification (see touch)
 }
 
 // NewArgsMobSession creates an ArgsMobSession.
+// The QFI is not checked: bits above the 6 bits of the field are lost when marshaling
+// (see NewArgsMobSessionChecked).
 func NewArgsMobSession(qfi uint8, r bool, u bool, pduSessionID uint32) *ArgsMobSession {
 	var ruint uint8 = 0
 	if r {
@@ -66,11 +73,19 @@ func NewArgsMobSession(qfi uint8, r bool, u bool, pduSessionID uint32) *ArgsMobS
 	}
 }
 
+// NewArgsMobSessionChecked creates an ArgsMobSession, checking the QFI fits in 6 bits.
+func NewArgsMobSessionChecked(qfi QFI, r bool, u bool, pduSessionID uint32) (*ArgsMobSession, error) {
+	if err := qfi.Validate(); err != nil {
+		return nil, err
+	}
+	return NewArgsMobSession(uint8(qfi), r, u, pduSessionID), nil
+}
+
 // ParseArgsMobSession parses given byte sequence as an ArgsMobSession.
 func ParseArgsMobSession(b []byte) (*ArgsMobSession, error) {
 	a := &ArgsMobSession{}
 	if err := a.UnmarshalBinary(b); err != nil {
-		return nil, err
+		return nil, encodingError("ArgsMobSession", b, err)
 	}
 	return a, nil
 }
@@ -101,6 +116,45 @@ func (a *ArgsMobSession) PDUSessionID() uint32 {
 	return a.pduSessionID
 }
 
+// SetQFI sets the Qos Flow Identifier for this ArgsMobSession.
+func (a *ArgsMobSession) SetQFI(qfi uint8) error {
+	if err := QFI(qfi).Validate(); err != nil {
+		return err
+	}
+	a.qfi = qfi
+	a.touch()
+	return nil
+}
+
+// SetR sets the Reflective QoS Indication for this ArgsMobSession.
+func (a *ArgsMobSession) SetR(r bool) {
+	a.r = 0
+	if r {
+		a.r = 1
+	}
+	a.touch()
+}
+
+// SetU sets the U bit for this ArgsMobSession.
+func (a *ArgsMobSession) SetU(u bool) {
+	a.u = 0
+	if u {
+		a.u = 1
+	}
+	a.touch()
+}
+
+// SetPDUSessionID sets the PDU Session Identifier for this ArgsMobSession.
+func (a *ArgsMobSession) SetPDUSessionID(pduSessionID uint32) {
+	a.pduSessionID = pduSessionID
+	a.touch()
+}
+
+// Validate checks the QFI fits in 6 bits.
+func (a *ArgsMobSession) Validate() error {
+	return QFI(a.qfi).Validate()
+}
+
 // MarshalLen returns the serial length of ArgsMobSession.
 func (a *ArgsMobSession) MarshalLen() int {
 	return 5
@@ -118,7 +172,7 @@ func (a *ArgsMobSession) Marshal() ([]byte, error) {
 // MarshalTo puts the byte sequence in the byte array given as b.
 func (a *ArgsMobSession) MarshalTo(b []byte) error {
 	if len(b) < a.MarshalLen() {
-		return errors.ErrTooShortToMarshal
+		return encodingError("ArgsMobSession", nil, &errors.FieldError{Field: "args-mob-session", Need: uint(8 * a.MarshalLen()), Have: uint(8 * len(b)), Err: errors.ErrTooShortToMarshal})
 	}
 	b[qfiPosByte] |= (qfiMask & a.qfi) << qfiPosBit
 	b[rPosByte] |= (rMask & a.r) << rPosBit
@@ -127,14 +181,69 @@ func (a *ArgsMobSession) MarshalTo(b []byte) error {
 	return nil
 }
 
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (a *ArgsMobSession) MarshalBinary() ([]byte, error) {
+	return a.Marshal()
+}
+
+// AppendBinary appends the byte sequence generated from ArgsMobSession to b.
+func (a *ArgsMobSession) AppendBinary(b []byte) ([]byte, error) {
+	return appendMarshal(b, a.MarshalLen(), a.MarshalTo)
+}
+
 // UnmarshalBinary sets the values retrieved from byte sequence in an ArgsMobSession.
 func (a *ArgsMobSession) UnmarshalBinary(b []byte) error {
 	if len(b) < 5 {
-		return errors.ErrTooShortToParse
+		return &errors.FieldError{Field: "args-mob-session", Need: 8 * 5, Have: uint(8 * len(b)), Err: errors.ErrTooShortToParse}
 	}
 	a.qfi = qfiMask & (b[qfiPosByte] >> qfiPosBit)
 	a.r = rMask & (b[rPosByte] >> rPosBit)
 	a.u = uMask & (b[uPosByte] >> uPosBit)
 	a.pduSessionID = binary.BigEndian.Uint32(b[teidPosByte : teidPosByte+teidSizeByte])
+	a.touch()
+	return nil
+}
+
+// argsMobSessionTextKeys are the keys of the textual form of ArgsMobSession.
+var argsMobSessionTextKeys = []string{"qfi", "r", "u"}
+
+// MarshalText implements encoding.TextMarshaler (e.g. "teid=0x1 qfi=5 r=0 u=0").
+func (a *ArgsMobSession) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("teid=0x%x qfi=%d r=%d u=%d", a.pduSessionID, a.qfi, a.r, a.u)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Only teid is required.
+func (a *ArgsMobSession) UnmarshalText(text []byte) error {
+	fields, err := textFields(text, []string{"teid"}, argsMobSessionTextKeys)
+	if err != nil {
+		return err
+	}
+	return a.setTextFields(fields)
+}
+
+// setTextFields sets ArgsMobSession from the fields of a textual form.
+func (a *ArgsMobSession) setTextFields(fields map[string]string) error {
+	teid, err := textUint(fields, "teid", teidSizeBit)
+	if err != nil {
+		return err
+	}
+	qfi, err := textUint(fields, "qfi", qfiSizeBit)
+	if err != nil {
+		return err
+	}
+	r, err := textUint(fields, "r", rSizeBit)
+	if err != nil {
+		return err
+	}
+	u, err := textUint(fields, "u", uSizeBit)
+	if err != nil {
+		return err
+	}
+	a.set(&ArgsMobSession{
+		qfi:          uint8(qfi),
+		r:            uint8(r),
+		u:            uint8(u),
+		pduSessionID: uint32(teid),
+	})
 	return nil
 }
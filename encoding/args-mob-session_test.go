@@ -0,0 +1,66 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+func ExampleArgsMobSessionBuilder() {
+	a, err := NewArgsMobSessionBuilder().QFI(5).R(true).PDUSessionID(1).Build()
+	if err != nil {
+		return
+	}
+	a.Marshal()
+}
+
+func TestArgsMobSessionSetters(t *testing.T) {
+	a := NewArgsMobSession(1, true, true, 1)
+	if err := a.SetQFI(63); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.SetQFI(64); !errors.Is(err, errors.ErrOutOfRange) {
+		t.Errorf("QFI out of range should be rejected: %v", err)
+	}
+	a.SetR(false)
+	a.SetU(false)
+	a.SetPDUSessionID(0xdeadbeef)
+	if a.QFI() != 63 || a.R() || a.U() || a.PDUSessionID() != 0xdeadbeef {
+		t.Errorf("Unexpected ArgsMobSession: %d %t %t %x", a.QFI(), a.R(), a.U(), a.PDUSessionID())
+	}
+	b, err := a.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(b, []byte{0xfc, 0xde, 0xad, 0xbe, 0xef}); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestArgsMobSessionBuilder(t *testing.T) {
+	a, err := NewArgsMobSessionBuilder().QFI(5).R(true).U(false).PDUSessionID(1).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.QFI() != 5 || !a.R() || a.U() || a.PDUSessionID() != 1 {
+		t.Errorf("Unexpected ArgsMobSession: %d %t %t %x", a.QFI(), a.R(), a.U(), a.PDUSessionID())
+	}
+	if _, err := NewArgsMobSessionBuilder().QFI(64).PDUSessionID(1).Build(); !errors.Is(err, errors.ErrOutOfRange) {
+		t.Errorf("QFI out of range should be rejected: %v", err)
+	}
+}
+
+func TestArgsMobSessionValidate(t *testing.T) {
+	if err := NewArgsMobSession(63, true, true, 1).Validate(); err != nil {
+		t.Error(err)
+	}
+	if err := NewArgsMobSession(64, false, false, 1).Validate(); !errors.Is(err, errors.ErrOutOfRange) {
+		t.Errorf("QFI out of range should be rejected: %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"net/netip"
+	"testing"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = &ArgsMobSession{}
+	_ encoding.BinaryUnmarshaler = &ArgsMobSession{}
+	_ encoding.BinaryMarshaler   = &MGTP4IPv6Src{}
+	_ encoding.BinaryUnmarshaler = &MGTP4IPv6Src{}
+	_ encoding.BinaryMarshaler   = &MGTP4IPv6Dst{}
+	_ encoding.BinaryUnmarshaler = &MGTP4IPv6Dst{}
+)
+
+func TestBinaryGob(t *testing.T) {
+	dst := NewMGTP4IPv6Dst(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{10, 0, 4, 1}, NewArgsMobSession(5, true, false, 0x1234))
+	src := NewMGTP4IPv6Src(netip.MustParsePrefix("fd00:1:2::/47"), [4]byte{10, 0, 4, 2}, 0x5678)
+
+	var buf bytes.Buffer
+	enc := gob.NewEncoder(&buf)
+	if err := enc.Encode(dst); err != nil {
+		t.Fatal(err)
+	}
+	if err := enc.Encode(src); err != nil {
+		t.Fatal(err)
+	}
+
+	dec := gob.NewDecoder(&buf)
+	dst2 := &MGTP4IPv6Dst{}
+	if err := dec.Decode(dst2); err != nil {
+		t.Fatal(err)
+	}
+	if dst2.Prefix() != dst.Prefix() || dst2.IPv4() != dst.IPv4() || dst2.QFI() != 5 || !dst2.R() || dst2.PDUSessionID() != 0x1234 {
+		t.Errorf("Unexpected MGTP4IPv6Dst: %s %s %d %x", dst2.Prefix(), dst2.IPv4(), dst2.QFI(), dst2.PDUSessionID())
+	}
+	src2 := &MGTP4IPv6Src{}
+	if err := dec.Decode(src2); err != nil {
+		t.Fatal(err)
+	}
+	if src2.prefix != src.prefix || src2.IPv4() != src.IPv4() || src2.UDPPortNumber() != 0x5678 {
+		t.Errorf("Unexpected MGTP4IPv6Src: %s %s %x", src2.prefix, src2.IPv4(), src2.UDPPortNumber())
+	}
+
+	if err := (&MGTP4IPv6Dst{}).UnmarshalBinary(make([]byte, 16)); err == nil {
+		t.Error("Missing prefix length should be rejected")
+	}
+}
+
+func TestAppendBinary(t *testing.T) {
+	a := NewArgsMobSession(5, true, false, 0x1234)
+	dst := NewMGTP4IPv6Dst(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{10, 0, 4, 1}, a)
+	src := NewMGTP4IPv6Src(netip.MustParsePrefix("fd00:1:2::/47"), [4]byte{10, 0, 4, 2}, 0x5678)
+
+	b := []byte{0xff}
+	var err error
+	for _, m := range []interface {
+		AppendBinary([]byte) ([]byte, error)
+	}{a, src, dst} {
+		if b, err = m.AppendBinary(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	exp := []byte{0xff}
+	for _, m := range []encoding.BinaryMarshaler{a, src, dst} {
+		r, err := m.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		exp = append(exp, r...)
+	}
+	if !bytes.Equal(b, exp) {
+		t.Errorf("Unexpected result: %x, expected %x", b, exp)
+	}
+}
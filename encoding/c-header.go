@@ -0,0 +1,124 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+//go:generate go run ../cmd/rfc9433-cheader -o ../include/rfc9433/encoding.h -dst 32,40,48,56 -src 32,40,48,56,64
+
+const (
+	ipv4SizeBit    = 8 * 4  // size of the IPv4 field of MGTP4IPv6Dst and MGTP4IPv6Src in bits
+	udpPortSizeBit = 8 * 2  // size of the UDP Source Port field of MGTP4IPv6Src in bits
+	ipv6SizeBit    = 8 * 16 // size of an IPv6 address in bits
+)
+
+// CHeaderOptions selects the layouts whose field positions are generated by WriteCHeader,
+// in addition to the macros valid for any prefix length.
+type CHeaderOptions struct {
+	DstPrefixLens []uint // prefix lengths of End.M.GTP4.E SIDs (MGTP4IPv6Dst)
+	SrcPrefixLens []uint // prefix lengths of IPv6 SAs of End.M.GTP4.E, NextMN scheme (MGTP4IPv6Src)
+}
+
+// WriteCHeader writes a C header describing the layouts of MGTP4IPv6Dst, MGTP4IPv6Src (NextMN scheme),
+// and ArgsMobSession, so data planes written in C (e.g. eBPF or DPDK) stay consistent with this package.
+func WriteCHeader(w io.Writer, opts CHeaderOptions) error {
+	srcPrefixLenOffset, _ := SrcSchemeNextMN{}.prefixLenField()
+	dstMax := uint(ipv6SizeBit - ipv4SizeBit - 8*(&ArgsMobSession{}).MarshalLen())
+	srcMax := srcPrefixLenOffset - ipv4SizeBit - udpPortSizeBit
+	for _, l := range opts.DstPrefixLens {
+		if l > dstMax {
+			return errors.ErrPrefixLength
+		}
+	}
+	for _, l := range opts.SrcPrefixLens {
+		if l > srcMax {
+			return errors.ErrPrefixLength
+		}
+	}
+	b := bufio.NewWriter(w)
+	fmt.Fprint(b, `/* Code generated by rfc9433-cheader. DO NOT EDIT. */
+/* SPDX-License-Identifier: MIT */
+
+/*
+ * Layouts of the IPv6 addresses of RFC 9433, as encoded by github.com/nextmn/rfc9433/encoding.
+ * Offsets are in bits from the most significant bit of the IPv6 address: a field at offset o
+ * starts in byte RFC9433_BYTE(o), after the RFC9433_BIT(o) most significant bits of this byte.
+ * Multi-byte fields are in network byte order.
+ */
+#ifndef RFC9433_ENCODING_H
+#define RFC9433_ENCODING_H
+
+#define RFC9433_BYTE(offset) ((offset) / 8)
+#define RFC9433_BIT(offset) ((offset) % 8)
+
+`)
+	fmt.Fprint(b, "/* Args.Mob.Session (RFC 9433, section 6.1): (byte >> SHIFT) & MASK */\n")
+	define(b, "RFC9433_ARGS_MOB_SESSION_LEN", (&ArgsMobSession{}).MarshalLen())
+	define(b, "RFC9433_ARGS_MOB_SESSION_QFI_BYTE", qfiPosByte)
+	define(b, "RFC9433_ARGS_MOB_SESSION_QFI_SHIFT", qfiPosBit)
+	defineHex(b, "RFC9433_ARGS_MOB_SESSION_QFI_MASK", qfiMask)
+	define(b, "RFC9433_ARGS_MOB_SESSION_R_BYTE", rPosByte)
+	define(b, "RFC9433_ARGS_MOB_SESSION_R_SHIFT", rPosBit)
+	defineHex(b, "RFC9433_ARGS_MOB_SESSION_R_MASK", rMask)
+	define(b, "RFC9433_ARGS_MOB_SESSION_U_BYTE", uPosByte)
+	define(b, "RFC9433_ARGS_MOB_SESSION_U_SHIFT", uPosBit)
+	defineHex(b, "RFC9433_ARGS_MOB_SESSION_U_MASK", uMask)
+	define(b, "RFC9433_ARGS_MOB_SESSION_TEID_BYTE", teidPosByte)
+	define(b, "RFC9433_ARGS_MOB_SESSION_TEID_LEN", teidSizeByte)
+
+	fmt.Fprint(b, "\n/* End.M.GTP4.E SID (RFC 9433, section 6.6): prefix, IPv4 DA, Args.Mob.Session, zero padding */\n")
+	define(b, "RFC9433_MGTP4_DST_IPV4_LEN", ipv4SizeBit/8)
+	fmt.Fprint(b, "#define RFC9433_MGTP4_DST_IPV4_OFFSET(prefix_len) (prefix_len)\n")
+	fmt.Fprintf(b, "#define RFC9433_MGTP4_DST_ARGS_OFFSET(prefix_len) ((prefix_len) + %d)\n", ipv4SizeBit)
+	define(b, "RFC9433_MGTP4_DST_MAX_PREFIX_LEN", dstMax)
+
+	fmt.Fprint(b, "\n/* IPv6 SA of End.M.GTP4.E, NextMN scheme: prefix, IPv4 SA, UDP Source Port, ignored bits, prefix length */\n")
+	define(b, "RFC9433_MGTP4_SRC_IPV4_LEN", ipv4SizeBit/8)
+	define(b, "RFC9433_MGTP4_SRC_UDP_PORT_LEN", udpPortSizeBit/8)
+	fmt.Fprint(b, "#define RFC9433_MGTP4_SRC_IPV4_OFFSET(prefix_len) (prefix_len)\n")
+	fmt.Fprintf(b, "#define RFC9433_MGTP4_SRC_UDP_PORT_OFFSET(prefix_len) ((prefix_len) + %d)\n", ipv4SizeBit)
+	define(b, "RFC9433_MGTP4_SRC_PREFIX_LEN_BYTE", ipv6LenEncodingPosByte)
+	define(b, "RFC9433_MGTP4_SRC_PREFIX_LEN_SHIFT", ipv6LenEncodingPosBit)
+	defineHex(b, "RFC9433_MGTP4_SRC_PREFIX_LEN_MASK", ipv6LenEncodingMask)
+	define(b, "RFC9433_MGTP4_SRC_MAX_PREFIX_LEN", srcMax)
+
+	for _, l := range opts.DstPrefixLens {
+		fmt.Fprintf(b, "\n/* End.M.GTP4.E SID with a /%d prefix */\n", l)
+		name := fmt.Sprintf("RFC9433_MGTP4_DST_%d", l)
+		defineField(b, name+"_IPV4", l)
+		defineField(b, name+"_ARGS", l+ipv4SizeBit)
+	}
+	for _, l := range opts.SrcPrefixLens {
+		fmt.Fprintf(b, "\n/* IPv6 SA of End.M.GTP4.E with a /%d prefix, NextMN scheme */\n", l)
+		name := fmt.Sprintf("RFC9433_MGTP4_SRC_%d", l)
+		defineField(b, name+"_IPV4", l)
+		defineField(b, name+"_UDP_PORT", l+ipv4SizeBit)
+	}
+	fmt.Fprint(b, "\n#endif /* RFC9433_ENCODING_H */\n")
+	return b.Flush()
+}
+
+// define writes a C macro with a decimal value.
+func define[T ~int | ~uint](w io.Writer, name string, value T) {
+	fmt.Fprintf(w, "#define %s %d\n", name, value)
+}
+
+// defineHex writes a C macro with an hexadecimal value.
+func defineHex[T ~int | ~uint](w io.Writer, name string, value T) {
+	fmt.Fprintf(w, "#define %s 0x%02x\n", name, value)
+}
+
+// defineField writes the C macros locating a field at offset bits.
+func defineField(w io.Writer, name string, offset uint) {
+	define(w, name+"_BYTE", offset/8)
+	define(w, name+"_BIT", offset%8)
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"bytes"
+	"fmt"
+	"net/netip"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+// cHeaderProgram prints the fields of a SID and of an IPv6 SA given as 32 hexadecimal digits,
+// using the macros of the generated header only.
+const cHeaderProgram = `#include <stdio.h>
+#include <stdint.h>
+#include "encoding.h"
+
+static uint64_t bits(const uint8_t *a, unsigned offset, unsigned n)
+{
+	uint64_t v = 0;
+	for (unsigned i = 0; i < n; i++) {
+		unsigned o = offset + i;
+		v = (v << 1) | ((a[RFC9433_BYTE(o)] >> (7 - RFC9433_BIT(o))) & 1);
+	}
+	return v;
+}
+
+static void read_addr(const char *s, uint8_t *a)
+{
+	for (int i = 0; i < 16; i++)
+		sscanf(s + 2 * i, "%2hhx", &a[i]);
+}
+
+int main(int argc, char **argv)
+{
+	uint8_t dst[16], src[16], args[RFC9433_ARGS_MOB_SESSION_LEN];
+	if (argc != 3)
+		return 1;
+	unsigned dst_len = DST_PREFIX_LEN;
+	read_addr(argv[1], dst);
+	read_addr(argv[2], src);
+	for (int i = 0; i < RFC9433_ARGS_MOB_SESSION_LEN; i++)
+		args[i] = bits(dst, RFC9433_MGTP4_DST_ARGS_OFFSET(dst_len) + 8 * i, 8);
+	printf("%08llx %u %u %u %08llx\n",
+		(unsigned long long)bits(dst, RFC9433_MGTP4_DST_IPV4_OFFSET(dst_len), 8 * RFC9433_MGTP4_DST_IPV4_LEN),
+		(args[RFC9433_ARGS_MOB_SESSION_QFI_BYTE] >> RFC9433_ARGS_MOB_SESSION_QFI_SHIFT) & RFC9433_ARGS_MOB_SESSION_QFI_MASK,
+		(args[RFC9433_ARGS_MOB_SESSION_R_BYTE] >> RFC9433_ARGS_MOB_SESSION_R_SHIFT) & RFC9433_ARGS_MOB_SESSION_R_MASK,
+		(args[RFC9433_ARGS_MOB_SESSION_U_BYTE] >> RFC9433_ARGS_MOB_SESSION_U_SHIFT) & RFC9433_ARGS_MOB_SESSION_U_MASK,
+		(unsigned long long)bits(args, 8 * RFC9433_ARGS_MOB_SESSION_TEID_BYTE, 8 * RFC9433_ARGS_MOB_SESSION_TEID_LEN));
+	unsigned src_len = (src[RFC9433_MGTP4_SRC_PREFIX_LEN_BYTE] >> RFC9433_MGTP4_SRC_PREFIX_LEN_SHIFT) & RFC9433_MGTP4_SRC_PREFIX_LEN_MASK;
+	printf("%u %08llx %u\n", src_len,
+		(unsigned long long)bits(src, RFC9433_MGTP4_SRC_IPV4_OFFSET(src_len), 8 * RFC9433_MGTP4_SRC_IPV4_LEN),
+		(unsigned)bits(src, RFC9433_MGTP4_SRC_UDP_PORT_OFFSET(src_len), 8 * RFC9433_MGTP4_SRC_UDP_PORT_LEN));
+	printf("%d %d %d %d\n", RFC9433_MGTP4_DST_43_IPV4_BYTE, RFC9433_MGTP4_DST_43_IPV4_BIT,
+		RFC9433_MGTP4_SRC_37_UDP_PORT_BYTE, RFC9433_MGTP4_SRC_37_UDP_PORT_BIT);
+	return 0;
+}
+`
+
+func TestCHeaderUpToDate(t *testing.T) {
+	// same options as the go:generate directive
+	var b bytes.Buffer
+	if err := WriteCHeader(&b, CHeaderOptions{
+		DstPrefixLens: []uint{32, 40, 48, 56},
+		SrcPrefixLens: []uint{32, 40, 48, 56, 64},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	h, err := os.ReadFile(filepath.Join("..", "include", "rfc9433", "encoding.h"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(string(h), b.String()); diff != "" {
+		t.Errorf("include/rfc9433/encoding.h is outdated, run go generate (-want +got):\n%s", diff)
+	}
+}
+
+func TestCHeaderPrefixLength(t *testing.T) {
+	var b bytes.Buffer
+	if err := WriteCHeader(&b, CHeaderOptions{DstPrefixLens: []uint{57}}); !errors.Is(err, errors.ErrPrefixLength) {
+		t.Errorf("Prefix length too long for Dst should be rejected: %v", err)
+	}
+	if err := WriteCHeader(&b, CHeaderOptions{SrcPrefixLens: []uint{74}}); !errors.Is(err, errors.ErrPrefixLength) {
+		t.Errorf("Prefix length too long for Src should be rejected: %v", err)
+	}
+	if err := WriteCHeader(&b, CHeaderOptions{DstPrefixLens: []uint{56}, SrcPrefixLens: []uint{73}}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCHeaderCompile(t *testing.T) {
+	cc, err := exec.LookPath("cc")
+	if err != nil {
+		t.Skip("cc not found")
+	}
+	dir := t.TempDir()
+	var h bytes.Buffer
+	if err := WriteCHeader(&h, CHeaderOptions{DstPrefixLens: []uint{43}, SrcPrefixLens: []uint{37}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "encoding.h"), h.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "main.c"), []byte(cHeaderProgram), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	bin := filepath.Join(dir, "main")
+	if out, err := exec.Command(cc, "-Wall", "-Werror", "-DDST_PREFIX_LEN=43", "-o", bin, filepath.Join(dir, "main.c")).CombinedOutput(); err != nil {
+		t.Fatalf("%v: %s", err, out)
+	}
+
+	dst, err := NewMGTP4IPv6Dst(netip.MustParsePrefix("2001:db8:ff00::/43"), [4]byte{10, 0, 200, 1}, NewArgsMobSession(42, true, false, 0xdeadbeef)).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, err := NewMGTP4IPv6Src(netip.MustParsePrefix("2001:db8:f000::/37"), [4]byte{192, 168, 1, 254}, 0xabcd).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := exec.Command(bin, fmt.Sprintf("%x", dst), fmt.Sprintf("%x", src)).Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"0a00c801 42 1 0 deadbeef",
+		fmt.Sprintf("37 c0a801fe %d", 0xabcd),
+		"5 3 8 5",
+	}
+	if diff := cmp.Diff(want, strings.Split(strings.TrimSpace(string(out)), "\n")); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import "sync/atomic"
+
+// argsMobSessionGen is the last generation of ArgsMobSession.
+// Generations are unique, so a modified ArgsMobSession never gets back a previous generation.
+var argsMobSessionGen atomic.Uint64
+
+// dstCache is the marshaled form of a SID, computed for a generation of its ArgsMobSession.
+type dstCache struct {
+	b   [16]byte
+	gen uint64
+}
+
+// touch marks the ArgsMobSession as modified, invalidating the marshaled forms of SIDs using it.
+func (a *ArgsMobSession) touch() {
+	a.gen = argsMobSessionGen.Add(1)
+}
+
+// generation returns the generation of the ArgsMobSession (0 if it has never been modified).
+func (a *ArgsMobSession) generation() uint64 {
+	if a == nil {
+		return 0
+	}
+	return a.gen
+}
+
+// set sets the fields of a to the fields of o.
+func (a *ArgsMobSession) set(o *ArgsMobSession) {
+	a.qfi = o.qfi
+	a.r = o.r
+	a.u = o.u
+	a.pduSessionID = o.pduSessionID
+	a.touch()
+}
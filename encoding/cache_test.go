@@ -0,0 +1,67 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"net/netip"
+	"sync"
+	"testing"
+)
+
+func TestCacheInvalidation(t *testing.T) {
+	a := NewArgsMobSession(1, false, false, 1)
+	dst := NewMGTP4IPv6Dst(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{10, 0, 4, 1}, a)
+	if addr, err := dst.Addr(); err != nil || addr != netip.MustParseAddr("fd00:1:1:a00:401:400:0:100") {
+		t.Fatalf("Unexpected address: %s %v", addr, err)
+	}
+	a.SetPDUSessionID(2)
+	if addr, err := dst.Addr(); err != nil || addr != netip.MustParseAddr("fd00:1:1:a00:401:400:0:200") {
+		t.Errorf("Cache not invalidated by ArgsMobSession setter: %s %v", addr, err)
+	}
+	if err := a.UnmarshalBinary([]byte{0, 0, 0, 0, 3}); err != nil {
+		t.Fatal(err)
+	}
+	if addr, err := dst.Addr(); err != nil || addr != netip.MustParseAddr("fd00:1:1:a00:401::300") {
+		t.Errorf("Cache not invalidated by ArgsMobSession.UnmarshalBinary: %s %v", addr, err)
+	}
+	if err := dst.UnmarshalText([]byte("prefix=fd00:2:2::/48 ipv4=10.0.4.1 teid=1")); err != nil {
+		t.Fatal(err)
+	}
+	if addr, err := dst.Addr(); err != nil || addr != netip.MustParseAddr("fd00:2:2:a00:401::100") {
+		t.Errorf("Cache not invalidated by UnmarshalText: %s %v", addr, err)
+	}
+
+	src, err := ParseMGTP4IPv6SrcNextMN(netip.MustParseAddr("fd00:1:1:a00:401:1234:ff:30").As16())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr, err := src.Addr(); err != nil || addr != netip.MustParseAddr("fd00:1:1:a00:401:1234:ff:30") {
+		t.Fatalf("Unexpected address: %s %v", addr, err)
+	}
+	src.ClearIgnoredBits()
+	if addr, err := src.Addr(); err != nil || addr != netip.MustParseAddr("fd00:1:1:a00:401:1234:0:30") {
+		t.Errorf("Cache not invalidated by ClearIgnoredBits: %s %v", addr, err)
+	}
+}
+
+func TestCacheConcurrent(t *testing.T) {
+	dst := NewMGTP4IPv6Dst(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{10, 0, 4, 1}, NewArgsMobSession(1, false, false, 1))
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var b [16]byte
+			for j := 0; j < 100; j++ {
+				if err := dst.MarshalTo(b[:]); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
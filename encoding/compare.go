@@ -0,0 +1,86 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"bytes"
+	"cmp"
+	"net/netip"
+)
+
+// comparePrefix compares two prefixes by address, then by length.
+func comparePrefix(a netip.Prefix, b netip.Prefix) int {
+	if c := a.Addr().Compare(b.Addr()); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.Bits(), b.Bits())
+}
+
+// Compare returns an integer comparing two ArgsMobSession (by PDU Session ID, QFI, R, then U).
+// The result is 0 if a == b, -1 if a < b, and +1 if a > b. A nil ArgsMobSession is smaller than any other.
+func (a *ArgsMobSession) Compare(b *ArgsMobSession) int {
+	switch {
+	case a == b:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	if c := cmp.Compare(a.pduSessionID, b.pduSessionID); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.qfi, b.qfi); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.r, b.r); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.u, b.u)
+}
+
+// Equal returns true if a and b are equal.
+func (a *ArgsMobSession) Equal(b *ArgsMobSession) bool {
+	return a.Compare(b) == 0
+}
+
+// Compare returns an integer comparing two MGTP4IPv6Dst (by prefix, IPv4, then ArgsMobSession).
+// The result is 0 if m == o, -1 if m < o, and +1 if m > o.
+func (m *MGTP4IPv6Dst) Compare(o *MGTP4IPv6Dst) int {
+	if c := comparePrefix(m.prefix, o.prefix); c != 0 {
+		return c
+	}
+	if c := bytes.Compare(m.ipv4[:], o.ipv4[:]); c != 0 {
+		return c
+	}
+	return m.argsMobSession.Compare(o.argsMobSession)
+}
+
+// Equal returns true if m and o are equal.
+func (m *MGTP4IPv6Dst) Equal(o *MGTP4IPv6Dst) bool {
+	return m.Compare(o) == 0
+}
+
+// Compare returns an integer comparing two MGTP4IPv6Src (by prefix, IPv4, UDP Port Number, then ignored bits).
+// The result is 0 if m == o, -1 if m < o, and +1 if m > o.
+// Encoding schemes are not compared.
+func (m *MGTP4IPv6Src) Compare(o *MGTP4IPv6Src) int {
+	if c := comparePrefix(m.prefix, o.prefix); c != 0 {
+		return c
+	}
+	if c := bytes.Compare(m.ipv4[:], o.ipv4[:]); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.udp, o.udp); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.ignored[:], o.ignored[:])
+}
+
+// Equal returns true if m and o are equal.
+func (m *MGTP4IPv6Src) Equal(o *MGTP4IPv6Src) bool {
+	return m.Compare(o) == 0
+}
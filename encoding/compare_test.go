@@ -0,0 +1,50 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"net/netip"
+	"slices"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	p48 := netip.MustParsePrefix("fd00:1:1::/48")
+	p47 := netip.MustParsePrefix("fd00:1:0::/47")
+	dsts := []*MGTP4IPv6Dst{
+		NewMGTP4IPv6Dst(p48, [4]byte{10, 0, 4, 2}, NewArgsMobSession(1, false, false, 1)),
+		NewMGTP4IPv6Dst(p48, [4]byte{10, 0, 4, 1}, NewArgsMobSession(1, false, false, 2)),
+		NewMGTP4IPv6Dst(p47, [4]byte{10, 0, 4, 1}, NewArgsMobSession(1, false, false, 1)),
+		NewMGTP4IPv6Dst(p48, [4]byte{10, 0, 4, 1}, NewArgsMobSession(1, true, false, 1)),
+		NewMGTP4IPv6Dst(p48, [4]byte{10, 0, 4, 1}, NewArgsMobSession(1, false, false, 1)),
+	}
+	slices.SortFunc(dsts, (*MGTP4IPv6Dst).Compare)
+	for i, exp := range []string{
+		"End.M.GTP4.E{prefix: fd00:1::/47, ipv4: 10.0.4.1, teid: 0x00000001, qfi: 1, r: 0, u: 0}",
+		"End.M.GTP4.E{prefix: fd00:1:1::/48, ipv4: 10.0.4.1, teid: 0x00000001, qfi: 1, r: 0, u: 0}",
+		"End.M.GTP4.E{prefix: fd00:1:1::/48, ipv4: 10.0.4.1, teid: 0x00000001, qfi: 1, r: 1, u: 0}",
+		"End.M.GTP4.E{prefix: fd00:1:1::/48, ipv4: 10.0.4.1, teid: 0x00000002, qfi: 1, r: 0, u: 0}",
+		"End.M.GTP4.E{prefix: fd00:1:1::/48, ipv4: 10.0.4.2, teid: 0x00000001, qfi: 1, r: 0, u: 0}",
+	} {
+		if dsts[i].String() != exp {
+			t.Errorf("Unexpected order at %d: %s", i, dsts[i])
+		}
+	}
+	if !dsts[0].Equal(NewMGTP4IPv6Dst(p47, [4]byte{10, 0, 4, 1}, NewArgsMobSession(1, false, false, 1))) {
+		t.Error("Equal values should be equal")
+	}
+
+	src := NewMGTP4IPv6Src(p48, [4]byte{10, 0, 4, 1}, 1)
+	if !src.Equal(NewMGTP4IPv6SrcWithScheme(p48, [4]byte{10, 0, 4, 1}, 1, SrcSchemeNextMN{})) {
+		t.Error("Equal values should be equal")
+	}
+	if src.Compare(NewMGTP4IPv6Src(p48, [4]byte{10, 0, 4, 1}, 2)) != -1 {
+		t.Error("Unexpected order of UDP Port Numbers")
+	}
+	if (*ArgsMobSession)(nil).Compare(NewArgsMobSession(0, false, false, 0)) != -1 {
+		t.Error("nil ArgsMobSession should be smaller than any other")
+	}
+}
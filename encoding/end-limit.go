@@ -0,0 +1,130 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	mbits "math/bits"
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/bitfield"
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+// RFC 9433, section 6.8 (End.Limit):
+// The End.Limit SID has the following format:
+//
+//	0                                                         127
+//	+------------------------+-------------+------------------+
+//	|  LOC+FUNC rate-limit   |  group-id   |    limit-rate    |
+//	+------------------------+-------------+------------------+
+//	         128-i-j                i                j
+//
+// A limit rate of 0 means no rate limit.
+type EndLimit struct {
+	prefix        netip.Prefix // prefix in canonical form
+	groupID       uint64
+	groupIDBits   uint
+	limitRate     uint64
+	limitRateBits uint
+}
+
+// NewEndLimit creates a new EndLimit.
+// groupIDBits and limitRateBits are the sizes of the group-id and limit-rate fields (i and j).
+func NewEndLimit(prefix netip.Prefix, groupID uint64, groupIDBits uint, limitRate uint64, limitRateBits uint) *EndLimit {
+	return &EndLimit{
+		prefix:        prefix.Masked(),
+		groupID:       groupID,
+		groupIDBits:   groupIDBits,
+		limitRate:     limitRate,
+		limitRateBits: limitRateBits,
+	}
+}
+
+// ParseEndLimit parses a given IPv6 address into an EndLimit according to the given prefixLength and field sizes.
+func ParseEndLimit(ipv6Addr [16]byte, prefixLength uint, groupIDBits uint, limitRateBits uint) (*EndLimit, error) {
+	if groupIDBits > 64 || limitRateBits > 64 || prefixLength+groupIDBits+limitRateBits > 8*16 {
+		return nil, encodingError("EndLimit", ipv6Addr[:], errors.ErrOutOfRange)
+	}
+	prefix := netip.PrefixFrom(netip.AddrFrom16(ipv6Addr), int(prefixLength)).Masked()
+	if !prefix.IsValid() {
+		return nil, encodingError("EndLimit", ipv6Addr[:], errors.ErrPrefixLength)
+	}
+	groupID, err := bitfield.ExtractBits(ipv6Addr, prefixLength, groupIDBits)
+	if err != nil {
+		return nil, encodingError("EndLimit", ipv6Addr[:], err)
+	}
+	limitRate, err := bitfield.ExtractBits(ipv6Addr, prefixLength+groupIDBits, limitRateBits)
+	if err != nil {
+		return nil, encodingError("EndLimit", ipv6Addr[:], err)
+	}
+	return &EndLimit{
+		prefix:        prefix,
+		groupID:       groupID,
+		groupIDBits:   groupIDBits,
+		limitRate:     limitRate,
+		limitRateBits: limitRateBits,
+	}, nil
+}
+
+// Prefix returns the LOC+FUNC of the End.Limit SID.
+func (e *EndLimit) Prefix() netip.Prefix {
+	return e.prefix
+}
+
+// GroupID returns the rate-limit group-id.
+func (e *EndLimit) GroupID() uint64 {
+	return e.groupID
+}
+
+// LimitRate returns the limit rate (0 means no limit).
+func (e *EndLimit) LimitRate() uint64 {
+	return e.limitRate
+}
+
+// MarshalLen returns the serial length of EndLimit.
+func (e *EndLimit) MarshalLen() int {
+	return 16
+}
+
+// Marshal returns the byte sequence generated from EndLimit.
+func (e *EndLimit) Marshal() ([]byte, error) {
+	b := make([]byte, e.MarshalLen())
+	if err := e.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (e *EndLimit) MarshalTo(b []byte) error {
+	if len(b) < e.MarshalLen() {
+		return encodingError("EndLimit", nil, &errors.FieldError{Field: "sid", Need: uint(8 * e.MarshalLen()), Have: uint(8 * len(b)), Err: errors.ErrTooShortToMarshal})
+	}
+	bits := e.prefix.Bits()
+	if bits == -1 {
+		return encodingError("EndLimit", nil, errors.ErrPrefixLength)
+	}
+	if e.groupIDBits > 64 || e.limitRateBits > 64 || uint(bits)+e.groupIDBits+e.limitRateBits > 8*16 {
+		return encodingError("EndLimit", nil, errors.ErrOutOfRange)
+	}
+	if e.groupIDBits < 64 && e.groupID>>e.groupIDBits != 0 {
+		return encodingError("EndLimit", nil, &errors.FieldError{Field: "group-id", Offset: uint(bits), Need: uint(mbits.Len64(e.groupID)), Have: e.groupIDBits, Err: errors.ErrOutOfRange})
+	}
+	if e.limitRateBits < 64 && e.limitRate>>e.limitRateBits != 0 {
+		return encodingError("EndLimit", nil, &errors.FieldError{Field: "limit-rate", Offset: uint(bits) + e.groupIDBits, Need: uint(mbits.Len64(e.limitRate)), Have: e.limitRateBits, Err: errors.ErrOutOfRange})
+	}
+	addr := e.prefix.Addr().As16()
+
+	// add group-id and limit-rate
+	if err := bitfield.InsertBits(&addr, uint(bits), e.groupIDBits, e.groupID); err != nil {
+		return encodingError("EndLimit", nil, err)
+	}
+	if err := bitfield.InsertBits(&addr, uint(bits)+e.groupIDBits, e.limitRateBits, e.limitRate); err != nil {
+		return encodingError("EndLimit", nil, err)
+	}
+	copy(b, addr[:])
+	return nil
+}
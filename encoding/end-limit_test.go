@@ -0,0 +1,46 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+func TestEndLimit(t *testing.T) {
+	b, err := NewEndLimit(netip.MustParsePrefix("fd00:1:1::/48"), 0xab, 12, 0x12345, 20).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := netip.AddrFrom16([16]byte(b)); a != netip.MustParseAddr("fd00:1:1:ab1:2345::") {
+		t.Errorf("Unexpected End.Limit SID: %s", a)
+	}
+	e, err := ParseEndLimit([16]byte(b), 48, 12, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.GroupID() != 0xab || e.LimitRate() != 0x12345 || e.Prefix() != netip.MustParsePrefix("fd00:1:1::/48") {
+		t.Errorf("Cannot extract End.Limit correctly: %s %x %x", e.Prefix(), e.GroupID(), e.LimitRate())
+	}
+
+	// unaligned fields, 64 bits limit rate
+	e, err = ParseEndLimit(netip.MustParseAddr("fd00:1:1:ffff:ffff:ffff:ffff:ffff").As16(), 53, 11, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.GroupID() != 0x7ff || e.LimitRate() != 0xffffffffffffffff {
+		t.Errorf("Cannot extract End.Limit correctly: %x %x", e.GroupID(), e.LimitRate())
+	}
+
+	if _, err := NewEndLimit(netip.MustParsePrefix("fd00:1:1::/48"), 0x1000, 12, 0, 20).Marshal(); !errors.Is(err, errors.ErrOutOfRange) {
+		t.Errorf("Group-id too large should be rejected: %v", err)
+	}
+	if _, err := ParseEndLimit([16]byte(b), 64, 32, 64); !errors.Is(err, errors.ErrOutOfRange) {
+		t.Errorf("Fields too large should be rejected: %v", err)
+	}
+}
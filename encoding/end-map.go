@@ -0,0 +1,92 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"encoding/binary"
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/bitfield"
+	"github.com/nextmn/rfc9433/encoding/errors"
+	"github.com/nextmn/rfc9433/lpm"
+)
+
+// RFC 9433, section 6.2 (End.MAP):
+// When a packet arrives with an End.MAP SID as IPv6 DA,
+// the IPv6 DA is replaced by the mapped SID found in the mapping table.
+//
+// In this implementation, End.MAP SIDs (LOC+FUNC) are mapped to other LOC+FUNC,
+// and the arguments of the IPv6 DA (bits following the End.MAP SID) are kept
+// right after the mapped LOC+FUNC.
+//
+//	0                                                         127
+//	+-----------------------+---------------------------------+
+//	|  End.MAP LOC+FUNC     |            Arguments            |
+//	+-----------------------+---------------------------------+
+//	                        |
+//	                        v
+//	+--------------------+------------------------------------+
+//	| Mapped LOC+FUNC    |            Arguments               |
+//	+--------------------+------------------------------------+
+//
+// EndMAP is safe for concurrent use.
+type EndMAP struct {
+	entries *lpm.Table[netip.Prefix] // End.MAP SID -> mapped SID, in canonical form
+}
+
+// NewEndMAP creates a new EndMAP with an empty mapping table.
+func NewEndMAP() *EndMAP {
+	return &EndMAP{
+		entries: lpm.NewTable[netip.Prefix](),
+	}
+}
+
+// Add adds an entry to the mapping table, replacing any existing entry for this SID.
+func (m *EndMAP) Add(sid netip.Prefix, mapped netip.Prefix) error {
+	if !sid.IsValid() || !mapped.IsValid() || !sid.Addr().Is6() || !mapped.Addr().Is6() {
+		return errors.ErrPrefixLength
+	}
+	if err := m.entries.Insert(sid, mapped.Masked()); err != nil {
+		return errors.ErrPrefixLength
+	}
+	return nil
+}
+
+// Remove removes the entry of the mapping table for this SID.
+func (m *EndMAP) Remove(sid netip.Prefix) {
+	m.entries.Delete(sid)
+}
+
+// Lookup returns the End.MAP SID matching the IPv6 DA with the longest prefix, and its mapped SID.
+func (m *EndMAP) Lookup(ipv6Addr [16]byte) (sid netip.Prefix, mapped netip.Prefix, ok bool) {
+	return m.entries.Lookup(netip.AddrFrom16(ipv6Addr))
+}
+
+// Rewrite returns the new IPv6 DA of the packet.
+func (m *EndMAP) Rewrite(ipv6Addr [16]byte) ([16]byte, error) {
+	sid, mapped, ok := m.Lookup(ipv6Addr)
+	if !ok {
+		return [16]byte{}, errors.ErrSIDMismatch
+	}
+	hi := binary.BigEndian.Uint64(ipv6Addr[:8])
+	lo := binary.BigEndian.Uint64(ipv6Addr[8:])
+
+	// keep only the arguments
+	hi, lo = bitfield.Shl128(hi, lo, uint(sid.Bits()))
+	// arguments bits must not be lost when the mapped SID is longer than the End.MAP SID
+	if mapped.Bits() > sid.Bits() {
+		if lostHi, lostLo := bitfield.Shl128(hi, lo, uint(128-mapped.Bits())); lostHi != 0 || lostLo != 0 {
+			return [16]byte{}, errors.ErrOutOfRange
+		}
+	}
+	hi, lo = bitfield.Shr128(hi, lo, uint(mapped.Bits()))
+
+	var r [16]byte
+	p := mapped.Addr().As16()
+	binary.BigEndian.PutUint64(r[:8], hi|binary.BigEndian.Uint64(p[:8]))
+	binary.BigEndian.PutUint64(r[8:], lo|binary.BigEndian.Uint64(p[8:]))
+	return r, nil
+}
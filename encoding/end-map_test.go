@@ -0,0 +1,52 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+func TestEndMAP(t *testing.T) {
+	m := NewEndMAP()
+	if err := m.Add(netip.MustParsePrefix("fd00:1::/32"), netip.MustParsePrefix("fd00:2:2::/48")); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Add(netip.MustParsePrefix("fd00:1:1::/48"), netip.MustParsePrefix("fd00:3::/32")); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Add(netip.MustParsePrefix("::ffff:10.0.0.0/104"), netip.MustParsePrefix("fd00:3::/32")); !errors.Is(err, errors.ErrPrefixLength) {
+		t.Errorf("IPv4-mapped SID should be rejected: %v", err)
+	}
+
+	tests := []struct {
+		da       string
+		expected string
+		err      error
+	}{
+		{da: "fd00:1:1:abcd::1", expected: "fd00:3:abcd::1:0"},
+		{da: "fd00:1:2:abcd::", expected: "fd00:2:2:2:abcd::"},
+		{da: "fd00:1:2:abcd::1", err: errors.ErrOutOfRange},
+		{da: "fd00:4::", err: errors.ErrSIDMismatch},
+	}
+	for _, tc := range tests {
+		r, err := m.Rewrite(netip.MustParseAddr(tc.da).As16())
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Rewrite(%s): unexpected error %v", tc.da, err)
+			continue
+		}
+		if err == nil && netip.AddrFrom16(r) != netip.MustParseAddr(tc.expected) {
+			t.Errorf("Rewrite(%s) = %s, expected %s", tc.da, netip.AddrFrom16(r), tc.expected)
+		}
+	}
+
+	m.Remove(netip.MustParsePrefix("fd00:1:1::/48"))
+	if sid, _, ok := m.Lookup(netip.MustParseAddr("fd00:1:1::1").As16()); !ok || sid != netip.MustParsePrefix("fd00:1::/32") {
+		t.Errorf("Unexpected lookup result after removal: %s", sid)
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"bytes"
+
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+// encodingError wraps err in an EncodingError for the type typ.
+// Errors of nested types (e.g. ArgsMobSession in MGTP4IPv6Dst) are wrapped again,
+// so errors.As returns the outermost type and its input.
+func encodingError(typ string, input []byte, err error) error {
+	var e *errors.EncodingError
+	if errors.As(err, &e) && e.Type == typ {
+		return err
+	}
+	return &errors.EncodingError{
+		Type:  typ,
+		Input: bytes.Clone(input),
+		Err:   err,
+	}
+}
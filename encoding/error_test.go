@@ -0,0 +1,43 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+func TestEncodingError(t *testing.T) {
+	addr := netip.MustParseAddr("fd00:1:1:0:a00:401:ff:ff").As16()
+	_, err := ParseMGTP4IPv6Dst(addr, 100)
+	if !errors.Is(err, errors.ErrOutOfRange) {
+		t.Fatalf("Prefix too long should be rejected: %v", err)
+	}
+	var e *errors.EncodingError
+	if !errors.As(err, &e) {
+		t.Fatalf("Expected an EncodingError: %v", err)
+	}
+	if e.Type != "MGTP4IPv6Dst" {
+		t.Errorf("Unexpected type: %s", e.Type)
+	}
+	if diff := cmp.Diff(e.Input, addr[:]); diff != "" {
+		t.Error(diff)
+	}
+
+	err = NewMGTP6IPv6Dst(netip.MustParsePrefix("fd00::/64"), NewArgsMobSession(1, false, false, 1)).MarshalTo(make([]byte, 8))
+	if !errors.Is(err, errors.ErrTooShortToMarshal) {
+		t.Fatalf("Too short buffer should be rejected: %v", err)
+	}
+	if !errors.As(err, &e) || e.Type != "MGTP6IPv6Dst" || e.Input != nil {
+		t.Errorf("Unexpected EncodingError: %v", err)
+	}
+	if err.Error() != "MGTP6IPv6Dst: sid: too short to serialize (offset 0, need 128 bits, have 64 bits)" {
+		t.Errorf("Unexpected error message: %s", err)
+	}
+}
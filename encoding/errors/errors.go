@@ -12,4 +12,9 @@ var (
 	ErrTooShortToParse   = errors.New("too short to parse")
 	ErrPrefixLength      = errors.New("wrong prefix length")
 	ErrOutOfRange        = errors.New("out of range")
+	ErrSIDMismatch       = errors.New("address does not match the SID")
+	ErrInvalidAddress    = errors.New("invalid address")
+	ErrSyntax            = errors.New("syntax error")
+	ErrUnsupportedType   = errors.New("unsupported type")
+	ErrTruncated         = errors.New("truncated")
 )
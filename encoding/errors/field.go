@@ -0,0 +1,61 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package errors
+
+import (
+	"errors"
+	"fmt"
+)
+
+// FieldError reports which field of an address failed to be encoded or decoded.
+// Offset, Need and Have are in bits. FieldError wraps one of the sentinel errors,
+// which can be checked with Is.
+type FieldError struct {
+	Field  string // name of the field (e.g. "udp-port")
+	Offset uint   // position of the field from the left
+	Need   uint   // required size
+	Have   uint   // available size
+	Err    error  // sentinel error
+}
+
+// Error implements the error interface.
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s (offset %d, need %d bits, have %d bits)", e.Field, e.Err, e.Offset, e.Need, e.Have)
+}
+
+// Unwrap returns the sentinel error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether any error in err's tree matches target (see errors.Is).
+func Is(err, target error) bool {
+	return errors.Is(err, target)
+}
+
+// As finds the first error in err's tree that matches target (see errors.As).
+func As(err error, target any) bool {
+	return errors.As(err, target)
+}
+
+// EncodingError reports the type and the input of a failed Parse or Marshal.
+// The input is not included in the error message, to avoid leaking addresses in logs.
+// EncodingError wraps the underlying error, which can be checked with Is.
+type EncodingError struct {
+	Type  string // name of the type (e.g. "MGTP4IPv6Dst")
+	Input []byte // raw input of Parse (nil for Marshal)
+	Err   error  // underlying error
+}
+
+// Error implements the error interface.
+func (e *EncodingError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Type, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *EncodingError) Unwrap() error {
+	return e.Err
+}
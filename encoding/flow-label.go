@@ -0,0 +1,38 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+// [TS 129.281, section 4.4.2.0] allows the sending GTP-U entity to set
+// either the UDP Source Port or the IPv6 Flow Label dynamically to help load balancing.
+// With SrcSchemeFlowLabel, the entropy is not carried in the IPv6 SA:
+// the headend sets the Flow Label of the IPv6 packet (RFC 6437),
+// and End.M.GTP4.E can derive the UDP Source Port from it.
+//
+// [TS 129.281, section 4.4.2.0]: https://www.etsi.org/deliver/etsi_ts/129200_129299/129281/17.04.00_60/ts_129281v170400p.pdf#page=16
+
+const flowLabelMask = 0xFFFFF // Flow Label is 20 bits long
+
+// FlowLabel returns a non-zero IPv6 Flow Label (20 bits) from an entropy value.
+func FlowLabel(entropy uint32) uint32 {
+	fl := (entropy ^ entropy>>20) & flowLabelMask
+	if fl == 0 {
+		// a zero Flow Label means the packet is not labeled (RFC 6437, section 2)
+		return 1
+	}
+	return fl
+}
+
+// UDPSourcePortFromFlowLabel returns a UDP Source Port derived from an IPv6 Flow Label.
+func UDPSourcePortFromFlowLabel(flowLabel uint32) uint16 {
+	flowLabel &= flowLabelMask
+	return uint16(flowLabel ^ flowLabel>>16)
+}
+
+// FlowLabel returns the IPv6 Flow Label to use with this MGTP4IPv6Src,
+// computed from its UDP Port Number used as entropy.
+func (m *MGTP4IPv6Src) FlowLabel() uint32 {
+	return FlowLabel(uint32(m.udp))
+}
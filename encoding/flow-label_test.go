@@ -0,0 +1,41 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestFlowLabel(t *testing.T) {
+	for _, entropy := range []uint32{0, 1, 0x100000, 0xFFFFFFFF, 0x1234} {
+		if fl := FlowLabel(entropy); fl == 0 || fl > flowLabelMask {
+			t.Errorf("FlowLabel(%x) = %x is not a valid Flow Label", entropy, fl)
+		}
+	}
+
+	src := NewMGTP4IPv6SrcWithScheme(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{10, 0, 4, 1}, 0x1234, SrcSchemeFlowLabel{})
+	b, err := src.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := netip.AddrFrom16([16]byte(b)); a != netip.MustParseAddr("fd00:1:1:a00:401::30") {
+		t.Errorf("Unexpected IPv6 SA: %s", a)
+	}
+	if src.FlowLabel() != 0x1234 {
+		t.Errorf("Unexpected Flow Label: %x", src.FlowLabel())
+	}
+	e, err := ParseMGTP4IPv6SrcWithScheme([16]byte(b), SrcSchemeFlowLabel{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.IPv4() != netip.MustParseAddr("10.0.4.1") || e.UDPPortNumber() != 0 || e.prefix != netip.MustParsePrefix("fd00:1:1::/48") {
+		t.Errorf("Cannot parse IPv6 SA correctly: %s %d", e.IPv4(), e.UDPPortNumber())
+	}
+	if p := UDPSourcePortFromFlowLabel(src.FlowLabel()); p != 0x1234 {
+		t.Errorf("Unexpected UDP Source Port: %x", p)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+// RFC 9433, section 6.7 (H.M.GTP4.D):
+// The IPv6 DA built by the headend has the following format:
+//
+//	0                                                         127
+//	+-----------------------+-------+----------------+---------+
+//	|Destination UPF Prefix |IPv4DA |Args.Mob.Session|0 Padded |
+//	+-----------------------+-------+----------------+---------+
+//	       128-a-b-c            a            b           c
+//	Figure 11: IPv6 DA Encoding for H.M.GTP4.D
+//
+// The encoding is the same as the End.M.GTP4.E SID (see MGTP4IPv6Dst),
+// but the headend additionally validates its inputs, since they come from the received GTP-U packet.
+type HMGTP4IPv6Dst struct {
+	prefix         netip.Prefix // prefix in canonical form
+	ipv4           [4]byte
+	argsMobSession *ArgsMobSession
+}
+
+// NewHMGTP4IPv6Dst creates a new HMGTP4IPv6Dst.
+func NewHMGTP4IPv6Dst(prefix netip.Prefix, ipv4 [4]byte, a *ArgsMobSession) *HMGTP4IPv6Dst {
+	return &HMGTP4IPv6Dst{
+		prefix:         prefix.Masked(),
+		ipv4:           ipv4,
+		argsMobSession: a,
+	}
+}
+
+// IPv4 returns the IPv4 DA of the GTP-U packet.
+func (h *HMGTP4IPv6Dst) IPv4() netip.Addr {
+	return netip.AddrFrom4(h.ipv4)
+}
+
+// ArgsMobSession returns the ArgsMobSession of the HMGTP4IPv6Dst.
+func (h *HMGTP4IPv6Dst) ArgsMobSession() *ArgsMobSession {
+	return h.argsMobSession
+}
+
+// Prefix returns the Destination UPF Prefix.
+func (h *HMGTP4IPv6Dst) Prefix() netip.Prefix {
+	return h.prefix
+}
+
+// Validate checks the HMGTP4IPv6Dst can be used as IPv6 DA:
+//   - the Destination UPF Prefix must be a non-empty IPv6 prefix leaving enough space for the IPv4 DA and Args.Mob.Session,
+//   - the Args.Mob.Session must be set and valid,
+//   - the IPv4 DA of the GTP-U packet must be a unicast address.
+func (h *HMGTP4IPv6Dst) Validate() error {
+	if h.prefix.Bits() == 0 {
+		return errors.ErrPrefixLength
+	}
+	dst := h.dst()
+	if err := dst.Validate(); err != nil {
+		return err
+	}
+	ipv4 := h.IPv4()
+	if ipv4.IsMulticast() || ipv4 == netip.AddrFrom4([4]byte{255, 255, 255, 255}) {
+		return errors.ErrInvalidAddress
+	}
+	return nil
+}
+
+// dst returns the End.M.GTP4.E SID with the same encoding.
+func (h *HMGTP4IPv6Dst) dst() MGTP4IPv6Dst {
+	return MGTP4IPv6Dst{
+		prefix:         h.prefix,
+		ipv4:           h.ipv4,
+		argsMobSession: h.argsMobSession,
+	}
+}
+
+// MarshalLen returns the serial length of HMGTP4IPv6Dst.
+func (h *HMGTP4IPv6Dst) MarshalLen() int {
+	return 16
+}
+
+// Marshal returns the byte sequence generated from HMGTP4IPv6Dst.
+func (h *HMGTP4IPv6Dst) Marshal() ([]byte, error) {
+	b := make([]byte, h.MarshalLen())
+	if err := h.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (h *HMGTP4IPv6Dst) MarshalTo(b []byte) error {
+	if len(b) < h.MarshalLen() {
+		return errors.ErrTooShortToMarshal
+	}
+	if err := h.Validate(); err != nil {
+		return err
+	}
+	dst := h.dst()
+	return dst.marshalTo(b[:h.MarshalLen()])
+}
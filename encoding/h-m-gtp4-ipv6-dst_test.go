@@ -0,0 +1,47 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+func TestHMGTP4IPv6Dst(t *testing.T) {
+	a := NewArgsMobSession(1, false, false, 1)
+	b, err := NewHMGTP4IPv6Dst(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{10, 0, 4, 1}, a).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := NewMGTP4IPv6Dst(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{10, 0, 4, 1}, a).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(b, res); diff != "" {
+		t.Error(diff)
+	}
+
+	tests := []struct {
+		h   *HMGTP4IPv6Dst
+		err error
+	}{
+		{h: NewHMGTP4IPv6Dst(netip.MustParsePrefix("10.0.0.0/8"), [4]byte{10, 0, 4, 1}, a), err: errors.ErrPrefixLength},
+		{h: NewHMGTP4IPv6Dst(netip.MustParsePrefix("::/0"), [4]byte{10, 0, 4, 1}, a), err: errors.ErrPrefixLength},
+		{h: NewHMGTP4IPv6Dst(netip.MustParsePrefix("fd00::/57"), [4]byte{10, 0, 4, 1}, a), err: errors.ErrOutOfRange},
+		{h: NewHMGTP4IPv6Dst(netip.MustParsePrefix("fd00::/56"), [4]byte{10, 0, 4, 1}, a), err: nil},
+		{h: NewHMGTP4IPv6Dst(netip.MustParsePrefix("fd00::/48"), [4]byte{224, 0, 0, 1}, a), err: errors.ErrInvalidAddress},
+		{h: NewHMGTP4IPv6Dst(netip.MustParsePrefix("fd00::/48"), [4]byte{255, 255, 255, 255}, a), err: errors.ErrInvalidAddress},
+		{h: NewHMGTP4IPv6Dst(netip.MustParsePrefix("fd00::/48"), [4]byte{10, 0, 4, 1}, nil), err: errors.ErrInvalidAddress},
+	}
+	for i, tc := range tests {
+		if err := tc.h.Validate(); !errors.Is(err, tc.err) {
+			t.Errorf("test %d: expected error %v, got %v", i, tc.err, err)
+		}
+	}
+}
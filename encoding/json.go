@@ -0,0 +1,66 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import "encoding/json"
+
+// MarshalJSON implements json.Marshaler.
+func (a *ArgsMobSession) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.view())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *ArgsMobSession) UnmarshalJSON(data []byte) error {
+	var v argsMobSessionView
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	r, err := v.argsMobSession()
+	if err != nil {
+		return err
+	}
+	a.set(r)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m *MGTP4IPv6Dst) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.view())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (m *MGTP4IPv6Dst) UnmarshalJSON(data []byte) error {
+	var v mgtp4IPv6DstView
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	r, err := v.mgtp4IPv6Dst()
+	if err != nil {
+		return err
+	}
+	m.set(r)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// The encoding scheme and the ignored bits are not part of the structured form.
+func (m *MGTP4IPv6Src) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.view())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The encoding scheme of m is kept.
+func (m *MGTP4IPv6Src) UnmarshalJSON(data []byte) error {
+	var v mgtp4IPv6SrcView
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	r, err := v.mgtp4IPv6Src(m.scheme)
+	if err != nil {
+		return err
+	}
+	m.set(r)
+	return nil
+}
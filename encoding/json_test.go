@@ -0,0 +1,64 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"encoding/json"
+	"net/netip"
+	"testing"
+
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+func TestJSON(t *testing.T) {
+	tests := []struct {
+		v    any
+		new  any
+		json string
+	}{
+		{
+			v:    NewArgsMobSession(5, true, false, 1),
+			new:  &ArgsMobSession{},
+			json: `{"teid":1,"qfi":5,"r":true,"u":false}`,
+		},
+		{
+			v:    NewMGTP4IPv6Dst(netip.MustParsePrefix("3fff::/20"), [4]byte{203, 0, 113, 1}, NewArgsMobSession(5, false, false, 0xcafe)),
+			new:  &MGTP4IPv6Dst{},
+			json: `{"prefix":"3fff::/20","ipv4":"203.0.113.1","teid":51966,"qfi":5,"r":false,"u":false}`,
+		},
+		{
+			v:    NewMGTP4IPv6Src(netip.MustParsePrefix("3fff::/20"), [4]byte{203, 0, 113, 1}, 1337),
+			new:  &MGTP4IPv6Src{},
+			json: `{"prefix":"3fff::/20","ipv4":"203.0.113.1","udpPort":1337}`,
+		},
+	}
+	for _, tc := range tests {
+		b, err := json.Marshal(tc.v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != tc.json {
+			t.Errorf("Unexpected JSON: %s, expected %s", b, tc.json)
+		}
+		if err := json.Unmarshal(b, tc.new); err != nil {
+			t.Fatal(err)
+		}
+		b, err = json.Marshal(tc.new)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != tc.json {
+			t.Errorf("Round trip failed: %s, expected %s", b, tc.json)
+		}
+	}
+
+	if err := json.Unmarshal([]byte(`{"prefix":"3fff::/20","ipv4":"2001:db8::1","teid":1}`), &MGTP4IPv6Dst{}); !errors.Is(err, errors.ErrInvalidAddress) {
+		t.Errorf("IPv6 address as ipv4 should be rejected: %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"teid":1,"qfi":64}`), &ArgsMobSession{}); !errors.Is(err, errors.ErrOutOfRange) {
+		t.Errorf("QFI out of range should be rejected: %v", err)
+	}
+}
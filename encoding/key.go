@@ -0,0 +1,69 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+// MGTP4IPv6DstKey is a compact comparable representation of MGTP4IPv6Dst,
+// usable as map key: the End.M.GTP4.E SID and the prefix length.
+type MGTP4IPv6DstKey struct {
+	addr      [16]byte
+	prefixLen uint8
+}
+
+// Key returns the MGTP4IPv6DstKey of MGTP4IPv6Dst.
+func (m *MGTP4IPv6Dst) Key() (MGTP4IPv6DstKey, error) {
+	k := MGTP4IPv6DstKey{prefixLen: uint8(m.prefix.Bits())}
+	if err := m.MarshalTo(k.addr[:]); err != nil {
+		return MGTP4IPv6DstKey{}, err
+	}
+	return k, nil
+}
+
+// Addr returns the End.M.GTP4.E SID.
+func (k MGTP4IPv6DstKey) Addr() [16]byte {
+	return k.addr
+}
+
+// PrefixLen returns the prefix length.
+func (k MGTP4IPv6DstKey) PrefixLen() uint {
+	return uint(k.prefixLen)
+}
+
+// MGTP4IPv6Dst returns the MGTP4IPv6Dst represented by the MGTP4IPv6DstKey.
+func (k MGTP4IPv6DstKey) MGTP4IPv6Dst() (*MGTP4IPv6Dst, error) {
+	return ParseMGTP4IPv6Dst(k.addr, uint(k.prefixLen))
+}
+
+// MGTP4IPv6SrcKey is a compact comparable representation of MGTP4IPv6Src,
+// usable as map key: the IPv6 SA (encoded with the scheme of the MGTP4IPv6Src) and the prefix length.
+type MGTP4IPv6SrcKey struct {
+	addr      [16]byte
+	prefixLen uint8
+}
+
+// Key returns the MGTP4IPv6SrcKey of MGTP4IPv6Src.
+func (m *MGTP4IPv6Src) Key() (MGTP4IPv6SrcKey, error) {
+	k := MGTP4IPv6SrcKey{prefixLen: uint8(m.prefix.Bits())}
+	if err := m.MarshalTo(k.addr[:]); err != nil {
+		return MGTP4IPv6SrcKey{}, err
+	}
+	return k, nil
+}
+
+// Addr returns the IPv6 SA.
+func (k MGTP4IPv6SrcKey) Addr() [16]byte {
+	return k.addr
+}
+
+// PrefixLen returns the prefix length.
+func (k MGTP4IPv6SrcKey) PrefixLen() uint {
+	return uint(k.prefixLen)
+}
+
+// MGTP4IPv6Src returns the MGTP4IPv6Src represented by the MGTP4IPv6SrcKey,
+// using the encoding scheme used to create the key (nil means NextMN).
+func (k MGTP4IPv6SrcKey) MGTP4IPv6Src(scheme SrcEncodingScheme) (*MGTP4IPv6Src, error) {
+	return ParseMGTP4IPv6SrcWithScheme(k.addr, scheme)
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestKey(t *testing.T) {
+	dst := NewMGTP4IPv6Dst(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{10, 0, 4, 1}, NewArgsMobSession(1, false, false, 2))
+	k1, err := dst.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// same address, different prefix length
+	other, err := ParseMGTP4IPv6Dst(k1.Addr(), 47)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k2, err := other.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k1.Addr() != k2.Addr() {
+		t.Fatalf("Test keys should have the same address: %x %x", k1.Addr(), k2.Addr())
+	}
+	table := map[MGTP4IPv6DstKey]int{k1: 1, k2: 2}
+	if len(table) != 2 || table[k1] != 1 {
+		t.Error("Keys with different prefix length should differ")
+	}
+	dst2, err := k1.MGTP4IPv6Dst()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !dst2.Equal(dst) {
+		t.Errorf("Unexpected MGTP4IPv6Dst: %s", dst2)
+	}
+	// the unspecified IPv4 address round-trips
+	zero := NewMGTP4IPv6Dst(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{}, NewArgsMobSession(1, false, false, 2))
+	k4, err := zero.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if zero2, err := k4.MGTP4IPv6Dst(); err != nil || !zero2.Equal(zero) {
+		t.Errorf("Unexpected MGTP4IPv6Dst: %s, %v", zero2, err)
+	}
+
+	src := NewMGTP4IPv6Src(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{10, 0, 4, 1}, 1337)
+	k3, err := src.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	src2, err := k3.MGTP4IPv6Src(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !src2.Equal(src) || k3.PrefixLen() != 48 {
+		t.Errorf("Unexpected MGTP4IPv6Src: %s", src2)
+	}
+}
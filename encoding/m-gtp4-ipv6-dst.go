@@ -6,10 +6,12 @@
 package encoding
 
 import (
+	"fmt"
 	"net/netip"
+	"sync/atomic"
 
+	"github.com/nextmn/rfc9433/bitfield"
 	"github.com/nextmn/rfc9433/encoding/errors"
-	"github.com/nextmn/rfc9433/internal/utils"
 )
 
 // RFC 9433, section 6.6 (End.M.GTP4.E):
@@ -25,6 +27,7 @@ type MGTP4IPv6Dst struct {
 	prefix         netip.Prefix // prefix in canonical form
 	ipv4           [4]byte
 	argsMobSession *ArgsMobSession
+	cache          atomic.Pointer[dstCache] // marshaled form
 }
 
 // NewMGTP4IPv6Dst creates a new MGTP4IPv6Dst.
@@ -36,31 +39,109 @@ func NewMGTP4IPv6Dst(prefix netip.Prefix, ipv4 [4]byte, a *ArgsMobSession) *MGTP
 	}
 }
 
+// NewMGTP4IPv6DstFromAddr creates a new MGTP4IPv6Dst, validating its inputs:
+// the prefix must be an IPv6 prefix leaving enough space for the IPv4 address and Args.Mob.Session,
+// and ipv4 must be an IPv4 address (IPv4-mapped IPv6 addresses are rejected).
+func NewMGTP4IPv6DstFromAddr(prefix netip.Prefix, ipv4 netip.Addr, a *ArgsMobSession) (*MGTP4IPv6Dst, error) {
+	if !prefix.IsValid() || !prefix.Addr().Is6() || prefix.Addr().Is4In6() {
+		return nil, errors.ErrPrefixLength
+	}
+	if !ipv4.Is4() {
+		return nil, errors.ErrInvalidAddress
+	}
+	if a == nil {
+		return nil, errors.ErrInvalidAddress
+	}
+	if prefix.Bits()+8*4+8*a.MarshalLen() > 8*16 {
+		return nil, &errors.FieldError{Field: "args-mob-session", Offset: uint(prefix.Bits() + 8*4), Need: uint(8 * a.MarshalLen()), Have: uint(8*16 - prefix.Bits() - 8*4), Err: errors.ErrOutOfRange}
+	}
+	return NewMGTP4IPv6Dst(prefix, ipv4.As4(), a), nil
+}
+
 // ParseMGTP4IPv6Dst parses a given byte sequence into a MGTP4IPv6Dst according to the given prefixLength.
-func ParseMGTP4IPv6Dst(ipv6Addr [16]byte, prefixLength uint) (*MGTP4IPv6Dst, error) {
+// See ParseOption for the available options.
+func ParseMGTP4IPv6Dst(ipv6Addr [16]byte, prefixLength uint, opts ...ParseOption) (*MGTP4IPv6Dst, error) {
+	o := newParseOptions(opts)
+	if prefixLength > o.maxPrefixLen {
+		return nil, encodingError("MGTP4IPv6Dst", ipv6Addr[:], &errors.FieldError{Field: "prefix", Need: prefixLength, Have: o.maxPrefixLen, Err: errors.ErrPrefixLength})
+	}
+	if o.partial && prefixLength <= 8*16 && prefixLength+8*4+8*5 > 8*16 {
+		return parseMGTP4IPv6DstPartial(ipv6Addr, prefixLength)
+	}
 	// prefix extraction
 	a := netip.AddrFrom16(ipv6Addr)
 	prefix := netip.PrefixFrom(a, int(prefixLength)).Masked()
 
 	// ipv4 extraction
 	var ipv4 [4]byte
-	if src, err := utils.FromIPv6(ipv6Addr, prefixLength, 4); err != nil {
-		return nil, err
+	if src, err := bitfield.ExtractBytes(ipv6Addr, prefixLength, 4); err != nil {
+		return nil, encodingError("MGTP4IPv6Dst", ipv6Addr[:], err)
 	} else {
 		copy(ipv4[:], src[:4])
 	}
+	if o.rejectZeroIPv4 && ipv4 == [4]byte{} {
+		return nil, encodingError("MGTP4IPv6Dst", ipv6Addr[:], &errors.FieldError{Field: "ipv4", Offset: prefixLength, Need: 8 * 4, Have: 8 * 4, Err: errors.ErrInvalidAddress})
+	}
 
 	// argMobSession extraction
-	argsMobSessionSlice, err := utils.FromIPv6(ipv6Addr, prefixLength+8*4, 5)
+	argsMobSessionSlice, err := bitfield.ExtractBytes(ipv6Addr, prefixLength+8*4, 5)
+	if err != nil {
+		return nil, encodingError("MGTP4IPv6Dst", ipv6Addr[:], err)
+	}
 	argsMobSession, err := ParseArgsMobSession(argsMobSessionSlice)
 	if err != nil {
-		return nil, err
+		return nil, encodingError("MGTP4IPv6Dst", ipv6Addr[:], err)
 	}
-	return &MGTP4IPv6Dst{
+	m := &MGTP4IPv6Dst{
 		prefix:         prefix,
 		ipv4:           ipv4,
 		argsMobSession: argsMobSession,
-	}, nil
+	}
+	if o.strictPadding {
+		// padding is zero if and only if the address is re-emitted unchanged
+		var b [16]byte
+		if err := m.marshalTo(b[:]); err != nil {
+			return nil, encodingError("MGTP4IPv6Dst", ipv6Addr[:], err)
+		}
+		if b != ipv6Addr {
+			offset := prefixLength + 8*4 + 8*uint(argsMobSession.MarshalLen())
+			return nil, encodingError("MGTP4IPv6Dst", ipv6Addr[:], &errors.FieldError{Field: "padding", Offset: offset, Need: 0, Have: 8*16 - offset, Err: errors.ErrInvalidAddress})
+		}
+	}
+	return m, nil
+}
+
+// parseMGTP4IPv6DstPartial parses the fields fitting after a prefix too long for the whole SID.
+// The returned MGTP4IPv6Dst is always set, and the error wraps ErrTruncated.
+func parseMGTP4IPv6DstPartial(ipv6Addr [16]byte, prefixLength uint) (*MGTP4IPv6Dst, error) {
+	// IPv4 and Args.Mob.Session, with missing bits set to zero
+	var fields [4 + 5]byte
+	for i := range fields {
+		offset := prefixLength + uint(8*i)
+		if offset >= 8*16 {
+			break
+		}
+		width := min(8, 8*16-offset)
+		v, err := bitfield.ExtractBits(ipv6Addr, offset, width)
+		if err != nil {
+			return nil, encodingError("MGTP4IPv6Dst", ipv6Addr[:], err)
+		}
+		fields[i] = byte(v << (8 - width))
+	}
+	argsMobSession, err := ParseArgsMobSession(fields[4:])
+	if err != nil {
+		return nil, encodingError("MGTP4IPv6Dst", ipv6Addr[:], err)
+	}
+	m := &MGTP4IPv6Dst{
+		prefix:         netip.PrefixFrom(netip.AddrFrom16(ipv6Addr), int(prefixLength)).Masked(),
+		ipv4:           [4]byte(fields[:4]),
+		argsMobSession: argsMobSession,
+	}
+	fieldErr := &errors.FieldError{Field: "ipv4", Offset: prefixLength, Need: 8 * 4, Have: 8*16 - prefixLength, Err: errors.ErrTruncated}
+	if prefixLength+8*4 <= 8*16 {
+		fieldErr = &errors.FieldError{Field: "args-mob-session", Offset: prefixLength + 8*4, Need: 8 * 5, Have: 8*16 - prefixLength - 8*4, Err: errors.ErrTruncated}
+	}
+	return m, encodingError("MGTP4IPv6Dst", ipv6Addr[:], fieldErr)
 }
 
 // IPv4 returns the IPv4 Address encoded in the MGTP4IPv6Dst.
@@ -98,11 +179,56 @@ func (m *MGTP4IPv6Dst) Prefix() netip.Prefix {
 	return m.prefix
 }
 
+// set sets the fields of m to the fields of o.
+func (m *MGTP4IPv6Dst) set(o *MGTP4IPv6Dst) {
+	m.prefix = o.prefix
+	m.ipv4 = o.ipv4
+	m.argsMobSession = o.argsMobSession
+	m.cache.Store(nil)
+}
+
+// PrefixLen returns the length of the IPv6 Prefix for this MGTP4IPv6Dst.
+func (m *MGTP4IPv6Dst) PrefixLen() int {
+	return m.prefix.Bits()
+}
+
+// Validate checks the MGTP4IPv6Dst can be marshaled:
+//   - the prefix must be an IPv6 prefix leaving enough space for the IPv4 DA and Args.Mob.Session,
+//   - the Args.Mob.Session must be set and valid,
+//   - the IPv4 DA must be set.
+func (m *MGTP4IPv6Dst) Validate() error {
+	if !m.prefix.IsValid() || !m.prefix.Addr().Is6() || m.prefix.Addr().Is4In6() {
+		return errors.ErrPrefixLength
+	}
+	if m.argsMobSession == nil {
+		return errors.ErrInvalidAddress
+	}
+	if err := m.argsMobSession.Validate(); err != nil {
+		return err
+	}
+	if m.prefix.Bits()+8*4+8*m.argsMobSession.MarshalLen() > 8*16 {
+		return &errors.FieldError{Field: "args-mob-session", Offset: uint(m.prefix.Bits() + 8*4), Need: uint(8 * m.argsMobSession.MarshalLen()), Have: uint(8*16 - m.prefix.Bits() - 8*4), Err: errors.ErrOutOfRange}
+	}
+	if m.IPv4().IsUnspecified() {
+		return errors.ErrInvalidAddress
+	}
+	return nil
+}
+
 // MarshalLen returns the serial length of MGTP4IPv6Dst.
 func (m *MGTP4IPv6Dst) MarshalLen() int {
 	return 16
 }
 
+// Addr returns the IPv6 address generated from MGTP4IPv6Dst.
+func (m *MGTP4IPv6Dst) Addr() (netip.Addr, error) {
+	var b [16]byte
+	if err := m.MarshalTo(b[:]); err != nil {
+		return netip.Addr{}, err
+	}
+	return netip.AddrFrom16(b), nil
+}
+
 // Marshal returns the byte sequence generated from MGTP4IPv6Dst.
 func (m *MGTP4IPv6Dst) Marshal() ([]byte, error) {
 	b := make([]byte, m.MarshalLen())
@@ -113,32 +239,112 @@ func (m *MGTP4IPv6Dst) Marshal() ([]byte, error) {
 }
 
 // MarshalTo puts the byte sequence in the byte array given as b.
-// warning: no caching is done, this result will be recomputed at each call
+// The result is cached, and recomputed only if the ArgsMobSession is modified:
+// MarshalTo does not allocate, except on first call.
 func (m *MGTP4IPv6Dst) MarshalTo(b []byte) error {
 	if len(b) < m.MarshalLen() {
-		return errors.ErrTooShortToMarshal
+		return encodingError("MGTP4IPv6Dst", nil, &errors.FieldError{Field: "sid", Need: uint(8 * m.MarshalLen()), Have: uint(8 * len(b)), Err: errors.ErrTooShortToMarshal})
+	}
+	gen := m.argsMobSession.generation()
+	if c := m.cache.Load(); c != nil && c.gen == gen {
+		copy(b, c.b[:])
+		return nil
 	}
+	c := &dstCache{gen: gen}
+	if err := m.marshalTo(c.b[:]); err != nil {
+		return encodingError("MGTP4IPv6Dst", nil, err)
+	}
+	m.cache.Store(c)
+	copy(b, c.b[:])
+	return nil
+}
+
+// marshalTo puts the byte sequence in b (16 bytes).
+func (m *MGTP4IPv6Dst) marshalTo(b []byte) error {
 	// init ipv6 with the prefix
 	prefix := m.prefix.Addr().As16()
 	copy(b, prefix[:])
 
-	ipv4 := netip.AddrFrom4(m.ipv4).AsSlice()
 	bits := m.prefix.Bits()
 	if bits == -1 {
 		return errors.ErrPrefixLength
 	}
 
 	// add ipv4
-	if err := utils.AppendToSlice(b, uint(bits), ipv4); err != nil {
+	if err := bitfield.InsertBytes(b, uint(bits), m.ipv4[:]); err != nil {
 		return err
 	}
-	argsMobSessionB, err := m.argsMobSession.Marshal()
-	if err != nil {
+	var argsMobSessionB [5]byte
+	if err := m.argsMobSession.MarshalTo(argsMobSessionB[:]); err != nil {
 		return err
 	}
 	// add Args-Mob-Session
-	if err := utils.AppendToSlice(b, uint(bits+8*4), argsMobSessionB); err != nil {
+	if err := bitfield.InsertBytes(b, uint(bits+8*4), argsMobSessionB[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// Since the prefix length is not encoded in the End.M.GTP4.E SID,
+// it is appended as an additional byte after the 16 bytes of the SID.
+func (m *MGTP4IPv6Dst) MarshalBinary() ([]byte, error) {
+	return m.AppendBinary(make([]byte, 0, m.MarshalLen()+1))
+}
+
+// AppendBinary appends the byte sequence generated by MarshalBinary to b.
+// Use MarshalTo to write only the 16 bytes of the SID.
+func (m *MGTP4IPv6Dst) AppendBinary(b []byte) ([]byte, error) {
+	return appendMarshal(b, m.MarshalLen()+1, func(out []byte) error {
+		if err := m.MarshalTo(out[:m.MarshalLen()]); err != nil {
+			return err
+		}
+		out[m.MarshalLen()] = byte(m.prefix.Bits())
+		return nil
+	})
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// b is the 16 bytes of the SID followed by the prefix length (see MarshalBinary).
+func (m *MGTP4IPv6Dst) UnmarshalBinary(b []byte) error {
+	if len(b) < m.MarshalLen()+1 {
+		return &errors.FieldError{Field: "prefix-length", Offset: uint(8 * m.MarshalLen()), Need: 8, Have: uint(max(8*(len(b)-m.MarshalLen()), 0)), Err: errors.ErrTooShortToParse}
+	}
+	r, err := ParseMGTP4IPv6Dst([16]byte(b), uint(b[m.MarshalLen()]))
+	if err != nil {
+		return err
+	}
+	m.set(r)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler (e.g. "prefix=3fff::/20 ipv4=203.0.113.1 teid=0x1 qfi=5 r=0 u=0").
+func (m *MGTP4IPv6Dst) MarshalText() ([]byte, error) {
+	a, err := m.argsMobSession.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(fmt.Sprintf("prefix=%s ipv4=%s %s", m.prefix, m.IPv4(), a)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Fields prefix, ipv4 and teid are required.
+func (m *MGTP4IPv6Dst) UnmarshalText(text []byte) error {
+	fields, err := textFields(text, []string{"prefix", "ipv4", "teid"}, argsMobSessionTextKeys)
+	if err != nil {
+		return err
+	}
+	prefix, err := textPrefix(fields)
+	if err != nil {
+		return err
+	}
+	ipv4, err := textIPv4(fields)
+	if err != nil {
+		return err
+	}
+	a := &ArgsMobSession{}
+	if err := a.setTextFields(fields); err != nil {
 		return err
 	}
+	m.set(NewMGTP4IPv6Dst(prefix, ipv4, a))
 	return nil
 }
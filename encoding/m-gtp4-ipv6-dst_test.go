@@ -5,9 +5,84 @@
 
 package encoding
 
-import "net/netip"
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
 
 func ExampleMGTP4IPv6Dst() {
 	dst := NewMGTP4IPv6Dst(netip.MustParsePrefix("3fff::/20"), netip.MustParseAddr("203.0.113.1").As4(), NewArgsMobSession(0, false, false, 1))
 	dst.Marshal()
 }
+
+func TestMGTP4IPv6DstAddr(t *testing.T) {
+	a, err := NewMGTP4IPv6Dst(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{10, 0, 4, 1}, NewArgsMobSession(1, false, false, 1)).Addr()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a != netip.MustParseAddr("fd00:1:1:a00:401:400:0:100") {
+		t.Errorf("Unexpected address: %s", a)
+	}
+	if _, err := NewMGTP4IPv6Dst(netip.MustParsePrefix("fd00::/64"), [4]byte{10, 0, 4, 1}, NewArgsMobSession(1, false, false, 1)).Addr(); err == nil {
+		t.Error("Prefix too long should be rejected")
+	}
+}
+
+func TestNewMGTP4IPv6DstFromAddr(t *testing.T) {
+	a := NewArgsMobSession(1, false, false, 1)
+	tests := []struct {
+		prefix string
+		ipv4   string
+		err    error
+	}{
+		{prefix: "fd00:1:1::/48", ipv4: "10.0.4.1", err: nil},
+		{prefix: "fd00:1:1::/56", ipv4: "10.0.4.1", err: nil},
+		{prefix: "fd00:1:1::/57", ipv4: "10.0.4.1", err: errors.ErrOutOfRange},
+		{prefix: "10.0.0.0/8", ipv4: "10.0.4.1", err: errors.ErrPrefixLength},
+		{prefix: "::ffff:0:0/96", ipv4: "10.0.4.1", err: errors.ErrPrefixLength},
+		{prefix: "fd00:1:1::/48", ipv4: "::ffff:10.0.4.1", err: errors.ErrInvalidAddress},
+		{prefix: "fd00:1:1::/48", ipv4: "fd00::1", err: errors.ErrInvalidAddress},
+	}
+	for _, tc := range tests {
+		dst, err := NewMGTP4IPv6DstFromAddr(netip.MustParsePrefix(tc.prefix), netip.MustParseAddr(tc.ipv4), a)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("NewMGTP4IPv6DstFromAddr(%s, %s): expected error %v, got %v", tc.prefix, tc.ipv4, tc.err, err)
+			continue
+		}
+		if err == nil {
+			if _, err := dst.Marshal(); err != nil {
+				t.Errorf("Valid MGTP4IPv6Dst cannot be marshaled: %v", err)
+			}
+		}
+	}
+	if _, err := NewMGTP4IPv6DstFromAddr(netip.MustParsePrefix("fd00:1:1::/48"), netip.Addr{}, a); !errors.Is(err, errors.ErrInvalidAddress) {
+		t.Errorf("Invalid address should be rejected: %v", err)
+	}
+	if _, err := NewMGTP4IPv6DstFromAddr(netip.Prefix{}, netip.MustParseAddr("10.0.4.1"), a); !errors.Is(err, errors.ErrPrefixLength) {
+		t.Errorf("Invalid prefix should be rejected: %v", err)
+	}
+}
+
+func TestMGTP4IPv6DstValidate(t *testing.T) {
+	a := NewArgsMobSession(1, false, false, 1)
+	tests := []struct {
+		m   *MGTP4IPv6Dst
+		err error
+	}{
+		{m: NewMGTP4IPv6Dst(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{10, 0, 4, 1}, a), err: nil},
+		{m: NewMGTP4IPv6Dst(netip.MustParsePrefix("fd00::/56"), [4]byte{10, 0, 4, 1}, a), err: nil},
+		{m: NewMGTP4IPv6Dst(netip.MustParsePrefix("fd00::/57"), [4]byte{10, 0, 4, 1}, a), err: errors.ErrOutOfRange},
+		{m: NewMGTP4IPv6Dst(netip.MustParsePrefix("10.0.0.0/8"), [4]byte{10, 0, 4, 1}, a), err: errors.ErrPrefixLength},
+		{m: NewMGTP4IPv6Dst(netip.Prefix{}, [4]byte{10, 0, 4, 1}, a), err: errors.ErrPrefixLength},
+		{m: NewMGTP4IPv6Dst(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{}, a), err: errors.ErrInvalidAddress},
+		{m: NewMGTP4IPv6Dst(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{10, 0, 4, 1}, nil), err: errors.ErrInvalidAddress},
+		{m: NewMGTP4IPv6Dst(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{10, 0, 4, 1}, NewArgsMobSession(64, false, false, 1)), err: errors.ErrOutOfRange},
+	}
+	for i, tc := range tests {
+		if err := tc.m.Validate(); !errors.Is(err, tc.err) {
+			t.Errorf("test %d: expected error %v, got %v", i, tc.err, err)
+		}
+	}
+}
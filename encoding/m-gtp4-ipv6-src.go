@@ -6,11 +6,11 @@
 package encoding
 
 import (
-	"encoding/binary"
+	"fmt"
 	"net/netip"
+	"sync/atomic"
 
 	"github.com/nextmn/rfc9433/encoding/errors"
-	"github.com/nextmn/rfc9433/internal/utils"
 )
 
 const (
@@ -71,6 +71,13 @@ type MGTP4IPv6Src struct {
 	prefix netip.Prefix // prefix in canonical form
 	ipv4   [4]byte
 	udp    uint16
+	scheme SrcEncodingScheme // nil means NextMN
+
+	// bits of the parsed IPv6 SA not used by the encoding scheme ("any bit pattern (ignored)"),
+	// re-emitted by MarshalTo
+	ignored [16]byte
+
+	cache atomic.Pointer[[16]byte] // marshaled form
 }
 
 // NewMGTP4IPv6Src creates a new MGTP4IPv6Src
@@ -82,59 +89,53 @@ func NewMGTP4IPv6Src(prefix netip.Prefix, ipv4 [4]byte, udpPortNumber uint16) *M
 	}
 }
 
-// ParseMGTP4IPv6SrcNextMN parses a given IPv6 source address with NextMN bit pattern into a MGTP4IPv6Src
-func ParseMGTP4IPv6SrcNextMN(addr [16]byte) (*MGTP4IPv6Src, error) {
-	// Prefix length extraction
-	prefixLen := uint(ipv6LenEncodingMask & (addr[ipv6LenEncodingPosByte] >> ipv6LenEncodingPosBit))
+// NewMGTP4IPv6SrcWithScheme creates a new MGTP4IPv6Src using the given encoding scheme.
+// If scheme is nil, the NextMN scheme is used.
+func NewMGTP4IPv6SrcWithScheme(prefix netip.Prefix, ipv4 [4]byte, udpPortNumber uint16, scheme SrcEncodingScheme) *MGTP4IPv6Src {
+	return &MGTP4IPv6Src{
+		prefix: prefix.Masked(),
+		ipv4:   ipv4,
+		udp:    udpPortNumber,
+		scheme: scheme,
+	}
+}
 
-	r, err := ParseMGTP4IPv6Src(addr, prefixLen)
+// ParseMGTP4IPv6SrcWithScheme parses a given IPv6 source address into a MGTP4IPv6Src using the given encoding scheme.
+// If scheme is nil, the NextMN scheme is used.
+func ParseMGTP4IPv6SrcWithScheme(addr [16]byte, scheme SrcEncodingScheme) (*MGTP4IPv6Src, error) {
+	s := scheme
+	if s == nil {
+		s = SrcSchemeNextMN{}
+	}
+	prefix, ipv4, udp, err := s.Parse(addr)
 	if err != nil {
-		return nil, err
+		return nil, encodingError("MGTP4IPv6Src", addr[:], err)
 	}
-
-	if prefixLen+8*4+16+ipv6LenEncodingSizeBit > 8*16 {
-		// Prefix is too big: no space for UDP Port and "IPv6 Prefix length"
-		return nil, errors.ErrOutOfRange
+	// ignored bits are the bits not re-emitted by the encoding scheme
+	var b [16]byte
+	if err := s.MarshalTo(b[:], prefix, ipv4, udp); err != nil {
+		return nil, encodingError("MGTP4IPv6Src", addr[:], err)
 	}
-	// udp port extraction
-	if src, err := utils.FromIPv6(addr, prefixLen+8*4, 2); err != nil {
-		return nil, err
-	} else {
-		var udp [2]byte
-		copy(udp[:], src[:2])
-		r.udp = binary.BigEndian.Uint16([]byte{udp[0], udp[1]})
+	for i := range b {
+		b[i] ^= addr[i]
 	}
-	return r, nil
+	return &MGTP4IPv6Src{
+		prefix:  prefix,
+		ipv4:    ipv4,
+		udp:     udp,
+		scheme:  scheme,
+		ignored: b,
+	}, nil
 }
 
-// ParseMGTP4IPv6SrcNextMN parses a given IPv6 source address without any specific bit pattern into a MGTP4IPv6Src
-func ParseMGTP4IPv6Src(addr [16]byte, prefixLen uint) (*MGTP4IPv6Src, error) {
-	if prefixLen == 0 {
-		// even if globally routable IPv6 Prefix size cannot currently be less than 32 (per ICANN policy),
-		// nothing prevent the use of such prefix with ULA (fc00::/7)
-		// or, in the future, a prefix from a currently not yet allocated address block.
-		return nil, errors.ErrPrefixLength
-	}
-	if prefixLen+8*4 > 8*16 {
-		// Prefix is too big: no space for IPv4 Address
-		return nil, errors.ErrOutOfRange
-	}
-	// prefix extraction
-	a := netip.AddrFrom16(addr)
-	prefix := netip.PrefixFrom(a, int(prefixLen)).Masked()
-
-	// ipv4 extraction
-	var ipv4 [4]byte
-	if src, err := utils.FromIPv6(addr, prefixLen, 4); err != nil {
-		return nil, err
-	} else {
-		copy(ipv4[:], src[:4])
-	}
+// ParseMGTP4IPv6SrcNextMN parses a given IPv6 source address with NextMN bit pattern into a MGTP4IPv6Src
+func ParseMGTP4IPv6SrcNextMN(addr [16]byte) (*MGTP4IPv6Src, error) {
+	return ParseMGTP4IPv6SrcWithScheme(addr, nil)
+}
 
-	return &MGTP4IPv6Src{
-		prefix: prefix,
-		ipv4:   ipv4,
-	}, nil
+// ParseMGTP4IPv6Src parses a given IPv6 source address without any specific bit pattern into a MGTP4IPv6Src
+func ParseMGTP4IPv6Src(addr [16]byte, prefixLen uint) (*MGTP4IPv6Src, error) {
+	return ParseMGTP4IPv6SrcWithScheme(addr, NewSrcSchemeRFC(prefixLen))
 }
 
 // IPv4 returns the IPv4 Address encoded in the MGTP4IPv6Src.
@@ -142,16 +143,70 @@ func (m *MGTP4IPv6Src) IPv4() netip.Addr {
 	return netip.AddrFrom4(m.ipv4)
 }
 
+// Prefix returns the IPv6 Prefix for this MGTP4IPv6Src.
+func (m *MGTP4IPv6Src) Prefix() netip.Prefix {
+	return m.prefix
+}
+
+// PrefixLen returns the length of the IPv6 Prefix for this MGTP4IPv6Src.
+func (m *MGTP4IPv6Src) PrefixLen() int {
+	return m.prefix.Bits()
+}
+
 // UDPPortNumber returns the UDP Port Number encoded in the MGTP4IPv6Src (0 if not set).
 func (m *MGTP4IPv6Src) UDPPortNumber() uint16 {
 	return m.udp
 }
 
+// set sets the fields of m to the fields of o.
+func (m *MGTP4IPv6Src) set(o *MGTP4IPv6Src) {
+	m.prefix = o.prefix
+	m.ipv4 = o.ipv4
+	m.udp = o.udp
+	m.scheme = o.scheme
+	m.ignored = o.ignored
+	m.cache.Store(nil)
+}
+
+// IgnoredBits returns the bits of the parsed IPv6 SA not used by the encoding scheme.
+func (m *MGTP4IPv6Src) IgnoredBits() [16]byte {
+	return m.ignored
+}
+
+// ClearIgnoredBits sets the ignored bits to zero.
+func (m *MGTP4IPv6Src) ClearIgnoredBits() {
+	m.ignored = [16]byte{}
+	m.cache.Store(nil)
+}
+
+// Validate checks the MGTP4IPv6Src can be marshaled:
+//   - the prefix must be an IPv6 prefix leaving enough space for the fields of the encoding scheme,
+//   - the IPv4 SA must be set.
+func (m *MGTP4IPv6Src) Validate() error {
+	if !m.prefix.IsValid() || !m.prefix.Addr().Is6() || m.prefix.Addr().Is4In6() {
+		return errors.ErrPrefixLength
+	}
+	if m.IPv4().IsUnspecified() {
+		return errors.ErrInvalidAddress
+	}
+	var b [16]byte
+	return m.marshalTo(b[:])
+}
+
 // MarshalLen returns the serial length of MGTP4IPv6Src.
 func (m *MGTP4IPv6Src) MarshalLen() int {
 	return 16
 }
 
+// Addr returns the IPv6 address generated from MGTP4IPv6Src.
+func (m *MGTP4IPv6Src) Addr() (netip.Addr, error) {
+	var b [16]byte
+	if err := m.MarshalTo(b[:]); err != nil {
+		return netip.Addr{}, err
+	}
+	return netip.AddrFrom16(b), nil
+}
+
 // Marshal returns the byte sequence generated from MGTP4IPv6Src.
 func (m *MGTP4IPv6Src) Marshal() ([]byte, error) {
 	b := make([]byte, m.MarshalLen())
@@ -161,33 +216,116 @@ func (m *MGTP4IPv6Src) Marshal() ([]byte, error) {
 	return b, nil
 }
 
-// MarshalTo puts the byte sequence in the byte array given as b.
-// warning: no caching is done, this result will be recomputed at each call
+// Scheme returns the encoding scheme of the MGTP4IPv6Src (nil means NextMN).
+func (m *MGTP4IPv6Src) Scheme() SrcEncodingScheme {
+	return m.scheme
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b, using the encoding scheme of the MGTP4IPv6Src.
+// Ignored bits of a parsed MGTP4IPv6Src are preserved (see ClearIgnoredBits).
+// The result is cached: MarshalTo does not allocate, except on first call.
 func (m *MGTP4IPv6Src) MarshalTo(b []byte) error {
 	if len(b) < m.MarshalLen() {
-		return errors.ErrTooShortToMarshal
+		return encodingError("MGTP4IPv6Src", nil, &errors.FieldError{Field: "sid", Need: uint(8 * m.MarshalLen()), Have: uint(8 * len(b)), Err: errors.ErrTooShortToMarshal})
+	}
+	if c := m.cache.Load(); c != nil {
+		copy(b, c[:])
+		return nil
 	}
-	// init b with prefix
-	prefix := m.prefix.Addr().As16()
-	copy(b, prefix[:])
+	c := &[16]byte{}
+	if err := m.marshalTo(c[:]); err != nil {
+		return encodingError("MGTP4IPv6Src", nil, err)
+	}
+	m.cache.Store(c)
+	copy(b, c[:])
+	return nil
+}
 
-	ipv4 := netip.AddrFrom4(m.ipv4).AsSlice()
-	udp := make([]byte, 2)
-	binary.BigEndian.PutUint16(udp, m.udp)
-	bits := m.prefix.Bits()
-	if bits == -1 {
-		return errors.ErrPrefixLength
+// marshalTo puts the byte sequence in b (16 bytes).
+func (m *MGTP4IPv6Src) marshalTo(b []byte) error {
+	s := m.scheme
+	if s == nil {
+		s = SrcSchemeNextMN{}
+	}
+	if err := s.MarshalTo(b, m.prefix, m.ipv4, m.udp); err != nil {
+		return err
+	}
+	for i, v := range m.ignored {
+		b[i] |= v
+	}
+	return nil
+}
+
+// MarshalRFC returns the byte sequence generated from MGTP4IPv6Src,
+// using the plain RFC 9433 layout (Figure 10), without NextMN extensions.
+func (m *MGTP4IPv6Src) MarshalRFC() ([]byte, error) {
+	b := make([]byte, m.MarshalLen())
+	if err := m.MarshalToRFC(b); err != nil {
+		return nil, err
 	}
+	return b, nil
+}
+
+// MarshalToRFC puts the byte sequence in the byte array given as b,
+// using the plain RFC 9433 layout (Figure 10): the UDP Port Number and the prefix length are not encoded,
+// and the ignored bits are set to zero.
+func (m *MGTP4IPv6Src) MarshalToRFC(b []byte) error {
+	if len(b) < m.MarshalLen() {
+		return &errors.FieldError{Field: "sid", Need: uint(8 * m.MarshalLen()), Have: uint(8 * len(b)), Err: errors.ErrTooShortToMarshal}
+	}
+	return NewSrcSchemeRFC(uint(max(m.prefix.Bits(), 0))).MarshalTo(b[:m.MarshalLen()], m.prefix, m.ipv4, m.udp)
+}
 
-	// add ipv4
-	if err := utils.AppendToSlice(b, uint(bits), ipv4); err != nil {
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (m *MGTP4IPv6Src) MarshalBinary() ([]byte, error) {
+	return m.Marshal()
+}
+
+// AppendBinary appends the byte sequence generated from MGTP4IPv6Src to b.
+func (m *MGTP4IPv6Src) AppendBinary(b []byte) ([]byte, error) {
+	return appendMarshal(b, m.MarshalLen(), m.MarshalTo)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// The encoding scheme of m is used to parse b (nil means NextMN):
+// with the plain RFC scheme, the prefix length must be known in advance.
+func (m *MGTP4IPv6Src) UnmarshalBinary(b []byte) error {
+	if len(b) < 16 {
+		return &errors.FieldError{Field: "sid", Need: 8 * 16, Have: uint(8 * len(b)), Err: errors.ErrTooShortToParse}
+	}
+	r, err := ParseMGTP4IPv6SrcWithScheme([16]byte(b), m.scheme)
+	if err != nil {
 		return err
 	}
-	// add upd port
-	if err := utils.AppendToSlice(b, uint(bits+8*4), udp); err != nil {
+	m.set(r)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler (e.g. "prefix=3fff::/20 ipv4=203.0.113.1 udp=1337").
+// The encoding scheme and the ignored bits are not part of the textual form.
+func (m *MGTP4IPv6Src) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("prefix=%s ipv4=%s udp=%d", m.prefix, m.IPv4(), m.udp)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Fields prefix and ipv4 are required.
+// The encoding scheme of m is kept.
+func (m *MGTP4IPv6Src) UnmarshalText(text []byte) error {
+	fields, err := textFields(text, []string{"prefix", "ipv4"}, []string{"udp"})
+	if err != nil {
+		return err
+	}
+	prefix, err := textPrefix(fields)
+	if err != nil {
+		return err
+	}
+	ipv4, err := textIPv4(fields)
+	if err != nil {
+		return err
+	}
+	udp, err := textUint(fields, "udp", 16)
+	if err != nil {
 		return err
 	}
-	// add prefix length
-	b[ipv6LenEncodingPosByte] = byte(bits)
+	m.set(NewMGTP4IPv6SrcWithScheme(prefix, ipv4, uint16(udp), m.scheme))
 	return nil
 }
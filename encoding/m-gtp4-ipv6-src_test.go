@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding/errors"
 )
 
 func ExampleMGTP4IPv6Src() {
@@ -34,11 +35,29 @@ func TestMGTP4IPv6Src(t *testing.T) {
 	if e.IPv4().Compare(netip.MustParseAddr("192.0.2.1")) != 0 {
 		t.Fatalf("Cannot extract ipv4 correctly: %s", e.IPv4())
 	}
+	if e.Prefix() != netip.MustParsePrefix("2001:db08::/32") || e.PrefixLen() != 32 {
+		t.Fatalf("Cannot extract prefix correctly: %s", e.Prefix())
+	}
 	if e.UDPPortNumber() != 0x0123 {
 		t.Fatalf("Cannot extract udp port number correctly: %x", e.UDPPortNumber())
 	}
+	b, err := e.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(b, ip_addr[:]); diff != "" {
+		t.Errorf("Ignored bits are not preserved: %s", diff)
+	}
+	e.ClearIgnoredBits()
+	b, err = e.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(b[10:15], []byte{0, 0, 0, 0, 0}); diff != "" {
+		t.Errorf("Ignored bits are not cleared: %s", diff)
+	}
 	ip_addr2 := NewMGTP4IPv6Src(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{10, 0, 4, 1}, 0x1234)
-	b, err := ip_addr2.Marshal()
+	b, err = ip_addr2.Marshal()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -54,5 +73,51 @@ func TestMGTP4IPv6Src(t *testing.T) {
 	if diff := cmp.Diff(b, res2); diff != "" {
 		t.Error(diff)
 	}
+	b, err = ip_addr2.MarshalRFC()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res3 := []byte{
+		0xfd, 0x00, 0x00, 0x01, 0x00, 0x01,
+		10, 0, 4, 1,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	}
+	if diff := cmp.Diff(b, res3); diff != "" {
+		t.Error(diff)
+	}
+	e, err = ParseMGTP4IPv6Src([16]byte(b), 48)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.IPv4().Compare(netip.MustParseAddr("10.0.4.1")) != 0 {
+		t.Fatalf("Cannot extract ipv4 correctly: %s", e.IPv4())
+	}
+}
 
+func TestMGTP4IPv6SrcAddr(t *testing.T) {
+	a, err := NewMGTP4IPv6Src(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{10, 0, 4, 1}, 0x1234).Addr()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a != netip.MustParseAddr("fd00:1:1:a00:401:1234:0:30") {
+		t.Errorf("Unexpected address: %s", a)
+	}
+}
+
+func TestMGTP4IPv6SrcValidate(t *testing.T) {
+	tests := []struct {
+		m   *MGTP4IPv6Src
+		err error
+	}{
+		{m: NewMGTP4IPv6Src(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{10, 0, 4, 1}, 1337), err: nil},
+		{m: NewMGTP4IPv6Src(netip.MustParsePrefix("fd00:1:1::/74"), [4]byte{10, 0, 4, 1}, 1337), err: errors.ErrOutOfRange},
+		{m: NewMGTP4IPv6Src(netip.MustParsePrefix("10.0.0.0/8"), [4]byte{10, 0, 4, 1}, 1337), err: errors.ErrPrefixLength},
+		{m: NewMGTP4IPv6Src(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{}, 1337), err: errors.ErrInvalidAddress},
+		{m: NewMGTP4IPv6SrcWithScheme(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{10, 0, 4, 1}, 1337, NewSrcSchemeRFC(64)), err: errors.ErrPrefixLength},
+	}
+	for i, tc := range tests {
+		if err := tc.m.Validate(); !errors.Is(err, tc.err) {
+			t.Errorf("test %d: expected error %v, got %v", i, tc.err, err)
+		}
+	}
 }
@@ -0,0 +1,89 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+// RFC 9433, section 6.3 (End.M.GTP6.D) and section 6.4 (End.M.GTP6.D.Di):
+// The End.M.GTP6.D SID is the IPv6 DA of GTP-U packets received by the SR Gateway.
+// It is bound to an SR Policy, and the Args.Mob.Session built from the GTP-U header
+// (TEID, QFI, RQI) is written in the arguments of a segment of this SR Policy:
+//   - with End.M.GTP6.D, in the last segment (SRH[0]);
+//   - with End.M.GTP6.D.Di (drop-in mode), in the End.M.GTP6.E SID (SRH[1]),
+//     and the IPv6 DA of the GTP-U packet is kept as last segment (SRH[0]).
+type MGTP6D struct {
+	sid         netip.Prefix // End.M.GTP6.D SID (LOC+FUNC) in canonical form
+	argsSegment netip.Prefix // LOC+FUNC of the segment carrying Args.Mob.Session in canonical form
+	dropIn      bool
+}
+
+// NewMGTP6D creates a new MGTP6D for End.M.GTP6.D.
+func NewMGTP6D(sid netip.Prefix, lastSegment netip.Prefix) *MGTP6D {
+	return &MGTP6D{
+		sid:         sid.Masked(),
+		argsSegment: lastSegment.Masked(),
+	}
+}
+
+// NewMGTP6DDi creates a new MGTP6D for End.M.GTP6.D.Di.
+func NewMGTP6DDi(sid netip.Prefix, mgtp6ESID netip.Prefix) *MGTP6D {
+	return &MGTP6D{
+		sid:         sid.Masked(),
+		argsSegment: mgtp6ESID.Masked(),
+		dropIn:      true,
+	}
+}
+
+// SID returns the End.M.GTP6.D SID (LOC+FUNC).
+func (m *MGTP6D) SID() netip.Prefix {
+	return m.sid
+}
+
+// ArgsSegment returns the LOC+FUNC of the segment carrying Args.Mob.Session.
+func (m *MGTP6D) ArgsSegment() netip.Prefix {
+	return m.argsSegment
+}
+
+// DropIn returns true for End.M.GTP6.D.Di.
+func (m *MGTP6D) DropIn() bool {
+	return m.dropIn
+}
+
+// Match returns true if the IPv6 DA of a GTP-U packet matches the End.M.GTP6.D SID.
+func (m *MGTP6D) Match(ipv6Addr [16]byte) bool {
+	return m.sid.Contains(netip.AddrFrom16(ipv6Addr))
+}
+
+// NextSID returns the segment carrying Args.Mob.Session, given the IPv6 DA of the GTP-U packet
+// and the Args.Mob.Session built from its GTP-U header
+// (e.g. NewArgsMobSession(qfi, rqi, false, teid)).
+func (m *MGTP6D) NextSID(ipv6Addr [16]byte, a *ArgsMobSession) (*MGTP6IPv6Dst, error) {
+	if !m.Match(ipv6Addr) {
+		return nil, errors.ErrSIDMismatch
+	}
+	return NewMGTP6IPv6Dst(m.argsSegment, a), nil
+}
+
+// Segments returns the segments to add at the end of the SR Policy, in the order they are visited:
+// the segment carrying Args.Mob.Session followed, in drop-in mode, by the IPv6 DA of the GTP-U packet.
+func (m *MGTP6D) Segments(ipv6Addr [16]byte, a *ArgsMobSession) ([][16]byte, error) {
+	sid, err := m.NextSID(ipv6Addr, a)
+	if err != nil {
+		return nil, err
+	}
+	var s [16]byte
+	if err := sid.MarshalTo(s[:]); err != nil {
+		return nil, err
+	}
+	if m.dropIn {
+		return [][16]byte{s, ipv6Addr}, nil
+	}
+	return [][16]byte{s}, nil
+}
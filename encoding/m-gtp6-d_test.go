@@ -0,0 +1,56 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+func TestMGTP6D(t *testing.T) {
+	d := NewMGTP6D(netip.MustParsePrefix("fd00:1:1:d::/64"), netip.MustParsePrefix("fd00:2:2::/48"))
+	sid, err := d.NextSID(netip.MustParseAddr("fd00:1:1:d::1").As16(), NewArgsMobSession(9, false, false, 0xCAFE))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := sid.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := netip.AddrFrom16([16]byte(b)); a != netip.MustParseAddr("fd00:2:2:2400:ca:fe00::") {
+		t.Errorf("Unexpected next SID: %s", a)
+	}
+	if _, err := d.NextSID(netip.MustParseAddr("fd00:1:1:e::1").As16(), NewArgsMobSession(9, false, false, 0xCAFE)); !errors.Is(err, errors.ErrSIDMismatch) {
+		t.Errorf("IPv6 DA not matching the SID should be rejected: %v", err)
+	}
+}
+
+func TestMGTP6DDi(t *testing.T) {
+	d := NewMGTP6DDi(netip.MustParsePrefix("fd00:1:1:d::/64"), netip.MustParsePrefix("fd00:3:3::/48"))
+	da := netip.MustParseAddr("fd00:1:1:d::1").As16()
+	segments, err := d.Segments(da, NewArgsMobSession(9, false, false, 0xCAFE))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := [][16]byte{
+		netip.MustParseAddr("fd00:3:3:2400:ca:fe00::").As16(),
+		da,
+	}
+	if diff := cmp.Diff(segments, res); diff != "" {
+		t.Error(diff)
+	}
+
+	segments, err = NewMGTP6D(d.SID(), d.ArgsSegment()).Segments(da, NewArgsMobSession(9, false, false, 0xCAFE))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(segments, res[:1]); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/bitfield"
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+// RFC 9433, section 6.3 (End.M.GTP6.D) and section 6.5 (End.M.GTP6.E):
+// the Args.Mob.Session of the GTP-U packet is carried in the arguments of a SID.
+// With End.M.GTP6.D, this SID is the last segment of the SR Policy;
+// with End.M.GTP6.E, this SID is the End.M.GTP6.E SID.
+//
+//	0                                                         127
+//	+-----------------------+----------------+----------------+
+//	|  SRGW-IPv6-LOC-FUNC   |Args.Mob.Session|    0 Padded    |
+//	+-----------------------+----------------+----------------+
+//	        128-a-b                 a                b
+type MGTP6IPv6Dst struct {
+	prefix         netip.Prefix // prefix in canonical form
+	argsMobSession *ArgsMobSession
+}
+
+// NewMGTP6IPv6Dst creates a new MGTP6IPv6Dst.
+func NewMGTP6IPv6Dst(prefix netip.Prefix, a *ArgsMobSession) *MGTP6IPv6Dst {
+	return &MGTP6IPv6Dst{
+		prefix:         prefix.Masked(),
+		argsMobSession: a,
+	}
+}
+
+// ParseMGTP6IPv6Dst parses a given byte sequence into a MGTP6IPv6Dst according to the given prefixLength.
+func ParseMGTP6IPv6Dst(ipv6Addr [16]byte, prefixLength uint) (*MGTP6IPv6Dst, error) {
+	// prefix extraction
+	a := netip.AddrFrom16(ipv6Addr)
+	prefix := netip.PrefixFrom(a, int(prefixLength)).Masked()
+	if !prefix.IsValid() {
+		return nil, encodingError("MGTP6IPv6Dst", ipv6Addr[:], errors.ErrPrefixLength)
+	}
+
+	// argMobSession extraction
+	argsMobSessionSlice, err := bitfield.ExtractBytes(ipv6Addr, prefixLength, 5)
+	if err != nil {
+		return nil, encodingError("MGTP6IPv6Dst", ipv6Addr[:], err)
+	}
+	argsMobSession, err := ParseArgsMobSession(argsMobSessionSlice)
+	if err != nil {
+		return nil, encodingError("MGTP6IPv6Dst", ipv6Addr[:], err)
+	}
+	return &MGTP6IPv6Dst{
+		prefix:         prefix,
+		argsMobSession: argsMobSession,
+	}, nil
+}
+
+// ArgsMobSession returns the ArgsMobSession encoded in the MGTP6IPv6Dst.
+func (m *MGTP6IPv6Dst) ArgsMobSession() *ArgsMobSession {
+	return m.argsMobSession
+}
+
+// QFI returns the QFI encoded in the MGTP6IPv6Dst's ArgsMobSession.
+func (m *MGTP6IPv6Dst) QFI() uint8 {
+	return m.argsMobSession.QFI()
+}
+
+// R returns the R bit encoded in the MGTP6IPv6Dst's ArgsMobSession.
+func (m *MGTP6IPv6Dst) R() bool {
+	return m.argsMobSession.R()
+}
+
+// U returns the U bit encoded in the MGTP6IPv6Dst's ArgsMobSession.
+func (m *MGTP6IPv6Dst) U() bool {
+	return m.argsMobSession.U()
+}
+
+// PDUSessionID returns the PDUSessionID for this MGTP6IPv6Dst's ArgsMobSession.
+func (m *MGTP6IPv6Dst) PDUSessionID() uint32 {
+	return m.argsMobSession.PDUSessionID()
+}
+
+// Prefix returns the IPv6 Prefix for this MGTP6IPv6Dst.
+func (m *MGTP6IPv6Dst) Prefix() netip.Prefix {
+	return m.prefix
+}
+
+// MarshalLen returns the serial length of MGTP6IPv6Dst.
+func (m *MGTP6IPv6Dst) MarshalLen() int {
+	return 16
+}
+
+// Marshal returns the byte sequence generated from MGTP6IPv6Dst.
+func (m *MGTP6IPv6Dst) Marshal() ([]byte, error) {
+	b := make([]byte, m.MarshalLen())
+	if err := m.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+// MarshalTo does not allocate.
+// warning: no caching is done, this result will be recomputed at each call
+func (m *MGTP6IPv6Dst) MarshalTo(b []byte) error {
+	if len(b) < m.MarshalLen() {
+		return encodingError("MGTP6IPv6Dst", nil, &errors.FieldError{Field: "sid", Need: uint(8 * m.MarshalLen()), Have: uint(8 * len(b)), Err: errors.ErrTooShortToMarshal})
+	}
+	// init ipv6 with the prefix
+	prefix := m.prefix.Addr().As16()
+	copy(b, prefix[:])
+
+	bits := m.prefix.Bits()
+	if bits == -1 {
+		return encodingError("MGTP6IPv6Dst", nil, errors.ErrPrefixLength)
+	}
+
+	var argsMobSessionB [5]byte
+	if err := m.argsMobSession.MarshalTo(argsMobSessionB[:]); err != nil {
+		return encodingError("MGTP6IPv6Dst", nil, err)
+	}
+	// add Args-Mob-Session
+	if err := bitfield.InsertBytes(b[:m.MarshalLen()], uint(bits), argsMobSessionB[:]); err != nil {
+		return encodingError("MGTP6IPv6Dst", nil, err)
+	}
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler (e.g. "prefix=3fff::/20 teid=0x1 qfi=5 r=0 u=0").
+func (m *MGTP6IPv6Dst) MarshalText() ([]byte, error) {
+	a, err := m.argsMobSession.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(fmt.Sprintf("prefix=%s %s", m.prefix, a)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Fields prefix and teid are required.
+func (m *MGTP6IPv6Dst) UnmarshalText(text []byte) error {
+	fields, err := textFields(text, []string{"prefix", "teid"}, argsMobSessionTextKeys)
+	if err != nil {
+		return err
+	}
+	prefix, err := textPrefix(fields)
+	if err != nil {
+		return err
+	}
+	a := &ArgsMobSession{}
+	if err := a.setTextFields(fields); err != nil {
+		return err
+	}
+	*m = *NewMGTP6IPv6Dst(prefix, a)
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func ExampleMGTP6IPv6Dst() {
+	dst := NewMGTP6IPv6Dst(netip.MustParsePrefix("3fff::/20"), NewArgsMobSession(0, false, false, 1))
+	dst.Marshal()
+}
+
+func TestMGTP6IPv6Dst(t *testing.T) {
+	dst := NewMGTP6IPv6Dst(netip.MustParsePrefix("fd00:1:1::/48"), NewArgsMobSession(5, true, false, 0x12345678))
+	b, err := dst.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := []byte{
+		0xfd, 0x00, 0x00, 0x01, 0x00, 0x01,
+		0x16, 0x12, 0x34, 0x56, 0x78,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+	}
+	if diff := cmp.Diff(b, res); diff != "" {
+		t.Error(diff)
+	}
+
+	e, err := ParseMGTP6IPv6Dst(netip.MustParseAddr("3fff:0160:0000:0010::").As16(), 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.QFI() != 5 || !e.R() || e.U() || e.PDUSessionID() != 1 {
+		t.Errorf("Cannot extract Args.Mob.Session correctly: %d %t %t %x", e.QFI(), e.R(), e.U(), e.PDUSessionID())
+	}
+	if e.Prefix() != netip.MustParsePrefix("3fff::/20") {
+		t.Errorf("Cannot extract prefix correctly: %s", e.Prefix())
+	}
+
+	if _, err := NewMGTP6IPv6Dst(netip.MustParsePrefix("fd00::/96"), NewArgsMobSession(0, false, false, 1)).Marshal(); err == nil {
+		t.Error("Prefix too long should be rejected")
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"slices"
+
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+// appendMarshal appends n bytes to b, written by marshalTo.
+func appendMarshal(b []byte, n int, marshalTo func([]byte) error) ([]byte, error) {
+	b = slices.Grow(b, n)
+	out := b[len(b) : len(b)+n]
+	clear(out)
+	if err := marshalTo(out); err != nil {
+		return nil, err
+	}
+	return b[:len(b)+n], nil
+}
+
+// MarshalSlice puts the byte sequences of dsts contiguously in buf (16 bytes per SID),
+// e.g. to fill the segment list of a SRH.
+// MarshalSlice does not allocate, except on first marshaling of each SID.
+func MarshalSlice(dsts []*MGTP4IPv6Dst, buf []byte) error {
+	if len(buf) < 16*len(dsts) {
+		return &errors.FieldError{Field: "sid-list", Need: uint(8 * 16 * len(dsts)), Have: uint(8 * len(buf)), Err: errors.ErrTooShortToMarshal}
+	}
+	for i, m := range dsts {
+		if err := m.MarshalTo(buf[16*i : 16*i+16]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+type marshalerTo interface {
+	MarshalTo(b []byte) error
+}
+
+func TestMarshalToAllocs(t *testing.T) {
+	prefix := netip.MustParsePrefix("fd00:1:1::/49")
+	a := NewArgsMobSession(1, false, false, 1)
+	tests := []struct {
+		name string
+		m    marshalerTo
+	}{
+		{name: "ArgsMobSession", m: a},
+		{name: "MGTP4IPv6Dst", m: NewMGTP4IPv6Dst(prefix, [4]byte{10, 0, 4, 1}, a)},
+		{name: "MGTP4IPv6Src", m: NewMGTP4IPv6Src(prefix, [4]byte{10, 0, 4, 1}, 1337)},
+		{name: "MGTP6IPv6Dst", m: NewMGTP6IPv6Dst(prefix, a)},
+		{name: "HMGTP4IPv6Dst", m: NewHMGTP4IPv6Dst(prefix, [4]byte{10, 0, 4, 1}, a)},
+	}
+	b := make([]byte, 16)
+	for _, tc := range tests {
+		allocs := testing.AllocsPerRun(100, func() {
+			clear(b)
+			if err := tc.m.MarshalTo(b); err != nil {
+				t.Fatal(err)
+			}
+		})
+		if allocs != 0 {
+			t.Errorf("%s.MarshalTo: %v allocations", tc.name, allocs)
+		}
+	}
+}
+
+func TestMarshalSlice(t *testing.T) {
+	prefix := netip.MustParsePrefix("fd00:1:1::/48")
+	dsts := []*MGTP4IPv6Dst{
+		NewMGTP4IPv6Dst(prefix, [4]byte{10, 0, 4, 1}, NewArgsMobSession(1, false, false, 1)),
+		NewMGTP4IPv6Dst(prefix, [4]byte{10, 0, 4, 2}, NewArgsMobSession(2, false, false, 2)),
+	}
+	buf := make([]byte, 32)
+	if err := MarshalSlice(dsts, buf); err != nil {
+		t.Fatal(err)
+	}
+	var res []byte
+	for _, d := range dsts {
+		b, err := d.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		res = append(res, b...)
+	}
+	if diff := cmp.Diff(buf, res); diff != "" {
+		t.Error(diff)
+	}
+	allocs := testing.AllocsPerRun(100, func() {
+		if err := MarshalSlice(dsts, buf); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("MarshalSlice: %v allocations", allocs)
+	}
+	if err := MarshalSlice(dsts, buf[:31]); !errors.Is(err, errors.ErrTooShortToMarshal) {
+		t.Errorf("Too short buffer should be rejected: %v", err)
+	}
+}
+
+func BenchmarkMGTP4IPv6DstMarshalTo(b *testing.B) {
+	dst := NewMGTP4IPv6Dst(netip.MustParsePrefix("fd00:1:1::/49"), [4]byte{10, 0, 4, 1}, NewArgsMobSession(1, false, false, 1))
+	buf := make([]byte, 16)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dst.MarshalTo(buf)
+	}
+}
+
+func BenchmarkMGTP4IPv6SrcMarshalTo(b *testing.B) {
+	src := NewMGTP4IPv6Src(netip.MustParsePrefix("fd00:1:1::/49"), [4]byte{10, 0, 4, 1}, 1337)
+	buf := make([]byte, 16)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		src.MarshalTo(buf)
+	}
+}
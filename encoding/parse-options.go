@@ -0,0 +1,69 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+// ParseOption configures how an address is parsed.
+// By default, parsing is tolerant: padding bits and the unspecified IPv4 address (0.0.0.0) are accepted,
+// as they are by Marshal.
+type ParseOption func(*parseOptions)
+
+type parseOptions struct {
+	strictPadding  bool
+	rejectZeroIPv4 bool
+	maxPrefixLen   uint
+	partial        bool
+}
+
+// newParseOptions returns the parseOptions with opts applied.
+func newParseOptions(opts []ParseOption) parseOptions {
+	o := parseOptions{
+		maxPrefixLen: 8 * 16,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithStrictPadding rejects addresses whose padding bits (after the last field) are not zero,
+// as required by RFC 9433.
+func WithStrictPadding() ParseOption {
+	return func(o *parseOptions) {
+		o.strictPadding = true
+	}
+}
+
+// WithRejectZeroIPv4 rejects addresses carrying the unspecified IPv4 address (0.0.0.0),
+// which is not a valid IPv4 DA of a GTP-U packet.
+func WithRejectZeroIPv4() ParseOption {
+	return func(o *parseOptions) {
+		o.rejectZeroIPv4 = true
+	}
+}
+
+// WithAllowZeroIPv4 accepts addresses carrying the unspecified IPv4 address (0.0.0.0),
+// cancelling a previous WithRejectZeroIPv4. This is the default.
+func WithAllowZeroIPv4() ParseOption {
+	return func(o *parseOptions) {
+		o.rejectZeroIPv4 = false
+	}
+}
+
+// WithMaxPrefixLen rejects prefixes longer than n bits.
+func WithMaxPrefixLen(n uint) ParseOption {
+	return func(o *parseOptions) {
+		o.maxPrefixLen = n
+	}
+}
+
+// WithPartialDecode decodes the fields fitting after a prefix too long for the whole SID,
+// e.g. to analyze captures of partially-compliant gateways: the missing bits are set to zero,
+// and the parsed value is returned with an error wrapping ErrTruncated.
+func WithPartialDecode() ParseOption {
+	return func(o *parseOptions) {
+		o.partial = true
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+func TestParseOptions(t *testing.T) {
+	tests := []struct {
+		addr      string
+		prefixLen uint
+		opts      []ParseOption
+		err       error
+	}{
+		{addr: "fd00:1:1:a00:401:400:0:100", prefixLen: 48},
+		{addr: "fd00:1:1:a00:401:400:0:100", prefixLen: 48, opts: []ParseOption{WithStrictPadding()}},
+		{addr: "fd00:1:1:a00:401:400:0:1ff", prefixLen: 48},
+		{addr: "fd00:1:1:a00:401:400:0:1ff", prefixLen: 48, opts: []ParseOption{WithStrictPadding()}, err: errors.ErrInvalidAddress},
+		{addr: "fd00:1:1:0:0:400:0:100", prefixLen: 48},
+		{addr: "fd00:1:1:0:0:400:0:100", prefixLen: 48, opts: []ParseOption{WithRejectZeroIPv4()}, err: errors.ErrInvalidAddress},
+		{addr: "fd00:1:1:0:0:400:0:100", prefixLen: 48, opts: []ParseOption{WithRejectZeroIPv4(), WithAllowZeroIPv4()}},
+		{addr: "fd00:1:1:a00:401:400:0:100", prefixLen: 48, opts: []ParseOption{WithMaxPrefixLen(48)}},
+		{addr: "fd00:1:1:a00:401:400:0:100", prefixLen: 48, opts: []ParseOption{WithMaxPrefixLen(40)}, err: errors.ErrPrefixLength},
+	}
+	for i, tc := range tests {
+		if _, err := ParseMGTP4IPv6Dst(netip.MustParseAddr(tc.addr).As16(), tc.prefixLen, tc.opts...); !errors.Is(err, tc.err) {
+			t.Errorf("test %d: expected error %v, got %v", i, tc.err, err)
+		}
+	}
+}
+
+func TestParsePartialDecode(t *testing.T) {
+	addr := netip.MustParseAddr("fd00:1:1:1:1:1:a00:401").As16()
+	if _, err := ParseMGTP4IPv6Dst(addr, 96); !errors.Is(err, errors.ErrOutOfRange) {
+		t.Errorf("Truncated SID should be rejected: %v", err)
+	}
+	m, err := ParseMGTP4IPv6Dst(addr, 96, WithPartialDecode())
+	if !errors.Is(err, errors.ErrTruncated) {
+		t.Fatalf("Truncated SID should be reported: %v", err)
+	}
+	if m.IPv4() != netip.MustParseAddr("10.0.4.1") || m.PDUSessionID() != 0 || m.Prefix() != netip.MustParsePrefix("fd00:1:1:1:1:1::/96") {
+		t.Errorf("Unexpected partial decode: %s %s %x", m.Prefix(), m.IPv4(), m.PDUSessionID())
+	}
+	var fieldErr *errors.FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Field != "args-mob-session" || fieldErr.Have != 0 {
+		t.Errorf("Unexpected truncated field: %v", err)
+	}
+
+	m, err = ParseMGTP4IPv6Dst(addr, 104, WithPartialDecode())
+	if !errors.As(err, &fieldErr) || fieldErr.Field != "ipv4" || fieldErr.Have != 24 {
+		t.Errorf("Unexpected truncated field: %v", err)
+	}
+	if m.IPv4() != netip.MustParseAddr("0.4.1.0") {
+		t.Errorf("Unexpected partial IPv4: %s", m.IPv4())
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import "sync"
+
+// BufferPool is a pool of 16 bytes buffers, to reduce GC pressure
+// when encoding addresses at high rate. BufferPool is safe for concurrent use.
+type BufferPool struct {
+	pool sync.Pool
+}
+
+// DefaultBufferPool is the BufferPool used by MarshalPooled and Release.
+var DefaultBufferPool = NewBufferPool()
+
+// NewBufferPool creates a new BufferPool.
+func NewBufferPool() *BufferPool {
+	return &BufferPool{
+		pool: sync.Pool{
+			New: func() any {
+				return new([16]byte)
+			},
+		},
+	}
+}
+
+// Get returns a 16 bytes buffer from the pool. Its content is undefined.
+func (p *BufferPool) Get() []byte {
+	return p.pool.Get().(*[16]byte)[:]
+}
+
+// Put returns a buffer obtained with Get to the pool.
+// The buffer must not be used after this call. Buffers of another size are ignored.
+func (p *BufferPool) Put(b []byte) {
+	if len(b) != 16 || cap(b) != 16 {
+		return
+	}
+	p.pool.Put((*[16]byte)(b))
+}
+
+// Release returns a buffer obtained with MarshalPooled to DefaultBufferPool.
+func Release(b []byte) {
+	DefaultBufferPool.Put(b)
+}
+
+// marshalPooled marshals into a buffer of DefaultBufferPool.
+func marshalPooled(marshalTo func([]byte) error) ([]byte, error) {
+	b := DefaultBufferPool.Get()
+	if err := marshalTo(b); err != nil {
+		DefaultBufferPool.Put(b)
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalPooled returns the byte sequence generated from MGTP4IPv6Dst in a buffer of DefaultBufferPool.
+// The buffer should be returned to the pool with Release once not used anymore.
+func (m *MGTP4IPv6Dst) MarshalPooled() ([]byte, error) {
+	return marshalPooled(m.MarshalTo)
+}
+
+// MarshalPooled returns the byte sequence generated from MGTP4IPv6Src in a buffer of DefaultBufferPool.
+// The buffer should be returned to the pool with Release once not used anymore.
+func (m *MGTP4IPv6Src) MarshalPooled() ([]byte, error) {
+	return marshalPooled(m.MarshalTo)
+}
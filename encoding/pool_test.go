@@ -0,0 +1,58 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func ExampleMGTP4IPv6Dst_MarshalPooled() {
+	dst := NewMGTP4IPv6Dst(netip.MustParsePrefix("3fff::/20"), netip.MustParseAddr("203.0.113.1").As4(), NewArgsMobSession(0, false, false, 1))
+	b, err := dst.MarshalPooled()
+	if err != nil {
+		return
+	}
+	defer Release(b)
+	// use b
+}
+
+func TestMarshalPooled(t *testing.T) {
+	dst := NewMGTP4IPv6Dst(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{10, 0, 4, 1}, NewArgsMobSession(1, false, false, 1))
+	src := NewMGTP4IPv6Src(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{10, 0, 4, 1}, 0x1234)
+	b, err := dst.MarshalPooled()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := netip.AddrFrom16([16]byte(b)); a != netip.MustParseAddr("fd00:1:1:a00:401:400:0:100") {
+		t.Errorf("Unexpected address: %s", a)
+	}
+	Release(b)
+	b, err = src.MarshalPooled()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := netip.AddrFrom16([16]byte(b)); a != netip.MustParseAddr("fd00:1:1:a00:401:1234:0:30") {
+		t.Errorf("Unexpected address: %s", a)
+	}
+	Release(b)
+
+	// warm up the cache
+	if _, err := dst.Addr(); err != nil {
+		t.Fatal(err)
+	}
+	allocs := testing.AllocsPerRun(100, func() {
+		b, err := dst.MarshalPooled()
+		if err != nil {
+			t.Fatal(err)
+		}
+		Release(b)
+	})
+	if allocs != 0 {
+		t.Errorf("MarshalPooled: %v allocations", allocs)
+	}
+	Release(make([]byte, 20))
+}
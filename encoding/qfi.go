@@ -0,0 +1,35 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"math/bits"
+
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+// QFI is a QoS Flow Identifier (6 bits).
+type QFI uint8
+
+// MaxQFI is the largest valid QFI.
+const MaxQFI QFI = qfiMask
+
+// NewQFI creates a QFI, checking it fits in 6 bits.
+func NewQFI(qfi uint8) (QFI, error) {
+	q := QFI(qfi)
+	if err := q.Validate(); err != nil {
+		return 0, err
+	}
+	return q, nil
+}
+
+// Validate checks the QFI fits in 6 bits.
+func (q QFI) Validate() error {
+	if q > MaxQFI {
+		return &errors.FieldError{Field: "qfi", Offset: 0, Need: uint(bits.Len8(uint8(q))), Have: qfiSizeBit, Err: errors.ErrOutOfRange}
+	}
+	return nil
+}
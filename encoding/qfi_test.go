@@ -0,0 +1,31 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"testing"
+
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+func TestQFI(t *testing.T) {
+	if q, err := NewQFI(63); err != nil || q != MaxQFI {
+		t.Errorf("Unexpected QFI: %d %v", q, err)
+	}
+	if _, err := NewQFI(64); !errors.Is(err, errors.ErrOutOfRange) {
+		t.Errorf("QFI out of range should be rejected: %v", err)
+	}
+	a, err := NewArgsMobSessionChecked(9, true, false, 0xCAFE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.QFI() != 9 || !a.R() || a.U() || a.PDUSessionID() != 0xCAFE {
+		t.Errorf("Unexpected ArgsMobSession: %d %t %t %x", a.QFI(), a.R(), a.U(), a.PDUSessionID())
+	}
+	if _, err := NewArgsMobSessionChecked(QFI(200), false, false, 1); !errors.Is(err, errors.ErrOutOfRange) {
+		t.Errorf("QFI out of range should be rejected: %v", err)
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"encoding/binary"
+	mbits "math/bits"
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/bitfield"
+	"github.com/nextmn/rfc9433/encoding/errors"
+	"github.com/nextmn/rfc9433/lpm"
+)
+
+// SrcEncodingScheme is a layout of the IPv6 SA used with End.M.GTP4.E.
+// User-defined layouts can be used by implementing this interface.
+type SrcEncodingScheme interface {
+	// MarshalTo puts the IPv6 SA in b (16 bytes).
+	MarshalTo(b []byte, prefix netip.Prefix, ipv4 [4]byte, udpPortNumber uint16) error
+	// Parse extracts the fields encoded in the IPv6 SA. udpPortNumber is 0 if not encoded.
+	Parse(addr [16]byte) (prefix netip.Prefix, ipv4 [4]byte, udpPortNumber uint16, err error)
+}
+
+// SrcSchemeNextMN is the NextMN layout of the IPv6 SA (see MGTP4IPv6Src).
+// The zero value uses the default position of the prefix length field (7 last bits);
+// use NewSrcSchemeNextMN to choose another position.
+type SrcSchemeNextMN struct {
+	prefixLenOffset uint // position of the prefix length field from the left in bits (0 means default)
+	prefixLenSize   uint // size of the prefix length field in bits (0 means default)
+}
+
+// NewSrcSchemeNextMN creates a SrcSchemeNextMN with the prefix length field
+// of size bits (from 1 to 8) at offset bits from the left of the IPv6 SA.
+// The prefix length field must be placed after the UDP Source Port field, which is checked by MarshalTo and Parse.
+func NewSrcSchemeNextMN(offset uint, size uint) (*SrcSchemeNextMN, error) {
+	if size == 0 || size > 8 || offset+size > 8*16 {
+		return nil, &errors.FieldError{Field: "prefix-length", Offset: offset, Need: size, Have: min(8, 8*16-min(offset, 8*16)), Err: errors.ErrOutOfRange}
+	}
+	return &SrcSchemeNextMN{
+		prefixLenOffset: offset,
+		prefixLenSize:   size,
+	}, nil
+}
+
+// prefixLenField returns the position and the size of the prefix length field.
+func (s SrcSchemeNextMN) prefixLenField() (offset uint, size uint) {
+	if s.prefixLenSize == 0 {
+		return 8*ipv6LenEncodingPosByte + 8 - ipv6LenEncodingPosBit - ipv6LenEncodingSizeBit, ipv6LenEncodingSizeBit
+	}
+	return s.prefixLenOffset, s.prefixLenSize
+}
+
+// MarshalTo puts the IPv6 SA in b.
+func (s SrcSchemeNextMN) MarshalTo(b []byte, prefix netip.Prefix, ipv4 [4]byte, udpPortNumber uint16) error {
+	if len(b) < 16 {
+		return &errors.FieldError{Field: "sid", Need: 8 * 16, Have: uint(8 * len(b)), Err: errors.ErrTooShortToMarshal}
+	}
+	// init b with prefix
+	p := prefix.Addr().As16()
+	copy(b, p[:])
+
+	var udp [2]byte
+	binary.BigEndian.PutUint16(udp[:], udpPortNumber)
+	bits := prefix.Bits()
+	if bits == -1 {
+		return errors.ErrPrefixLength
+	}
+	offset, size := s.prefixLenField()
+	if uint(bits)+8*4+16 > offset {
+		// Prefix is too big: IPv4 SA and UDP Port would overlap "IPv6 Prefix length"
+		return udpPortFieldError(uint(bits), offset)
+	}
+	if bits>>size != 0 {
+		// Prefix is too big: cannot be encoded in "IPv6 Prefix length"
+		return &errors.FieldError{Field: "prefix-length", Offset: offset, Need: uint(mbits.Len(uint(bits))), Have: size, Err: errors.ErrPrefixLength}
+	}
+
+	// add ipv4
+	if err := bitfield.InsertBytes(b[:16], uint(bits), ipv4[:]); err != nil {
+		return err
+	}
+	// add upd port
+	if err := bitfield.InsertBytes(b[:16], uint(bits+8*4), udp[:]); err != nil {
+		return err
+	}
+	// add prefix length
+	return bitfield.InsertBits((*[16]byte)(b[:16]), offset, size, uint64(bits))
+}
+
+// Parse extracts the fields encoded in the IPv6 SA.
+func (s SrcSchemeNextMN) Parse(addr [16]byte) (netip.Prefix, [4]byte, uint16, error) {
+	// Prefix length extraction
+	offset, size := s.prefixLenField()
+	l, err := bitfield.ExtractBits(addr, offset, size)
+	if err != nil {
+		return netip.Prefix{}, [4]byte{}, 0, err
+	}
+	prefixLen := uint(l)
+
+	prefix, ipv4, _, err := NewSrcSchemeRFC(prefixLen).Parse(addr)
+	if err != nil {
+		return netip.Prefix{}, [4]byte{}, 0, err
+	}
+
+	if prefixLen+8*4+16 > offset {
+		// Prefix is too big: no space for UDP Port and "IPv6 Prefix length"
+		return netip.Prefix{}, [4]byte{}, 0, udpPortFieldError(prefixLen, offset)
+	}
+	// udp port extraction
+	src, err := bitfield.ExtractBytes(addr, prefixLen+8*4, 2)
+	if err != nil {
+		return netip.Prefix{}, [4]byte{}, 0, err
+	}
+	return prefix, ipv4, binary.BigEndian.Uint16(src[:2]), nil
+}
+
+// SrcSchemeRFC is the plain RFC 9433 layout of the IPv6 SA (Figure 10).
+// Since the prefix length is not encoded in the IPv6 SA, it must be known in advance.
+type SrcSchemeRFC struct {
+	prefixLength uint
+}
+
+// NewSrcSchemeRFC creates a new SrcSchemeRFC for Source UPF Prefixes of the given length.
+func NewSrcSchemeRFC(prefixLength uint) *SrcSchemeRFC {
+	return &SrcSchemeRFC{
+		prefixLength: prefixLength,
+	}
+}
+
+// MarshalTo puts the IPv6 SA in b. The UDP Port Number is not encoded.
+func (s *SrcSchemeRFC) MarshalTo(b []byte, prefix netip.Prefix, ipv4 [4]byte, udpPortNumber uint16) error {
+	if len(b) < 16 {
+		return &errors.FieldError{Field: "sid", Need: 8 * 16, Have: uint(8 * len(b)), Err: errors.ErrTooShortToMarshal}
+	}
+	bits := prefix.Bits()
+	if bits == -1 || uint(bits) != s.prefixLength {
+		return errors.ErrPrefixLength
+	}
+	// init b with prefix
+	p := prefix.Addr().As16()
+	copy(b, p[:])
+
+	// add ipv4
+	if err := bitfield.InsertBytes(b[:16], uint(bits), ipv4[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Parse extracts the fields encoded in the IPv6 SA. The UDP Port Number is always 0.
+func (s *SrcSchemeRFC) Parse(addr [16]byte) (netip.Prefix, [4]byte, uint16, error) {
+	if s.prefixLength == 0 {
+		// even if globally routable IPv6 Prefix size cannot currently be less than 32 (per ICANN policy),
+		// nothing prevent the use of such prefix with ULA (fc00::/7)
+		// or, in the future, a prefix from a currently not yet allocated address block.
+		return netip.Prefix{}, [4]byte{}, 0, errors.ErrPrefixLength
+	}
+	if s.prefixLength+8*4 > 8*16 {
+		// Prefix is too big: no space for IPv4 Address
+		return netip.Prefix{}, [4]byte{}, 0, &errors.FieldError{Field: "ipv4", Offset: s.prefixLength, Need: 8 * 4, Have: 8*16 - min(s.prefixLength, 8*16), Err: errors.ErrOutOfRange}
+	}
+	// prefix extraction
+	prefix := netip.PrefixFrom(netip.AddrFrom16(addr), int(s.prefixLength)).Masked()
+
+	// ipv4 extraction
+	var ipv4 [4]byte
+	src, err := bitfield.ExtractBytes(addr, s.prefixLength, 4)
+	if err != nil {
+		return netip.Prefix{}, [4]byte{}, 0, err
+	}
+	copy(ipv4[:], src[:4])
+	return prefix, ipv4, 0, nil
+}
+
+// SrcSchemePrefixes is the plain RFC 9433 layout of the IPv6 SA (see SrcSchemeRFC)
+// for Source UPF Prefixes of various lengths: the prefix length is not encoded in the IPv6 SA,
+// and is found by a longest-prefix-match lookup in the known Source UPF Prefixes.
+// SrcSchemePrefixes is safe for concurrent use.
+type SrcSchemePrefixes struct {
+	prefixes *lpm.Table[struct{}]
+}
+
+// NewSrcSchemePrefixes creates a new SrcSchemePrefixes with the given Source UPF Prefixes.
+func NewSrcSchemePrefixes(prefixes ...netip.Prefix) (*SrcSchemePrefixes, error) {
+	s := &SrcSchemePrefixes{
+		prefixes: lpm.NewTable[struct{}](),
+	}
+	for _, p := range prefixes {
+		if err := s.Add(p); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Add adds a Source UPF Prefix.
+func (s *SrcSchemePrefixes) Add(prefix netip.Prefix) error {
+	if !prefix.Addr().Is6() || prefix.Bits()+8*4 > 8*16 {
+		return errors.ErrPrefixLength
+	}
+	return s.prefixes.Insert(prefix, struct{}{})
+}
+
+// Remove removes a Source UPF Prefix.
+func (s *SrcSchemePrefixes) Remove(prefix netip.Prefix) {
+	s.prefixes.Delete(prefix)
+}
+
+// MarshalTo puts the IPv6 SA in b. The prefix must be a known Source UPF Prefix.
+// The UDP Port Number is not encoded.
+func (s *SrcSchemePrefixes) MarshalTo(b []byte, prefix netip.Prefix, ipv4 [4]byte, udpPortNumber uint16) error {
+	if _, ok := s.prefixes.Get(prefix); !ok {
+		return errors.ErrPrefixLength
+	}
+	return NewSrcSchemeRFC(uint(prefix.Bits())).MarshalTo(b, prefix, ipv4, udpPortNumber)
+}
+
+// Parse extracts the fields encoded in the IPv6 SA, using the longest Source UPF Prefix matching it.
+// The UDP Port Number is always 0.
+func (s *SrcSchemePrefixes) Parse(addr [16]byte) (netip.Prefix, [4]byte, uint16, error) {
+	prefix, _, ok := s.prefixes.Lookup(netip.AddrFrom16(addr))
+	if !ok {
+		return netip.Prefix{}, [4]byte{}, 0, errors.ErrSIDMismatch
+	}
+	return NewSrcSchemeRFC(uint(prefix.Bits())).Parse(addr)
+}
+
+// SrcSchemeFlowLabel is the NextMN layout of the IPv6 SA without the UDP Source Port field:
+// load-balancing entropy is carried by the IPv6 Flow Label instead (see FlowLabel).
+//
+//	0                                                                          127
+//	+----------------------+--------+--------------------------+---------------+
+//	|  Source UPF Prefix   |IPv4 SA | any bit pattern(ignored) | Prefix length |
+//	+----------------------+--------+--------------------------+---------------+
+//	        128-a-b'-7    a (32 bits)              b'             7 bits
+type SrcSchemeFlowLabel struct{}
+
+// MarshalTo puts the IPv6 SA in b. The UDP Port Number is not encoded.
+func (SrcSchemeFlowLabel) MarshalTo(b []byte, prefix netip.Prefix, ipv4 [4]byte, udpPortNumber uint16) error {
+	if len(b) < 16 {
+		return &errors.FieldError{Field: "sid", Need: 8 * 16, Have: uint(8 * len(b)), Err: errors.ErrTooShortToMarshal}
+	}
+	bits := prefix.Bits()
+	if bits == -1 {
+		return errors.ErrPrefixLength
+	}
+	if uint(bits)+8*4+ipv6LenEncodingSizeBit > 8*16 {
+		return &errors.FieldError{Field: "ipv4", Offset: uint(bits), Need: 8 * 4, Have: 8*16 - ipv6LenEncodingSizeBit - uint(bits), Err: errors.ErrOutOfRange}
+	}
+	// init b with prefix
+	p := prefix.Addr().As16()
+	copy(b, p[:])
+
+	// add ipv4
+	if err := bitfield.InsertBytes(b[:16], uint(bits), ipv4[:]); err != nil {
+		return err
+	}
+	// add prefix length
+	b[ipv6LenEncodingPosByte] |= byte(bits)
+	return nil
+}
+
+// Parse extracts the fields encoded in the IPv6 SA. The UDP Port Number is always 0.
+func (SrcSchemeFlowLabel) Parse(addr [16]byte) (netip.Prefix, [4]byte, uint16, error) {
+	// Prefix length extraction
+	prefixLen := uint(ipv6LenEncodingMask & (addr[ipv6LenEncodingPosByte] >> ipv6LenEncodingPosBit))
+	if prefixLen+8*4+ipv6LenEncodingSizeBit > 8*16 {
+		// Prefix is too big: no space for "IPv6 Prefix length"
+		return netip.Prefix{}, [4]byte{}, 0, &errors.FieldError{Field: "ipv4", Offset: prefixLen, Need: 8 * 4, Have: 8*16 - ipv6LenEncodingSizeBit - prefixLen, Err: errors.ErrOutOfRange}
+	}
+	return NewSrcSchemeRFC(prefixLen).Parse(addr)
+}
+
+// udpPortFieldError reports an UDP Source Port field overlapping the prefix length field at offset.
+func udpPortFieldError(prefixLen uint, offset uint) error {
+	udpOffset := prefixLen + 8*4
+	return &errors.FieldError{Field: "udp-port", Offset: udpOffset, Need: 16, Have: offset - min(udpOffset, offset), Err: errors.ErrOutOfRange}
+}
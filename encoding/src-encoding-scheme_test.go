@@ -0,0 +1,160 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+// suffixScheme puts the IPv4 SA in the last 32 bits of the IPv6 SA.
+type suffixScheme struct{}
+
+func (suffixScheme) MarshalTo(b []byte, prefix netip.Prefix, ipv4 [4]byte, udpPortNumber uint16) error {
+	if prefix.Bits() != 96 {
+		return errors.ErrPrefixLength
+	}
+	p := prefix.Addr().As16()
+	copy(b, p[:12])
+	copy(b[12:], ipv4[:])
+	return nil
+}
+
+func (suffixScheme) Parse(addr [16]byte) (netip.Prefix, [4]byte, uint16, error) {
+	return netip.PrefixFrom(netip.AddrFrom16(addr), 96).Masked(), [4]byte(addr[12:]), 0, nil
+}
+
+func TestSrcEncodingScheme(t *testing.T) {
+	prefix := netip.MustParsePrefix("fd00:1:1::/48")
+	tests := []struct {
+		scheme SrcEncodingScheme
+		res    string
+	}{
+		{scheme: nil, res: "fd00:1:1:a00:401:1234:0:30"},
+		{scheme: SrcSchemeNextMN{}, res: "fd00:1:1:a00:401:1234:0:30"},
+		{scheme: NewSrcSchemeRFC(48), res: "fd00:1:1:a00:401::"},
+	}
+	for _, tc := range tests {
+		src := NewMGTP4IPv6SrcWithScheme(prefix, [4]byte{10, 0, 4, 1}, 0x1234, tc.scheme)
+		b, err := src.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a := netip.AddrFrom16([16]byte(b)); a != netip.MustParseAddr(tc.res) {
+			t.Errorf("Unexpected IPv6 SA: %s, expected %s", a, tc.res)
+		}
+		e, err := ParseMGTP4IPv6SrcWithScheme([16]byte(b), tc.scheme)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e.IPv4() != netip.MustParseAddr("10.0.4.1") {
+			t.Errorf("Cannot extract ipv4 correctly: %s", e.IPv4())
+		}
+	}
+
+	if _, err := NewMGTP4IPv6SrcWithScheme(prefix, [4]byte{10, 0, 4, 1}, 0, NewSrcSchemeRFC(64)).Marshal(); !errors.Is(err, errors.ErrPrefixLength) {
+		t.Errorf("Prefix length mismatch should be rejected: %v", err)
+	}
+
+	src := NewMGTP4IPv6SrcWithScheme(netip.MustParsePrefix("fd00:1:1::/96"), [4]byte{10, 0, 4, 1}, 0, suffixScheme{})
+	b, err := src.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(b, netip.MustParseAddr("fd00:1:1::a00:401").AsSlice()); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestSrcSchemeNextMNPrefixLenField(t *testing.T) {
+	scheme, err := NewSrcSchemeNextMN(96, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewMGTP4IPv6SrcWithScheme(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{10, 0, 4, 1}, 0x1234, scheme).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := netip.AddrFrom16([16]byte(b)); a != netip.MustParseAddr("fd00:1:1:a00:401:1234:3000:0") {
+		t.Errorf("Unexpected IPv6 SA: %s", a)
+	}
+	e, err := ParseMGTP4IPv6SrcWithScheme([16]byte(b), scheme)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.IPv4() != netip.MustParseAddr("10.0.4.1") || e.UDPPortNumber() != 0x1234 {
+		t.Errorf("Cannot parse IPv6 SA correctly: %s %x", e.IPv4(), e.UDPPortNumber())
+	}
+
+	_, err = NewMGTP4IPv6SrcWithScheme(netip.MustParsePrefix("fd00:1:1::/49"), [4]byte{10, 0, 4, 1}, 0x1234, scheme).Marshal()
+	if !errors.Is(err, errors.ErrOutOfRange) {
+		t.Errorf("Collision with the prefix length field should be rejected: %v", err)
+	}
+	var fieldErr *errors.FieldError
+	if !errors.As(err, &fieldErr) {
+		t.Fatalf("Expected a FieldError: %v", err)
+	}
+	if diff := cmp.Diff(*fieldErr, errors.FieldError{Field: "udp-port", Offset: 81, Need: 16, Have: 15, Err: errors.ErrOutOfRange}, cmpopts.EquateErrors()); diff != "" {
+		t.Error(diff)
+	}
+	if _, err := NewSrcSchemeNextMN(124, 7); !errors.Is(err, errors.ErrOutOfRange) {
+		t.Errorf("Field out of the IPv6 SA should be rejected: %v", err)
+	}
+	if _, err := NewSrcSchemeNextMN(100, 0); !errors.Is(err, errors.ErrOutOfRange) {
+		t.Errorf("Empty field should be rejected: %v", err)
+	}
+}
+
+func TestSrcSchemePrefixes(t *testing.T) {
+	s, err := NewSrcSchemePrefixes(netip.MustParsePrefix("fd00:1::/32"), netip.MustParsePrefix("fd00:1:1::/48"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		prefix string
+		res    string
+	}{
+		{prefix: "fd00:1::/32", res: "fd00:1:a00:401::"},
+		{prefix: "fd00:1:1::/48", res: "fd00:1:1:a00:401::"},
+	} {
+		b, err := NewMGTP4IPv6SrcWithScheme(netip.MustParsePrefix(tc.prefix), [4]byte{10, 0, 4, 1}, 0, s).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a := netip.AddrFrom16([16]byte(b)); a != netip.MustParseAddr(tc.res) {
+			t.Errorf("Unexpected IPv6 SA: %s, expected %s", a, tc.res)
+		}
+		e, err := ParseMGTP4IPv6SrcWithScheme([16]byte(b), s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e.Prefix() != netip.MustParsePrefix(tc.prefix) || e.IPv4() != netip.MustParseAddr("10.0.4.1") {
+			t.Errorf("Unexpected MGTP4IPv6Src: %s %s", e.Prefix(), e.IPv4())
+		}
+	}
+
+	if _, err := NewMGTP4IPv6SrcWithScheme(netip.MustParsePrefix("fd00:2::/32"), [4]byte{10, 0, 4, 1}, 0, s).Marshal(); !errors.Is(err, errors.ErrPrefixLength) {
+		t.Errorf("Unknown prefix should be rejected: %v", err)
+	}
+	if _, err := ParseMGTP4IPv6SrcWithScheme([16]byte(netip.MustParseAddr("fd00:2::").As16()), s); !errors.Is(err, errors.ErrSIDMismatch) {
+		t.Errorf("Unknown prefix should be rejected: %v", err)
+	}
+	if err := s.Add(netip.MustParsePrefix("fd00:1:1:1:1::/100")); !errors.Is(err, errors.ErrPrefixLength) {
+		t.Errorf("Prefix too long should be rejected: %v", err)
+	}
+	s.Remove(netip.MustParsePrefix("fd00:1:1::/48"))
+	e, err := ParseMGTP4IPv6SrcWithScheme([16]byte(netip.MustParseAddr("fd00:1:1:a00:401::").As16()), s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Prefix() != netip.MustParsePrefix("fd00:1::/32") {
+		t.Errorf("Unexpected prefix: %s", e.Prefix())
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import "fmt"
+
+// fields returns the human-readable fields of ArgsMobSession.
+func (a *ArgsMobSession) fields() string {
+	if a == nil {
+		return "args: <nil>"
+	}
+	return fmt.Sprintf("teid: 0x%08x, qfi: %d, r: %d, u: %d", a.pduSessionID, a.qfi, a.r, a.u)
+}
+
+// String implements fmt.Stringer.
+func (a *ArgsMobSession) String() string {
+	return "Args.Mob.Session{" + a.fields() + "}"
+}
+
+// String implements fmt.Stringer.
+func (m *MGTP4IPv6Dst) String() string {
+	return fmt.Sprintf("End.M.GTP4.E{prefix: %s, ipv4: %s, %s}", m.prefix, m.IPv4(), m.argsMobSession.fields())
+}
+
+// String implements fmt.Stringer.
+func (m *MGTP4IPv6Src) String() string {
+	return fmt.Sprintf("End.M.GTP4.E.SA{prefix: %s, ipv4: %s, udp: %d}", m.prefix, m.IPv4(), m.udp)
+}
+
+// String implements fmt.Stringer.
+func (m *MGTP6IPv6Dst) String() string {
+	return fmt.Sprintf("End.M.GTP6{prefix: %s, %s}", m.prefix, m.argsMobSession.fields())
+}
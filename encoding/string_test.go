@@ -0,0 +1,34 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"fmt"
+	"net/netip"
+	"testing"
+)
+
+func ExampleMGTP4IPv6Dst_String() {
+	dst := NewMGTP4IPv6Dst(netip.MustParsePrefix("3fff::/20"), netip.MustParseAddr("203.0.113.1").As4(), NewArgsMobSession(5, false, false, 1))
+	fmt.Println(dst)
+	// Output: End.M.GTP4.E{prefix: 3fff::/20, ipv4: 203.0.113.1, teid: 0x00000001, qfi: 5, r: 0, u: 0}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		v fmt.Stringer
+		s string
+	}{
+		{v: NewArgsMobSession(5, true, false, 0xcafe), s: "Args.Mob.Session{teid: 0x0000cafe, qfi: 5, r: 1, u: 0}"},
+		{v: NewMGTP4IPv6Src(netip.MustParsePrefix("3fff::/20"), [4]byte{203, 0, 113, 1}, 1337), s: "End.M.GTP4.E.SA{prefix: 3fff::/20, ipv4: 203.0.113.1, udp: 1337}"},
+		{v: NewMGTP6IPv6Dst(netip.MustParsePrefix("fd00:1:1::/48"), nil), s: "End.M.GTP6{prefix: fd00:1:1::/48, args: <nil>}"},
+	}
+	for _, tc := range tests {
+		if s := tc.v.String(); s != tc.s {
+			t.Errorf("Unexpected string: %q, expected %q", s, tc.s)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+// The canonical textual form of the encoding types is a space separated list of key=value fields, e.g.:
+//
+//	prefix=3fff::/20 ipv4=203.0.113.1 teid=0x1 qfi=5 r=0 u=0
+//
+// Available keys are:
+//   - prefix: IPv6 prefix,
+//   - ipv4: IPv4 address,
+//   - udp: UDP Port Number (decimal),
+//   - teid: PDU Session ID (hexadecimal with 0x prefix, or decimal),
+//   - qfi: QoS Flow Identifier (decimal),
+//   - r and u: bits of Args.Mob.Session (0 or 1).
+
+// textFields parses a canonical textual form.
+// Keys must be either required or optional; required keys must be present.
+func textFields(text []byte, required []string, optional []string) (map[string]string, error) {
+	fields := make(map[string]string)
+	for _, f := range strings.Fields(string(text)) {
+		k, v, ok := strings.Cut(f, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: field %q is not in key=value form", errors.ErrSyntax, f)
+		}
+		if _, ok := fields[k]; ok {
+			return nil, fmt.Errorf("%w: duplicate field %q", errors.ErrSyntax, k)
+		}
+		if !slices.Contains(required, k) && !slices.Contains(optional, k) {
+			return nil, fmt.Errorf("%w: unknown field %q", errors.ErrSyntax, k)
+		}
+		fields[k] = v
+	}
+	for _, k := range required {
+		if _, ok := fields[k]; !ok {
+			return nil, fmt.Errorf("%w: missing field %q", errors.ErrSyntax, k)
+		}
+	}
+	return fields, nil
+}
+
+// textUint parses an unsigned integer field (decimal, or hexadecimal with 0x prefix).
+func textUint(fields map[string]string, key string, bitSize int) (uint64, error) {
+	v, ok := fields[key]
+	if !ok {
+		return 0, nil
+	}
+	n, err := strconv.ParseUint(v, 0, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("%w: field %q: %w", errors.ErrSyntax, key, err)
+	}
+	return n, nil
+}
+
+// textPrefix parses an IPv6 prefix field.
+func textPrefix(fields map[string]string) (netip.Prefix, error) {
+	p, err := netip.ParsePrefix(fields["prefix"])
+	if err != nil || !p.Addr().Is6() {
+		return netip.Prefix{}, fmt.Errorf("%w: field %q: invalid IPv6 prefix", errors.ErrSyntax, "prefix")
+	}
+	return p, nil
+}
+
+// textIPv4 parses an IPv4 address field.
+func textIPv4(fields map[string]string) ([4]byte, error) {
+	a, err := netip.ParseAddr(fields["ipv4"])
+	if err != nil || !a.Is4() {
+		return [4]byte{}, fmt.Errorf("%w: field %q: invalid IPv4 address", errors.ErrSyntax, "ipv4")
+	}
+	return a.As4(), nil
+}
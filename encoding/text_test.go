@@ -0,0 +1,65 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"encoding"
+	goerrors "errors"
+	"testing"
+
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+type textCodec interface {
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+}
+
+func TestText(t *testing.T) {
+	tests := []struct {
+		v    textCodec
+		text string
+	}{
+		{v: &ArgsMobSession{}, text: "teid=0x1 qfi=5 r=1 u=0"},
+		{v: &MGTP4IPv6Dst{}, text: "prefix=3fff::/20 ipv4=203.0.113.1 teid=0xcafe qfi=5 r=0 u=1"},
+		{v: &MGTP6IPv6Dst{}, text: "prefix=fd00:1:1::/48 teid=0x1 qfi=63 r=0 u=0"},
+		{v: &MGTP4IPv6Src{}, text: "prefix=3fff::/20 ipv4=203.0.113.1 udp=1337"},
+	}
+	for _, tc := range tests {
+		if err := tc.v.UnmarshalText([]byte(tc.text)); err != nil {
+			t.Fatalf("UnmarshalText(%q): %v", tc.text, err)
+		}
+		text, err := tc.v.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(text) != tc.text {
+			t.Errorf("Round trip failed: %q, expected %q", text, tc.text)
+		}
+	}
+
+	dst := &MGTP4IPv6Dst{}
+	if err := dst.UnmarshalText([]byte("ipv4=203.0.113.1 teid=1 prefix=3fff::/20")); err != nil {
+		t.Fatal(err)
+	}
+	if text, _ := dst.MarshalText(); string(text) != "prefix=3fff::/20 ipv4=203.0.113.1 teid=0x1 qfi=0 r=0 u=0" {
+		t.Errorf("Unexpected canonical form: %q", text)
+	}
+
+	for _, text := range []string{
+		"prefix=3fff::/20 ipv4=203.0.113.1",
+		"prefix=3fff::/20 ipv4=203.0.113.1 teid=1 teid=2",
+		"prefix=3fff::/20 ipv4=203.0.113.1 teid=1 foo=2",
+		"prefix=3fff::/20 ipv4=203.0.113.1 teid=1 qfi=64",
+		"prefix=3fff::/20 ipv4=2001:db8::1 teid=1",
+		"prefix=10.0.0.0/8 ipv4=203.0.113.1 teid=1",
+		"prefix=3fff::/20 ipv4 teid=1",
+	} {
+		if err := dst.UnmarshalText([]byte(text)); !goerrors.Is(err, errors.ErrSyntax) {
+			t.Errorf("UnmarshalText(%q) should fail with syntax error: %v", text, err)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/encoding/errors"
+)
+
+// argsMobSessionView is the structured form of ArgsMobSession.
+type argsMobSessionView struct {
+	TEID uint32 `json:"teid" yaml:"teid"`
+	QFI  uint8  `json:"qfi" yaml:"qfi"`
+	R    bool   `json:"r" yaml:"r"`
+	U    bool   `json:"u" yaml:"u"`
+}
+
+// mgtp4IPv6DstView is the structured form of MGTP4IPv6Dst.
+type mgtp4IPv6DstView struct {
+	Prefix             netip.Prefix `json:"prefix" yaml:"prefix"`
+	IPv4               netip.Addr   `json:"ipv4" yaml:"ipv4"`
+	argsMobSessionView `yaml:",inline"`
+}
+
+// mgtp4IPv6SrcView is the structured form of MGTP4IPv6Src.
+type mgtp4IPv6SrcView struct {
+	Prefix  netip.Prefix `json:"prefix" yaml:"prefix"`
+	IPv4    netip.Addr   `json:"ipv4" yaml:"ipv4"`
+	UDPPort uint16       `json:"udpPort" yaml:"udpPort"`
+}
+
+// view returns the structured form of ArgsMobSession.
+func (a *ArgsMobSession) view() argsMobSessionView {
+	return argsMobSessionView{
+		TEID: a.PDUSessionID(),
+		QFI:  a.QFI(),
+		R:    a.R(),
+		U:    a.U(),
+	}
+}
+
+// argsMobSession returns an ArgsMobSession from its structured form.
+func (v argsMobSessionView) argsMobSession() (*ArgsMobSession, error) {
+	return NewArgsMobSessionBuilder().QFI(v.QFI).R(v.R).U(v.U).PDUSessionID(v.TEID).Build()
+}
+
+// view returns the structured form of MGTP4IPv6Dst.
+func (m *MGTP4IPv6Dst) view() mgtp4IPv6DstView {
+	return mgtp4IPv6DstView{
+		Prefix:             m.prefix,
+		IPv4:               m.IPv4(),
+		argsMobSessionView: m.argsMobSession.view(),
+	}
+}
+
+// mgtp4IPv6Dst returns a MGTP4IPv6Dst from its structured form.
+func (v mgtp4IPv6DstView) mgtp4IPv6Dst() (*MGTP4IPv6Dst, error) {
+	if !v.Prefix.IsValid() || !v.Prefix.Addr().Is6() {
+		return nil, errors.ErrPrefixLength
+	}
+	if !v.IPv4.Is4() {
+		return nil, errors.ErrInvalidAddress
+	}
+	a, err := v.argsMobSessionView.argsMobSession()
+	if err != nil {
+		return nil, err
+	}
+	return NewMGTP4IPv6Dst(v.Prefix, v.IPv4.As4(), a), nil
+}
+
+// view returns the structured form of MGTP4IPv6Src.
+func (m *MGTP4IPv6Src) view() mgtp4IPv6SrcView {
+	return mgtp4IPv6SrcView{
+		Prefix:  m.prefix,
+		IPv4:    m.IPv4(),
+		UDPPort: m.udp,
+	}
+}
+
+// mgtp4IPv6Src returns a MGTP4IPv6Src from its structured form, using the given encoding scheme.
+func (v mgtp4IPv6SrcView) mgtp4IPv6Src(scheme SrcEncodingScheme) (*MGTP4IPv6Src, error) {
+	if !v.Prefix.IsValid() || !v.Prefix.Addr().Is6() {
+		return nil, errors.ErrPrefixLength
+	}
+	if !v.IPv4.Is4() {
+		return nil, errors.ErrInvalidAddress
+	}
+	return NewMGTP4IPv6SrcWithScheme(v.Prefix, v.IPv4.As4(), v.UDPPort, scheme), nil
+}
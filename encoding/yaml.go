@@ -0,0 +1,69 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+// YAML support is provided without depending on a YAML library:
+// MarshalYAML and UnmarshalYAML follow the interfaces of gopkg.in/yaml.v2
+// (also supported by gopkg.in/yaml.v3 and github.com/goccy/go-yaml),
+// and use the same structured form as JSON.
+
+// MarshalYAML implements yaml.Marshaler.
+func (a *ArgsMobSession) MarshalYAML() (any, error) {
+	return a.view(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (a *ArgsMobSession) UnmarshalYAML(unmarshal func(any) error) error {
+	var v argsMobSessionView
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	r, err := v.argsMobSession()
+	if err != nil {
+		return err
+	}
+	a.set(r)
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (m *MGTP4IPv6Dst) MarshalYAML() (any, error) {
+	return m.view(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (m *MGTP4IPv6Dst) UnmarshalYAML(unmarshal func(any) error) error {
+	var v mgtp4IPv6DstView
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	r, err := v.mgtp4IPv6Dst()
+	if err != nil {
+		return err
+	}
+	m.set(r)
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// The encoding scheme and the ignored bits are not part of the structured form.
+func (m *MGTP4IPv6Src) MarshalYAML() (any, error) {
+	return m.view(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. The encoding scheme of m is kept.
+func (m *MGTP4IPv6Src) UnmarshalYAML(unmarshal func(any) error) error {
+	var v mgtp4IPv6SrcView
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	r, err := v.mgtp4IPv6Src(m.scheme)
+	if err != nil {
+		return err
+	}
+	m.set(r)
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package encoding
+
+import (
+	"encoding/json"
+	"net/netip"
+	"testing"
+)
+
+type yamlCodec interface {
+	MarshalYAML() (any, error)
+	UnmarshalYAML(unmarshal func(any) error) error
+}
+
+// TestYAML uses encoding/json to decode the structured form, as a YAML library would.
+func TestYAML(t *testing.T) {
+	tests := []struct {
+		v   yamlCodec
+		new yamlCodec
+	}{
+		{v: NewArgsMobSession(5, true, false, 1), new: &ArgsMobSession{}},
+		{v: NewMGTP4IPv6Dst(netip.MustParsePrefix("3fff::/20"), [4]byte{203, 0, 113, 1}, NewArgsMobSession(5, false, true, 0xcafe)), new: &MGTP4IPv6Dst{}},
+		{v: NewMGTP4IPv6Src(netip.MustParsePrefix("3fff::/20"), [4]byte{203, 0, 113, 1}, 1337), new: &MGTP4IPv6Src{}},
+	}
+	for _, tc := range tests {
+		view, err := tc.v.MarshalYAML()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := json.Marshal(view)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := tc.new.UnmarshalYAML(func(v any) error { return json.Unmarshal(data, v) }); err != nil {
+			t.Fatal(err)
+		}
+		view2, err := tc.new.MarshalYAML()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if view != view2 {
+			t.Errorf("Round trip failed: %v, expected %v", view2, view)
+		}
+	}
+}
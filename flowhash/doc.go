@@ -0,0 +1,9 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package flowhash provides keyed hashing of flows (SipHash-2-4),
+// used to derive load-balancing entropy such as UDP Source Ports and IPv6 Flow Labels.
+// Hashes are stable across restarts as long as the Key is unchanged.
+package flowhash
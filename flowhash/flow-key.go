@@ -0,0 +1,132 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package flowhash
+
+import (
+	"encoding/binary"
+	"net/netip"
+)
+
+// Protocols whose header starts with a Source Port and a Destination Port.
+const (
+	protoTCP     = 6
+	protoUDP     = 17
+	protoSCTP    = 132
+	protoUDPLite = 136
+)
+
+// IPv6 extension headers skipped to find the upper-layer protocol.
+const (
+	nhHopByHop = 0
+	nhRouting  = 43
+	nhFragment = 44
+	nhDestOpts = 60
+)
+
+const flowKeyLen = 16 + 16 + 1 + 2 + 2 // serial length of a FlowKey
+
+// FlowKey is the 5-tuple identifying the flow of an inner packet.
+// Ports are zero for protocols without ports, and for fragments.
+type FlowKey struct {
+	Src      netip.Addr
+	Dst      netip.Addr
+	Protocol uint8
+	SrcPort  uint16
+	DstPort  uint16
+}
+
+// ParseFlowKey returns the FlowKey of an IPv4 or IPv6 packet.
+func ParseFlowKey(pkt []byte) (FlowKey, error) {
+	if len(pkt) == 0 {
+		return FlowKey{}, ErrMalformedPacket
+	}
+	var k FlowKey
+	var l4 []byte
+	switch pkt[0] >> 4 {
+	case 4:
+		if len(pkt) < 20 {
+			return FlowKey{}, ErrMalformedPacket
+		}
+		ihl := 4 * int(pkt[0]&0x0F)
+		if ihl < 20 || len(pkt) < ihl {
+			return FlowKey{}, ErrMalformedPacket
+		}
+		k.Src = netip.AddrFrom4([4]byte(pkt[12:16]))
+		k.Dst = netip.AddrFrom4([4]byte(pkt[16:20]))
+		k.Protocol = pkt[9]
+		if binary.BigEndian.Uint16(pkt[6:8])&0x3FFF == 0 {
+			// not a fragment (MF flag and Fragment Offset are zero)
+			l4 = pkt[ihl:]
+		}
+	case 6:
+		if len(pkt) < 40 {
+			return FlowKey{}, ErrMalformedPacket
+		}
+		k.Src = netip.AddrFrom16([16]byte(pkt[8:24]))
+		k.Dst = netip.AddrFrom16([16]byte(pkt[24:40]))
+		nh, b := pkt[6], pkt[40:]
+	loop:
+		for {
+			switch nh {
+			case nhHopByHop, nhRouting, nhDestOpts:
+				if len(b) < 2 || len(b) < 8*(int(b[1])+1) {
+					return FlowKey{}, ErrMalformedPacket
+				}
+				nh, b = b[0], b[8*(int(b[1])+1):]
+			case nhFragment:
+				if len(b) < 8 {
+					return FlowKey{}, ErrMalformedPacket
+				}
+				nh, b = b[0], nil
+				break loop
+			default:
+				break loop
+			}
+		}
+		k.Protocol = nh
+		l4 = b
+	default:
+		return FlowKey{}, ErrMalformedPacket
+	}
+	switch k.Protocol {
+	case protoTCP, protoUDP, protoSCTP, protoUDPLite:
+		if len(l4) >= 4 {
+			k.SrcPort = binary.BigEndian.Uint16(l4[0:2])
+			k.DstPort = binary.BigEndian.Uint16(l4[2:4])
+		}
+	}
+	return k, nil
+}
+
+// Reverse returns the FlowKey of the other direction of the flow.
+func (k FlowKey) Reverse() FlowKey {
+	return FlowKey{
+		Src:      k.Dst,
+		Dst:      k.Src,
+		Protocol: k.Protocol,
+		SrcPort:  k.DstPort,
+		DstPort:  k.SrcPort,
+	}
+}
+
+// canonical returns the FlowKey of the direction whose source is the lowest endpoint,
+// so that both directions of a flow have the same canonical FlowKey.
+func (k FlowKey) canonical() FlowKey {
+	if c := k.Src.Compare(k.Dst); c > 0 || (c == 0 && k.SrcPort > k.DstPort) {
+		return k.Reverse()
+	}
+	return k
+}
+
+// marshalTo puts the FlowKey in b: IPv4 addresses are written as IPv4-mapped IPv6 addresses.
+func (k FlowKey) marshalTo(b *[flowKeyLen]byte) {
+	src, dst := k.Src.As16(), k.Dst.As16()
+	copy(b[0:16], src[:])
+	copy(b[16:32], dst[:])
+	b[32] = k.Protocol
+	binary.BigEndian.PutUint16(b[33:35], k.SrcPort)
+	binary.BigEndian.PutUint16(b[35:37], k.DstPort)
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package flowhash
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseFlowKey(t *testing.T) {
+	udp4 := []byte{
+		0x45, 0x00, 0x00, 0x1c,
+		0x00, 0x00, 0x40, 0x00,
+		0x40, 0x11, 0x00, 0x00,
+		10, 0, 0, 1,
+		10, 0, 0, 2,
+		0x04, 0xd2, 0x00, 0x35, // ports 1234 -> 53
+		0x00, 0x08, 0x00, 0x00,
+	}
+	fragment4 := append([]byte(nil), udp4...)
+	fragment4[6] = 0x20 // MF
+	tcp6 := append([]byte{
+		0x60, 0x00, 0x00, 0x00,
+		0x00, 0x1c, nhDestOpts, 64,
+		0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+		0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2,
+		protoTCP, 0, 1, 4, 0, 0, 0, 0, // Destination Options
+	}, make([]byte, 20)...)
+	tcp6[48], tcp6[49], tcp6[50], tcp6[51] = 0x1f, 0x90, 0x01, 0xbb // ports 8080 -> 443
+
+	for _, tc := range []struct {
+		name string
+		pkt  []byte
+		key  FlowKey
+	}{
+		{"udp4", udp4, FlowKey{netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("10.0.0.2"), protoUDP, 1234, 53}},
+		{"fragment4", fragment4, FlowKey{netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("10.0.0.2"), protoUDP, 0, 0}},
+		{"tcp6", tcp6, FlowKey{netip.MustParseAddr("2001:db8::1"), netip.MustParseAddr("2001:db8::2"), protoTCP, 8080, 443}},
+	} {
+		k, err := ParseFlowKey(tc.pkt)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if diff := cmp.Diff(k, tc.key, cmp.Comparer(func(a, b netip.Addr) bool { return a == b })); diff != "" {
+			t.Errorf("%s: %s", tc.name, diff)
+		}
+	}
+	for _, pkt := range [][]byte{nil, udp4[:19], {0x50}, tcp6[:44]} {
+		if _, err := ParseFlowKey(pkt); err != ErrMalformedPacket {
+			t.Errorf("Expected ErrMalformedPacket for %x, got %v", pkt, err)
+		}
+	}
+}
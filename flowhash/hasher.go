@@ -0,0 +1,81 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package flowhash
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+
+	"github.com/nextmn/rfc9433/encoding"
+)
+
+// Key is the secret key of a Hasher, which should be unique per node and kept in configuration,
+// so that hashes are stable across restarts. Its textual form is 32 hexadecimal digits.
+type Key [16]byte
+
+// MarshalText implements encoding.TextMarshaler.
+func (k Key) MarshalText() ([]byte, error) {
+	return []byte(hex.EncodeToString(k[:])), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (k *Key) UnmarshalText(text []byte) error {
+	if hex.DecodedLen(len(text)) != len(k) {
+		return ErrInvalidKey
+	}
+	var r Key
+	if _, err := hex.Decode(r[:], text); err != nil {
+		return ErrInvalidKey
+	}
+	*k = r
+	return nil
+}
+
+// Hasher hashes flows with SipHash-2-4. Hasher is safe for concurrent use.
+type Hasher struct {
+	k0, k1    uint64
+	symmetric bool
+}
+
+// New creates a Hasher. If symmetric is true, both directions of a flow have the same hash
+// (e.g. uplink and downlink packets of a PDU Session flow).
+func New(key Key, symmetric bool) *Hasher {
+	return &Hasher{
+		k0:        binary.LittleEndian.Uint64(key[:8]),
+		k1:        binary.LittleEndian.Uint64(key[8:]),
+		symmetric: symmetric,
+	}
+}
+
+// Sum64 returns the hash of b.
+func (h *Hasher) Sum64(b []byte) uint64 {
+	return sipHash24(h.k0, h.k1, b)
+}
+
+// Hash returns the hash of the flow.
+func (h *Hasher) Hash(k FlowKey) uint64 {
+	if h.symmetric {
+		k = k.canonical()
+	}
+	var b [flowKeyLen]byte
+	k.marshalTo(&b)
+	return h.Sum64(b[:])
+}
+
+// HashPacket returns the hash of the flow of an IPv4 or IPv6 packet.
+func (h *Hasher) HashPacket(pkt []byte) (uint64, error) {
+	k, err := ParseFlowKey(pkt)
+	if err != nil {
+		return 0, err
+	}
+	return h.Hash(k), nil
+}
+
+// FlowLabel returns a non-zero IPv6 Flow Label (RFC 6437) for the flow.
+func (h *Hasher) FlowLabel(k FlowKey) uint32 {
+	x := h.Hash(k)
+	return encoding.FlowLabel(uint32(x ^ x>>32))
+}
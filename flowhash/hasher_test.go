@@ -0,0 +1,76 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package flowhash
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestHasher(t *testing.T) {
+	k := FlowKey{
+		Src:      netip.MustParseAddr("10.0.0.2"),
+		Dst:      netip.MustParseAddr("10.0.0.1"),
+		Protocol: protoUDP,
+		SrcPort:  1234,
+		DstPort:  53,
+	}
+	h := New(Key{1}, false)
+	if h.Hash(k) != New(Key{1}, false).Hash(k) {
+		t.Error("Hash should be stable for a given key")
+	}
+	if h.Hash(k) == New(Key{2}, false).Hash(k) {
+		t.Error("Hash should depend on the key")
+	}
+	if h.Hash(k) == h.Hash(k.Reverse()) {
+		t.Error("Asymmetric hash should depend on the direction")
+	}
+	s := New(Key{1}, true)
+	if s.Hash(k) != s.Hash(k.Reverse()) {
+		t.Error("Symmetric hash should not depend on the direction")
+	}
+	same := FlowKey{Src: k.Src, Dst: k.Src, Protocol: protoUDP, SrcPort: 2, DstPort: 1}
+	if s.Hash(same) != s.Hash(same.Reverse()) {
+		t.Error("Symmetric hash should not depend on the direction with a single address")
+	}
+	if fl := h.FlowLabel(k); fl == 0 || fl > 0xFFFFF {
+		t.Errorf("Invalid Flow Label: %#x", fl)
+	}
+
+	pkt := []byte{
+		0x45, 0x00, 0x00, 0x1c,
+		0x00, 0x00, 0x40, 0x00,
+		0x40, 0x11, 0x00, 0x00,
+		10, 0, 0, 2,
+		10, 0, 0, 1,
+		0x04, 0xd2, 0x00, 0x35,
+		0x00, 0x08, 0x00, 0x00,
+	}
+	if v, err := h.HashPacket(pkt); err != nil || v != h.Hash(k) {
+		t.Errorf("Unexpected hash of the packet: %#x, %v", v, err)
+	}
+	if _, err := h.HashPacket(nil); err != ErrMalformedPacket {
+		t.Errorf("Expected ErrMalformedPacket, got %v", err)
+	}
+}
+
+func TestKeyText(t *testing.T) {
+	var k Key
+	if err := k.UnmarshalText([]byte("000102030405060708090a0b0c0d0e0f")); err != nil {
+		t.Fatal(err)
+	}
+	if k != (Key{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}) {
+		t.Errorf("Unexpected key: %x", k)
+	}
+	if text, err := k.MarshalText(); err != nil || string(text) != "000102030405060708090a0b0c0d0e0f" {
+		t.Errorf("Unexpected textual form: %s, %v", text, err)
+	}
+	for _, text := range []string{"", "0001", "zz0102030405060708090a0b0c0d0e0f"} {
+		if err := k.UnmarshalText([]byte(text)); err != ErrInvalidKey {
+			t.Errorf("Expected ErrInvalidKey for %q, got %v", text, err)
+		}
+	}
+}
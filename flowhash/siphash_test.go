@@ -0,0 +1,33 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package flowhash
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func TestSipHash24(t *testing.T) {
+	// test vectors of the reference implementation: key 00..0f, message 00..(n-1)
+	var key [16]byte
+	msg := make([]byte, 64)
+	for i := range key {
+		key[i] = byte(i)
+	}
+	for i := range msg {
+		msg[i] = byte(i)
+	}
+	k0 := binary.LittleEndian.Uint64(key[:8])
+	k1 := binary.LittleEndian.Uint64(key[8:])
+	for n, want := range map[int]uint64{
+		0:  0x726fdb47dd0e0e31,
+		15: 0xa129ca6149be45e5,
+	} {
+		if got := sipHash24(k0, k1, msg[:n]); got != want {
+			t.Errorf("SipHash-2-4 of %d bytes: %#x instead of %#x", n, got, want)
+		}
+	}
+}
@@ -1,5 +1,20 @@
 module github.com/nextmn/rfc9433
 
-go 1.22.7
+go 1.23.0
 
-require github.com/google/go-cmp v0.6.0 // indirect
+require (
+	github.com/google/go-cmp v0.6.0
+	github.com/google/gopacket v1.1.19
+	github.com/vishvananda/netlink v1.3.0
+	github.com/wmnsk/go-gtp v0.7.25
+	golang.org/x/sys v0.26.0
+	google.golang.org/grpc v1.67.1
+	google.golang.org/protobuf v1.35.1
+)
+
+require (
+	github.com/vishvananda/netns v0.0.4 // indirect
+	golang.org/x/net v0.28.0 // indirect
+	golang.org/x/text v0.17.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240814211410-ddb44dafa142 // indirect
+)
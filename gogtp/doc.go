@@ -0,0 +1,10 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package gogtp converts the GTPv2-C IEs of github.com/wmnsk/go-gtp (F-TEID and Bearer QoS)
+// to and from the F-TEID, Outer Header Creation and QFI of this module, and builds the Args.Mob.Session
+// and End.M.GTP4.E SIDs of the bearers, so that projects using go-gtp for signaling
+// can adopt the SRv6 encoding without manual field copying.
+package gogtp
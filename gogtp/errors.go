@@ -0,0 +1,13 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gogtp
+
+import "errors"
+
+var (
+	ErrNoIPv4  = errors.New("F-TEID has no IPv4 address")
+	ErrNotGTPU = errors.New("outer header is not GTP-U/UDP/IP")
+)
@@ -0,0 +1,61 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gogtp
+
+import (
+	"net"
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/pfcp"
+	"github.com/nextmn/rfc9433/session"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// FTEID decodes a F-TEID IE (3GPP TS 29.274, section 8.22).
+func FTEID(i *ie.IE) (*pfcp.FTEID, error) {
+	f, err := i.FullyQualifiedTEID()
+	if err != nil {
+		return nil, err
+	}
+	return &pfcp.FTEID{
+		TEID: f.TEIDGREKey,
+		IPv4: addr(f.IPv4Address),
+		IPv6: addr(f.IPv6Address),
+	}, nil
+}
+
+// NewFTEID creates a F-TEID IE of the given interface type (e.g. gtpv2.IFTypeS1USGWGTPU).
+func NewFTEID(ifType uint8, f *pfcp.FTEID) *ie.IE {
+	return ie.NewFullyQualifiedTEIDNetIP(ifType, f.TEID, ip(f.IPv4), ip(f.IPv6))
+}
+
+// Key returns the GTP-U tunnel endpoint of a F-TEID IE; the IPv4 address is used if both are present.
+func Key(i *ie.IE) (session.Key, error) {
+	f, err := FTEID(i)
+	if err != nil {
+		return session.Key{}, err
+	}
+	return f.Key()
+}
+
+// addr converts an IP address of go-gtp, which may be nil.
+func addr(a net.IP) netip.Addr {
+	if v := a.To4(); v != nil {
+		return netip.AddrFrom4([4]byte(v))
+	}
+	if v := a.To16(); v != nil {
+		return netip.AddrFrom16([16]byte(v))
+	}
+	return netip.Addr{}
+}
+
+// ip converts an IP address to go-gtp, which uses nil for absent addresses.
+func ip(a netip.Addr) net.IP {
+	if !a.IsValid() {
+		return nil
+	}
+	return a.AsSlice()
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gogtp
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/pfcp"
+	"github.com/nextmn/rfc9433/session"
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+var compareAddrs = cmp.Comparer(func(a, b netip.Addr) bool { return a == b })
+
+func TestFTEID(t *testing.T) {
+	for _, f := range []*pfcp.FTEID{
+		{TEID: 0x12345678, IPv4: netip.MustParseAddr("192.0.2.1")},
+		{TEID: 1, IPv6: netip.MustParseAddr("2001:db8::1")},
+		{TEID: 2, IPv4: netip.MustParseAddr("192.0.2.1"), IPv6: netip.MustParseAddr("2001:db8::1")},
+	} {
+		i := NewFTEID(gtpv2.IFTypeS1USGWGTPU, f)
+		if ifType, err := i.InterfaceType(); err != nil || ifType != gtpv2.IFTypeS1USGWGTPU {
+			t.Errorf("unexpected interface type: %d, %v", ifType, err)
+		}
+		// round trip through the wire format
+		b, err := i.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		i, err = ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := FTEID(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, f, compareAddrs); diff != "" {
+			t.Error(diff)
+		}
+	}
+	if _, err := FTEID(ie.NewEPSBearerID(5)); err == nil {
+		t.Error("F-TEID should not be decoded from another IE")
+	}
+}
+
+func TestKey(t *testing.T) {
+	k, err := Key(ie.NewFullyQualifiedTEID(gtpv2.IFTypeS1UeNodeBGTPU, 7, "192.0.2.1", "2001:db8::1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(k, session.Key{Peer: netip.MustParseAddr("192.0.2.1"), TEID: 7}, compareAddrs); diff != "" {
+		t.Error(diff)
+	}
+}
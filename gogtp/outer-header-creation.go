@@ -0,0 +1,52 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gogtp
+
+import (
+	"github.com/nextmn/rfc9433/pfcp"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// OuterHeaderCreation returns the GTP-U/UDP/IP outer header of the packets sent to the remote F-TEID
+// of a F-TEID IE (e.g. the S1-U eNodeB F-TEID); GTP-U/UDP/IPv4 and GTP-U/UDP/IPv6 are both described
+// if the F-TEID has both addresses.
+func OuterHeaderCreation(i *ie.IE) (*pfcp.OuterHeaderCreation, error) {
+	f, err := FTEID(i)
+	if err != nil {
+		return nil, err
+	}
+	o := &pfcp.OuterHeaderCreation{
+		TEID: f.TEID,
+		IPv4: f.IPv4,
+		IPv6: f.IPv6,
+	}
+	if f.IPv4.IsValid() {
+		o.Description |= pfcp.OuterHeaderCreationGTPUUDPIPv4
+	}
+	if f.IPv6.IsValid() {
+		o.Description |= pfcp.OuterHeaderCreationGTPUUDPIPv6
+	}
+	if !o.GTPU() {
+		return nil, ErrNotGTPU
+	}
+	return o, nil
+}
+
+// NewOuterHeaderCreationFTEID creates the F-TEID IE of the given interface type,
+// whose address and TEID are the ones of a GTP-U/UDP/IP outer header.
+func NewOuterHeaderCreationFTEID(ifType uint8, o *pfcp.OuterHeaderCreation) (*ie.IE, error) {
+	if !o.GTPU() {
+		return nil, ErrNotGTPU
+	}
+	f := &pfcp.FTEID{TEID: o.TEID}
+	if o.Description&pfcp.OuterHeaderCreationGTPUUDPIPv4 != 0 {
+		f.IPv4 = o.IPv4
+	}
+	if o.Description&pfcp.OuterHeaderCreationGTPUUDPIPv6 != 0 {
+		f.IPv6 = o.IPv6
+	}
+	return NewFTEID(ifType, f), nil
+}
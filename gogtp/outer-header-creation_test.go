@@ -0,0 +1,48 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gogtp
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/pfcp"
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+func TestOuterHeaderCreation(t *testing.T) {
+	o, err := OuterHeaderCreation(ie.NewFullyQualifiedTEID(gtpv2.IFTypeS1UeNodeBGTPU, 7, "192.0.2.1", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pfcp.OuterHeaderCreation{
+		Description: pfcp.OuterHeaderCreationGTPUUDPIPv4,
+		TEID:        7,
+		IPv4:        netip.MustParseAddr("192.0.2.1"),
+	}
+	if diff := cmp.Diff(o, want, compareAddrs); diff != "" {
+		t.Error(diff)
+	}
+	if _, err := OuterHeaderCreation(ie.NewFullyQualifiedTEID(gtpv2.IFTypeS1UeNodeBGTPU, 7, "", "")); !errors.Is(err, ErrNotGTPU) {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// the address not described is ignored
+	want.IPv6 = netip.MustParseAddr("2001:db8::1")
+	i, err := NewOuterHeaderCreationFTEID(gtpv2.IFTypeS1UeNodeBGTPU, want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i.HasIPv6() {
+		t.Error("IPv6 address should not be set")
+	}
+	if _, err := NewOuterHeaderCreationFTEID(gtpv2.IFTypeS1UeNodeBGTPU, &pfcp.OuterHeaderCreation{}); !errors.Is(err, ErrNotGTPU) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gogtp
+
+import (
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// QFI returns the QFI of a bearer from its Bearer QoS or Flow QoS IE (3GPP TS 29.274, sections 8.15 and 8.16).
+// The QFI is the 5QI of the QoS flow, and standardized QCIs have the same value as their 5QI
+// (3GPP TS 23.501, sections 5.7.1.1 and 5.7.4).
+func QFI(i *ie.IE) (encoding.QFI, error) {
+	qci, err := i.QCILabel()
+	if err != nil {
+		return 0, err
+	}
+	return encoding.NewQFI(qci)
+}
+
+// ArgsMobSession returns the Args.Mob.Session of a bearer, whose PDU Session ID is the TEID of the F-TEID IE,
+// and whose QFI is the one of the Bearer QoS or Flow QoS IE.
+func ArgsMobSession(fteid *ie.IE, qos *ie.IE) (*encoding.ArgsMobSession, error) {
+	f, err := FTEID(fteid)
+	if err != nil {
+		return nil, err
+	}
+	qfi, err := QFI(qos)
+	if err != nil {
+		return nil, err
+	}
+	return encoding.NewArgsMobSessionChecked(qfi, false, false, f.TEID)
+}
+
+// MGTP4IPv6Dst returns the End.M.GTP4.E SID of a bearer, whose IPv4 DA is the IPv4 address of the F-TEID IE.
+func MGTP4IPv6Dst(prefix netip.Prefix, fteid *ie.IE, qos *ie.IE) (*encoding.MGTP4IPv6Dst, error) {
+	f, err := FTEID(fteid)
+	if err != nil {
+		return nil, err
+	}
+	if !f.IPv4.IsValid() {
+		return nil, ErrNoIPv4
+	}
+	a, err := ArgsMobSession(fteid, qos)
+	if err != nil {
+		return nil, err
+	}
+	return encoding.NewMGTP4IPv6DstFromAddr(prefix, f.IPv4, a)
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gogtp
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+func TestQFI(t *testing.T) {
+	if qfi, err := QFI(ie.NewBearerQoS(1, 2, 1, 9, 0, 0, 0, 0)); err != nil || qfi != 9 {
+		t.Errorf("unexpected QFI: %d, %v", qfi, err)
+	}
+	if qfi, err := QFI(ie.NewFlowQoS(5, 0, 0, 0, 0)); err != nil || qfi != 5 {
+		t.Errorf("unexpected QFI: %d, %v", qfi, err)
+	}
+	// QCIs from 128 are operator specific, and do not fit in a QFI
+	if _, err := QFI(ie.NewBearerQoS(1, 2, 1, 128, 0, 0, 0, 0)); err == nil {
+		t.Error("QCI 128 should not be a QFI")
+	}
+}
+
+func TestMGTP4IPv6Dst(t *testing.T) {
+	fteid := ie.NewFullyQualifiedTEID(gtpv2.IFTypeS1UeNodeBGTPU, 0x12345678, "192.0.2.1", "")
+	qos := ie.NewBearerQoS(1, 2, 1, 9, 0, 0, 0, 0)
+	prefix := netip.MustParsePrefix("2001:db8::/48")
+	got, err := MGTP4IPv6Dst(prefix, fteid, qos)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := encoding.NewMGTP4IPv6DstFromAddr(prefix, netip.MustParseAddr("192.0.2.1"), encoding.NewArgsMobSession(9, false, false, 0x12345678))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotAddr, err := got.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantAddr, err := want.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(gotAddr, wantAddr); diff != "" {
+		t.Error(diff)
+	}
+	if _, err := MGTP4IPv6Dst(prefix, ie.NewFullyQualifiedTEID(gtpv2.IFTypeS1UeNodeBGTPU, 1, "", "2001:db8::1"), qos); err == nil {
+		t.Error("F-TEID without IPv4 address should be rejected")
+	}
+}
@@ -0,0 +1,10 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package gopacketlayers provides github.com/google/gopacket layers for SRv6 MUP packets:
+// the Segment Routing Header (SRH), and the IPv6 header of packets to an End.M.GTP4.E SID (MGTP4IPv6),
+// whose SA is a NextMN source address. Packets can be crafted with gopacket.SerializeLayers,
+// and decoded with gopacket.NewPacket or, without allocating, with a gopacket.DecodingLayerParser.
+package gopacketlayers
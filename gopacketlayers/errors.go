@@ -0,0 +1,14 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gopacketlayers
+
+import "errors"
+
+var (
+	ErrTooLong         = errors.New("payload is too long")
+	ErrTooShortToParse = errors.New("too short to parse")
+	ErrInvalidVersion  = errors.New("not an IPv6 packet")
+)
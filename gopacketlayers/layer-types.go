@@ -0,0 +1,15 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gopacketlayers
+
+import "github.com/google/gopacket"
+
+var (
+	// LayerTypeSRH is the LayerType of SRH.
+	LayerTypeSRH = gopacket.RegisterLayerType(9433, gopacket.LayerTypeMetadata{Name: "SRH", Decoder: gopacket.DecodeFunc(decodeSRH)})
+	// LayerTypeMGTP4IPv6 is the LayerType of MGTP4IPv6.
+	LayerTypeMGTP4IPv6 = gopacket.RegisterLayerType(9434, gopacket.LayerTypeMetadata{Name: "MGTP4IPv6", Decoder: gopacket.DecodeFunc(decodeMGTP4IPv6)})
+)
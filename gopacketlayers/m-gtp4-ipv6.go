@@ -0,0 +1,150 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gopacketlayers
+
+import (
+	"encoding/binary"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/nextmn/rfc9433/encoding"
+)
+
+// ipv6HeaderLen is the length of the IPv6 header without extension headers.
+const ipv6HeaderLen = 40
+
+// MGTP4IPv6 is a gopacket layer of the IPv6 header of a packet to an End.M.GTP4.E SID (RFC 9433, section 6.6),
+// whose SA is a NextMN source address carrying the IPv4 SA and the UDP Source Port of the GTP-U packet.
+// Addresses are kept in their binary form: use SetDst and SetSrc to encode them, Dst and Src to decode them.
+// DstPrefixLen is not carried by the packet, and must be set before decoding DstIP.
+type MGTP4IPv6 struct {
+	layers.BaseLayer
+	TrafficClass uint8
+	FlowLabel    uint32
+	Length       uint16 // payload length, computed when serializing with FixLengths
+	NextHeader   layers.IPProtocol
+	HopLimit     uint8
+	SrcIP        [16]byte // NextMN source address
+	DstIP        [16]byte // End.M.GTP4.E SID
+	DstPrefixLen uint     // length of the prefix of the End.M.GTP4.E SID
+}
+
+// SetDst sets DstIP and DstPrefixLen to the End.M.GTP4.E SID.
+func (l *MGTP4IPv6) SetDst(dst *encoding.MGTP4IPv6Dst) error {
+	b, err := dst.Marshal()
+	if err != nil {
+		return err
+	}
+	l.DstIP = [16]byte(b)
+	l.DstPrefixLen = uint(dst.PrefixLen())
+	return nil
+}
+
+// SetSrc sets SrcIP to the source address, and FlowLabel to the Flow Label carrying its UDP Port Number.
+func (l *MGTP4IPv6) SetSrc(src *encoding.MGTP4IPv6Src) error {
+	b, err := src.Marshal()
+	if err != nil {
+		return err
+	}
+	l.SrcIP = [16]byte(b)
+	l.FlowLabel = src.FlowLabel()
+	return nil
+}
+
+// Dst parses DstIP as an End.M.GTP4.E SID whose prefix has a length of DstPrefixLen.
+func (l *MGTP4IPv6) Dst(opts ...encoding.ParseOption) (*encoding.MGTP4IPv6Dst, error) {
+	return encoding.ParseMGTP4IPv6Dst(l.DstIP, l.DstPrefixLen, opts...)
+}
+
+// Src parses SrcIP as a NextMN source address.
+func (l *MGTP4IPv6) Src() (*encoding.MGTP4IPv6Src, error) {
+	return encoding.ParseMGTP4IPv6SrcNextMN(l.SrcIP)
+}
+
+// LayerType returns LayerTypeMGTP4IPv6.
+func (l *MGTP4IPv6) LayerType() gopacket.LayerType {
+	return LayerTypeMGTP4IPv6
+}
+
+// SerializeTo writes the IPv6 header to b, before the payload already in b.
+func (l *MGTP4IPv6) SerializeTo(b gopacket.SerializeBuffer, opts gopacket.SerializeOptions) error {
+	if opts.FixLengths {
+		n := len(b.Bytes())
+		if n > 0xFFFF {
+			return ErrTooLong
+		}
+		l.Length = uint16(n)
+	}
+	bytes, err := b.PrependBytes(ipv6HeaderLen)
+	if err != nil {
+		return err
+	}
+	binary.BigEndian.PutUint32(bytes[0:4], 6<<28|uint32(l.TrafficClass)<<20|l.FlowLabel&0xFFFFF)
+	binary.BigEndian.PutUint16(bytes[4:6], l.Length)
+	bytes[6] = uint8(l.NextHeader)
+	bytes[7] = l.HopLimit
+	copy(bytes[8:24], l.SrcIP[:])
+	copy(bytes[24:40], l.DstIP[:])
+	return nil
+}
+
+// DecodeFromBytes decodes the IPv6 header. DstPrefixLen is not modified.
+func (l *MGTP4IPv6) DecodeFromBytes(data []byte, df gopacket.DecodeFeedback) error {
+	if len(data) < ipv6HeaderLen {
+		df.SetTruncated()
+		return ErrTooShortToParse
+	}
+	if data[0]>>4 != 6 {
+		return ErrInvalidVersion
+	}
+	l.TrafficClass = data[0]<<4 | data[1]>>4
+	l.FlowLabel = binary.BigEndian.Uint32(data[0:4]) & 0xFFFFF
+	l.Length = binary.BigEndian.Uint16(data[4:6])
+	l.NextHeader = layers.IPProtocol(data[6])
+	l.HopLimit = data[7]
+	l.SrcIP = [16]byte(data[8:24])
+	l.DstIP = [16]byte(data[24:40])
+	end := ipv6HeaderLen + int(l.Length)
+	if end > len(data) {
+		df.SetTruncated()
+		end = len(data)
+	}
+	l.Contents, l.Payload = data[:ipv6HeaderLen], data[ipv6HeaderLen:end]
+	return nil
+}
+
+// CanDecode returns LayerTypeMGTP4IPv6.
+func (l *MGTP4IPv6) CanDecode() gopacket.LayerClass {
+	return LayerTypeMGTP4IPv6
+}
+
+// NextLayerType returns LayerTypeSRH if the Next Header is a Routing Header,
+// and the LayerType of the Next Header otherwise.
+func (l *MGTP4IPv6) NextLayerType() gopacket.LayerType {
+	if l.NextHeader == layers.IPProtocolIPv6Routing {
+		return LayerTypeSRH
+	}
+	return l.NextHeader.LayerType()
+}
+
+// NetworkFlow returns the flow of the IPv6 addresses.
+func (l *MGTP4IPv6) NetworkFlow() gopacket.Flow {
+	return gopacket.NewFlow(layers.EndpointIPv6, l.SrcIP[:], l.DstIP[:])
+}
+
+// decodeMGTP4IPv6 decodes an IPv6 header with a NextMN SA and an End.M.GTP4.E SID as DA, and its payload.
+func decodeMGTP4IPv6(data []byte, p gopacket.PacketBuilder) error {
+	l := &MGTP4IPv6{}
+	if err := l.DecodeFromBytes(data, p); err != nil {
+		return err
+	}
+	p.AddLayer(l)
+	p.SetNetworkLayer(l)
+	if l.NextHeader == layers.IPProtocolIPv6Routing {
+		return p.NextDecoder(LayerTypeSRH)
+	}
+	return p.NextDecoder(l.NextHeader)
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gopacketlayers
+
+import (
+	"errors"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/srh"
+)
+
+var (
+	testDst = encoding.NewMGTP4IPv6Dst(netip.MustParsePrefix("2001:db8::/32"), [4]byte{203, 0, 113, 1}, encoding.NewArgsMobSession(5, false, false, 0xcafe))
+	testSrc = encoding.NewMGTP4IPv6Src(netip.MustParsePrefix("2001:db8:1::/48"), [4]byte{198, 51, 100, 1}, 2152)
+)
+
+// buildPacket returns an SRv6 packet to testDst from testSrc, with a SRH and an IPv4/UDP payload.
+func buildPacket(t *testing.T) ([]byte, *MGTP4IPv6, *SRH) {
+	t.Helper()
+	ip := &MGTP4IPv6{TrafficClass: 0xb8, NextHeader: layers.IPProtocolIPv6Routing, HopLimit: 64}
+	if err := ip.SetDst(testDst); err != nil {
+		t.Fatal(err)
+	}
+	if err := ip.SetSrc(testSrc); err != nil {
+		t.Fatal(err)
+	}
+	segment := [16]byte{0x20, 0x01, 0x0d, 0xb8, 0xff, 15: 1}
+	s := &SRH{SRH: *srh.NewSRH(uint8(layers.IPProtocolIPv4), [][16]byte{segment, ip.DstIP})}
+	inner := &layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolUDP, SrcIP: net.IP{10, 45, 0, 1}, DstIP: net.IP{10, 45, 0, 2}}
+	udp := &layers.UDP{SrcPort: 1234, DstPort: 5678}
+	if err := udp.SetNetworkLayerForChecksum(inner); err != nil {
+		t.Fatal(err)
+	}
+	b := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	if err := gopacket.SerializeLayers(b, opts, ip, s, inner, udp, gopacket.Payload{1, 2, 3, 4}); err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes(), ip, s
+}
+
+func TestMGTP4IPv6SerializeTo(t *testing.T) {
+	dst, src := testDst, testSrc
+	pkt, ip, s := buildPacket(t)
+
+	// decoded by the IPv6 layer of gopacket, which does not support SRH
+	ip6 := &layers.IPv6{}
+	if err := ip6.DecodeFromBytes(pkt, gopacket.NilDecodeFeedback); err != nil {
+		t.Fatal(err)
+	}
+	if ip6.TrafficClass != 0xb8 || ip6.FlowLabel != src.FlowLabel() || int(ip6.Length) != len(pkt)-ipv6HeaderLen || ip6.HopLimit != 64 {
+		t.Errorf("Unexpected IPv6 header: %+v", ip6)
+	}
+	if netip.AddrFrom16([16]byte(ip6.DstIP)) != netip.AddrFrom16(ip.DstIP) || netip.AddrFrom16([16]byte(ip6.SrcIP)) != netip.AddrFrom16(ip.SrcIP) {
+		t.Errorf("Unexpected addresses: %s %s", ip6.SrcIP, ip6.DstIP)
+	}
+	s2, err := srh.Parse(ip6.Payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(s2, &s.SRH); diff != "" {
+		t.Error(diff)
+	}
+	p := gopacket.NewPacket(ip6.Payload[s2.MarshalLen():], layers.LayerTypeIPv4, gopacket.Default)
+	if p.ErrorLayer() != nil || p.Layer(layers.LayerTypeUDP) == nil {
+		t.Errorf("Inner packet should be decoded: %s", p)
+	}
+
+	// addresses are decoded back
+	if d, err := ip.Dst(); err != nil || !d.Equal(dst) {
+		t.Errorf("Unexpected End.M.GTP4.E SID: %s, %v", d, err)
+	}
+	if s, err := ip.Src(); err != nil || !s.Equal(src) {
+		t.Errorf("Unexpected source address: %s, %v", s, err)
+	}
+}
+
+func TestMGTP4IPv6TooLong(t *testing.T) {
+	b := gopacket.NewSerializeBuffer()
+	err := gopacket.SerializeLayers(b, gopacket.SerializeOptions{FixLengths: true}, &MGTP4IPv6{}, gopacket.Payload(make([]byte, 0x10000)))
+	if !errors.Is(err, ErrTooLong) {
+		t.Errorf("Expected ErrTooLong, got %v", err)
+	}
+}
+
+func TestMGTP4IPv6DecodingLayerParser(t *testing.T) {
+	pkt, ip, s := buildPacket(t)
+	var (
+		ip2     MGTP4IPv6
+		s2      SRH
+		inner   layers.IPv4
+		udp     layers.UDP
+		payload gopacket.Payload
+	)
+	ip2.DstPrefixLen = ip.DstPrefixLen
+	parser := gopacket.NewDecodingLayerParser(LayerTypeMGTP4IPv6, &ip2, &s2, &inner, &udp, &payload)
+	decoded := make([]gopacket.LayerType, 0, 5)
+	if err := parser.DecodeLayers(pkt, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(decoded, []gopacket.LayerType{LayerTypeMGTP4IPv6, LayerTypeSRH, layers.LayerTypeIPv4, layers.LayerTypeUDP, gopacket.LayerTypePayload}); diff != "" {
+		t.Error(diff)
+	}
+	if ip2.TrafficClass != ip.TrafficClass || ip2.FlowLabel != ip.FlowLabel || int(ip2.Length) != len(pkt)-ipv6HeaderLen ||
+		ip2.HopLimit != ip.HopLimit || ip2.SrcIP != ip.SrcIP || ip2.DstIP != ip.DstIP {
+		t.Errorf("Unexpected IPv6 header: %+v", ip2)
+	}
+	if dst, err := ip2.Dst(); err != nil || !dst.Equal(testDst) {
+		t.Errorf("Unexpected End.M.GTP4.E SID: %s, %v", dst, err)
+	}
+	if diff := cmp.Diff(s2.SRH, s.SRH); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff([]byte(payload), []byte{1, 2, 3, 4}); diff != "" {
+		t.Error(diff)
+	}
+	if n := testing.AllocsPerRun(100, func() { parser.DecodeLayers(pkt, &decoded) }); n != 0 {
+		t.Errorf("DecodeLayers should not allocate: %v allocations", n)
+	}
+}
+
+func TestMGTP4IPv6NewPacket(t *testing.T) {
+	pkt, ip, _ := buildPacket(t)
+	p := gopacket.NewPacket(pkt, LayerTypeMGTP4IPv6, gopacket.Default)
+	if err := p.ErrorLayer(); err != nil {
+		t.Fatal(err.Error())
+	}
+	if p.Layer(LayerTypeSRH) == nil || p.Layer(layers.LayerTypeUDP) == nil {
+		t.Errorf("Unexpected packet: %s", p)
+	}
+	if f := p.NetworkLayer().NetworkFlow(); f != gopacket.NewFlow(layers.EndpointIPv6, ip.SrcIP[:], ip.DstIP[:]) {
+		t.Errorf("Unexpected flow: %s", f)
+	}
+}
+
+func TestMGTP4IPv6DecodeErrors(t *testing.T) {
+	pkt, _, _ := buildPacket(t)
+	var ip MGTP4IPv6
+	if err := ip.DecodeFromBytes(pkt[:ipv6HeaderLen-1], gopacket.NilDecodeFeedback); !errors.Is(err, ErrTooShortToParse) {
+		t.Errorf("Expected ErrTooShortToParse, got %v", err)
+	}
+	if err := ip.DecodeFromBytes(append([]byte{0x40}, pkt[1:]...), gopacket.NilDecodeFeedback); !errors.Is(err, ErrInvalidVersion) {
+		t.Errorf("Expected ErrInvalidVersion, got %v", err)
+	}
+	// truncated payload
+	p := gopacket.NewPacket(pkt[:ipv6HeaderLen+8], LayerTypeMGTP4IPv6, gopacket.Default)
+	if !p.Metadata().Truncated {
+		t.Error("Packet should be truncated")
+	}
+	if ip := p.Layer(LayerTypeMGTP4IPv6).(*MGTP4IPv6); len(ip.Payload) != 8 {
+		t.Errorf("Unexpected payload length: %d", len(ip.Payload))
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gopacketlayers
+
+import (
+	"errors"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/nextmn/rfc9433/srh"
+)
+
+// SRH is a gopacket layer of a Segment Routing Header.
+// Hdr Ext Len and Last Entry are always computed when serializing.
+// Once decoded, TLVs references the decoded bytes.
+type SRH struct {
+	layers.BaseLayer
+	srh.SRH
+}
+
+// LayerType returns LayerTypeSRH.
+func (s *SRH) LayerType() gopacket.LayerType {
+	return LayerTypeSRH
+}
+
+// SerializeTo writes the SRH to b, before the payload already in b.
+func (s *SRH) SerializeTo(b gopacket.SerializeBuffer, opts gopacket.SerializeOptions) error {
+	bytes, err := b.PrependBytes(s.MarshalLen())
+	if err != nil {
+		return err
+	}
+	return s.MarshalTo(bytes)
+}
+
+// DecodeFromBytes decodes the SRH, reusing the segment list of s.
+func (s *SRH) DecodeFromBytes(data []byte, df gopacket.DecodeFeedback) error {
+	if err := s.Decode(data); err != nil {
+		if errors.Is(err, srh.ErrTooShortToParse) {
+			df.SetTruncated()
+		}
+		return err
+	}
+	l := s.MarshalLen()
+	s.Contents, s.Payload = data[:l], data[l:]
+	return nil
+}
+
+// CanDecode returns LayerTypeSRH.
+func (s *SRH) CanDecode() gopacket.LayerClass {
+	return LayerTypeSRH
+}
+
+// NextLayerType returns the LayerType of the Next Header.
+func (s *SRH) NextLayerType() gopacket.LayerType {
+	return layers.IPProtocol(s.NextHeader).LayerType()
+}
+
+// decodeSRH decodes a SRH, and its payload.
+func decodeSRH(data []byte, p gopacket.PacketBuilder) error {
+	s := &SRH{}
+	if err := s.DecodeFromBytes(data, p); err != nil {
+		return err
+	}
+	p.AddLayer(s)
+	return p.NextDecoder(layers.IPProtocol(s.NextHeader))
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gopacketlayers
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/nextmn/rfc9433/srh"
+)
+
+func TestSRHSerializeTo(t *testing.T) {
+	s := &SRH{SRH: *srh.NewSRH(uint8(layers.IPProtocolIPv4), [][16]byte{{0x20, 0x01, 0x0d, 0xb8, 15: 1}, {0x20, 0x01, 0x0d, 0xb8, 15: 2}})}
+	s.Tag = 0x1234
+	payload := []byte{1, 2, 3, 4}
+	b := gopacket.NewSerializeBuffer()
+	if err := gopacket.SerializeLayers(b, gopacket.SerializeOptions{}, s, gopacket.Payload(payload)); err != nil {
+		t.Fatal(err)
+	}
+	want, err := s.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(b.Bytes(), append(want, payload...)); diff != "" {
+		t.Error(diff)
+	}
+	if s.LayerType() != LayerTypeSRH || LayerTypeSRH.String() != "SRH" {
+		t.Errorf("Unexpected layer type: %s", s.LayerType())
+	}
+
+	// invalid SRH
+	if err := gopacket.SerializeLayers(b, gopacket.SerializeOptions{}, &SRH{}); err == nil {
+		t.Error("SRH without segments should not be serialized")
+	}
+}
+
+func TestSRHDecodeFromBytes(t *testing.T) {
+	s := &SRH{SRH: *srh.NewSRH(uint8(layers.IPProtocolNoNextHeader), [][16]byte{{0x20, 0x01, 0x0d, 0xb8, 15: 1}})}
+	b := gopacket.NewSerializeBuffer()
+	if err := gopacket.SerializeLayers(b, gopacket.SerializeOptions{}, s, gopacket.Payload{1, 2}); err != nil {
+		t.Fatal(err)
+	}
+	var s2 SRH
+	if err := s2.DecodeFromBytes(b.Bytes(), gopacket.NilDecodeFeedback); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(s2.SRH, s.SRH); diff != "" {
+		t.Error(diff)
+	}
+	if len(s2.Contents) != s.MarshalLen() || len(s2.Payload) != 2 {
+		t.Errorf("Unexpected contents and payload: %x %x", s2.Contents, s2.Payload)
+	}
+	if s2.CanDecode() != LayerTypeSRH || s2.NextLayerType() != gopacket.LayerTypePayload {
+		t.Errorf("Unexpected layer types: %s %s", s2.CanDecode(), s2.NextLayerType())
+	}
+
+	// truncated
+	p := gopacket.NewPacket(b.Bytes()[:10], LayerTypeSRH, gopacket.Default)
+	if p.ErrorLayer() == nil || !p.Metadata().Truncated {
+		t.Errorf("Truncated SRH should not be decoded: %s", p)
+	}
+}
@@ -0,0 +1,14 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package grpcserver provides a gRPC server exposing a control.Service,
+// implementing the Control service of control.proto (package controlpb).
+//
+//	g := grpc.NewServer()
+//	controlpb.RegisterControlServer(g, grpcserver.NewServer(s))
+//
+// Errors of the control.Service are returned with a status code depending on their kind
+// (e.g. codes.NotFound for session.ErrNotFound).
+package grpcserver
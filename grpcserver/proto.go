@@ -0,0 +1,337 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package grpcserver
+
+import (
+	"errors"
+	"math"
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/control"
+	"github.com/nextmn/rfc9433/control/controlpb"
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/iproute2"
+	"github.com/nextmn/rfc9433/locator"
+	"github.com/nextmn/rfc9433/session"
+	"github.com/nextmn/rfc9433/trace"
+)
+
+// addrString returns the text form of an address, or an empty string if it is not set.
+func addrString(a netip.Addr) string {
+	if !a.IsValid() {
+		return ""
+	}
+	return a.String()
+}
+
+// prefixString returns the text form of a prefix, or an empty string if it is not set.
+func prefixString(p netip.Prefix) string {
+	if !p.IsValid() {
+		return ""
+	}
+	return p.String()
+}
+
+// parseAddr parses the text form of an address; an empty string is the zero netip.Addr.
+func parseAddr(s string) (netip.Addr, error) {
+	if s == "" {
+		return netip.Addr{}, nil
+	}
+	a, err := netip.ParseAddr(s)
+	if err != nil {
+		return a, errors.Join(ErrInvalidRequest, err)
+	}
+	return a, nil
+}
+
+// parsePrefix parses the text form of a prefix; an empty string is the zero netip.Prefix.
+func parsePrefix(s string) (netip.Prefix, error) {
+	if s == "" {
+		return netip.Prefix{}, nil
+	}
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		return p, errors.Join(ErrInvalidRequest, err)
+	}
+	return p, nil
+}
+
+// newSessionKeyPB returns the protobuf form of a session key.
+func newSessionKeyPB(k session.Key) *controlpb.SessionKey {
+	return &controlpb.SessionKey{Peer: addrString(k.Peer), Teid: k.TEID}
+}
+
+// sessionKeyOf returns the session key of its protobuf form.
+func sessionKeyOf(pb *controlpb.SessionKey) (session.Key, error) {
+	peer, err := parseAddr(pb.GetPeer())
+	if err != nil {
+		return session.Key{}, err
+	}
+	if !peer.IsValid() {
+		return session.Key{}, ErrInvalidRequest
+	}
+	return session.Key{Peer: peer, TEID: pb.GetTeid()}, nil
+}
+
+// newArgsPB returns the protobuf form of an Args.Mob.Session.
+func newArgsPB(a *encoding.ArgsMobSession) *controlpb.ArgsMobSession {
+	if a == nil {
+		return nil
+	}
+	return &controlpb.ArgsMobSession{
+		Qfi:          uint32(a.QFI()),
+		R:            a.R(),
+		U:            a.U(),
+		PduSessionId: a.PDUSessionID(),
+	}
+}
+
+// argsOf returns the Args.Mob.Session of its protobuf form.
+func argsOf(pb *controlpb.ArgsMobSession) (*encoding.ArgsMobSession, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	if pb.GetQfi() > math.MaxUint8 {
+		return nil, ErrInvalidRequest
+	}
+	a, err := encoding.NewArgsMobSessionChecked(encoding.QFI(pb.GetQfi()), pb.GetR(), pb.GetU(), pb.GetPduSessionId())
+	if err != nil {
+		return nil, errors.Join(ErrInvalidRequest, err)
+	}
+	return a, nil
+}
+
+// newSessionPB returns the protobuf form of a session.
+func newSessionPB(k session.Key, s session.Session) *controlpb.Session {
+	pb := &controlpb.Session{
+		Key:  newSessionKeyPB(k),
+		Sid:  addrString(s.SID),
+		Args: newArgsPB(s.Args),
+	}
+	for _, seg := range s.Segments {
+		pb.Segments = append(pb.Segments, netip.AddrFrom16(seg).String())
+	}
+	return pb
+}
+
+// sessionEntryOf returns the session and its key of their protobuf form.
+func sessionEntryOf(pb *controlpb.Session) (control.SessionEntry, error) {
+	k, err := sessionKeyOf(pb.GetKey())
+	if err != nil {
+		return control.SessionEntry{}, err
+	}
+	s, err := sessionOf(pb)
+	if err != nil {
+		return control.SessionEntry{}, err
+	}
+	return control.SessionEntry{Key: k, Session: s}, nil
+}
+
+// sessionOf returns the session of its protobuf form, ignoring its key.
+func sessionOf(pb *controlpb.Session) (session.Session, error) {
+	var s session.Session
+	sid, err := parseAddr(pb.GetSid())
+	if err != nil {
+		return s, err
+	}
+	if !sid.Is6() {
+		return s, ErrInvalidRequest
+	}
+	s.SID = sid
+	if s.Args, err = argsOf(pb.GetArgs()); err != nil {
+		return s, err
+	}
+	for _, seg := range pb.GetSegments() {
+		a, err := parseAddr(seg)
+		if err != nil {
+			return s, err
+		}
+		if !a.Is6() {
+			return s, ErrInvalidRequest
+		}
+		s.Segments = append(s.Segments, a.As16())
+	}
+	return s, nil
+}
+
+// newFlowStatsPB returns the protobuf form of control.FlowStats.
+func newFlowStatsPB(f control.FlowStats) *controlpb.FlowStats {
+	return &controlpb.FlowStats{
+		PduSessionId: f.Flow.PDUSessionID,
+		Qfi:          uint32(f.Flow.QFI),
+		Packets:      f.Stats.Packets,
+		Bytes:        f.Stats.Bytes,
+		Drops:        f.Stats.Drops,
+	}
+}
+
+// newLocatorPB returns the protobuf form of control.Locator.
+func newLocatorPB(l control.Locator) *controlpb.Locator {
+	return &controlpb.Locator{Prefix: prefixString(l.Prefix), Owner: l.Owner}
+}
+
+// locatorOf returns the control.Locator of its protobuf form.
+func locatorOf(pb *controlpb.Locator) (control.Locator, error) {
+	prefix, err := parsePrefix(pb.GetPrefix())
+	if err != nil {
+		return control.Locator{}, err
+	}
+	return control.Locator{Prefix: prefix, Owner: pb.GetOwner()}, nil
+}
+
+// newBehaviorPB returns the protobuf form of control.BehaviorSpec.
+func newBehaviorPB(spec control.BehaviorSpec) *controlpb.Behavior {
+	pb := &controlpb.Behavior{
+		Sid:       prefixString(spec.SID),
+		Action:    string(spec.Action),
+		Source:    addrString(spec.Source),
+		SrcPrefix: prefixString(spec.SrcPrefix),
+		DstPrefix: prefixString(spec.DstPrefix),
+		Reduced:   spec.Reduced,
+		HopLimit:  uint32(spec.HopLimit),
+	}
+	for _, seg := range spec.Segments {
+		pb.Segments = append(pb.Segments, addrString(seg))
+	}
+	return pb
+}
+
+// behaviorSpecOf returns the control.BehaviorSpec of its protobuf form.
+func behaviorSpecOf(pb *controlpb.Behavior) (control.BehaviorSpec, error) {
+	spec := control.BehaviorSpec{
+		Action:  iproute2.Action(pb.GetAction()),
+		Reduced: pb.GetReduced(),
+	}
+	if pb.GetHopLimit() > math.MaxUint8 {
+		return spec, ErrInvalidRequest
+	}
+	spec.HopLimit = uint8(pb.GetHopLimit())
+	var err error
+	if spec.SID, err = parsePrefix(pb.GetSid()); err != nil {
+		return spec, err
+	}
+	if spec.Source, err = parseAddr(pb.GetSource()); err != nil {
+		return spec, err
+	}
+	if spec.SrcPrefix, err = parsePrefix(pb.GetSrcPrefix()); err != nil {
+		return spec, err
+	}
+	if spec.DstPrefix, err = parsePrefix(pb.GetDstPrefix()); err != nil {
+		return spec, err
+	}
+	for _, seg := range pb.GetSegments() {
+		a, err := parseAddr(seg)
+		if err != nil {
+			return spec, err
+		}
+		spec.Segments = append(spec.Segments, a)
+	}
+	return spec, nil
+}
+
+// newTraceRecordPB returns the protobuf form of trace.Record.
+func newTraceRecordPB(r trace.Record) *controlpb.TraceRecord {
+	return &controlpb.TraceRecord{
+		Time:     r.Time.UnixNano(),
+		Src:      addrString(r.Src),
+		Dst:      addrString(r.Dst),
+		Len:      uint32(r.Len),
+		Decision: r.Decision.String(),
+		Reason:   r.Reason,
+	}
+}
+
+// newAllocationPB returns the protobuf form of locator.Allocation.
+func newAllocationPB(a locator.Allocation) *controlpb.SIDAllocation {
+	return &controlpb.SIDAllocation{Sid: prefixString(a.SID), Owner: a.Owner}
+}
+
+// newDecodeResponsePB returns the protobuf form of control.Decoded.
+func newDecodeResponsePB(d *control.Decoded) *controlpb.DecodeResponse {
+	if d.MGTP4Dst != nil {
+		return &controlpb.DecodeResponse{Result: &controlpb.DecodeResponse_Mgtp4Dst{Mgtp4Dst: &controlpb.MGTP4Dst{
+			Prefix: prefixString(d.MGTP4Dst.Prefix()),
+			Ipv4:   addrString(d.MGTP4Dst.IPv4()),
+			Args:   newArgsPB(d.MGTP4Dst.ArgsMobSession()),
+		}}}
+	}
+	return &controlpb.DecodeResponse{Result: &controlpb.DecodeResponse_Mgtp4Src{Mgtp4Src: &controlpb.MGTP4Src{
+		Prefix:  prefixString(d.MGTP4Src.Prefix()),
+		Ipv4:    addrString(d.MGTP4Src.IPv4()),
+		UdpPort: uint32(d.MGTP4Src.UDPPortNumber()),
+	}}}
+}
+
+// newConfigPB returns the protobuf form of control.Config.
+func newConfigPB(cfg control.Config) *controlpb.Config {
+	pb := &controlpb.Config{}
+	for _, l := range cfg.Locators {
+		pb.Locators = append(pb.Locators, newLocatorPB(l))
+	}
+	for _, spec := range cfg.Behaviors {
+		pb.Behaviors = append(pb.Behaviors, newBehaviorPB(spec))
+	}
+	for _, e := range cfg.Sessions {
+		pb.Sessions = append(pb.Sessions, newSessionPB(e.Key, e.Session))
+	}
+	return pb
+}
+
+// configOf returns the control.Config of its protobuf form.
+func configOf(pb *controlpb.Config) (control.Config, error) {
+	cfg := control.Config{
+		Locators:  make([]control.Locator, len(pb.GetLocators())),
+		Behaviors: make([]control.BehaviorSpec, len(pb.GetBehaviors())),
+		Sessions:  make([]control.SessionEntry, len(pb.GetSessions())),
+	}
+	var err error
+	for i, l := range pb.GetLocators() {
+		if cfg.Locators[i], err = locatorOf(l); err != nil {
+			return cfg, err
+		}
+	}
+	for i, b := range pb.GetBehaviors() {
+		if cfg.Behaviors[i], err = behaviorSpecOf(b); err != nil {
+			return cfg, err
+		}
+	}
+	for i, s := range pb.GetSessions() {
+		if cfg.Sessions[i], err = sessionEntryOf(s); err != nil {
+			return cfg, err
+		}
+	}
+	return cfg, nil
+}
+
+// newDiffPB returns the protobuf form of control.Diff.
+func newDiffPB(d *control.Diff) *controlpb.Diff {
+	pb := &controlpb.Diff{}
+	for _, l := range d.AddLocators {
+		pb.AddLocators = append(pb.AddLocators, newLocatorPB(l))
+	}
+	for _, l := range d.UpdateLocators {
+		pb.UpdateLocators = append(pb.UpdateLocators, newLocatorPB(l))
+	}
+	for _, p := range d.DeleteLocators {
+		pb.DeleteLocators = append(pb.DeleteLocators, prefixString(p))
+	}
+	for _, spec := range d.SetBehaviors {
+		pb.SetBehaviors = append(pb.SetBehaviors, newBehaviorPB(spec))
+	}
+	for _, p := range d.DeleteBehaviors {
+		pb.DeleteBehaviors = append(pb.DeleteBehaviors, prefixString(p))
+	}
+	for _, e := range d.CreateSessions {
+		pb.CreateSessions = append(pb.CreateSessions, newSessionPB(e.Key, e.Session))
+	}
+	for _, e := range d.UpdateSessions {
+		pb.UpdateSessions = append(pb.UpdateSessions, newSessionPB(e.Key, e.Session))
+	}
+	for _, k := range d.DeleteSessions {
+		pb.DeleteSessions = append(pb.DeleteSessions, newSessionKeyPB(k))
+	}
+	return pb
+}
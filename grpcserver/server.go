@@ -0,0 +1,342 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/control"
+	"github.com/nextmn/rfc9433/control/controlpb"
+	"github.com/nextmn/rfc9433/locator"
+	"github.com/nextmn/rfc9433/session"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server is a controlpb.ControlServer exposing a control.Service.
+type Server struct {
+	controlpb.UnimplementedControlServer
+	s *control.Service
+}
+
+// NewServer creates a Server.
+func NewServer(s *control.Service) *Server {
+	return &Server{s: s}
+}
+
+// CreateSession implements controlpb.ControlServer.
+func (srv *Server) CreateSession(ctx context.Context, req *controlpb.CreateSessionRequest) (*controlpb.Session, error) {
+	e, err := sessionEntryOf(req.GetSession())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	if err := srv.s.CreateSession(e.Key, e.Session); err != nil {
+		return nil, statusError(err)
+	}
+	return newSessionPB(e.Key, e.Session), nil
+}
+
+// UpdateSession implements controlpb.ControlServer.
+func (srv *Server) UpdateSession(ctx context.Context, req *controlpb.UpdateSessionRequest) (*controlpb.Session, error) {
+	e, err := sessionEntryOf(req.GetSession())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	if err := srv.s.UpdateSession(e.Key, e.Session); err != nil {
+		return nil, statusError(err)
+	}
+	return newSessionPB(e.Key, e.Session), nil
+}
+
+// DeleteSession implements controlpb.ControlServer.
+func (srv *Server) DeleteSession(ctx context.Context, req *controlpb.DeleteSessionRequest) (*controlpb.DeleteSessionResponse, error) {
+	k, err := sessionKeyOf(req.GetKey())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	if err := srv.s.DeleteSession(k); err != nil {
+		return nil, statusError(err)
+	}
+	return &controlpb.DeleteSessionResponse{}, nil
+}
+
+// GetSession implements controlpb.ControlServer.
+func (srv *Server) GetSession(ctx context.Context, req *controlpb.GetSessionRequest) (*controlpb.Session, error) {
+	k, err := sessionKeyOf(req.GetKey())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	s, err := srv.s.GetSession(k)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return newSessionPB(k, s), nil
+}
+
+// ListSessions implements controlpb.ControlServer.
+func (srv *Server) ListSessions(ctx context.Context, req *controlpb.ListSessionsRequest) (*controlpb.ListSessionsResponse, error) {
+	res := &controlpb.ListSessionsResponse{}
+	for _, e := range srv.s.Sessions() {
+		res.Sessions = append(res.Sessions, newSessionPB(e.Key, e.Session))
+	}
+	return res, nil
+}
+
+// GetSessionStats implements controlpb.ControlServer.
+func (srv *Server) GetSessionStats(ctx context.Context, req *controlpb.GetSessionStatsRequest) (*controlpb.GetSessionStatsResponse, error) {
+	k, err := sessionKeyOf(req.GetKey())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	stats, err := srv.s.SessionStats(k)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := &controlpb.GetSessionStatsResponse{}
+	for _, f := range stats {
+		res.Flows = append(res.Flows, newFlowStatsPB(f))
+	}
+	return res, nil
+}
+
+// AddLocator implements controlpb.ControlServer.
+func (srv *Server) AddLocator(ctx context.Context, req *controlpb.AddLocatorRequest) (*controlpb.Locator, error) {
+	l, err := locatorOf(req.GetLocator())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	if err := srv.s.AddLocator(l); err != nil {
+		return nil, statusError(err)
+	}
+	l.Prefix = l.Prefix.Masked()
+	return newLocatorPB(l), nil
+}
+
+// UpdateLocator implements controlpb.ControlServer.
+func (srv *Server) UpdateLocator(ctx context.Context, req *controlpb.UpdateLocatorRequest) (*controlpb.Locator, error) {
+	l, err := locatorOf(req.GetLocator())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	l.Prefix = l.Prefix.Masked()
+	if err := srv.s.UpdateLocator(l); err != nil {
+		return nil, statusError(err)
+	}
+	return newLocatorPB(l), nil
+}
+
+// DeleteLocator implements controlpb.ControlServer.
+func (srv *Server) DeleteLocator(ctx context.Context, req *controlpb.DeleteLocatorRequest) (*controlpb.DeleteLocatorResponse, error) {
+	prefix, err := parsePrefix(req.GetPrefix())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	if err := srv.s.DeleteLocator(prefix); err != nil {
+		return nil, statusError(err)
+	}
+	return &controlpb.DeleteLocatorResponse{}, nil
+}
+
+// ListLocators implements controlpb.ControlServer.
+func (srv *Server) ListLocators(ctx context.Context, req *controlpb.ListLocatorsRequest) (*controlpb.ListLocatorsResponse, error) {
+	res := &controlpb.ListLocatorsResponse{}
+	for _, l := range srv.s.Locators() {
+		res.Locators = append(res.Locators, newLocatorPB(l))
+	}
+	return res, nil
+}
+
+// SetBehavior implements controlpb.ControlServer.
+func (srv *Server) SetBehavior(ctx context.Context, req *controlpb.SetBehaviorRequest) (*controlpb.Behavior, error) {
+	spec, err := behaviorSpecOf(req.GetBehavior())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	spec.SID = spec.SID.Masked()
+	if err := srv.s.SetBehavior(spec); err != nil {
+		return nil, statusError(err)
+	}
+	return newBehaviorPB(spec), nil
+}
+
+// DeleteBehavior implements controlpb.ControlServer.
+func (srv *Server) DeleteBehavior(ctx context.Context, req *controlpb.DeleteBehaviorRequest) (*controlpb.DeleteBehaviorResponse, error) {
+	sid, err := parsePrefix(req.GetSid())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	if err := srv.s.DeleteBehavior(sid); err != nil {
+		return nil, statusError(err)
+	}
+	return &controlpb.DeleteBehaviorResponse{}, nil
+}
+
+// ListBehaviors implements controlpb.ControlServer.
+func (srv *Server) ListBehaviors(ctx context.Context, req *controlpb.ListBehaviorsRequest) (*controlpb.ListBehaviorsResponse, error) {
+	res := &controlpb.ListBehaviorsResponse{}
+	for _, spec := range srv.s.Behaviors() {
+		res.Behaviors = append(res.Behaviors, newBehaviorPB(spec))
+	}
+	return res, nil
+}
+
+// GetTrace implements controlpb.ControlServer.
+func (srv *Server) GetTrace(ctx context.Context, req *controlpb.GetTraceRequest) (*controlpb.GetTraceResponse, error) {
+	sid, err := parsePrefix(req.GetSid())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	records, err := srv.s.Trace(sid)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := &controlpb.GetTraceResponse{}
+	for _, rec := range records {
+		res.Records = append(res.Records, newTraceRecordPB(rec))
+	}
+	return res, nil
+}
+
+// AllocateSID implements controlpb.ControlServer.
+func (srv *Server) AllocateSID(ctx context.Context, req *controlpb.AllocateSIDRequest) (*controlpb.SIDAllocation, error) {
+	sid, err := parseAddr(req.GetSid())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	if !sid.IsValid() {
+		prefix, err := srv.s.AllocateSID(req.GetOwner())
+		if err != nil {
+			return nil, statusError(err)
+		}
+		return newAllocationPB(locator.Allocation{SID: prefix, Owner: req.GetOwner()}), nil
+	}
+	if err := srv.s.ReserveSID(sid, req.GetOwner()); err != nil {
+		return nil, statusError(err)
+	}
+	a, err := srv.s.SIDAllocation(sid)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return newAllocationPB(a), nil
+}
+
+// ReleaseSID implements controlpb.ControlServer.
+func (srv *Server) ReleaseSID(ctx context.Context, req *controlpb.ReleaseSIDRequest) (*controlpb.ReleaseSIDResponse, error) {
+	sid, err := netip.ParseAddr(req.GetSid())
+	if err != nil {
+		return nil, statusError(errors.Join(ErrInvalidRequest, err))
+	}
+	if err := srv.s.ReleaseSID(sid); err != nil {
+		return nil, statusError(err)
+	}
+	return &controlpb.ReleaseSIDResponse{}, nil
+}
+
+// GetSIDAllocation implements controlpb.ControlServer.
+func (srv *Server) GetSIDAllocation(ctx context.Context, req *controlpb.GetSIDAllocationRequest) (*controlpb.SIDAllocation, error) {
+	sid, err := netip.ParseAddr(req.GetSid())
+	if err != nil {
+		return nil, statusError(errors.Join(ErrInvalidRequest, err))
+	}
+	a, err := srv.s.SIDAllocation(sid)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return newAllocationPB(a), nil
+}
+
+// ListSIDAllocations implements controlpb.ControlServer.
+func (srv *Server) ListSIDAllocations(ctx context.Context, req *controlpb.ListSIDAllocationsRequest) (*controlpb.ListSIDAllocationsResponse, error) {
+	allocations, err := srv.s.SIDAllocations()
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := &controlpb.ListSIDAllocationsResponse{}
+	for _, a := range allocations {
+		res.Allocations = append(res.Allocations, newAllocationPB(a))
+	}
+	return res, nil
+}
+
+// Decode implements controlpb.ControlServer.
+func (srv *Server) Decode(ctx context.Context, req *controlpb.DecodeRequest) (*controlpb.DecodeResponse, error) {
+	addr, err := netip.ParseAddr(req.GetAddress())
+	if err != nil {
+		return nil, statusError(errors.Join(ErrInvalidRequest, err))
+	}
+	if req.GetPrefixLength() > math.MaxUint8 {
+		return nil, statusError(ErrInvalidRequest)
+	}
+	d, err := control.Decode(addr, control.Layout(req.GetLayout()), uint(req.GetPrefixLength()))
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return newDecodeResponsePB(d), nil
+}
+
+// GetConfig implements controlpb.ControlServer.
+func (srv *Server) GetConfig(ctx context.Context, req *controlpb.GetConfigRequest) (*controlpb.Config, error) {
+	return newConfigPB(srv.s.Config()), nil
+}
+
+// DiffConfig implements controlpb.ControlServer.
+func (srv *Server) DiffConfig(ctx context.Context, req *controlpb.DiffConfigRequest) (*controlpb.Diff, error) {
+	cfg, err := configOf(req.GetConfig())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	d, err := srv.s.Diff(cfg)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return newDiffPB(d), nil
+}
+
+// ApplyConfig implements controlpb.ControlServer.
+func (srv *Server) ApplyConfig(ctx context.Context, req *controlpb.ApplyConfigRequest) (*controlpb.Diff, error) {
+	cfg, err := configOf(req.GetConfig())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	d, err := srv.s.ApplyConfig(cfg)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return newDiffPB(d), nil
+}
+
+// statusError returns err as a gRPC status error, whose code depends on err.
+func statusError(err error) error {
+	return status.Error(codeOf(err), err.Error())
+}
+
+// codeOf returns the gRPC status code of an error.
+func codeOf(err error) codes.Code {
+	switch {
+	case errors.Is(err, session.ErrNotFound),
+		errors.Is(err, control.ErrLocatorNotFound),
+		errors.Is(err, control.ErrBehaviorNotFound),
+		errors.Is(err, locator.ErrNotAllocated):
+		return codes.NotFound
+	case errors.Is(err, session.ErrExists),
+		errors.Is(err, locator.ErrCollision):
+		return codes.AlreadyExists
+	case errors.Is(err, session.ErrSIDInUse),
+		errors.Is(err, control.ErrLocatorInUse),
+		errors.Is(err, locator.ErrHeldDown):
+		return codes.FailedPrecondition
+	case errors.Is(err, locator.ErrNoFreeSID):
+		return codes.ResourceExhausted
+	case errors.Is(err, control.ErrNoAllocator),
+		errors.Is(err, control.ErrNoRecorder):
+		return codes.Unimplemented
+	default:
+		return codes.InvalidArgument
+	}
+}
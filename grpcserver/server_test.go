@@ -0,0 +1,321 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package grpcserver
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/behavior"
+	"github.com/nextmn/rfc9433/control"
+	"github.com/nextmn/rfc9433/control/controlpb"
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/locator"
+	"github.com/nextmn/rfc9433/session"
+	"github.com/nextmn/rfc9433/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// dial serves a Server of s over an in-memory connection, and returns a client of it.
+func dial(t *testing.T, s *control.Service) controlpb.ControlClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	g := grpc.NewServer()
+	controlpb.RegisterControlServer(g, NewServer(s))
+	go g.Serve(lis)
+	t.Cleanup(g.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return controlpb.NewControlClient(conn)
+}
+
+func TestServerSessions(t *testing.T) {
+	c := dial(t, control.NewService(session.NewTable(), behavior.NewRegistry()))
+	ctx := context.Background()
+	key := &controlpb.SessionKey{Peer: "10.0.0.2", Teid: 1}
+	s := &controlpb.Session{
+		Key:  key,
+		Sid:  "2001:db8::1",
+		Args: &controlpb.ArgsMobSession{Qfi: 5, PduSessionId: 1},
+	}
+	if _, err := c.CreateSession(ctx, &controlpb.CreateSessionRequest{Session: s}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CreateSession(ctx, &controlpb.CreateSessionRequest{Session: s}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("Duplicated session should be rejected: %v", err)
+	}
+	if _, err := c.CreateSession(ctx, &controlpb.CreateSessionRequest{Session: &controlpb.Session{
+		Key: &controlpb.SessionKey{Peer: "10.0.0.2", Teid: 2},
+		Sid: "10.0.0.1",
+	}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("IPv4 SID should be rejected: %v", err)
+	}
+	if _, err := c.CreateSession(ctx, &controlpb.CreateSessionRequest{Session: &controlpb.Session{
+		Key:  &controlpb.SessionKey{Peer: "10.0.0.2", Teid: 2},
+		Sid:  "2001:db8::2",
+		Args: &controlpb.ArgsMobSession{Qfi: 64},
+	}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Invalid QFI should be rejected: %v", err)
+	}
+	updated := &controlpb.Session{Key: key, Sid: "2001:db8::2", Segments: []string{"2001:db8:1::1"}}
+	if _, err := c.UpdateSession(ctx, &controlpb.UpdateSessionRequest{Session: updated}); err != nil {
+		t.Fatal(err)
+	}
+	res, err := c.GetSession(ctx, &controlpb.GetSessionRequest{Key: key})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(updated, res, protocmp.Transform()); diff != "" {
+		t.Error(diff)
+	}
+	if list, err := c.ListSessions(ctx, &controlpb.ListSessionsRequest{}); err != nil || len(list.GetSessions()) != 1 {
+		t.Errorf("Unexpected sessions %v: %v", list, err)
+	}
+	if _, err := c.DeleteSession(ctx, &controlpb.DeleteSessionRequest{Key: key}); err != nil {
+		t.Error(err)
+	}
+	if _, err := c.GetSession(ctx, &controlpb.GetSessionRequest{Key: key}); status.Code(err) != codes.NotFound {
+		t.Errorf("Deleted session should not be found: %v", err)
+	}
+	if _, err := c.GetSession(ctx, &controlpb.GetSessionRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Missing key should be rejected: %v", err)
+	}
+}
+
+func TestServerSessionStats(t *testing.T) {
+	table := session.NewTable()
+	c := dial(t, control.NewService(table, behavior.NewRegistry()))
+	ctx := context.Background()
+	key := &controlpb.SessionKey{Peer: "10.0.0.2", Teid: 1}
+	if _, err := c.GetSessionStats(ctx, &controlpb.GetSessionStatsRequest{Key: key}); status.Code(err) != codes.NotFound {
+		t.Errorf("Stats of a missing session should not be found: %v", err)
+	}
+	k := session.Key{Peer: netip.MustParseAddr("10.0.0.2"), TEID: 1}
+	if err := table.Add(k, session.Session{SID: netip.MustParseAddr("2001:db8::1")}); err != nil {
+		t.Fatal(err)
+	}
+	table.Count(k, session.QoSFlow{PDUSessionID: 1, QFI: 5}, 100)
+	table.Drop(k, session.QoSFlow{PDUSessionID: 1, QFI: 5})
+	res, err := c.GetSessionStats(ctx, &controlpb.GetSessionStatsRequest{Key: key})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&controlpb.GetSessionStatsResponse{Flows: []*controlpb.FlowStats{
+		{PduSessionId: 1, Qfi: 5, Packets: 1, Bytes: 100, Drops: 1},
+	}}, res, protocmp.Transform()); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestServerLocatorsAndBehaviors(t *testing.T) {
+	c := dial(t, control.NewService(session.NewTable(), behavior.NewRegistry()))
+	ctx := context.Background()
+	if _, err := c.AddLocator(ctx, &controlpb.AddLocatorRequest{Locator: &controlpb.Locator{Prefix: "2001:db8::/48", Owner: "srgw"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.AddLocator(ctx, &controlpb.AddLocatorRequest{Locator: &controlpb.Locator{Prefix: "2001:db8::/64"}}); status.Code(err) == codes.OK {
+		t.Error("Overlapping locator should be rejected")
+	}
+	if _, err := c.AddLocator(ctx, &controlpb.AddLocatorRequest{Locator: &controlpb.Locator{Prefix: "2001:db8::"}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Invalid prefix should be rejected: %v", err)
+	}
+	b, err := c.SetBehavior(ctx, &controlpb.SetBehaviorRequest{Behavior: &controlpb.Behavior{
+		Sid:       "2001:db8::1/64",
+		Action:    "End.M.GTP4.E",
+		SrcPrefix: "2001:db8:1::/48",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&controlpb.Behavior{
+		Sid:       "2001:db8::/64",
+		Action:    "End.M.GTP4.E",
+		SrcPrefix: "2001:db8:1::/48",
+	}, b, protocmp.Transform()); diff != "" {
+		t.Error(diff)
+	}
+	if list, err := c.ListBehaviors(ctx, &controlpb.ListBehaviorsRequest{}); err != nil || len(list.GetBehaviors()) != 1 {
+		t.Errorf("Unexpected behaviors %v: %v", list, err)
+	}
+	if l, err := c.UpdateLocator(ctx, &controlpb.UpdateLocatorRequest{Locator: &controlpb.Locator{Prefix: "2001:db8::/48", Owner: "upf"}}); err != nil || l.GetOwner() != "upf" {
+		t.Errorf("Unexpected locator %v: %v", l, err)
+	}
+	if _, err := c.UpdateLocator(ctx, &controlpb.UpdateLocatorRequest{Locator: &controlpb.Locator{Prefix: "2001:db9::/48", Owner: "upf"}}); status.Code(err) != codes.NotFound {
+		t.Errorf("Missing locator should not be updated: %v", err)
+	}
+	if _, err := c.DeleteLocator(ctx, &controlpb.DeleteLocatorRequest{Prefix: "2001:db8::/48"}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Locator in use should not be deleted: %v", err)
+	}
+	if _, err := c.DeleteBehavior(ctx, &controlpb.DeleteBehaviorRequest{Sid: "2001:db8::/64"}); err != nil {
+		t.Error(err)
+	}
+	if _, err := c.DeleteBehavior(ctx, &controlpb.DeleteBehaviorRequest{Sid: "2001:db8::/64"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Deleted behavior should not be found: %v", err)
+	}
+	if _, err := c.DeleteLocator(ctx, &controlpb.DeleteLocatorRequest{Prefix: "2001:db8::/48"}); err != nil {
+		t.Error(err)
+	}
+	if list, err := c.ListLocators(ctx, &controlpb.ListLocatorsRequest{}); err != nil || len(list.GetLocators()) != 0 {
+		t.Errorf("Unexpected locators %v: %v", list, err)
+	}
+}
+
+func TestServerTrace(t *testing.T) {
+	ctx := context.Background()
+	if _, err := dial(t, control.NewService(session.NewTable(), behavior.NewRegistry())).GetTrace(ctx, &controlpb.GetTraceRequest{Sid: "2001:db8::/64"}); status.Code(err) != codes.Unimplemented {
+		t.Errorf("Trace without Recorder should not be implemented: %v", err)
+	}
+	r := behavior.NewRegistry(behavior.WithRecorder(trace.NewRecorder(8)))
+	c := dial(t, control.NewService(session.NewTable(), r))
+	if _, err := c.GetTrace(ctx, &controlpb.GetTraceRequest{Sid: "2001:db8::/64"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Missing behavior should not be found: %v", err)
+	}
+	if _, err := c.AddLocator(ctx, &controlpb.AddLocatorRequest{Locator: &controlpb.Locator{Prefix: "2001:db8::/48"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.SetBehavior(ctx, &controlpb.SetBehaviorRequest{Behavior: &controlpb.Behavior{Sid: "2001:db8::/64", Action: "End.M.GTP4.E"}}); err != nil {
+		t.Fatal(err)
+	}
+	// IPv6 header without payload, dropped by End.M.GTP4.E
+	pkt := make([]byte, 40)
+	pkt[0] = 0x60
+	pkt[6] = 59
+	copy(pkt[24:40], netip.MustParseAddr("2001:db8::1").AsSlice())
+	r.Process(pkt, nil)
+	res, err := c.GetTrace(ctx, &controlpb.GetTraceRequest{Sid: "2001:db8::/64"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.GetRecords()) != 1 || res.GetRecords()[0].GetDecision() != "drop" || res.GetRecords()[0].GetDst() != "2001:db8::1" {
+		t.Errorf("Unexpected records: %v", res.GetRecords())
+	}
+}
+
+func TestServerSIDs(t *testing.T) {
+	ctx := context.Background()
+	if _, err := dial(t, control.NewService(session.NewTable(), behavior.NewRegistry())).ListSIDAllocations(ctx, &controlpb.ListSIDAllocationsRequest{}); status.Code(err) != codes.Unimplemented {
+		t.Errorf("SIDs without Allocator should not be implemented: %v", err)
+	}
+	a, err := locator.NewAllocator(16, 0, netip.MustParsePrefix("2001:db8::/48"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := dial(t, control.NewService(session.NewTable(), behavior.NewRegistry(), control.WithAllocator(a)))
+	if res, err := c.AllocateSID(ctx, &controlpb.AllocateSIDRequest{Owner: "smf1"}); err != nil || res.GetOwner() != "smf1" {
+		t.Fatalf("Unexpected allocation %v: %v", res, err)
+	}
+	res, err := c.AllocateSID(ctx, &controlpb.AllocateSIDRequest{Owner: "smf2", Sid: "2001:db8:0:ff::"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&controlpb.SIDAllocation{Sid: "2001:db8:0:ff::/64", Owner: "smf2"}, res, protocmp.Transform()); diff != "" {
+		t.Error(diff)
+	}
+	if _, err := c.AllocateSID(ctx, &controlpb.AllocateSIDRequest{Owner: "smf3", Sid: "2001:db8:0:ff::"}); status.Code(err) == codes.OK {
+		t.Error("Allocated SID should not be reserved")
+	}
+	if list, err := c.ListSIDAllocations(ctx, &controlpb.ListSIDAllocationsRequest{}); err != nil || len(list.GetAllocations()) != 2 {
+		t.Errorf("Unexpected allocations %v: %v", list, err)
+	}
+	if res, err := c.GetSIDAllocation(ctx, &controlpb.GetSIDAllocationRequest{Sid: "2001:db8:0:ff::"}); err != nil || res.GetOwner() != "smf2" {
+		t.Errorf("Unexpected allocation %v: %v", res, err)
+	}
+	if _, err := c.ReleaseSID(ctx, &controlpb.ReleaseSIDRequest{Sid: "2001:db8:0:ff::"}); err != nil {
+		t.Error(err)
+	}
+	if _, err := c.GetSIDAllocation(ctx, &controlpb.GetSIDAllocationRequest{Sid: "2001:db8:0:ff::"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Released SID should not be found: %v", err)
+	}
+}
+
+func TestServerDecode(t *testing.T) {
+	c := dial(t, control.NewService(session.NewTable(), behavior.NewRegistry()))
+	ctx := context.Background()
+	b, err := encoding.NewMGTP4IPv6Dst(netip.MustParsePrefix("2001:db8::/32"), [4]byte{10, 0, 0, 1}, encoding.NewArgsMobSession(5, true, false, 0xcafe)).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := c.Decode(ctx, &controlpb.DecodeRequest{
+		Address:      netip.AddrFrom16([16]byte(b)).String(),
+		Layout:       string(control.LayoutMGTP4Dst),
+		PrefixLength: 32,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&controlpb.DecodeResponse{Result: &controlpb.DecodeResponse_Mgtp4Dst{Mgtp4Dst: &controlpb.MGTP4Dst{
+		Prefix: "2001:db8::/32",
+		Ipv4:   "10.0.0.1",
+		Args:   &controlpb.ArgsMobSession{Qfi: 5, R: true, PduSessionId: 0xcafe},
+	}}}, res, protocmp.Transform()); diff != "" {
+		t.Error(diff)
+	}
+	if _, err := c.Decode(ctx, &controlpb.DecodeRequest{Address: "10.0.0.1", Layout: "mgtp4-dst", PrefixLength: 32}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("IPv4 address should be rejected: %v", err)
+	}
+}
+
+func TestServerConfig(t *testing.T) {
+	c := dial(t, control.NewService(session.NewTable(), behavior.NewRegistry()))
+	ctx := context.Background()
+	cfg := &controlpb.Config{
+		Locators:  []*controlpb.Locator{{Prefix: "2001:db8::/48", Owner: "srgw"}},
+		Behaviors: []*controlpb.Behavior{{Sid: "2001:db8::/64", Action: "End.M.GTP4.E"}},
+		Sessions:  []*controlpb.Session{{Key: &controlpb.SessionKey{Peer: "10.0.0.2", Teid: 1}, Sid: "2001:db8::1"}},
+	}
+	d, err := c.DiffConfig(ctx, &controlpb.DiffConfigRequest{Config: cfg})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&controlpb.Diff{
+		AddLocators:    cfg.Locators,
+		SetBehaviors:   cfg.Behaviors,
+		CreateSessions: cfg.Sessions,
+	}, d, protocmp.Transform()); diff != "" {
+		t.Error(diff)
+	}
+	if list, err := c.ListLocators(ctx, &controlpb.ListLocatorsRequest{}); err != nil || len(list.GetLocators()) != 0 {
+		t.Errorf("Diff should not change the running state %v: %v", list, err)
+	}
+	if d, err := c.ApplyConfig(ctx, &controlpb.ApplyConfigRequest{Config: cfg}); err != nil || len(d.GetAddLocators()) != 1 {
+		t.Fatalf("Unexpected applied diff %v: %v", d, err)
+	}
+	if d, err := c.DiffConfig(ctx, &controlpb.DiffConfigRequest{Config: cfg}); err != nil || !cmp.Equal(&controlpb.Diff{}, d, protocmp.Transform()) {
+		t.Errorf("Running state should match the configuration %v: %v", d, err)
+	}
+	res, err := c.GetConfig(ctx, &controlpb.GetConfigRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(cfg, res, protocmp.Transform()); diff != "" {
+		t.Error(diff)
+	}
+	d, err = c.ApplyConfig(ctx, &controlpb.ApplyConfigRequest{Config: &controlpb.Config{}})
+	if err != nil || len(d.GetDeleteLocators()) != 1 || len(d.GetDeleteSessions()) != 1 {
+		t.Errorf("Unexpected applied diff %v: %v", d, err)
+	}
+	if _, err := c.ApplyConfig(ctx, &controlpb.ApplyConfigRequest{Config: &controlpb.Config{Locators: []*controlpb.Locator{
+		{Prefix: "2001:db8::/48"},
+		{Prefix: "2001:db8::/48"},
+	}}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Duplicate locators should be rejected: %v", err)
+	}
+}
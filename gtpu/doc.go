@@ -0,0 +1,9 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package gtpu provides a codec for the GTP-U v1 header (3GPP TS 29.281),
+// used by End.M.GTP4.E and H.M.GTP4.D (RFC 9433, sections 6.6 and 6.7),
+// and a Server receiving GTP-U messages on an UDP socket.
+package gtpu
@@ -0,0 +1,161 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gtpu
+
+import "encoding/binary"
+
+// Information Element types (3GPP TS 29.281, section 8.1).
+const (
+	ieTypeRecovery         = 14
+	ieTypePrivateExtension = 255
+
+	recoveryIELen = 2 // Type and Restart Counter
+)
+
+// EchoRequest is a GTP-U Echo Request (3GPP TS 29.281, section 7.2.1).
+type EchoRequest struct {
+	SequenceNumber uint16
+}
+
+// EchoResponse is a GTP-U Echo Response (3GPP TS 29.281, section 7.2.2).
+// The Restart Counter of the Recovery IE should be zero for GTP-U.
+type EchoResponse struct {
+	SequenceNumber uint16
+	RestartCounter uint8
+}
+
+// echoHeader returns the header of an Echo message.
+func echoHeader(messageType uint8, seq uint16, payloadLen int) Header {
+	return Header{
+		MessageType:    messageType,
+		S:              true,
+		SequenceNumber: seq,
+		PayloadLen:     payloadLen,
+	}
+}
+
+// parseEcho parses the header of an Echo message of the given type and returns its payload.
+func parseEcho(b []byte, messageType uint8) (*Header, []byte, error) {
+	h, err := ParseHeader(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	if h.MessageType != messageType || !h.S || h.E {
+		return nil, nil, ErrMalformedHeader
+	}
+	l := h.MarshalLen()
+	if len(b) < l+h.PayloadLen {
+		return nil, nil, ErrTooShortToParse
+	}
+	return h, b[l : l+h.PayloadLen], nil
+}
+
+// Response returns the EchoResponse to this EchoRequest.
+func (e *EchoRequest) Response() *EchoResponse {
+	return &EchoResponse{
+		SequenceNumber: e.SequenceNumber,
+	}
+}
+
+// MarshalLen returns the serial length of EchoRequest.
+func (e *EchoRequest) MarshalLen() int {
+	return minHeaderLen + optionalLen
+}
+
+// Marshal returns the byte sequence generated from EchoRequest.
+func (e *EchoRequest) Marshal() ([]byte, error) {
+	b := make([]byte, e.MarshalLen())
+	if err := e.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (e *EchoRequest) MarshalTo(b []byte) error {
+	h := echoHeader(MessageTypeEchoRequest, e.SequenceNumber, 0)
+	return h.MarshalTo(b)
+}
+
+// ParseEchoRequest parses a given byte sequence as an EchoRequest.
+// Private Extensions are ignored.
+func ParseEchoRequest(b []byte) (*EchoRequest, error) {
+	h, _, err := parseEcho(b, MessageTypeEchoRequest)
+	if err != nil {
+		return nil, err
+	}
+	return &EchoRequest{
+		SequenceNumber: h.SequenceNumber,
+	}, nil
+}
+
+// MarshalLen returns the serial length of EchoResponse.
+func (e *EchoResponse) MarshalLen() int {
+	return minHeaderLen + optionalLen + recoveryIELen
+}
+
+// Marshal returns the byte sequence generated from EchoResponse.
+func (e *EchoResponse) Marshal() ([]byte, error) {
+	b := make([]byte, e.MarshalLen())
+	if err := e.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (e *EchoResponse) MarshalTo(b []byte) error {
+	if len(b) < e.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	h := echoHeader(MessageTypeEchoResponse, e.SequenceNumber, recoveryIELen)
+	if err := h.MarshalTo(b); err != nil {
+		return err
+	}
+	l := h.MarshalLen()
+	b[l] = ieTypeRecovery
+	b[l+1] = e.RestartCounter
+	return nil
+}
+
+// ParseEchoResponse parses a given byte sequence as an EchoResponse.
+// The Recovery IE is mandatory; Private Extensions are ignored.
+func ParseEchoResponse(b []byte) (*EchoResponse, error) {
+	h, payload, err := parseEcho(b, MessageTypeEchoResponse)
+	if err != nil {
+		return nil, err
+	}
+	e := &EchoResponse{
+		SequenceNumber: h.SequenceNumber,
+	}
+	recovery := false
+	for len(payload) > 0 {
+		switch payload[0] {
+		case ieTypeRecovery:
+			if len(payload) < recoveryIELen {
+				return nil, ErrTooShortToParse
+			}
+			e.RestartCounter = payload[1]
+			recovery = true
+			payload = payload[recoveryIELen:]
+		case ieTypePrivateExtension:
+			if len(payload) < 3 {
+				return nil, ErrTooShortToParse
+			}
+			l := 3 + int(binary.BigEndian.Uint16(payload[1:3]))
+			if len(payload) < l {
+				return nil, ErrTooShortToParse
+			}
+			payload = payload[l:]
+		default:
+			return nil, ErrMalformedHeader
+		}
+	}
+	if !recovery {
+		return nil, ErrMalformedHeader
+	}
+	return e, nil
+}
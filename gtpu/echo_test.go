@@ -0,0 +1,73 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gtpu
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEcho(t *testing.T) {
+	req := &EchoRequest{SequenceNumber: 0x1234}
+	b, err := req.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := []byte{
+		0x32, 0x01, 0x00, 0x04,
+		0x00, 0x00, 0x00, 0x00,
+		0x12, 0x34, 0x00, 0x00,
+	}
+	if diff := cmp.Diff(b, res); diff != "" {
+		t.Error(diff)
+	}
+	p, err := ParseEchoRequest(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(p, req); diff != "" {
+		t.Error(diff)
+	}
+
+	b, err = p.Response().Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res = []byte{
+		0x32, 0x02, 0x00, 0x06,
+		0x00, 0x00, 0x00, 0x00,
+		0x12, 0x34, 0x00, 0x00,
+		0x0e, 0x00,
+	}
+	if diff := cmp.Diff(b, res); diff != "" {
+		t.Error(diff)
+	}
+	resp, err := ParseEchoResponse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(resp, &EchoResponse{SequenceNumber: 0x1234}); diff != "" {
+		t.Error(diff)
+	}
+
+	if _, err := ParseEchoRequest(b); err != ErrMalformedHeader {
+		t.Errorf("Echo Response should not be parsed as Echo Request: %v", err)
+	}
+	if _, err := ParseEchoResponse(b[:12]); err != ErrTooShortToParse {
+		t.Errorf("Truncated Echo Response should be rejected: %v", err)
+	}
+	withExt := []byte{
+		0x32, 0x02, 0x00, 0x0b,
+		0x00, 0x00, 0x00, 0x00,
+		0x12, 0x34, 0x00, 0x00,
+		0xff, 0x00, 0x02, 0xca, 0xfe,
+		0x0e, 0x00,
+	}
+	if _, err := ParseEchoResponse(withExt); err != nil {
+		t.Errorf("Private Extension should be ignored: %v", err)
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gtpu
+
+import (
+	"encoding/binary"
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/encoding"
+)
+
+// Information Element types (3GPP TS 29.281, section 8.1).
+const (
+	ieTypeTEIDDataI       = 16
+	ieTypeGTPUPeerAddress = 133
+
+	teidDataIIELen = 5 // Type and TEID
+)
+
+// ErrorIndication is a GTP-U Error Indication (3GPP TS 29.281, section 7.3.1),
+// sent when a G-PDU is received for a TEID without context.
+type ErrorIndication struct {
+	SequenceNumber uint16
+	TEID           uint32     // TEID Data I: TEID of the G-PDU that triggered the procedure
+	PeerAddress    netip.Addr // GTP-U Peer Address: destination address of the G-PDU that triggered the procedure
+}
+
+// NewErrorIndication creates the ErrorIndication for a G-PDU with header h received on localAddr.
+func NewErrorIndication(h *Header, localAddr netip.Addr) *ErrorIndication {
+	return &ErrorIndication{
+		TEID:        h.TEID,
+		PeerAddress: localAddr,
+	}
+}
+
+// NewErrorIndicationFromSID creates the ErrorIndication for the G-PDU that would be
+// emitted by End.M.GTP4.E for the given SID: the TEID is the PDU Session ID of its Args.Mob.Session,
+// and the GTP-U Peer Address is its IPv4 DA.
+func NewErrorIndicationFromSID(dst *encoding.MGTP4IPv6Dst) *ErrorIndication {
+	return &ErrorIndication{
+		TEID:        dst.PDUSessionID(),
+		PeerAddress: dst.IPv4(),
+	}
+}
+
+// peerAddressLen returns the length of the GTP-U Peer Address.
+func (e *ErrorIndication) peerAddressLen() int {
+	if e.PeerAddress.Is4() {
+		return 4
+	}
+	return 16
+}
+
+// payloadLen returns the length of the IEs.
+func (e *ErrorIndication) payloadLen() int {
+	return teidDataIIELen + 3 + e.peerAddressLen()
+}
+
+// MarshalLen returns the serial length of ErrorIndication.
+func (e *ErrorIndication) MarshalLen() int {
+	return minHeaderLen + optionalLen + e.payloadLen()
+}
+
+// Marshal returns the byte sequence generated from ErrorIndication.
+func (e *ErrorIndication) Marshal() ([]byte, error) {
+	b := make([]byte, e.MarshalLen())
+	if err := e.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (e *ErrorIndication) MarshalTo(b []byte) error {
+	if len(b) < e.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	if !e.PeerAddress.IsValid() {
+		return ErrMalformedHeader
+	}
+	h := Header{
+		MessageType:    MessageTypeErrorIndication,
+		S:              true,
+		SequenceNumber: e.SequenceNumber,
+		PayloadLen:     e.payloadLen(),
+	}
+	if err := h.MarshalTo(b); err != nil {
+		return err
+	}
+	p := b[h.MarshalLen():]
+	p[0] = ieTypeTEIDDataI
+	binary.BigEndian.PutUint32(p[1:teidDataIIELen], e.TEID)
+	p = p[teidDataIIELen:]
+	p[0] = ieTypeGTPUPeerAddress
+	binary.BigEndian.PutUint16(p[1:3], uint16(e.peerAddressLen()))
+	copy(p[3:], e.PeerAddress.AsSlice())
+	return nil
+}
+
+// ParseErrorIndication parses a given byte sequence as an ErrorIndication.
+// TEID Data I and GTP-U Peer Address are mandatory; Private Extensions are ignored.
+func ParseErrorIndication(b []byte) (*ErrorIndication, error) {
+	h, err := ParseHeader(b)
+	if err != nil {
+		return nil, err
+	}
+	if h.MessageType != MessageTypeErrorIndication || !h.S {
+		return nil, ErrMalformedHeader
+	}
+	l := h.MarshalLen()
+	if len(b) < l+h.PayloadLen {
+		return nil, ErrTooShortToParse
+	}
+	payload := b[l : l+h.PayloadLen]
+	if h.E {
+		// skip extension headers (e.g. UDP Port)
+		next := h.NextExtensionHeaderType
+		for next != ExtensionHeaderTypeNoMore {
+			if len(payload) < 4 || payload[0] == 0 || len(payload) < 4*int(payload[0]) {
+				return nil, ErrTooShortToParse
+			}
+			extLen := 4 * int(payload[0])
+			next = payload[extLen-1]
+			payload = payload[extLen:]
+		}
+	}
+	e := &ErrorIndication{
+		SequenceNumber: h.SequenceNumber,
+	}
+	teid := false
+	for len(payload) > 0 {
+		switch payload[0] {
+		case ieTypeTEIDDataI:
+			if len(payload) < teidDataIIELen {
+				return nil, ErrTooShortToParse
+			}
+			e.TEID = binary.BigEndian.Uint32(payload[1:teidDataIIELen])
+			teid = true
+			payload = payload[teidDataIIELen:]
+		case ieTypeGTPUPeerAddress, ieTypePrivateExtension:
+			if len(payload) < 3 {
+				return nil, ErrTooShortToParse
+			}
+			ieLen := int(binary.BigEndian.Uint16(payload[1:3]))
+			if len(payload) < 3+ieLen {
+				return nil, ErrTooShortToParse
+			}
+			if payload[0] == ieTypeGTPUPeerAddress {
+				addr, ok := netip.AddrFromSlice(payload[3 : 3+ieLen])
+				if !ok {
+					return nil, ErrMalformedHeader
+				}
+				e.PeerAddress = addr
+			}
+			payload = payload[3+ieLen:]
+		default:
+			return nil, ErrMalformedHeader
+		}
+	}
+	if !teid || !e.PeerAddress.IsValid() {
+		return nil, ErrMalformedHeader
+	}
+	return e, nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gtpu
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding"
+)
+
+func TestErrorIndication(t *testing.T) {
+	dst := encoding.NewMGTP4IPv6Dst(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{10, 0, 4, 1}, encoding.NewArgsMobSession(1, false, false, 0xCAFE))
+	e := NewErrorIndicationFromSID(dst)
+	b, err := e.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := []byte{
+		0x32, 0x1a, 0x00, 0x10,
+		0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00,
+		0x10, 0x00, 0x00, 0xca, 0xfe,
+		0x85, 0x00, 0x04, 10, 0, 4, 1,
+	}
+	if diff := cmp.Diff(b, res); diff != "" {
+		t.Error(diff)
+	}
+	p, err := ParseErrorIndication(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(p, e, cmp.Comparer(func(a, b netip.Addr) bool { return a == b })); diff != "" {
+		t.Error(diff)
+	}
+
+	e = NewErrorIndication(&Header{MessageType: MessageTypeGPDU, TEID: 1}, netip.MustParseAddr("fd00::1"))
+	b, err = e.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err = ParseErrorIndication(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.TEID != 1 || p.PeerAddress != netip.MustParseAddr("fd00::1") {
+		t.Errorf("Unexpected Error Indication: %x %s", p.TEID, p.PeerAddress)
+	}
+	if _, err := ParseErrorIndication(b[:len(b)-1]); err != ErrTooShortToParse {
+		t.Errorf("Truncated Error Indication should be rejected: %v", err)
+	}
+	if _, err := (&ErrorIndication{TEID: 1}).Marshal(); err != ErrMalformedHeader {
+		t.Errorf("Missing GTP-U Peer Address should be rejected: %v", err)
+	}
+}
@@ -0,0 +1,15 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gtpu
+
+import "errors"
+
+var (
+	ErrTooShortToMarshal = errors.New("too short to serialize")
+	ErrTooShortToParse   = errors.New("too short to parse")
+	ErrMalformedHeader   = errors.New("malformed GTP-U header")
+	ErrTooLong           = errors.New("GTP-U packet is too long")
+)
@@ -0,0 +1,59 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gtpu
+
+// GPDU is a G-PDU with its extension headers parsed.
+type GPDU struct {
+	Header              *Header
+	PDUSessionContainer *PDUSessionContainer // nil if absent
+	TPDU                []byte               // the user packet
+}
+
+// ParseGPDU parses a given byte sequence as a G-PDU.
+// Extension headers other than the PDU Session Container are skipped.
+// Bytes beyond the Length field of the header are ignored.
+func ParseGPDU(b []byte) (*GPDU, error) {
+	h, err := ParseHeader(b)
+	if err != nil {
+		return nil, err
+	}
+	if h.MessageType != MessageTypeGPDU {
+		return nil, ErrMalformedHeader
+	}
+	offset := h.MarshalLen()
+	if len(b) < offset+h.PayloadLen {
+		return nil, ErrTooShortToParse
+	}
+	b = b[:offset+h.PayloadLen]
+	g := &GPDU{Header: h}
+	var next uint8 = ExtensionHeaderTypeNoMore
+	if h.E {
+		next = h.NextExtensionHeaderType
+	}
+	for next != ExtensionHeaderTypeNoMore {
+		if len(b) < offset+4 {
+			return nil, ErrTooShortToParse
+		}
+		l := 4 * int(b[offset])
+		if l == 0 {
+			return nil, ErrMalformedHeader
+		}
+		if len(b) < offset+l {
+			return nil, ErrTooShortToParse
+		}
+		if next == ExtensionHeaderTypePDUSessionContainer {
+			c, err := ParsePDUSessionContainer(b[offset:])
+			if err != nil {
+				return nil, err
+			}
+			g.PDUSessionContainer = c
+		}
+		next = b[offset+l-1]
+		offset += l
+	}
+	g.TPDU = b[offset:]
+	return g, nil
+}
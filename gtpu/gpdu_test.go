@@ -0,0 +1,60 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gtpu
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseGPDU(t *testing.T) {
+	b := []byte{
+		0x34, 0xff, 0x00, 0x10,
+		0x00, 0x00, 0xca, 0xfe,
+		0x00, 0x00, 0x00, 0x40, // UDP Port extension header
+		0x01, 0x08, 0x68, 0x85,
+		0x01, 0x00, 0x45, 0x00, // PDU Session Container
+		0x45, 0x00, 0x00, 0x14,
+		0xff, // after the Length field
+	}
+	g, err := ParseGPDU(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Header.TEID != 0xcafe {
+		t.Errorf("Unexpected TEID: %x", g.Header.TEID)
+	}
+	if diff := cmp.Diff(g.PDUSessionContainer, &PDUSessionContainer{PDUType: PDUTypeDLPDUSessionInformation, QFI: 5, RQI: true}); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(g.TPDU, []byte{0x45, 0x00, 0x00, 0x14}); diff != "" {
+		t.Error(diff)
+	}
+
+	g, err = ParseGPDU([]byte{0x30, 0xff, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01, 0x45})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.PDUSessionContainer != nil || len(g.TPDU) != 1 {
+		t.Errorf("Unexpected G-PDU: %+v", g)
+	}
+
+	for _, tc := range []struct {
+		name string
+		b    []byte
+		err  error
+	}{
+		{"echo", []byte{0x30, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, ErrMalformedHeader},
+		{"truncated", b[:22], ErrTooShortToParse},
+		{"zero length", []byte{0x34, 0xff, 0x00, 0x08, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x85, 0x00, 0x00, 0x00, 0x00}, ErrMalformedHeader},
+	} {
+		if _, err := ParseGPDU(tc.b); !errors.Is(err, tc.err) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.err, err)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gtpu
+
+import "encoding/binary"
+
+// UDP port of GTP-U (3GPP TS 29.281, section 4.4.2).
+const Port = 2152
+
+// Message types (3GPP TS 29.281, section 6.1).
+const (
+	MessageTypeEchoRequest                           = 1
+	MessageTypeEchoResponse                          = 2
+	MessageTypeErrorIndication                       = 26
+	MessageTypeSupportedExtensionHeadersNotification = 31
+	MessageTypeEndMarker                             = 254
+	MessageTypeGPDU                                  = 255
+)
+
+const (
+	minHeaderLen = 8 // size of the mandatory part of the header in bytes
+	optionalLen  = 4 // size of Sequence Number, N-PDU Number and Next Extension Header Type in bytes
+	maxLength    = 0xFFFF
+
+	// Field Flags
+	flagsPosByte  = 0
+	versionPosBit = 5 // position from right of the byte in bits
+	version       = 1
+	ptMask        = 0x10 // Protocol Type (1 for GTP)
+	eMask         = 0x04 // Extension Header flag
+	sMask         = 0x02 // Sequence Number flag
+	pnMask        = 0x01 // N-PDU Number flag
+
+	// Byte positions of the other fields
+	messageTypePosByte = 1
+	lengthPosByte      = 2
+	teidPosByte        = 4
+	seqPosByte         = 8
+	npduPosByte        = 10
+	nextExtPosByte     = 11
+)
+
+// Header is a GTP-U v1 header as defined in 3GPP TS 29.281, section 5.1.
+// Sequence Number, N-PDU Number and Next Extension Header Type are present in the header
+// if any of the E, S or PN flags is set; their value is meaningful only if the corresponding flag is set.
+type Header struct {
+	MessageType             uint8
+	TEID                    uint32 // Tunnel Endpoint Identifier
+	E                       bool   // Extension Header flag
+	S                       bool   // Sequence Number flag
+	PN                      bool   // N-PDU Number flag
+	SequenceNumber          uint16
+	NPDUNumber              uint8
+	NextExtensionHeaderType uint8
+	PayloadLen              int // Length in bytes of what follows the header (extension headers and T-PDU), used to compute the Length field
+}
+
+// hasOptional returns true if Sequence Number, N-PDU Number and Next Extension Header Type are present.
+func (h *Header) hasOptional() bool {
+	return h.E || h.S || h.PN
+}
+
+// MarshalLen returns the serial length of Header.
+func (h *Header) MarshalLen() int {
+	if h.hasOptional() {
+		return minHeaderLen + optionalLen
+	}
+	return minHeaderLen
+}
+
+// Marshal returns the byte sequence generated from Header.
+func (h *Header) Marshal() ([]byte, error) {
+	b := make([]byte, h.MarshalLen())
+	if err := h.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (h *Header) MarshalTo(b []byte) error {
+	l := h.MarshalLen()
+	if len(b) < l {
+		return ErrTooShortToMarshal
+	}
+	if h.PayloadLen < 0 || l-minHeaderLen+h.PayloadLen > maxLength {
+		return ErrTooLong
+	}
+	flags := uint8(version<<versionPosBit | ptMask)
+	if h.E {
+		flags |= eMask
+	}
+	if h.S {
+		flags |= sMask
+	}
+	if h.PN {
+		flags |= pnMask
+	}
+	b[flagsPosByte] = flags
+	b[messageTypePosByte] = h.MessageType
+	binary.BigEndian.PutUint16(b[lengthPosByte:lengthPosByte+2], uint16(l-minHeaderLen+h.PayloadLen))
+	binary.BigEndian.PutUint32(b[teidPosByte:teidPosByte+4], h.TEID)
+	if h.hasOptional() {
+		binary.BigEndian.PutUint16(b[seqPosByte:seqPosByte+2], h.SequenceNumber)
+		b[npduPosByte] = h.NPDUNumber
+		b[nextExtPosByte] = h.NextExtensionHeaderType
+	}
+	return nil
+}
+
+// ParseHeader parses a given byte sequence as a Header.
+// The payload starts at offset MarshalLen() of b.
+func ParseHeader(b []byte) (*Header, error) {
+	h := &Header{}
+	if err := h.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a Header.
+func (h *Header) UnmarshalBinary(b []byte) error {
+	if len(b) < minHeaderLen {
+		return ErrTooShortToParse
+	}
+	flags := b[flagsPosByte]
+	if flags>>versionPosBit != version || flags&ptMask == 0 {
+		return ErrMalformedHeader
+	}
+	r := Header{
+		MessageType: b[messageTypePosByte],
+		TEID:        binary.BigEndian.Uint32(b[teidPosByte : teidPosByte+4]),
+		E:           flags&eMask != 0,
+		S:           flags&sMask != 0,
+		PN:          flags&pnMask != 0,
+	}
+	length := int(binary.BigEndian.Uint16(b[lengthPosByte : lengthPosByte+2]))
+	if r.hasOptional() {
+		if len(b) < minHeaderLen+optionalLen {
+			return ErrTooShortToParse
+		}
+		if length < optionalLen {
+			return ErrMalformedHeader
+		}
+		r.SequenceNumber = binary.BigEndian.Uint16(b[seqPosByte : seqPosByte+2])
+		r.NPDUNumber = b[npduPosByte]
+		r.NextExtensionHeaderType = b[nextExtPosByte]
+	}
+	r.PayloadLen = length - (r.MarshalLen() - minHeaderLen)
+	*h = r
+	return nil
+}
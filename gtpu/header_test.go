@@ -0,0 +1,83 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gtpu
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestHeader(t *testing.T) {
+	h := &Header{
+		MessageType: MessageTypeGPDU,
+		TEID:        0xCAFE,
+		PayloadLen:  84,
+	}
+	b, err := h.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := []byte{
+		0x30, 0xff, 0x00, 0x54,
+		0x00, 0x00, 0xca, 0xfe,
+	}
+	if diff := cmp.Diff(b, res); diff != "" {
+		t.Error(diff)
+	}
+	p, err := ParseHeader(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(p, h); diff != "" {
+		t.Error(diff)
+	}
+
+	h.S = true
+	h.SequenceNumber = 0x1234
+	b, err = h.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res = []byte{
+		0x32, 0xff, 0x00, 0x58,
+		0x00, 0x00, 0xca, 0xfe,
+		0x12, 0x34, 0x00, 0x00,
+	}
+	if diff := cmp.Diff(b, res); diff != "" {
+		t.Error(diff)
+	}
+	p, err = ParseHeader(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(p, h); diff != "" {
+		t.Error(diff)
+	}
+
+	h.PayloadLen = 0xFFFF
+	if _, err := h.Marshal(); err != ErrTooLong {
+		t.Errorf("Length overflow should be detected: %v", err)
+	}
+}
+
+func TestParseHeaderErrors(t *testing.T) {
+	tests := []struct {
+		b   []byte
+		err error
+	}{
+		{b: []byte{0x30, 0xff, 0x00, 0x00}, err: ErrTooShortToParse},
+		{b: []byte{0x50, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01}, err: ErrMalformedHeader},
+		{b: []byte{0x20, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01}, err: ErrMalformedHeader},
+		{b: []byte{0x34, 0xff, 0x00, 0x04, 0x00, 0x00, 0x00, 0x01}, err: ErrTooShortToParse},
+		{b: []byte{0x34, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x85}, err: ErrMalformedHeader},
+	}
+	for i, tc := range tests {
+		if _, err := ParseHeader(tc.b); err != tc.err {
+			t.Errorf("test %d: expected error %v, got %v", i, tc.err, err)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gtpu
+
+import "github.com/nextmn/rfc9433/encoding"
+
+// Extension header types (3GPP TS 29.281, section 5.2.1).
+const (
+	ExtensionHeaderTypeNoMore              = 0x00
+	ExtensionHeaderTypePDUSessionContainer = 0x85
+)
+
+// PDU Types of the PDU Session Container (3GPP TS 38.415, section 5.5.3.1).
+const (
+	PDUTypeDLPDUSessionInformation = 0
+	PDUTypeULPDUSessionInformation = 1
+)
+
+const (
+	// Field PDU Type
+	pduTypePosByte = 1 // position from left in bytes (after the Extension Header Length)
+	pduTypePosBit  = 4 // position from right of the byte in bits
+	pduTypeMask    = 0x0F
+
+	// Fields PPP, RQI, QFI (DL PDU SESSION INFORMATION)
+	qfiPosByte = 2
+	qfiMask    = 0x3F
+	rqiMask    = 0x40
+	pppMask    = 0x80
+
+	// Field PPI (DL PDU SESSION INFORMATION, present if PPP is set)
+	ppiPosByte = 3
+	ppiPosBit  = 5
+	ppiMask    = 0x07
+)
+
+// PDUSessionContainer is the PDU Session Container extension header (3GPP TS 29.281, section 5.2.2.7),
+// whose content is defined in 3GPP TS 38.415, section 5.5.2.
+// Only QFI, RQI and PPI are supported; other fields are set to zero.
+type PDUSessionContainer struct {
+	PDUType                 uint8 // PDUTypeDLPDUSessionInformation or PDUTypeULPDUSessionInformation
+	QFI                     uint8 // QoS Flow Identifier
+	RQI                     bool  // Reflective QoS Indicator (DL only)
+	PPP                     bool  // Paging Policy Presence (DL only)
+	PPI                     uint8 // Paging Policy Indicator (DL only, if PPP is set)
+	NextExtensionHeaderType uint8
+}
+
+// NewPDUSessionContainer creates a PDUSessionContainer from the Args.Mob.Session of a SID:
+// the QFI is copied, and the R bit is used as RQI (RFC 9433, section 6.1).
+func NewPDUSessionContainer(pduType uint8, a *encoding.ArgsMobSession) *PDUSessionContainer {
+	return &PDUSessionContainer{
+		PDUType: pduType,
+		QFI:     a.QFI(),
+		RQI:     a.R() && pduType == PDUTypeDLPDUSessionInformation,
+	}
+}
+
+// ArgsMobSession returns the Args.Mob.Session for the given TEID,
+// with the QFI of the PDUSessionContainer and RQI as R bit.
+func (c *PDUSessionContainer) ArgsMobSession(teid uint32) *encoding.ArgsMobSession {
+	return encoding.NewArgsMobSession(c.QFI&qfiMask, c.RQI, false, teid)
+}
+
+// hasPPI returns true if the PPI field is present.
+func (c *PDUSessionContainer) hasPPI() bool {
+	return c.PDUType == PDUTypeDLPDUSessionInformation && c.PPP
+}
+
+// MarshalLen returns the serial length of PDUSessionContainer.
+func (c *PDUSessionContainer) MarshalLen() int {
+	if c.hasPPI() {
+		return 8
+	}
+	return 4
+}
+
+// Marshal returns the byte sequence generated from PDUSessionContainer.
+func (c *PDUSessionContainer) Marshal() ([]byte, error) {
+	b := make([]byte, c.MarshalLen())
+	if err := c.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (c *PDUSessionContainer) MarshalTo(b []byte) error {
+	l := c.MarshalLen()
+	if len(b) < l {
+		return ErrTooShortToMarshal
+	}
+	clear(b[:l])
+	b[0] = uint8(l / 4) // Extension Header Length, in 4 bytes units
+	b[pduTypePosByte] = (c.PDUType & pduTypeMask) << pduTypePosBit
+	b[qfiPosByte] = c.QFI & qfiMask
+	if c.PDUType == PDUTypeDLPDUSessionInformation {
+		if c.RQI {
+			b[qfiPosByte] |= rqiMask
+		}
+		if c.PPP {
+			b[qfiPosByte] |= pppMask
+			b[ppiPosByte] = (c.PPI & ppiMask) << ppiPosBit
+		}
+	}
+	b[l-1] = c.NextExtensionHeaderType
+	return nil
+}
+
+// ParsePDUSessionContainer parses a given byte sequence as a PDUSessionContainer.
+// The next extension header (or the T-PDU) starts at offset 4*b[0] (Extension Header Length).
+func ParsePDUSessionContainer(b []byte) (*PDUSessionContainer, error) {
+	c := &PDUSessionContainer{}
+	if err := c.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a PDUSessionContainer.
+func (c *PDUSessionContainer) UnmarshalBinary(b []byte) error {
+	if len(b) < 4 {
+		return ErrTooShortToParse
+	}
+	l := 4 * int(b[0])
+	if l == 0 {
+		return ErrMalformedHeader
+	}
+	if len(b) < l {
+		return ErrTooShortToParse
+	}
+	r := PDUSessionContainer{
+		PDUType:                 (b[pduTypePosByte] >> pduTypePosBit) & pduTypeMask,
+		QFI:                     b[qfiPosByte] & qfiMask,
+		NextExtensionHeaderType: b[l-1],
+	}
+	if r.PDUType == PDUTypeDLPDUSessionInformation {
+		r.RQI = b[qfiPosByte]&rqiMask != 0
+		r.PPP = b[qfiPosByte]&pppMask != 0
+		if r.PPP {
+			if l < 8 {
+				return ErrMalformedHeader
+			}
+			r.PPI = (b[ppiPosByte] >> ppiPosBit) & ppiMask
+		}
+	}
+	*c = r
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gtpu
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding"
+)
+
+func TestPDUSessionContainer(t *testing.T) {
+	a := encoding.NewArgsMobSession(9, true, false, 0xCAFE)
+	c := NewPDUSessionContainer(PDUTypeDLPDUSessionInformation, a)
+	b, err := c.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(b, []byte{0x01, 0x00, 0x49, 0x00}); diff != "" {
+		t.Error(diff)
+	}
+	p, err := ParsePDUSessionContainer(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(p, c); diff != "" {
+		t.Error(diff)
+	}
+	res := p.ArgsMobSession(0xCAFE)
+	if res.QFI() != 9 || !res.R() || res.U() || res.PDUSessionID() != 0xCAFE {
+		t.Errorf("Unexpected ArgsMobSession: %d %t %t %x", res.QFI(), res.R(), res.U(), res.PDUSessionID())
+	}
+
+	c.PPP = true
+	c.PPI = 5
+	c.NextExtensionHeaderType = 0x40
+	b, err = c.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(b, []byte{0x02, 0x00, 0xc9, 0xa0, 0x00, 0x00, 0x00, 0x40}); diff != "" {
+		t.Error(diff)
+	}
+	p, err = ParsePDUSessionContainer(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(p, c); diff != "" {
+		t.Error(diff)
+	}
+
+	ul := NewPDUSessionContainer(PDUTypeULPDUSessionInformation, a)
+	b, err = ul.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(b, []byte{0x01, 0x10, 0x09, 0x00}); diff != "" {
+		t.Error(diff)
+	}
+	if _, err := ParsePDUSessionContainer([]byte{0x02, 0x00, 0x09, 0x00}); err != ErrTooShortToParse {
+		t.Errorf("Truncated extension header should be rejected: %v", err)
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gtpu
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"strconv"
+	"time"
+)
+
+// defaultBufferSize is large enough for any UDP datagram.
+const defaultBufferSize = 0xFFFF
+
+// Message is a GTP-U message received by a Server.
+// Data and GPDU are only valid until the Handler returns.
+type Message struct {
+	Local  netip.AddrPort // local address of the Server, which may be unspecified
+	Remote netip.AddrPort // address of the sender
+	Header *Header
+	GPDU   *GPDU  // parsed G-PDU, nil for other messages
+	Data   []byte // the whole message
+}
+
+// Handler handles the messages received by a Server.
+type Handler interface {
+	ServeGTPU(m *Message) error
+}
+
+// HandlerFunc is an adapter to allow the use of ordinary functions as Handler.
+type HandlerFunc func(m *Message) error
+
+// ServeGTPU calls f(m).
+func (f HandlerFunc) ServeGTPU(m *Message) error {
+	return f(m)
+}
+
+// ErrorHandler is notified of the messages dropped by a Server.
+// b is only valid until HandleError returns.
+type ErrorHandler interface {
+	HandleError(b []byte, remote netip.AddrPort, err error)
+}
+
+// ErrorHandlerFunc is an adapter to allow the use of ordinary functions as ErrorHandler.
+type ErrorHandlerFunc func(b []byte, remote netip.AddrPort, err error)
+
+// HandleError calls f(b, remote, err).
+func (f ErrorHandlerFunc) HandleError(b []byte, remote netip.AddrPort, err error) {
+	f(b, remote, err)
+}
+
+// ServerOption configures a Server.
+type ServerOption func(*Server)
+
+// WithErrorHandler sets the ErrorHandler of the Server.
+// By default, errors are ignored.
+func WithErrorHandler(h ErrorHandler) ServerOption {
+	return func(s *Server) {
+		s.errors = h
+	}
+}
+
+// WithMessageHandler sets the Handler of the messages other than G-PDU and Echo Request
+// (e.g. Echo Response, Error Indication, End Marker).
+// By default, they are ignored.
+func WithMessageHandler(h Handler) ServerOption {
+	return func(s *Server) {
+		s.messages = h
+	}
+}
+
+// WithRestartCounter sets the Restart Counter of the Echo Responses, which should be zero for GTP-U.
+func WithRestartCounter(c uint8) ServerOption {
+	return func(s *Server) {
+		s.restartCounter = c
+	}
+}
+
+// Server receives GTP-U messages on an UDP socket:
+// Echo Requests are answered, G-PDUs are handed to a Handler (e.g. a H.M.GTP4.D translator),
+// and other messages are handed to the optional message Handler.
+type Server struct {
+	gpdu           Handler
+	messages       Handler
+	errors         ErrorHandler
+	restartCounter uint8
+}
+
+// NewServer creates a Server handing G-PDUs to h.
+func NewServer(h Handler, opts ...ServerOption) *Server {
+	s := &Server{
+		gpdu: h,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// ListenAndServe listens on the UDP address addr, and serves until ctx is done.
+// If addr has no port, Port is used; an empty addr listens on all addresses.
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, strconv.Itoa(Port))
+	}
+	conn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return s.Serve(ctx, conn)
+}
+
+// Serve serves the messages received on conn until ctx is done or conn fails, and returns ctx.Err() in the former case.
+// Messages are served one at a time, so the message being served when ctx is done is handled before Serve returns:
+// cancelling ctx is enough for a graceful shutdown, and conn can be closed once Serve has returned.
+// conn is not closed.
+func (s *Server) Serve(ctx context.Context, conn net.PacketConn) error {
+	stop := context.AfterFunc(ctx, func() {
+		conn.SetReadDeadline(time.Now())
+	})
+	defer stop()
+	var local netip.AddrPort
+	if a, ok := conn.LocalAddr().(*net.UDPAddr); ok {
+		local = a.AddrPort()
+	}
+	buf := make([]byte, defaultBufferSize)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		var remote netip.AddrPort
+		if a, ok := addr.(*net.UDPAddr); ok {
+			remote = a.AddrPort()
+		}
+		if err := s.serve(conn, &Message{Local: local, Remote: remote, Data: buf[:n]}, addr); err != nil && s.errors != nil {
+			s.errors.HandleError(buf[:n], remote, err)
+		}
+	}
+}
+
+// serve demultiplexes a single message.
+func (s *Server) serve(conn net.PacketConn, m *Message, addr net.Addr) error {
+	h, err := ParseHeader(m.Data)
+	if err != nil {
+		return err
+	}
+	m.Header = h
+	switch h.MessageType {
+	case MessageTypeGPDU:
+		if m.GPDU, err = ParseGPDU(m.Data); err != nil {
+			return err
+		}
+		return s.gpdu.ServeGTPU(m)
+	case MessageTypeEchoRequest:
+		req, err := ParseEchoRequest(m.Data)
+		if err != nil {
+			return err
+		}
+		resp := req.Response()
+		resp.RestartCounter = s.restartCounter
+		b, err := resp.Marshal()
+		if err != nil {
+			return err
+		}
+		_, err = conn.WriteTo(b, addr)
+		return err
+	default:
+		if s.messages == nil {
+			return nil
+		}
+		return s.messages.ServeGTPU(m)
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package gtpu
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestServer(t *testing.T) {
+	gpdus := make(chan *GPDU, 1)
+	messages := make(chan uint8, 1)
+	errs := make(chan error, 1)
+	s := NewServer(HandlerFunc(func(m *Message) error {
+		if m.Remote.Addr() != netip.MustParseAddr("127.0.0.1") || !m.Local.Addr().IsLoopback() {
+			t.Errorf("Unexpected addresses: %s -> %s", m.Remote, m.Local)
+		}
+		g := *m.GPDU
+		g.TPDU = append([]byte(nil), g.TPDU...)
+		gpdus <- &g
+		return nil
+	}), WithMessageHandler(HandlerFunc(func(m *Message) error {
+		messages <- m.Header.MessageType
+		return nil
+	})), WithErrorHandler(ErrorHandlerFunc(func(b []byte, remote netip.AddrPort, err error) {
+		errs <- err
+	})), WithRestartCounter(3))
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- s.Serve(ctx, conn)
+	}()
+
+	client, err := net.Dial("udp", conn.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	client.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	// Echo
+	req, err := (&EchoRequest{SequenceNumber: 42}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Write(req); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 64)
+	n, err := client.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := ParseEchoResponse(buf[:n])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(resp, &EchoResponse{SequenceNumber: 42, RestartCounter: 3}); diff != "" {
+		t.Error(diff)
+	}
+
+	// G-PDU
+	if _, err := client.Write([]byte{0x30, 0xff, 0x00, 0x01, 0x00, 0x00, 0xca, 0xfe, 0x45}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case g := <-gpdus:
+		if g.Header.TEID != 0xcafe {
+			t.Errorf("Unexpected TEID: %x", g.Header.TEID)
+		}
+		if diff := cmp.Diff(g.TPDU, []byte{0x45}); diff != "" {
+			t.Error(diff)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("G-PDU not handled")
+	}
+
+	// other message
+	ei, err := (&ErrorIndication{TEID: 1, PeerAddress: netip.MustParseAddr("10.0.0.1")}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Write(ei); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case mt := <-messages:
+		if mt != MessageTypeErrorIndication {
+			t.Errorf("Unexpected message type: %d", mt)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Error Indication not handled")
+	}
+
+	// malformed message
+	if _, err := client.Write([]byte{0x00}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-errs:
+		if !errors.Is(err, ErrTooShortToParse) {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("error not handled")
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Serve did not return")
+	}
+}
+
+func TestServerListenAndServe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s := NewServer(HandlerFunc(func(m *Message) error { return nil }))
+	if err := s.ListenAndServe(ctx, "127.0.0.1:0"); !errors.Is(err, context.Canceled) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := s.ListenAndServe(ctx, "invalid address"); err == nil {
+		t.Error("Invalid address should be rejected")
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package headend provides helpers for SR Gateways acting as headend
+// (H.M.GTP4.D, RFC 9433 section 6.7) when building IPv6 addresses from GTP4 packets.
+package headend
@@ -0,0 +1,115 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package headend
+
+import (
+	"net/netip"
+	"sync/atomic"
+
+	"github.com/nextmn/rfc9433/encoding"
+)
+
+// Request contains information available to select the Source UPF Prefix.
+type Request struct {
+	Peer netip.Addr // GTP4 peer (IPv4 SA of the GTP4 packet)
+	DNN  string     // Data Network Name of the PDU Session, if known
+}
+
+// PrefixSelector chooses the Source UPF Prefix used to build the IPv6 SA,
+// for gateways fronting multiple UPF identities.
+type PrefixSelector interface {
+	SelectPrefix(req Request) (netip.Prefix, error)
+}
+
+// StaticPrefix is a PrefixSelector always returning the same prefix.
+type StaticPrefix netip.Prefix
+
+// SelectPrefix returns the static prefix.
+func (p StaticPrefix) SelectPrefix(req Request) (netip.Prefix, error) {
+	return netip.Prefix(p), nil
+}
+
+// PeerPrefixes is a PrefixSelector choosing the prefix according to the GTP4 peer.
+type PeerPrefixes struct {
+	prefixes map[netip.Addr]netip.Prefix
+	fallback PrefixSelector
+}
+
+// NewPeerPrefixes creates a PeerPrefixes.
+// The fallback is used for unknown peers, and may be nil.
+func NewPeerPrefixes(prefixes map[netip.Addr]netip.Prefix, fallback PrefixSelector) *PeerPrefixes {
+	return &PeerPrefixes{
+		prefixes: prefixes,
+		fallback: fallback,
+	}
+}
+
+// SelectPrefix returns the prefix associated with the GTP4 peer.
+func (p *PeerPrefixes) SelectPrefix(req Request) (netip.Prefix, error) {
+	if prefix, ok := p.prefixes[req.Peer]; ok {
+		return prefix, nil
+	}
+	if p.fallback == nil {
+		return netip.Prefix{}, ErrNoPrefix
+	}
+	return p.fallback.SelectPrefix(req)
+}
+
+// DNNPrefixes is a PrefixSelector choosing the prefix according to the DNN.
+type DNNPrefixes struct {
+	prefixes map[string]netip.Prefix
+	fallback PrefixSelector
+}
+
+// NewDNNPrefixes creates a DNNPrefixes.
+// The fallback is used for unknown DNNs, and may be nil.
+func NewDNNPrefixes(prefixes map[string]netip.Prefix, fallback PrefixSelector) *DNNPrefixes {
+	return &DNNPrefixes{
+		prefixes: prefixes,
+		fallback: fallback,
+	}
+}
+
+// SelectPrefix returns the prefix associated with the DNN.
+func (p *DNNPrefixes) SelectPrefix(req Request) (netip.Prefix, error) {
+	if prefix, ok := p.prefixes[req.DNN]; ok {
+		return prefix, nil
+	}
+	if p.fallback == nil {
+		return netip.Prefix{}, ErrNoPrefix
+	}
+	return p.fallback.SelectPrefix(req)
+}
+
+// RoundRobin is a PrefixSelector cycling through a list of prefixes.
+type RoundRobin struct {
+	prefixes []netip.Prefix
+	next     atomic.Uint64
+}
+
+// NewRoundRobin creates a RoundRobin.
+func NewRoundRobin(prefixes []netip.Prefix) *RoundRobin {
+	return &RoundRobin{
+		prefixes: prefixes,
+	}
+}
+
+// SelectPrefix returns the next prefix of the list.
+func (p *RoundRobin) SelectPrefix(req Request) (netip.Prefix, error) {
+	if len(p.prefixes) == 0 {
+		return netip.Prefix{}, ErrNoPrefix
+	}
+	return p.prefixes[(p.next.Add(1)-1)%uint64(len(p.prefixes))], nil
+}
+
+// NewMGTP4IPv6Src creates a MGTP4IPv6Src using the Source UPF Prefix chosen by the selector.
+func NewMGTP4IPv6Src(selector PrefixSelector, req Request, ipv4 [4]byte, udpPortNumber uint16) (*encoding.MGTP4IPv6Src, error) {
+	prefix, err := selector.SelectPrefix(req)
+	if err != nil {
+		return nil, err
+	}
+	return encoding.NewMGTP4IPv6Src(prefix, ipv4, udpPortNumber), nil
+}
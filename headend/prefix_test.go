@@ -0,0 +1,48 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package headend
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func ExamplePeerPrefixes() {
+	selector := NewPeerPrefixes(map[netip.Addr]netip.Prefix{
+		netip.MustParseAddr("192.0.2.1"): netip.MustParsePrefix("fd00:1:1::/48"),
+	}, StaticPrefix(netip.MustParsePrefix("fd00:1:2::/48")))
+	src, _ := NewMGTP4IPv6Src(selector, Request{Peer: netip.MustParseAddr("192.0.2.1")}, [4]byte{192, 0, 2, 1}, 1337)
+	src.Marshal()
+}
+
+func TestPrefixSelector(t *testing.T) {
+	p1 := netip.MustParsePrefix("fd00:1:1::/48")
+	p2 := netip.MustParsePrefix("fd00:1:2::/48")
+	p3 := netip.MustParsePrefix("fd00:1:3::/48")
+
+	rr := NewRoundRobin([]netip.Prefix{p1, p2})
+	dnn := NewDNNPrefixes(map[string]netip.Prefix{"internet": p3}, rr)
+	for _, expected := range []netip.Prefix{p1, p2, p1} {
+		if p, err := dnn.SelectPrefix(Request{DNN: "ims"}); err != nil {
+			t.Fatal(err)
+		} else if p != expected {
+			t.Errorf("Unexpected prefix: %s instead of %s", p, expected)
+		}
+	}
+	if p, err := dnn.SelectPrefix(Request{DNN: "internet"}); err != nil {
+		t.Fatal(err)
+	} else if p != p3 {
+		t.Errorf("Unexpected prefix: %s instead of %s", p, p3)
+	}
+
+	peer := NewPeerPrefixes(map[netip.Addr]netip.Prefix{netip.MustParseAddr("192.0.2.1"): p1}, nil)
+	if _, err := peer.SelectPrefix(Request{Peer: netip.MustParseAddr("192.0.2.2")}); err != ErrNoPrefix {
+		t.Errorf("Unknown peer without fallback should fail: %v", err)
+	}
+	if _, err := NewRoundRobin(nil).SelectPrefix(Request{}); err != ErrNoPrefix {
+		t.Errorf("Empty round robin should fail: %v", err)
+	}
+}
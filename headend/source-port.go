@@ -0,0 +1,75 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package headend
+
+import "github.com/nextmn/rfc9433/flowhash"
+
+// PortRange is an inclusive range of UDP ports.
+type PortRange struct {
+	Min uint16
+	Max uint16
+}
+
+// DefaultPortRange is the range of Dynamic Ports (RFC 6335, section 6).
+var DefaultPortRange = PortRange{Min: 49152, Max: 65535}
+
+// SourcePortGenerator derives the UDP Source Port embedded in the IPv6 SA (MGTP4IPv6Src)
+// from the flow of the inner packet, so that the packets of a flow share the same port,
+// and different flows are spread over the configured port ranges for load balancing
+// (TS 129.281, section 4.4.2.0). The port is derived from the hash of the flow.
+type SourcePortGenerator struct {
+	hasher *flowhash.Hasher
+	ranges []PortRange
+	total  uint32 // number of ports in ranges
+}
+
+// NewSourcePortGenerator creates a SourcePortGenerator hashing flows with hasher, using ports of the given ranges,
+// which must not overlap nor contain the port 0. If no range is given, DefaultPortRange is used.
+func NewSourcePortGenerator(hasher *flowhash.Hasher, ranges ...PortRange) (*SourcePortGenerator, error) {
+	if len(ranges) == 0 {
+		ranges = []PortRange{DefaultPortRange}
+	}
+	g := &SourcePortGenerator{
+		hasher: hasher,
+		ranges: make([]PortRange, 0, len(ranges)),
+	}
+	for _, r := range ranges {
+		if r.Min == 0 || r.Min > r.Max {
+			return nil, ErrInvalidPortRange
+		}
+		for _, o := range g.ranges {
+			if r.Min <= o.Max && o.Min <= r.Max {
+				return nil, ErrInvalidPortRange
+			}
+		}
+		g.ranges = append(g.ranges, r)
+		g.total += uint32(r.Max-r.Min) + 1
+	}
+	return g, nil
+}
+
+// Port returns the UDP Source Port of the flow.
+func (g *SourcePortGenerator) Port(k flowhash.FlowKey) uint16 {
+	n := uint32(g.hasher.Hash(k) % uint64(g.total))
+	for _, r := range g.ranges {
+		size := uint32(r.Max-r.Min) + 1
+		if n < size {
+			return r.Min + uint16(n)
+		}
+		n -= size
+	}
+	// unreachable: n < total
+	return g.ranges[0].Min
+}
+
+// PortForPacket returns the UDP Source Port of the flow of an IPv4 or IPv6 packet.
+func (g *SourcePortGenerator) PortForPacket(pkt []byte) (uint16, error) {
+	k, err := flowhash.ParseFlowKey(pkt)
+	if err != nil {
+		return 0, err
+	}
+	return g.Port(k), nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package headend
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/nextmn/rfc9433/flowhash"
+)
+
+func TestSourcePortGenerator(t *testing.T) {
+	ranges := []PortRange{{Min: 1000, Max: 1004}, {Min: 2000, Max: 2004}}
+	g, err := NewSourcePortGenerator(flowhash.New(flowhash.Key{1}, false), ranges...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := NewSourcePortGenerator(flowhash.New(flowhash.Key{2}, false), ranges...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := make(map[uint16]bool)
+	differ := false
+	for i := 0; i < 1000; i++ {
+		k := flowhash.FlowKey{
+			Src:      netip.MustParseAddr("10.0.0.1"),
+			Dst:      netip.MustParseAddr("10.0.0.2"),
+			Protocol: 17,
+			SrcPort:  uint16(i),
+			DstPort:  53,
+		}
+		p := g.Port(k)
+		if p != g.Port(k) {
+			t.Fatal("Port should be stable for a flow")
+		}
+		if (p < 1000 || p > 1004) && (p < 2000 || p > 2004) {
+			t.Fatalf("Port out of ranges: %d", p)
+		}
+		seen[p] = true
+		differ = differ || p != other.Port(k)
+	}
+	if len(seen) != 10 {
+		t.Errorf("Flows should be spread over all ports: %d ports used", len(seen))
+	}
+	if !differ {
+		t.Error("Ports should depend on the secret")
+	}
+
+	g, err = NewSourcePortGenerator(flowhash.New(flowhash.Key{}, false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := g.Port(flowhash.FlowKey{}); p < DefaultPortRange.Min {
+		t.Errorf("Port out of the default range: %d", p)
+	}
+	for _, r := range [][]PortRange{
+		{{Min: 0, Max: 10}},
+		{{Min: 10, Max: 5}},
+		{{Min: 10, Max: 20}, {Min: 20, Max: 30}},
+	} {
+		if _, err := NewSourcePortGenerator(flowhash.New(flowhash.Key{}, false), r...); err != ErrInvalidPortRange {
+			t.Errorf("Expected ErrInvalidPortRange for %v, got %v", r, err)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package icmp provides translation of ICMP error messages
+// between the SR domain (ICMPv6) and the legacy GTP4 network (ICMPv4),
+// so path errors can propagate across the SRv6/GTP4 interworking boundary.
+//
+// Translation of types and codes follows RFC 7915 (IP/ICMP Translation Algorithm).
+package icmp
@@ -0,0 +1,17 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package icmp
+
+import "errors"
+
+var (
+	ErrTooShortToParse  = errors.New("too short to parse")
+	ErrNotTranslatable  = errors.New("ICMP message cannot be translated")
+	ErrMalformedPacket  = errors.New("malformed invoking packet")
+	ErrNotGTP4          = errors.New("invoking packet is not a GTP4 packet")
+	ErrUnsupportedRoute = errors.New("unsupported routing header")
+	ErrNotPacketTooBig  = errors.New("not a Packet Too Big message")
+)
@@ -0,0 +1,68 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package icmp
+
+import "encoding/binary"
+
+// lastSegment returns the final destination of the given IPv6 packet
+// (last segment of the SRH if any, IPv6 DA otherwise),
+// and the offset of the payload after extension headers.
+func lastSegment(packet []byte) (sid [16]byte, offset int, err error) {
+	if len(packet) < ipv6HeaderLen {
+		return sid, 0, ErrTooShortToParse
+	}
+	if packet[0]>>4 != 6 {
+		return sid, 0, ErrMalformedPacket
+	}
+	copy(sid[:], packet[24:40])
+	nh := packet[6]
+	offset = ipv6HeaderLen
+	for {
+		switch nh {
+		case nhHopByHop, nhDestOpts:
+			if len(packet) < offset+2 {
+				return sid, 0, ErrTooShortToParse
+			}
+			nh, offset = packet[offset], offset+(int(packet[offset+1])+1)*8
+		case nhRouting:
+			if len(packet) < offset+8+16 {
+				return sid, 0, ErrTooShortToParse
+			}
+			if packet[offset+2] != routingTypeSRH {
+				return sid, 0, ErrUnsupportedRoute
+			}
+			l := (int(packet[offset+1]) + 1) * 8
+			if l < 8+16 {
+				return sid, 0, ErrMalformedPacket
+			}
+			// the last segment is the first of the list
+			copy(sid[:], packet[offset+8:offset+8+16])
+			nh, offset = packet[offset], offset+l
+		default:
+			if offset > len(packet) {
+				return sid, 0, ErrTooShortToParse
+			}
+			return sid, offset, nil
+		}
+	}
+}
+
+// ParsePacketTooBig parses an ICMPv6 Packet Too Big message (starting with the ICMPv6 header),
+// and returns the MTU and the final destination of the invoking packet
+// (last segment of the SRH if any, IPv6 DA otherwise).
+func ParsePacketTooBig(msg []byte) (mtu uint32, dst [16]byte, err error) {
+	if len(msg) < headerLen {
+		return 0, dst, ErrTooShortToParse
+	}
+	if msg[0] != TypeV6PacketTooBig {
+		return 0, dst, ErrNotPacketTooBig
+	}
+	dst, _, err = lastSegment(msg[headerLen:])
+	if err != nil {
+		return 0, dst, err
+	}
+	return binary.BigEndian.Uint32(msg[4:headerLen]), dst, nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package icmp
+
+// ICMPv4 error types
+const (
+	TypeV4DestinationUnreachable = 3
+	TypeV4TimeExceeded           = 11
+	TypeV4ParameterProblem       = 12
+)
+
+// ICMPv6 error types
+const (
+	TypeV6DestinationUnreachable = 1
+	TypeV6PacketTooBig           = 2
+	TypeV6TimeExceeded           = 3
+	TypeV6ParameterProblem       = 4
+)
+
+const (
+	codeV4FragmentationNeeded = 4
+	codeV4ProtocolUnreachable = 2
+	codeV6UnrecognizedNH      = 1
+
+	pointerV6NextHeader = 6 // position of the Next Header field in the IPv6 header
+)
+
+// pointerV6ToV4 translates the pointer of an ICMPv6 Parameter Problem
+// into the pointer of an ICMPv4 Parameter Problem (RFC 7915, section 5.2, Figure 6).
+// Returns false if there is no equivalent field in the IPv4 header.
+func pointerV6ToV4(p uint8) (uint8, bool) {
+	switch {
+	case p == 0: // Version
+		return 0, true
+	case p == 1: // Traffic Class
+		return 1, true
+	case p == 4 || p == 5: // Payload Length
+		return 2, true
+	case p == 6: // Next Header
+		return 9, true
+	case p == 7: // Hop Limit
+		return 8, true
+	case p >= 8 && p <= 23: // Source Address
+		return 12, true
+	case p >= 24 && p <= 39: // Destination Address
+		return 16, true
+	}
+	return 0, false
+}
+
+// pointerV4ToV6 translates the pointer of an ICMPv4 Parameter Problem
+// into the pointer of an ICMPv6 Parameter Problem (RFC 7915, section 4.2, Figure 3).
+// Returns false if there is no equivalent field in the IPv6 header.
+func pointerV4ToV6(p uint8) (uint8, bool) {
+	switch {
+	case p == 0 || p == 1: // Version/IHL, Type of Service
+		return p, true
+	case p == 2 || p == 3: // Total Length
+		return 4, true
+	case p == 8: // Time to Live
+		return 7, true
+	case p == 9: // Protocol
+		return 6, true
+	case p >= 12 && p <= 15: // Source Address
+		return 8, true
+	case p >= 16 && p <= 19: // Destination Address
+		return 24, true
+	}
+	return 0, false
+}
+
+// V6ToV4 translates the type and code of an ICMPv6 error message
+// into the type and code of the equivalent ICMPv4 error message (RFC 7915, section 5.2).
+// Returns false if the message must be silently dropped.
+// For Parameter Problem messages, the pointer must be translated separately.
+func V6ToV4(typ uint8, code uint8) (uint8, uint8, bool) {
+	switch typ {
+	case TypeV6DestinationUnreachable:
+		switch code {
+		case 0, 2, 3: // No route, Beyond scope, Address unreachable
+			return TypeV4DestinationUnreachable, 1, true // Host unreachable
+		case 1: // Administratively prohibited
+			return TypeV4DestinationUnreachable, 10, true
+		case 4: // Port unreachable
+			return TypeV4DestinationUnreachable, 3, true
+		}
+	case TypeV6PacketTooBig:
+		return TypeV4DestinationUnreachable, codeV4FragmentationNeeded, true
+	case TypeV6TimeExceeded:
+		return TypeV4TimeExceeded, code, true
+	case TypeV6ParameterProblem:
+		switch code {
+		case 0: // Erroneous header field
+			return TypeV4ParameterProblem, 0, true
+		case codeV6UnrecognizedNH:
+			return TypeV4DestinationUnreachable, codeV4ProtocolUnreachable, true
+		}
+	}
+	return 0, 0, false
+}
+
+// V4ToV6 translates the type and code of an ICMPv4 error message
+// into the type and code of the equivalent ICMPv6 error message (RFC 7915, section 4.2).
+// Returns false if the message must be silently dropped.
+// For Parameter Problem messages, the pointer must be translated separately.
+func V4ToV6(typ uint8, code uint8) (uint8, uint8, bool) {
+	switch typ {
+	case TypeV4DestinationUnreachable:
+		switch code {
+		case 0, 1, 5, 6, 7, 8, 11, 12: // Net/Host unreachable, Source route failed, ...
+			return TypeV6DestinationUnreachable, 0, true // No route to destination
+		case codeV4ProtocolUnreachable:
+			return TypeV6ParameterProblem, codeV6UnrecognizedNH, true
+		case 3: // Port unreachable
+			return TypeV6DestinationUnreachable, 4, true
+		case codeV4FragmentationNeeded:
+			return TypeV6PacketTooBig, 0, true
+		case 9, 10, 13, 15: // Administratively prohibited, Precedence cutoff in effect
+			return TypeV6DestinationUnreachable, 1, true
+		}
+	case TypeV4TimeExceeded:
+		return TypeV6TimeExceeded, code, true
+	case TypeV4ParameterProblem:
+		switch code {
+		case 0, 2: // Pointer indicates the error, Bad length
+			return TypeV6ParameterProblem, 0, true
+		}
+	}
+	return 0, 0, false
+}
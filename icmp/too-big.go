@@ -0,0 +1,52 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package icmp
+
+import (
+	"encoding/binary"
+
+	"github.com/nextmn/rfc9433/checksum"
+)
+
+// NewPacketTooBig returns an ICMPv6 Packet Too Big message (RFC 4443, section 3.2),
+// starting with the ICMPv6 header, about the invoking IPv6 packet.
+// The message is sent from src to the IPv6 SA of the invoking packet, which is truncated
+// to fit in the minimum IPv6 MTU. The MTU is raised to the minimum IPv6 MTU if needed.
+func NewPacketTooBig(src [16]byte, mtu int, invoking []byte) ([]byte, error) {
+	if len(invoking) < ipv6HeaderLen {
+		return nil, ErrTooShortToParse
+	}
+	if invoking[0]>>4 != 6 {
+		return nil, ErrMalformedPacket
+	}
+	out := make([]byte, min(headerLen+len(invoking), maxICMPv6Len))
+	out[0] = TypeV6PacketTooBig
+	binary.BigEndian.PutUint32(out[4:headerLen], uint32(max(mtu, minMTUv6)))
+	copy(out[headerLen:], invoking)
+	dst := [16]byte(invoking[8:24])
+	binary.BigEndian.PutUint16(out[2:4], checksum.Fold(checksum.Sum(checksum.PseudoHeaderIPv6(src, dst, protoICMPv6, len(out)), out)))
+	return out, nil
+}
+
+// NewFragmentationNeeded returns an ICMPv4 Destination Unreachable message
+// with code Fragmentation Needed and DF Set (RFC 1191, section 4), starting with the ICMPv4 header,
+// about the invoking IPv4 packet. The message is sent to the IPv4 SA of the invoking packet.
+// The invoking packet is truncated to fit in 576 bytes, and the MTU is raised to 68 bytes if needed.
+func NewFragmentationNeeded(mtu int, invoking []byte) ([]byte, error) {
+	if len(invoking) < ipv4HeaderLen {
+		return nil, ErrTooShortToParse
+	}
+	if invoking[0]>>4 != 4 {
+		return nil, ErrMalformedPacket
+	}
+	out := make([]byte, min(headerLen+len(invoking), maxICMPv4Len))
+	out[0] = TypeV4DestinationUnreachable
+	out[1] = codeV4FragmentationNeeded
+	binary.BigEndian.PutUint16(out[6:headerLen], uint16(min(max(mtu, minMTUv4), maxMTU)))
+	copy(out[headerLen:], invoking)
+	binary.BigEndian.PutUint16(out[2:4], checksum.Checksum(out))
+	return out, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package icmp
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"testing"
+
+	"github.com/nextmn/rfc9433/checksum"
+	"github.com/nextmn/rfc9433/ipv4"
+)
+
+func TestNewPacketTooBig(t *testing.T) {
+	src := netip.MustParseAddr("fd00:3::1").As16()
+	sa := netip.MustParseAddr("fd00:1::1").As16()
+	invoking := make([]byte, 1500)
+	invoking[0] = 0x60
+	binary.BigEndian.PutUint16(invoking[4:6], 1500-ipv6HeaderLen)
+	invoking[6] = protoUDP
+	copy(invoking[8:24], sa[:])
+
+	msg, err := NewPacketTooBig(src, 1400, invoking)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msg) != maxICMPv6Len {
+		t.Errorf("Unexpected length: %d", len(msg))
+	}
+	if msg[0] != TypeV6PacketTooBig || msg[1] != 0 {
+		t.Errorf("Unexpected type/code: %d/%d", msg[0], msg[1])
+	}
+	if mtu := binary.BigEndian.Uint32(msg[4:8]); mtu != 1400 {
+		t.Errorf("Unexpected MTU: %d", mtu)
+	}
+	if checksum.Fold(checksum.Sum(checksum.PseudoHeaderIPv6(src, sa, protoICMPv6, len(msg)), msg)) != 0 {
+		t.Error("Invalid ICMPv6 checksum")
+	}
+	if mtu, _, err := ParsePacketTooBig(msg); err != nil || mtu != 1400 {
+		t.Errorf("Unexpected ParsePacketTooBig result: %d, %v", mtu, err)
+	}
+
+	if msg, err := NewPacketTooBig(src, 1000, invoking); err != nil || binary.BigEndian.Uint32(msg[4:8]) != minMTUv6 {
+		t.Errorf("MTU should be raised to %d: %v", minMTUv6, err)
+	}
+	if _, err := NewPacketTooBig(src, 1400, invoking[:ipv6HeaderLen-1]); err != ErrTooShortToParse {
+		t.Errorf("Expected ErrTooShortToParse, got %v", err)
+	}
+}
+
+func TestNewFragmentationNeeded(t *testing.T) {
+	h := ipv4.Header{
+		DF:         true,
+		TTL:        64,
+		Protocol:   protoUDP,
+		Src:        [4]byte{192, 0, 2, 1},
+		Dst:        [4]byte{198, 51, 100, 1},
+		PayloadLen: 28,
+	}
+	invoking, err := h.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	invoking = append(invoking, make([]byte, 28)...)
+
+	msg, err := NewFragmentationNeeded(20, invoking)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msg) != headerLen+len(invoking) {
+		t.Errorf("Unexpected length: %d", len(msg))
+	}
+	if msg[0] != TypeV4DestinationUnreachable || msg[1] != codeV4FragmentationNeeded {
+		t.Errorf("Unexpected type/code: %d/%d", msg[0], msg[1])
+	}
+	if mtu := binary.BigEndian.Uint16(msg[6:8]); mtu != minMTUv4 {
+		t.Errorf("Unexpected MTU: %d", mtu)
+	}
+	if ipv4.Checksum(msg) != 0 {
+		t.Error("Invalid ICMPv4 checksum")
+	}
+	if _, err := NewFragmentationNeeded(1400, invoking[:ipv4HeaderLen-1]); err != ErrTooShortToParse {
+		t.Errorf("Expected ErrTooShortToParse, got %v", err)
+	}
+}
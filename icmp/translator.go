@@ -0,0 +1,353 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package icmp
+
+import (
+	"encoding/binary"
+	"errors"
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/checksum"
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/gtpu"
+	"github.com/nextmn/rfc9433/ipv4"
+)
+
+const (
+	headerLen     = 8  // ICMP header (type, code, checksum, and 4 bytes depending on the type)
+	ipv4HeaderLen = 20 // IPv4 header without options
+	ipv6HeaderLen = 40
+	udpHeaderLen  = 8
+
+	protoUDP    = 17
+	protoICMPv6 = 58
+
+	// IPv6 Next Header values
+	nhHopByHop = 0
+	nhIPv4     = 4
+	nhIPv6     = 41
+	nhRouting  = 43
+	nhDestOpts = 60
+	nhEthernet = 143
+
+	routingTypeSRH = 4
+
+	maxICMPv4Len = 576 - ipv4HeaderLen  // RFC 1812, section 4.3.2.3
+	maxICMPv6Len = 1280 - ipv6HeaderLen // RFC 4443, section 2.4 (c)
+	minMTUv4     = 68
+	minMTUv6     = 1280
+	maxMTU       = 0xFFFF
+)
+
+// Translator translates ICMP error messages at the boundary
+// between the SR domain and the legacy GTP4 network.
+//
+// ICMPv6 errors received from the SR domain are about packets
+// built by H.M.GTP4.D (IPv6 SA using NextMN encoding, End.M.GTP4.E SID as last segment):
+// the invoking GTP4 packet is reconstructed, and the ICMPv4 error
+// is sent to the IPv4 SA of this packet.
+//
+// ICMPv4 errors received from the GTP4 network are about packets
+// built by End.M.GTP4.E: the invoking SRv6 packet is reconstructed
+// (without SRH, since the segment list cannot be recovered),
+// and the ICMPv6 error is sent to the IPv6 SA of this packet.
+type Translator struct {
+	ipv6      [16]byte     // source address of generated ICMPv6 messages
+	srcPrefix netip.Prefix // Source UPF Prefix used to reconstruct the IPv6 SA
+	dstPrefix netip.Prefix // prefix of End.M.GTP4.E SIDs
+	ptb       PacketTooBigHandler
+}
+
+// PacketTooBigHandler handles the ICMPv6 Packet Too Big messages received from the SR domain,
+// e.g. a pmtu.Cache learning the Path MTU towards each SID.
+type PacketTooBigHandler interface {
+	HandlePacketTooBig(msg []byte) error
+}
+
+// Option is an option of the Translator.
+type Option func(*Translator)
+
+// WithPacketTooBigHandler makes ToIPv4 pass the ICMPv6 Packet Too Big messages to h before translating them.
+func WithPacketTooBigHandler(h PacketTooBigHandler) Option {
+	return func(t *Translator) {
+		t.ptb = h
+	}
+}
+
+// NewTranslator creates a Translator.
+// The IPv6 address is required to compute the checksum of ICMPv6 messages.
+func NewTranslator(ipv6 [16]byte, srcPrefix netip.Prefix, dstPrefix netip.Prefix, opts ...Option) *Translator {
+	t := &Translator{
+		ipv6:      ipv6,
+		srcPrefix: srcPrefix.Masked(),
+		dstPrefix: dstPrefix.Masked(),
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// ToIPv4 translates an ICMPv6 error message (starting with the ICMPv6 header)
+// into an ICMPv4 error message, and returns it along with its destination.
+// The checksum of the ICMPv6 message is not verified.
+func (t *Translator) ToIPv4(msg []byte) (netip.Addr, []byte, error) {
+	if len(msg) < headerLen+ipv6HeaderLen {
+		return netip.Addr{}, nil, ErrTooShortToParse
+	}
+	typ, code, ok := V6ToV4(msg[0], msg[1])
+	if !ok {
+		return netip.Addr{}, nil, ErrNotTranslatable
+	}
+	if msg[0] == TypeV6PacketTooBig && t.ptb != nil {
+		if err := t.ptb.HandlePacketTooBig(msg); err != nil {
+			return netip.Addr{}, nil, err
+		}
+	}
+	rest := binary.BigEndian.Uint32(msg[4:headerLen])
+
+	// parse invoking packet
+	invoking := msg[headerLen:]
+	if invoking[0]>>4 != 6 {
+		return netip.Addr{}, nil, ErrMalformedPacket
+	}
+	tc := invoking[0]<<4 | invoking[1]>>4
+	payloadLen := int(binary.BigEndian.Uint16(invoking[4:6]))
+	hopLimit := invoking[7]
+	var sa [16]byte
+	copy(sa[:], invoking[8:24])
+	sid, offset, err := lastSegment(invoking)
+	if err != nil {
+		return netip.Addr{}, nil, err
+	}
+	inner := invoking[offset:]
+	innerLen := payloadLen + ipv6HeaderLen - offset
+	if innerLen < 0 {
+		return netip.Addr{}, nil, ErrMalformedPacket
+	}
+	src, err := encoding.ParseMGTP4IPv6SrcNextMN(sa)
+	if err != nil {
+		return netip.Addr{}, nil, err
+	}
+	dst, err := encoding.ParseMGTP4IPv6Dst(sid, uint(t.dstPrefix.Bits()))
+	if err != nil {
+		return netip.Addr{}, nil, err
+	}
+
+	container := gtpu.NewPDUSessionContainer(gtpu.PDUTypeDLPDUSessionInformation, dst.ArgsMobSession())
+	gtpHeader := gtpu.Header{
+		MessageType:             gtpu.MessageTypeGPDU,
+		TEID:                    dst.PDUSessionID(),
+		E:                       true,
+		NextExtensionHeaderType: gtpu.ExtensionHeaderTypePDUSessionContainer,
+		PayloadLen:              container.MarshalLen() + innerLen,
+	}
+
+	// translate ICMP header
+	gtp4Len := ipv4HeaderLen + udpHeaderLen + gtpHeader.MarshalLen() + container.MarshalLen()
+	out := make([]byte, min(headerLen+gtp4Len+len(inner), maxICMPv4Len))
+	out[0] = typ
+	out[1] = code
+	switch {
+	case typ == TypeV4DestinationUnreachable && code == codeV4FragmentationNeeded:
+		mtu := int(rest) - offset + gtp4Len
+		binary.BigEndian.PutUint16(out[6:headerLen], uint16(min(max(mtu, minMTUv4), maxMTU)))
+	case typ == TypeV4ParameterProblem:
+		if rest > 0xFF {
+			return netip.Addr{}, nil, ErrNotTranslatable
+		}
+		p, ok := pointerV6ToV4(uint8(rest))
+		if !ok {
+			return netip.Addr{}, nil, ErrNotTranslatable
+		}
+		out[4] = p
+	}
+
+	// reconstruct invoking packet
+	b := make([]byte, gtp4Len+len(inner))
+	h := ipv4.Header{
+		TOS:        tc,
+		TTL:        hopLimit,
+		Protocol:   protoUDP,
+		Src:        src.IPv4().As4(),
+		Dst:        dst.IPv4().As4(),
+		PayloadLen: gtp4Len - ipv4HeaderLen + innerLen,
+	}
+	if err := h.MarshalTo(b); err != nil {
+		return netip.Addr{}, nil, err
+	}
+	udp := b[ipv4HeaderLen:]
+	port := src.UDPPortNumber()
+	if port == 0 {
+		port = gtpu.Port
+	}
+	binary.BigEndian.PutUint16(udp[0:2], port)
+	binary.BigEndian.PutUint16(udp[2:4], gtpu.Port)
+	binary.BigEndian.PutUint16(udp[4:6], uint16(gtp4Len-ipv4HeaderLen+innerLen))
+	gtp := udp[udpHeaderLen:]
+	if err := gtpHeader.MarshalTo(gtp); err != nil {
+		return netip.Addr{}, nil, err
+	}
+	if err := container.MarshalTo(gtp[gtpHeader.MarshalLen():]); err != nil {
+		return netip.Addr{}, nil, err
+	}
+	copy(b[gtp4Len:], inner)
+	copy(out[headerLen:], b)
+
+	binary.BigEndian.PutUint16(out[2:4], checksum.Checksum(out))
+	return src.IPv4(), out, nil
+}
+
+// ToIPv6 translates an ICMPv4 error message (starting with the ICMPv4 header)
+// into an ICMPv6 error message, and returns it along with its destination.
+// The checksum of the ICMPv4 message is not verified.
+func (t *Translator) ToIPv6(msg []byte) (netip.Addr, []byte, error) {
+	if len(msg) < headerLen+ipv4HeaderLen {
+		return netip.Addr{}, nil, ErrTooShortToParse
+	}
+	typ, code, ok := V4ToV6(msg[0], msg[1])
+	if !ok {
+		return netip.Addr{}, nil, ErrNotTranslatable
+	}
+
+	// parse invoking packet
+	invoking := msg[headerLen:]
+	if invoking[0]>>4 != 4 {
+		return netip.Addr{}, nil, ErrMalformedPacket
+	}
+	ihl := int(invoking[0]&0x0F) * 4
+	if ihl < ipv4HeaderLen {
+		return netip.Addr{}, nil, ErrMalformedPacket
+	}
+	if len(invoking) < ihl+udpHeaderLen {
+		return netip.Addr{}, nil, ErrTooShortToParse
+	}
+	tos := invoking[1]
+	totalLen := int(binary.BigEndian.Uint16(invoking[2:4]))
+	ttl := invoking[8]
+	if invoking[9] != protoUDP {
+		return netip.Addr{}, nil, ErrNotGTP4
+	}
+	var srcIPv4, dstIPv4 [4]byte
+	copy(srcIPv4[:], invoking[12:16])
+	copy(dstIPv4[:], invoking[16:20])
+	udp := invoking[ihl:]
+	port := binary.BigEndian.Uint16(udp[0:2])
+	if binary.BigEndian.Uint16(udp[2:4]) != gtpu.Port {
+		return netip.Addr{}, nil, ErrNotGTP4
+	}
+
+	// the invoking packet may be truncated: the G-PDU cannot be parsed as a whole
+	gtp := udp[udpHeaderLen:]
+	h, err := gtpu.ParseHeader(gtp)
+	if err != nil {
+		return netip.Addr{}, nil, gtpuError(err, ErrNotGTP4)
+	}
+	if h.MessageType != gtpu.MessageTypeGPDU {
+		return netip.Addr{}, nil, ErrNotGTP4
+	}
+	gtpLen := h.MarshalLen()
+	var args *encoding.ArgsMobSession
+	var next uint8 = gtpu.ExtensionHeaderTypeNoMore
+	if h.E {
+		next = h.NextExtensionHeaderType
+	}
+	for next != gtpu.ExtensionHeaderTypeNoMore {
+		if len(gtp) < gtpLen+1 {
+			return netip.Addr{}, nil, ErrTooShortToParse
+		}
+		l := int(gtp[gtpLen]) * 4
+		if l == 0 {
+			return netip.Addr{}, nil, ErrMalformedPacket
+		}
+		if len(gtp) < gtpLen+l {
+			return netip.Addr{}, nil, ErrTooShortToParse
+		}
+		if next == gtpu.ExtensionHeaderTypePDUSessionContainer {
+			c, err := gtpu.ParsePDUSessionContainer(gtp[gtpLen:])
+			if err != nil {
+				return netip.Addr{}, nil, gtpuError(err, ErrMalformedPacket)
+			}
+			args = c.ArgsMobSession(h.TEID)
+		}
+		next = gtp[gtpLen+l-1]
+		gtpLen += l
+	}
+	if args == nil {
+		args = encoding.NewArgsMobSession(0, false, false, h.TEID)
+	}
+	offset := ihl + udpHeaderLen + gtpLen
+	if offset > len(invoking) {
+		return netip.Addr{}, nil, ErrTooShortToParse
+	}
+	inner := invoking[offset:]
+	innerLen := totalLen - offset
+	if innerLen < 0 {
+		return netip.Addr{}, nil, ErrMalformedPacket
+	}
+	sa, err := encoding.NewMGTP4IPv6Src(t.srcPrefix, srcIPv4, port).Marshal()
+	if err != nil {
+		return netip.Addr{}, nil, err
+	}
+	da, err := encoding.NewMGTP4IPv6Dst(t.dstPrefix, dstIPv4, args).Marshal()
+	if err != nil {
+		return netip.Addr{}, nil, err
+	}
+
+	// translate ICMP header
+	out := make([]byte, min(headerLen+ipv6HeaderLen+len(inner), maxICMPv6Len))
+	out[0] = typ
+	out[1] = code
+	switch {
+	case typ == TypeV6PacketTooBig:
+		mtu := int(binary.BigEndian.Uint16(msg[6:headerLen])) - offset + ipv6HeaderLen
+		binary.BigEndian.PutUint32(out[4:headerLen], uint32(max(mtu, minMTUv6)))
+	case typ == TypeV6ParameterProblem && code == codeV6UnrecognizedNH:
+		binary.BigEndian.PutUint32(out[4:headerLen], pointerV6NextHeader)
+	case typ == TypeV6ParameterProblem:
+		p, ok := pointerV4ToV6(msg[4])
+		if !ok {
+			return netip.Addr{}, nil, ErrNotTranslatable
+		}
+		binary.BigEndian.PutUint32(out[4:headerLen], uint32(p))
+	}
+
+	// reconstruct invoking packet
+	b := make([]byte, ipv6HeaderLen+len(inner))
+	b[0] = 0x60 | tos>>4
+	b[1] = tos << 4
+	binary.BigEndian.PutUint16(b[4:6], uint16(innerLen))
+	b[6] = nhEthernet
+	if len(inner) > 0 {
+		switch inner[0] >> 4 {
+		case 4:
+			b[6] = nhIPv4
+		case 6:
+			b[6] = nhIPv6
+		}
+	}
+	b[7] = ttl
+	copy(b[8:24], sa)
+	copy(b[24:40], da)
+	copy(b[ipv6HeaderLen:], inner)
+	copy(out[headerLen:], b)
+
+	// checksum including IPv6 pseudo-header (RFC 8200, section 8.1)
+	var dst [16]byte
+	copy(dst[:], sa)
+	binary.BigEndian.PutUint16(out[2:4], checksum.Fold(checksum.Sum(checksum.PseudoHeaderIPv6(t.ipv6, dst, protoICMPv6, len(out)), out)))
+
+	return netip.AddrFrom16(dst), out, nil
+}
+
+// gtpuError returns the error of this package matching an error of the gtpu codec,
+// or def if there is none.
+func gtpuError(err error, def error) error {
+	if errors.Is(err, gtpu.ErrTooShortToParse) {
+		return ErrTooShortToParse
+	}
+	return def
+}
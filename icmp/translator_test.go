@@ -0,0 +1,109 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package icmp
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/ipv4"
+)
+
+// ptbRecorder is a PacketTooBigHandler recording the messages it handles.
+type ptbRecorder [][]byte
+
+func (r *ptbRecorder) HandlePacketTooBig(msg []byte) error {
+	*r = append(*r, msg)
+	return nil
+}
+
+func TestTranslator(t *testing.T) {
+	srcPrefix := netip.MustParsePrefix("fd00:1:1::/48")
+	dstPrefix := netip.MustParsePrefix("fd00:2:2::/48")
+	var handled ptbRecorder
+	tr := NewTranslator(netip.MustParseAddr("fd00:3::1").As16(), srcPrefix, dstPrefix, WithPacketTooBigHandler(&handled))
+
+	sa, err := encoding.NewMGTP4IPv6Src(srcPrefix, [4]byte{192, 0, 2, 1}, 1337).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	da, err := encoding.NewMGTP4IPv6Dst(dstPrefix, [4]byte{198, 51, 100, 1}, encoding.NewArgsMobSession(9, true, false, 0x1234)).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	inner := []byte{
+		0x45, 0x00, 0x00, 0x14,
+		0x00, 0x00, 0x40, 0x00,
+		0x40, 0x11, 0x00, 0x00,
+		10, 0, 0, 1,
+		10, 0, 0, 2,
+	}
+	invoking := append([]byte{
+		0x60, 0x00, 0x00, 0x00,
+		0x00, byte(len(inner)), nhIPv4, 63,
+	}, sa...)
+	invoking = append(invoking, da...)
+	invoking = append(invoking, inner...)
+	ptb := append([]byte{TypeV6PacketTooBig, 0, 0, 0, 0, 0, 0x05, 0x78}, invoking...)
+
+	dst4, msg4, err := tr.ToIPv4(ptb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(handled) != 1 {
+		t.Errorf("Packet Too Big should be handled once, got %d", len(handled))
+	}
+	if dst4 != netip.MustParseAddr("192.0.2.1") {
+		t.Errorf("Unexpected destination: %s", dst4)
+	}
+	if msg4[0] != TypeV4DestinationUnreachable || msg4[1] != codeV4FragmentationNeeded {
+		t.Errorf("Unexpected type/code: %d/%d", msg4[0], msg4[1])
+	}
+	if mtu := binary.BigEndian.Uint16(msg4[6:8]); mtu != 1400-40+44 {
+		t.Errorf("Unexpected MTU: %d", mtu)
+	}
+	if ipv4.Checksum(msg4) != 0 {
+		t.Error("Invalid ICMPv4 checksum")
+	}
+	gtp4 := msg4[headerLen:]
+	if diff := cmp.Diff(gtp4[12:20], []byte{192, 0, 2, 1, 198, 51, 100, 1}); diff != "" {
+		t.Error(diff)
+	}
+	if port := binary.BigEndian.Uint16(gtp4[20:22]); port != 1337 {
+		t.Errorf("Unexpected UDP source port: %d", port)
+	}
+	if teid := binary.BigEndian.Uint32(gtp4[32:36]); teid != 0x1234 {
+		t.Errorf("Unexpected TEID: %x", teid)
+	}
+
+	// translating back must give the original invoking packet
+	dst6, msg6, err := tr.ToIPv6(msg4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dst6 != netip.AddrFrom16([16]byte(sa)) {
+		t.Errorf("Unexpected destination: %s", dst6)
+	}
+	if msg6[0] != TypeV6PacketTooBig {
+		t.Errorf("Unexpected type: %d", msg6[0])
+	}
+	if mtu := binary.BigEndian.Uint32(msg6[4:8]); mtu != 1400 {
+		t.Errorf("Unexpected MTU: %d", mtu)
+	}
+	if diff := cmp.Diff(msg6[headerLen:], invoking); diff != "" {
+		t.Error(diff)
+	}
+
+	if _, _, err := tr.ToIPv4(append([]byte{128, 0, 0, 0, 0, 0, 0, 0}, invoking...)); err != ErrNotTranslatable {
+		t.Errorf("Echo Request should not be translated: %v", err)
+	}
+	if len(handled) != 1 {
+		t.Errorf("Only Packet Too Big messages should be handled, got %d", len(handled))
+	}
+}
@@ -0,0 +1,100 @@
+/* Code generated by rfc9433-cheader. DO NOT EDIT. */
+/* SPDX-License-Identifier: MIT */
+
+/*
+ * Layouts of the IPv6 addresses of RFC 9433, as encoded by github.com/nextmn/rfc9433/encoding.
+ * Offsets are in bits from the most significant bit of the IPv6 address: a field at offset o
+ * starts in byte RFC9433_BYTE(o), after the RFC9433_BIT(o) most significant bits of this byte.
+ * Multi-byte fields are in network byte order.
+ */
+#ifndef RFC9433_ENCODING_H
+#define RFC9433_ENCODING_H
+
+#define RFC9433_BYTE(offset) ((offset) / 8)
+#define RFC9433_BIT(offset) ((offset) % 8)
+
+/* Args.Mob.Session (RFC 9433, section 6.1): (byte >> SHIFT) & MASK */
+#define RFC9433_ARGS_MOB_SESSION_LEN 5
+#define RFC9433_ARGS_MOB_SESSION_QFI_BYTE 0
+#define RFC9433_ARGS_MOB_SESSION_QFI_SHIFT 2
+#define RFC9433_ARGS_MOB_SESSION_QFI_MASK 0x3f
+#define RFC9433_ARGS_MOB_SESSION_R_BYTE 0
+#define RFC9433_ARGS_MOB_SESSION_R_SHIFT 1
+#define RFC9433_ARGS_MOB_SESSION_R_MASK 0x01
+#define RFC9433_ARGS_MOB_SESSION_U_BYTE 0
+#define RFC9433_ARGS_MOB_SESSION_U_SHIFT 0
+#define RFC9433_ARGS_MOB_SESSION_U_MASK 0x01
+#define RFC9433_ARGS_MOB_SESSION_TEID_BYTE 1
+#define RFC9433_ARGS_MOB_SESSION_TEID_LEN 4
+
+/* End.M.GTP4.E SID (RFC 9433, section 6.6): prefix, IPv4 DA, Args.Mob.Session, zero padding */
+#define RFC9433_MGTP4_DST_IPV4_LEN 4
+#define RFC9433_MGTP4_DST_IPV4_OFFSET(prefix_len) (prefix_len)
+#define RFC9433_MGTP4_DST_ARGS_OFFSET(prefix_len) ((prefix_len) + 32)
+#define RFC9433_MGTP4_DST_MAX_PREFIX_LEN 56
+
+/* IPv6 SA of End.M.GTP4.E, NextMN scheme: prefix, IPv4 SA, UDP Source Port, ignored bits, prefix length */
+#define RFC9433_MGTP4_SRC_IPV4_LEN 4
+#define RFC9433_MGTP4_SRC_UDP_PORT_LEN 2
+#define RFC9433_MGTP4_SRC_IPV4_OFFSET(prefix_len) (prefix_len)
+#define RFC9433_MGTP4_SRC_UDP_PORT_OFFSET(prefix_len) ((prefix_len) + 32)
+#define RFC9433_MGTP4_SRC_PREFIX_LEN_BYTE 15
+#define RFC9433_MGTP4_SRC_PREFIX_LEN_SHIFT 0
+#define RFC9433_MGTP4_SRC_PREFIX_LEN_MASK 0x7f
+#define RFC9433_MGTP4_SRC_MAX_PREFIX_LEN 73
+
+/* End.M.GTP4.E SID with a /32 prefix */
+#define RFC9433_MGTP4_DST_32_IPV4_BYTE 4
+#define RFC9433_MGTP4_DST_32_IPV4_BIT 0
+#define RFC9433_MGTP4_DST_32_ARGS_BYTE 8
+#define RFC9433_MGTP4_DST_32_ARGS_BIT 0
+
+/* End.M.GTP4.E SID with a /40 prefix */
+#define RFC9433_MGTP4_DST_40_IPV4_BYTE 5
+#define RFC9433_MGTP4_DST_40_IPV4_BIT 0
+#define RFC9433_MGTP4_DST_40_ARGS_BYTE 9
+#define RFC9433_MGTP4_DST_40_ARGS_BIT 0
+
+/* End.M.GTP4.E SID with a /48 prefix */
+#define RFC9433_MGTP4_DST_48_IPV4_BYTE 6
+#define RFC9433_MGTP4_DST_48_IPV4_BIT 0
+#define RFC9433_MGTP4_DST_48_ARGS_BYTE 10
+#define RFC9433_MGTP4_DST_48_ARGS_BIT 0
+
+/* End.M.GTP4.E SID with a /56 prefix */
+#define RFC9433_MGTP4_DST_56_IPV4_BYTE 7
+#define RFC9433_MGTP4_DST_56_IPV4_BIT 0
+#define RFC9433_MGTP4_DST_56_ARGS_BYTE 11
+#define RFC9433_MGTP4_DST_56_ARGS_BIT 0
+
+/* IPv6 SA of End.M.GTP4.E with a /32 prefix, NextMN scheme */
+#define RFC9433_MGTP4_SRC_32_IPV4_BYTE 4
+#define RFC9433_MGTP4_SRC_32_IPV4_BIT 0
+#define RFC9433_MGTP4_SRC_32_UDP_PORT_BYTE 8
+#define RFC9433_MGTP4_SRC_32_UDP_PORT_BIT 0
+
+/* IPv6 SA of End.M.GTP4.E with a /40 prefix, NextMN scheme */
+#define RFC9433_MGTP4_SRC_40_IPV4_BYTE 5
+#define RFC9433_MGTP4_SRC_40_IPV4_BIT 0
+#define RFC9433_MGTP4_SRC_40_UDP_PORT_BYTE 9
+#define RFC9433_MGTP4_SRC_40_UDP_PORT_BIT 0
+
+/* IPv6 SA of End.M.GTP4.E with a /48 prefix, NextMN scheme */
+#define RFC9433_MGTP4_SRC_48_IPV4_BYTE 6
+#define RFC9433_MGTP4_SRC_48_IPV4_BIT 0
+#define RFC9433_MGTP4_SRC_48_UDP_PORT_BYTE 10
+#define RFC9433_MGTP4_SRC_48_UDP_PORT_BIT 0
+
+/* IPv6 SA of End.M.GTP4.E with a /56 prefix, NextMN scheme */
+#define RFC9433_MGTP4_SRC_56_IPV4_BYTE 7
+#define RFC9433_MGTP4_SRC_56_IPV4_BIT 0
+#define RFC9433_MGTP4_SRC_56_UDP_PORT_BYTE 11
+#define RFC9433_MGTP4_SRC_56_UDP_PORT_BIT 0
+
+/* IPv6 SA of End.M.GTP4.E with a /64 prefix, NextMN scheme */
+#define RFC9433_MGTP4_SRC_64_IPV4_BYTE 8
+#define RFC9433_MGTP4_SRC_64_IPV4_BIT 0
+#define RFC9433_MGTP4_SRC_64_UDP_PORT_BYTE 12
+#define RFC9433_MGTP4_SRC_64_UDP_PORT_BIT 0
+
+#endif /* RFC9433_ENCODING_H */
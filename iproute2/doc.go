@@ -0,0 +1,14 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package iproute2 generates the iproute2 commands (and the `ip -j` JSON)
+// equivalent to a routing configuration of the SR Gateway, for environments
+// where it cannot be applied through netlink: operators review the output
+// and apply it out-of-band.
+//
+// Behaviors implemented by the Linux kernel are installed as seg6local routes (SIDs)
+// or seg6 routes (headends); behaviors of RFC 9433 are not, and their prefixes
+// are routed toward the device of the datapath of the SR Gateway.
+package iproute2
@@ -0,0 +1,18 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package iproute2
+
+import "errors"
+
+var (
+	ErrUnsupportedAction = errors.New("unsupported action")
+	ErrUnsupportedOp     = errors.New("unsupported operation")
+	ErrInvalidPrefix     = errors.New("invalid prefix")
+	ErrMissingDevice     = errors.New("missing device")
+	ErrMissingNextHop    = errors.New("missing next-hop")
+	ErrMissingTable      = errors.New("missing lookup table")
+	ErrMissingSegments   = errors.New("missing segments")
+)
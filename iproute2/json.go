@@ -0,0 +1,51 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package iproute2
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// MarshalJSON implements json.Marshaler, using the layout of `ip -j route show`:
+// members are in the order printed by iproute2, and, as printed by iproute2, the lookup table of End.T
+// and the routing table of the route are both "table" members.
+func (r *Route) MarshalJSON() ([]byte, error) {
+	fs, err := r.fields()
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	b.WriteByte('{')
+	first := true
+	for _, f := range fs {
+		if f.values == nil {
+			continue
+		}
+		if !first {
+			b.WriteByte(',')
+		}
+		first = false
+		key, err := json.Marshal(f.key)
+		if err != nil {
+			return nil, err
+		}
+		var value []byte
+		if f.list {
+			value, err = json.Marshal(f.values)
+		} else {
+			value, err = json.Marshal(f.values[0])
+		}
+		if err != nil {
+			return nil, err
+		}
+		b.Write(key)
+		b.WriteByte(':')
+		b.Write(value)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
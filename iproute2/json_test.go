@@ -0,0 +1,36 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package iproute2
+
+import (
+	"encoding/json"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRouteMarshalJSON(t *testing.T) {
+	routes := []Route{
+		{Prefix: netip.MustParsePrefix("fc00:1::5/128"), Action: ActionEndDT4, Device: "vrf100", LookupTable: 100},
+		{Prefix: netip.MustParsePrefix("fc00:1::6/128"), Action: ActionEndB6Encaps, Device: "eth0", Table: 10,
+			Segments: []netip.Addr{netip.MustParseAddr("fc00:2::1"), netip.MustParseAddr("fc00:3::1")}},
+		{Prefix: netip.MustParsePrefix("10.0.1.0/24"), Action: ActionHMGTP4D, Device: "srgw0"},
+	}
+	b, err := json.Marshal(routes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"dst":"fc00:1::5/128","encap":"seg6local","action":"End.DT4","vrftable":"100","dev":"vrf100"},` +
+		`{"dst":"fc00:1::6/128","encap":"seg6local","action":"End.B6.Encaps","segs":["fc00:2::1","fc00:3::1"],"dev":"eth0","table":"10"},` +
+		`{"dst":"10.0.1.0/24","dev":"srgw0"}]`
+	if diff := cmp.Diff(want, string(b)); diff != "" {
+		t.Error(diff)
+	}
+	if _, err := json.Marshal(&Route{Action: ActionEnd}); err == nil {
+		t.Error("invalid route should not be marshaled")
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package iproute2
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// Action is the behavior bound to the prefix of a Route.
+type Action string
+
+// Behaviors implemented by the Linux kernel (RFC 8986).
+const (
+	ActionEnd         Action = "End"
+	ActionEndX        Action = "End.X"
+	ActionEndT        Action = "End.T"
+	ActionEndDX4      Action = "End.DX4"
+	ActionEndDX6      Action = "End.DX6"
+	ActionEndDT4      Action = "End.DT4"
+	ActionEndDT6      Action = "End.DT6"
+	ActionEndDT46     Action = "End.DT46"
+	ActionEndB6Encaps Action = "End.B6.Encaps"
+	ActionHEncaps     Action = "H.Encaps"
+	ActionHEncapsRed  Action = "H.Encaps.Red"
+	ActionHEncapsL2   Action = "H.Encaps.L2"
+)
+
+// Behaviors of RFC 9433, processed by the datapath of the SR Gateway.
+const (
+	ActionEndMGTP4E   Action = "End.M.GTP4.E"
+	ActionEndMGTP6D   Action = "End.M.GTP6.D"
+	ActionEndMGTP6DDi Action = "End.M.GTP6.D.Di"
+	ActionEndMGTP6E   Action = "End.M.GTP6.E"
+	ActionEndMAP      Action = "End.MAP"
+	ActionEndLimit    Action = "End.Limit"
+	ActionHMGTP4D     Action = "H.M.GTP4.D"
+)
+
+// Op is the iproute2 command applied to routes.
+type Op string
+
+const (
+	OpAdd     Op = "add"
+	OpReplace Op = "replace"
+	OpDel     Op = "del"
+)
+
+// Route binds an Action to a prefix: a SID (or a locator) for End behaviors,
+// and the prefix of the steered traffic for headend behaviors.
+type Route struct {
+	Prefix      netip.Prefix
+	Action      Action
+	Device      string       // device of the route; for RFC 9433 behaviors, device of the datapath (e.g. a TUN device)
+	Table       int          // routing table of the route, 0 for the main table
+	NextHop     netip.Addr   // End.X, End.DX4, End.DX6
+	LookupTable int          // End.T, End.DT4, End.DT6, End.DT46
+	Segments    []netip.Addr // End.B6.Encaps, H.Encaps, H.Encaps.Red, H.Encaps.L2, in the order they are visited
+}
+
+// field is an attribute of a Route, used both as command argument and as `ip -j` JSON member.
+type field struct {
+	key    string
+	values []string // nil for keywords, which are not part of the JSON
+	list   bool
+}
+
+// fields returns the attributes of the route, in the order used by iproute2.
+func (r *Route) fields() ([]field, error) {
+	if !r.Prefix.IsValid() {
+		return nil, ErrInvalidPrefix
+	}
+	if r.Device == "" {
+		return nil, ErrMissingDevice
+	}
+	fs := []field{{key: "dst", values: []string{r.Prefix.Masked().String()}}}
+	encap, err := r.encap()
+	if err != nil {
+		return nil, err
+	}
+	fs = append(fs, encap...)
+	fs = append(fs, field{key: "dev", values: []string{r.Device}})
+	if r.Table != 0 {
+		fs = append(fs, field{key: "table", values: []string{strconv.Itoa(r.Table)}})
+	}
+	return fs, nil
+}
+
+// encap returns the lightweight tunnel attributes of the route.
+func (r *Route) encap() ([]field, error) {
+	switch r.Action {
+	case ActionEndMGTP4E, ActionEndMGTP6D, ActionEndMGTP6DDi, ActionEndMGTP6E, ActionEndMAP, ActionEndLimit:
+		if !r.Prefix.Addr().Is6() {
+			return nil, ErrInvalidPrefix
+		}
+		return nil, nil
+	case ActionHMGTP4D:
+		if !r.Prefix.Addr().Is4() {
+			return nil, ErrInvalidPrefix
+		}
+		return nil, nil
+	case ActionHEncaps, ActionHEncapsRed, ActionHEncapsL2:
+		return r.seg6()
+	}
+	if !r.Prefix.Addr().Is6() {
+		return nil, ErrInvalidPrefix
+	}
+	fs := []field{
+		{key: "encap", values: []string{"seg6local"}},
+		{key: "action", values: []string{string(r.Action)}},
+	}
+	switch r.Action {
+	case ActionEnd:
+	case ActionEndX, ActionEndDX6:
+		if !r.NextHop.Is6() {
+			return nil, ErrMissingNextHop
+		}
+		fs = append(fs, field{key: "nh6", values: []string{r.NextHop.String()}})
+	case ActionEndDX4:
+		if !r.NextHop.Is4() {
+			return nil, ErrMissingNextHop
+		}
+		fs = append(fs, field{key: "nh4", values: []string{r.NextHop.String()}})
+	case ActionEndT:
+		if r.LookupTable == 0 {
+			return nil, ErrMissingTable
+		}
+		fs = append(fs, field{key: "table", values: []string{strconv.Itoa(r.LookupTable)}})
+	case ActionEndDT4, ActionEndDT6, ActionEndDT46:
+		if r.LookupTable == 0 {
+			return nil, ErrMissingTable
+		}
+		fs = append(fs, field{key: "vrftable", values: []string{strconv.Itoa(r.LookupTable)}})
+	case ActionEndB6Encaps:
+		segs, err := r.segs()
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, field{key: "srh"}, segs)
+	default:
+		return nil, ErrUnsupportedAction
+	}
+	return fs, nil
+}
+
+// seg6 returns the seg6 attributes of a headend route.
+func (r *Route) seg6() ([]field, error) {
+	mode := "encap"
+	switch r.Action {
+	case ActionHEncapsRed:
+		mode = "encap.red"
+	case ActionHEncapsL2:
+		mode = "l2encap"
+	}
+	segs, err := r.segs()
+	if err != nil {
+		return nil, err
+	}
+	return []field{
+		{key: "encap", values: []string{"seg6"}},
+		{key: "mode", values: []string{mode}},
+		segs,
+	}, nil
+}
+
+// segs returns the segment list of the route.
+func (r *Route) segs() (field, error) {
+	if len(r.Segments) == 0 {
+		return field{}, ErrMissingSegments
+	}
+	segs := make([]string, len(r.Segments))
+	for i, s := range r.Segments {
+		if !s.Is6() {
+			return field{}, ErrMissingSegments
+		}
+		segs[i] = s.String()
+	}
+	return field{key: "segs", values: segs, list: true}, nil
+}
+
+// Args returns the arguments of the ip command applying op to the route, starting with "ip".
+// Routes are deleted by destination, device and table only.
+func (r *Route) Args(op Op) ([]string, error) {
+	switch op {
+	case OpAdd, OpReplace, OpDel:
+	default:
+		return nil, ErrUnsupportedOp
+	}
+	fs, err := r.fields()
+	if err != nil {
+		return nil, err
+	}
+	family := "-6"
+	if r.Prefix.Addr().Is4() {
+		family = "-4"
+	}
+	args := []string{"ip", family, "route", string(op)}
+	if op == OpDel {
+		args = append(args, fs[0].values[0], "dev", r.Device)
+		if r.Table != 0 {
+			args = append(args, "table", strconv.Itoa(r.Table))
+		}
+		return args, nil
+	}
+	for _, f := range fs {
+		switch {
+		case f.key == "dst":
+			args = append(args, f.values[0])
+		case f.values == nil:
+			args = append(args, f.key)
+		default:
+			args = append(args, f.key, strings.Join(f.values, ","))
+		}
+	}
+	return args, nil
+}
+
+// Command returns the ip command applying op to the route.
+func (r *Route) Command(op Op) (string, error) {
+	args, err := r.Args(op)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(args, " "), nil
+}
+
+// Commands returns the ip commands applying op to the routes.
+// Routes are deleted in the reverse order.
+func Commands(routes []Route, op Op) ([]string, error) {
+	cmds := make([]string, len(routes))
+	for i := range routes {
+		cmd, err := routes[i].Command(op)
+		if err != nil {
+			return nil, err
+		}
+		if op == OpDel {
+			cmds[len(routes)-1-i] = cmd
+		} else {
+			cmds[i] = cmd
+		}
+	}
+	return cmds, nil
+}
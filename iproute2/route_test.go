@@ -0,0 +1,148 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package iproute2
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRouteCommand(t *testing.T) {
+	for _, c := range []struct {
+		name  string
+		route Route
+		op    Op
+		want  string
+	}{
+		{
+			name:  "End",
+			route: Route{Prefix: netip.MustParsePrefix("fc00:1::1/128"), Action: ActionEnd, Device: "eth0"},
+			op:    OpAdd,
+			want:  "ip -6 route add fc00:1::1/128 encap seg6local action End dev eth0",
+		},
+		{
+			name:  "End.X",
+			route: Route{Prefix: netip.MustParsePrefix("fc00:1::2/128"), Action: ActionEndX, Device: "eth0", NextHop: netip.MustParseAddr("fe80::1")},
+			op:    OpReplace,
+			want:  "ip -6 route replace fc00:1::2/128 encap seg6local action End.X nh6 fe80::1 dev eth0",
+		},
+		{
+			name:  "End.DX4",
+			route: Route{Prefix: netip.MustParsePrefix("fc00:1::3/128"), Action: ActionEndDX4, Device: "eth1", NextHop: netip.MustParseAddr("10.0.0.1")},
+			op:    OpAdd,
+			want:  "ip -6 route add fc00:1::3/128 encap seg6local action End.DX4 nh4 10.0.0.1 dev eth1",
+		},
+		{
+			name:  "End.T",
+			route: Route{Prefix: netip.MustParsePrefix("fc00:1::4/128"), Action: ActionEndT, Device: "eth0", LookupTable: 100, Table: 10},
+			op:    OpAdd,
+			want:  "ip -6 route add fc00:1::4/128 encap seg6local action End.T table 100 dev eth0 table 10",
+		},
+		{
+			name:  "End.DT46",
+			route: Route{Prefix: netip.MustParsePrefix("fc00:1::5/128"), Action: ActionEndDT46, Device: "vrf100", LookupTable: 100},
+			op:    OpAdd,
+			want:  "ip -6 route add fc00:1::5/128 encap seg6local action End.DT46 vrftable 100 dev vrf100",
+		},
+		{
+			name: "End.B6.Encaps",
+			route: Route{Prefix: netip.MustParsePrefix("fc00:1::6/128"), Action: ActionEndB6Encaps, Device: "eth0",
+				Segments: []netip.Addr{netip.MustParseAddr("fc00:2::1"), netip.MustParseAddr("fc00:3::1")}},
+			op:   OpAdd,
+			want: "ip -6 route add fc00:1::6/128 encap seg6local action End.B6.Encaps srh segs fc00:2::1,fc00:3::1 dev eth0",
+		},
+		{
+			name: "H.Encaps.Red",
+			route: Route{Prefix: netip.MustParsePrefix("10.1.0.0/16"), Action: ActionHEncapsRed, Device: "eth0",
+				Segments: []netip.Addr{netip.MustParseAddr("fc00:2::1"), netip.MustParseAddr("fc00:3::1")}},
+			op:   OpAdd,
+			want: "ip -4 route add 10.1.0.0/16 encap seg6 mode encap.red segs fc00:2::1,fc00:3::1 dev eth0",
+		},
+		{
+			name:  "End.M.GTP4.E",
+			route: Route{Prefix: netip.MustParsePrefix("fc00:4::/48"), Action: ActionEndMGTP4E, Device: "srgw0"},
+			op:    OpAdd,
+			want:  "ip -6 route add fc00:4::/48 dev srgw0",
+		},
+		{
+			name:  "H.M.GTP4.D",
+			route: Route{Prefix: netip.MustParsePrefix("10.0.1.1/24"), Action: ActionHMGTP4D, Device: "srgw0"},
+			op:    OpAdd,
+			want:  "ip -4 route add 10.0.1.0/24 dev srgw0",
+		},
+		{
+			name:  "del",
+			route: Route{Prefix: netip.MustParsePrefix("fc00:1::4/128"), Action: ActionEndT, Device: "eth0", LookupTable: 100, Table: 10},
+			op:    OpDel,
+			want:  "ip -6 route del fc00:1::4/128 dev eth0 table 10",
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			cmd, err := c.route.Command(c.op)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(c.want, cmd); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestRouteCommandErrors(t *testing.T) {
+	for _, c := range []struct {
+		name  string
+		route Route
+		op    Op
+		err   error
+	}{
+		{name: "op", route: Route{Prefix: netip.MustParsePrefix("fc00::/64"), Action: ActionEnd, Device: "eth0"}, op: "flush", err: ErrUnsupportedOp},
+		{name: "prefix", route: Route{Action: ActionEnd, Device: "eth0"}, op: OpAdd, err: ErrInvalidPrefix},
+		{name: "family", route: Route{Prefix: netip.MustParsePrefix("10.0.0.0/8"), Action: ActionEnd, Device: "eth0"}, op: OpAdd, err: ErrInvalidPrefix},
+		{name: "gtp4 family", route: Route{Prefix: netip.MustParsePrefix("fc00::/64"), Action: ActionHMGTP4D, Device: "eth0"}, op: OpAdd, err: ErrInvalidPrefix},
+		{name: "device", route: Route{Prefix: netip.MustParsePrefix("fc00::/64"), Action: ActionEnd}, op: OpAdd, err: ErrMissingDevice},
+		{name: "next-hop", route: Route{Prefix: netip.MustParsePrefix("fc00::/64"), Action: ActionEndDX4, Device: "eth0", NextHop: netip.MustParseAddr("fe80::1")}, op: OpAdd, err: ErrMissingNextHop},
+		{name: "table", route: Route{Prefix: netip.MustParsePrefix("fc00::/64"), Action: ActionEndDT4, Device: "eth0"}, op: OpAdd, err: ErrMissingTable},
+		{name: "segments", route: Route{Prefix: netip.MustParsePrefix("10.0.0.0/8"), Action: ActionHEncaps, Device: "eth0"}, op: OpAdd, err: ErrMissingSegments},
+		{name: "action", route: Route{Prefix: netip.MustParsePrefix("fc00::/64"), Action: "End.BPF", Device: "eth0"}, op: OpAdd, err: ErrUnsupportedAction},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := c.route.Command(c.op); !errors.Is(err, c.err) {
+				t.Errorf("expected %v, got %v", c.err, err)
+			}
+		})
+	}
+}
+
+func TestCommands(t *testing.T) {
+	routes := []Route{
+		{Prefix: netip.MustParsePrefix("fc00:1::1/128"), Action: ActionEnd, Device: "eth0"},
+		{Prefix: netip.MustParsePrefix("fc00:4::/48"), Action: ActionEndMGTP4E, Device: "srgw0"},
+	}
+	add, err := Commands(routes, OpAdd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{
+		"ip -6 route add fc00:1::1/128 encap seg6local action End dev eth0",
+		"ip -6 route add fc00:4::/48 dev srgw0",
+	}, add); diff != "" {
+		t.Error(diff)
+	}
+	del, err := Commands(routes, OpDel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{
+		"ip -6 route del fc00:4::/48 dev srgw0",
+		"ip -6 route del fc00:1::1/128 dev eth0",
+	}, del); diff != "" {
+		t.Error(diff)
+	}
+}
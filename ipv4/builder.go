@@ -0,0 +1,162 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package ipv4
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/nextmn/rfc9433/encoding"
+)
+
+// DefaultTTL is the TTL used by HeaderBuilder when no TTLPolicy is provided.
+const DefaultTTL = 64
+
+// ProtocolUDP is the Protocol number of UDP.
+const ProtocolUDP = 17
+
+// TTLPolicy chooses the TTL of the outer IPv4 header given the inner packet.
+type TTLPolicy interface {
+	TTL(inner []byte) uint8
+}
+
+// TTLFunc is an adapter to allow the use of ordinary functions as TTLPolicy.
+type TTLFunc func(inner []byte) uint8
+
+// TTL calls f(inner).
+func (f TTLFunc) TTL(inner []byte) uint8 {
+	return f(inner)
+}
+
+// FixedTTL is a TTLPolicy always returning the same TTL.
+type FixedTTL uint8
+
+// TTL returns the fixed TTL.
+func (t FixedTTL) TTL(inner []byte) uint8 {
+	return uint8(t)
+}
+
+// FlowKey identifies the packets sharing the same Identification space,
+// as defined in RFC 6864, section 4.1.
+type FlowKey struct {
+	Src      [4]byte
+	Dst      [4]byte
+	Protocol uint8
+}
+
+// IDGenerator chooses the Identification field of the outer IPv4 header.
+type IDGenerator interface {
+	ID(key FlowKey, df bool) uint16
+}
+
+// ZeroIfDF is an IDGenerator returning 0 for atomic datagrams (DF bit set),
+// as allowed by RFC 6864, section 4.1, and delegating to Fallback otherwise.
+// If Fallback is nil, 0 is always returned.
+type ZeroIfDF struct {
+	Fallback IDGenerator
+}
+
+// ID returns the Identification field for this flow.
+func (z ZeroIfDF) ID(key FlowKey, df bool) uint16 {
+	if df || z.Fallback == nil {
+		return 0
+	}
+	return z.Fallback.ID(key, df)
+}
+
+// AtomicCounter is an IDGenerator using a single counter shared by all flows.
+type AtomicCounter struct {
+	counter atomic.Uint32
+}
+
+// NewAtomicCounter creates an AtomicCounter starting at the given value.
+func NewAtomicCounter(start uint16) *AtomicCounter {
+	c := &AtomicCounter{}
+	c.counter.Store(uint32(start))
+	return c
+}
+
+// ID returns the Identification field for this flow.
+func (c *AtomicCounter) ID(key FlowKey, df bool) uint16 {
+	return uint16(c.counter.Add(1) - 1)
+}
+
+// PerFlowCounter is an IDGenerator using a counter for each FlowKey.
+// Counters are never removed: memory usage grows with the number of flows.
+type PerFlowCounter struct {
+	mu       sync.Mutex
+	counters map[FlowKey]uint16
+}
+
+// NewPerFlowCounter creates a PerFlowCounter.
+func NewPerFlowCounter() *PerFlowCounter {
+	return &PerFlowCounter{
+		counters: make(map[FlowKey]uint16),
+	}
+}
+
+// ID returns the Identification field for this flow.
+func (c *PerFlowCounter) ID(key FlowKey, df bool) uint16 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id := c.counters[key]
+	c.counters[key] = id + 1
+	return id
+}
+
+// HeaderBuilder builds outer IPv4 headers for packets emitted by End.M.GTP4.E.
+type HeaderBuilder struct {
+	policy Policy
+	ttl    TTLPolicy
+	id     IDGenerator
+}
+
+// NewHeaderBuilder creates a HeaderBuilder.
+// If ttl is nil, DefaultTTL is used.
+// If id is nil, Identification is 0 for atomic datagrams and generated by an AtomicCounter otherwise.
+func NewHeaderBuilder(policy Policy, ttl TTLPolicy, id IDGenerator) *HeaderBuilder {
+	if ttl == nil {
+		ttl = FixedTTL(DefaultTTL)
+	}
+	if id == nil {
+		id = ZeroIfDF{Fallback: NewAtomicCounter(0)}
+	}
+	return &HeaderBuilder{
+		policy: policy,
+		ttl:    ttl,
+		id:     id,
+	}
+}
+
+// Build returns the outer IPv4 header of a packet
+// whose payload has a length of payloadLen bytes and encapsulates the inner packet.
+func (b *HeaderBuilder) Build(src [4]byte, dst [4]byte, protocol uint8, tos uint8, payloadLen int, inner []byte) (*Header, error) {
+	df, options, err := b.policy.Apply(inner)
+	if err != nil {
+		return nil, err
+	}
+	return &Header{
+		TOS:        tos,
+		ID:         b.id.ID(FlowKey{Src: src, Dst: dst, Protocol: protocol}, df),
+		DF:         df,
+		TTL:        b.ttl.TTL(inner),
+		Protocol:   protocol,
+		Src:        src,
+		Dst:        dst,
+		Options:    options,
+		PayloadLen: payloadLen,
+	}, nil
+}
+
+// BuildForSID returns the outer IPv4 header of a GTP-U packet emitted by End.M.GTP4.E:
+// the IPv4 SA and DA are decoded from the IPv6 SA and the End.M.GTP4.E SID, and the protocol is UDP.
+// payloadLen is the length of the UDP datagram.
+func (b *HeaderBuilder) BuildForSID(src *encoding.MGTP4IPv6Src, dst *encoding.MGTP4IPv6Dst, tos uint8, payloadLen int, inner []byte) (*Header, error) {
+	if minHeaderLen+payloadLen > maxTotalLen {
+		return nil, ErrTooLong
+	}
+	return b.Build(src.IPv4().As4(), dst.IPv4().As4(), ProtocolUDP, tos, payloadLen, inner)
+}
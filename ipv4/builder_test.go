@@ -0,0 +1,81 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package ipv4
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/nextmn/rfc9433/encoding"
+)
+
+func TestHeaderBuilder(t *testing.T) {
+	inner := []byte{
+		0x45, 0x00, 0x00, 0x14,
+		0x00, 0x00, 0x40, 0x00,
+		0x40, 0x11, 0x00, 0x00,
+		10, 0, 0, 1,
+		10, 0, 0, 2,
+	}
+	src := [4]byte{192, 0, 2, 1}
+	dst := [4]byte{198, 51, 100, 1}
+
+	b := NewHeaderBuilder(Policy{}, nil, nil)
+	h, err := b.Build(src, dst, 17, 0, 36, inner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !h.DF || h.ID != 0 || h.TTL != DefaultTTL {
+		t.Errorf("Unexpected header: %+v", h)
+	}
+
+	b = NewHeaderBuilder(Policy{DF: DFClear}, FixedTTL(10), NewPerFlowCounter())
+	for i := uint16(0); i < 3; i++ {
+		h, err := b.Build(src, dst, 17, 0, 36, inner)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if h.ID != i {
+			t.Errorf("Unexpected Identification: %d instead of %d", h.ID, i)
+		}
+		if h.TTL != 10 {
+			t.Errorf("Unexpected TTL: %d", h.TTL)
+		}
+	}
+	h, err = b.Build(src, [4]byte{198, 51, 100, 2}, 17, 0, 36, inner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.ID != 0 {
+		t.Errorf("Identification should be per flow: %d", h.ID)
+	}
+}
+
+func TestBuildForSID(t *testing.T) {
+	src := encoding.NewMGTP4IPv6Src(netip.MustParsePrefix("2001:db8:1::/48"), [4]byte{192, 0, 2, 1}, 1234)
+	dst := encoding.NewMGTP4IPv6Dst(netip.MustParsePrefix("2001:db8::/32"), [4]byte{198, 51, 100, 1}, encoding.NewArgsMobSession(5, false, false, 1))
+	b := NewHeaderBuilder(Policy{DF: DFSet}, nil, nil)
+	h, err := b.BuildForSID(src, dst, 0xb8, 36, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.Src != [4]byte{192, 0, 2, 1} || h.Dst != [4]byte{198, 51, 100, 1} || h.Protocol != ProtocolUDP || h.TOS != 0xb8 || !h.DF || h.PayloadLen != 36 {
+		t.Errorf("Unexpected header: %+v", h)
+	}
+	if _, err := b.BuildForSID(src, dst, 0, maxTotalLen, nil); err != ErrTooLong {
+		t.Errorf("Expected ErrTooLong, got %v", err)
+	}
+}
+
+func TestAtomicCounter(t *testing.T) {
+	c := NewAtomicCounter(0xFFFF)
+	if id := c.ID(FlowKey{}, false); id != 0xFFFF {
+		t.Errorf("Unexpected Identification: %d", id)
+	}
+	if id := c.ID(FlowKey{}, false); id != 0 {
+		t.Errorf("Identification should wrap around: %d", id)
+	}
+}
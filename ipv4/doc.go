@@ -0,0 +1,8 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package ipv4 provides helpers to build the outer IPv4 header
+// of packets emitted by End.M.GTP4.E (RFC 9433, section 6.6).
+package ipv4
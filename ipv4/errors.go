@@ -0,0 +1,19 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package ipv4
+
+import "errors"
+
+var (
+	ErrTooShortToMarshal = errors.New("too short to serialize")
+	ErrTooShortToParse   = errors.New("too short to parse")
+	ErrMalformedHeader   = errors.New("malformed IPv4 header")
+	ErrOptionsRejected   = errors.New("IPv4 options are rejected by policy")
+	ErrOptionsTooLong    = errors.New("IPv4 options are too long")
+	ErrTooLong           = errors.New("IPv4 packet is too long")
+	ErrDontFragment      = errors.New("IPv4 packet has the DF bit set")
+	ErrMTUTooSmall       = errors.New("MTU is too small")
+)
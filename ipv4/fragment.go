@@ -0,0 +1,107 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package ipv4
+
+import "encoding/binary"
+
+const (
+	// Flag MF and Fragment Offset (in 8 bytes units)
+	mfMask     = 0x2000 // mask of the flag in the 16 bits field starting at dfPosByte
+	offsetMask = 0x1FFF
+
+	// Options
+	optionEOL        = 0
+	optionNOP        = 1
+	optionCopiedMask = 0x80
+)
+
+// Fragment splits an IPv4 packet into fragments of at most mtu bytes (RFC 791, section 3.2).
+// Options are kept in the following fragments only if their copied flag is set.
+// A packet fitting in the MTU is returned as the only fragment, and is not copied.
+// Packets with the DF bit set are not fragmented (ErrDontFragment).
+func Fragment(pkt []byte, mtu int) ([][]byte, error) {
+	if len(pkt) < minHeaderLen {
+		return nil, ErrTooShortToParse
+	}
+	if pkt[versionPosByte]>>versionPosBit != 4 {
+		return nil, ErrMalformedHeader
+	}
+	ihl := int(pkt[ihlPosByte]&ihlMask) * 4
+	totalLen := int(binary.BigEndian.Uint16(pkt[totalLenPosByte : totalLenPosByte+2]))
+	if ihl < minHeaderLen || totalLen < ihl {
+		return nil, ErrMalformedHeader
+	}
+	if len(pkt) < totalLen {
+		return nil, ErrTooShortToParse
+	}
+	pkt = pkt[:totalLen]
+	if totalLen <= mtu {
+		return [][]byte{pkt}, nil
+	}
+	flags := binary.BigEndian.Uint16(pkt[dfPosByte : dfPosByte+2])
+	if pkt[dfPosByte]&dfMask != 0 {
+		return nil, ErrDontFragment
+	}
+
+	first := pkt[:ihl]
+	next := fragmentHeader(first)
+	firstLen := (mtu - len(first)) &^ 7
+	nextLen := (mtu - len(next)) &^ 7
+	if firstLen <= 0 || nextLen <= 0 {
+		return nil, ErrMTUTooSmall
+	}
+	data := pkt[ihl:]
+	offset := int(flags & offsetMask)
+	var fragments [][]byte
+	for header, l := first, firstLen; len(data) > 0; header, l = next, nextLen {
+		l = min(l, len(data))
+		f := make([]byte, len(header)+l)
+		copy(f, header)
+		copy(f[len(header):], data[:l])
+		data = data[l:]
+		fl := uint16(offset)
+		if len(data) > 0 || flags&mfMask != 0 {
+			fl |= mfMask
+		}
+		binary.BigEndian.PutUint16(f[totalLenPosByte:totalLenPosByte+2], uint16(len(f)))
+		binary.BigEndian.PutUint16(f[dfPosByte:dfPosByte+2], fl)
+		f[checksumPosByte] = 0
+		f[checksumPosByte+1] = 0
+		binary.BigEndian.PutUint16(f[checksumPosByte:checksumPosByte+2], Checksum(f[:len(header)]))
+		fragments = append(fragments, f)
+		offset += l / 8
+	}
+	return fragments, nil
+}
+
+// fragmentHeader returns the header of the fragments following the first one:
+// only the options with the copied flag set are kept.
+func fragmentHeader(header []byte) []byte {
+	options := make([]byte, 0, len(header)-minHeaderLen)
+	for b := header[minHeaderLen:]; len(b) > 0; {
+		if b[0] == optionEOL {
+			break
+		}
+		if b[0] == optionNOP {
+			b = b[1:]
+			continue
+		}
+		if len(b) < 2 || int(b[1]) < 2 || int(b[1]) > len(b) {
+			// malformed options are not copied
+			break
+		}
+		if b[0]&optionCopiedMask != 0 {
+			options = append(options, b[:b[1]]...)
+		}
+		b = b[b[1]:]
+	}
+	l := minHeaderLen + (len(options)+3)&^3
+	h := make([]byte, l)
+	copy(h, header[:minHeaderLen])
+	copy(h[minHeaderLen:], options)
+	h[ihlPosByte] = h[ihlPosByte]&^ihlMask | uint8(l/4)
+	return h
+}
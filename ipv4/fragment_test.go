@@ -0,0 +1,85 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package ipv4
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFragment(t *testing.T) {
+	payload := make([]byte, 100)
+	for i := range payload {
+		payload[i] = byte(i)
+	}
+	h := &Header{
+		ID:         0x1234,
+		TTL:        64,
+		Protocol:   ProtocolUDP,
+		Src:        [4]byte{192, 0, 2, 1},
+		Dst:        [4]byte{198, 51, 100, 1},
+		Options:    []byte{0x01, 0x94, 0x04, 0x00, 0x00, 0x07, 0x03, 0x00}, // NOP, Router Alert (copied), not copied option
+		PayloadLen: len(payload),
+	}
+	b, err := h.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkt := append(b, payload...)
+
+	fragments, err := Fragment(pkt, 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 28 bytes header: 32 bytes of data in the first fragment
+	// 24 bytes header: 32 bytes of data in the next ones
+	lengths := []int{28 + 32, 24 + 32, 24 + 32, 24 + 4}
+	if len(fragments) != len(lengths) {
+		t.Fatalf("Unexpected number of fragments: %d", len(fragments))
+	}
+	var data []byte
+	for i, f := range fragments {
+		if len(f) != lengths[i] || int(binary.BigEndian.Uint16(f[2:4])) != lengths[i] {
+			t.Errorf("Unexpected length of fragment %d: %d", i, len(f))
+		}
+		if Checksum(f[:int(f[0]&0x0F)*4]) != 0 {
+			t.Errorf("Invalid checksum of fragment %d", i)
+		}
+		fl := binary.BigEndian.Uint16(f[6:8])
+		if int(fl&offsetMask)*8 != len(data) {
+			t.Errorf("Unexpected offset of fragment %d: %d", i, fl&offsetMask)
+		}
+		if (fl&mfMask != 0) != (i < len(fragments)-1) {
+			t.Errorf("Unexpected MF flag of fragment %d", i)
+		}
+		if i > 0 && !bytes.Equal(f[20:24], []byte{0x94, 0x04, 0x00, 0x00}) {
+			t.Errorf("Unexpected options of fragment %d: %x", i, f[20:24])
+		}
+		data = append(data, f[int(f[0]&0x0F)*4:]...)
+	}
+	if diff := cmp.Diff(data, payload); diff != "" {
+		t.Error(diff)
+	}
+
+	// fitting packet
+	if fragments, err := Fragment(pkt, len(pkt)); err != nil || len(fragments) != 1 {
+		t.Errorf("Packet should not be fragmented: %v", err)
+	}
+	// DF bit set
+	h.DF = true
+	if b, err = h.Marshal(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Fragment(append(b, payload...), 60); err != ErrDontFragment {
+		t.Errorf("Expected ErrDontFragment, got %v", err)
+	}
+	if _, err := Fragment(pkt, 30); err != ErrMTUTooSmall {
+		t.Errorf("Expected ErrMTUTooSmall, got %v", err)
+	}
+}
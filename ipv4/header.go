@@ -0,0 +1,101 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package ipv4
+
+import (
+	"encoding/binary"
+
+	"github.com/nextmn/rfc9433/checksum"
+)
+
+const (
+	maxOptionsLen = 40 // IHL is a 4 bits field counting 32 bits words
+	maxTotalLen   = 0xFFFF
+
+	// Byte positions of the fields
+	tosPosByte      = 1
+	totalLenPosByte = 2
+	idPosByte       = 4
+	ttlPosByte      = 8
+	protocolPosByte = 9
+	checksumPosByte = 10
+	srcPosByte      = 12
+	dstPosByte      = 16
+)
+
+// Header is an IPv4 header as defined in RFC 791, section 3.1.
+// Fragmentation is not supported: More Fragments flag and Fragment Offset are always zero.
+type Header struct {
+	TOS        uint8   // Type of Service (DSCP and ECN)
+	ID         uint16  // Identification
+	DF         bool    // Don't Fragment flag
+	TTL        uint8   // Time To Live
+	Protocol   uint8   // Protocol of the payload
+	Src        [4]byte // Source Address
+	Dst        [4]byte // Destination Address
+	Options    []byte  // Options, padded to a 32 bits boundary when marshaled
+	PayloadLen int     // Length of the payload in bytes, used to compute the Total Length field
+}
+
+// optionsLen returns the length of the options, including padding.
+func (h *Header) optionsLen() int {
+	return (len(h.Options) + 3) &^ 3
+}
+
+// MarshalLen returns the serial length of Header.
+func (h *Header) MarshalLen() int {
+	return minHeaderLen + h.optionsLen()
+}
+
+// Marshal returns the byte sequence generated from Header.
+func (h *Header) Marshal() ([]byte, error) {
+	b := make([]byte, h.MarshalLen())
+	if err := h.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+// The header checksum is computed.
+func (h *Header) MarshalTo(b []byte) error {
+	l := h.MarshalLen()
+	if len(b) < l {
+		return ErrTooShortToMarshal
+	}
+	if h.optionsLen() > maxOptionsLen {
+		return ErrOptionsTooLong
+	}
+	if h.PayloadLen < 0 || l+h.PayloadLen > maxTotalLen {
+		return ErrTooLong
+	}
+	b[versionPosByte] = 4<<versionPosBit | uint8(l/4)
+	b[tosPosByte] = h.TOS
+	binary.BigEndian.PutUint16(b[totalLenPosByte:totalLenPosByte+2], uint16(l+h.PayloadLen))
+	binary.BigEndian.PutUint16(b[idPosByte:idPosByte+2], h.ID)
+	b[dfPosByte] = 0
+	if h.DF {
+		b[dfPosByte] = dfMask
+	}
+	b[dfPosByte+1] = 0
+	b[ttlPosByte] = h.TTL
+	b[protocolPosByte] = h.Protocol
+	b[checksumPosByte] = 0
+	b[checksumPosByte+1] = 0
+	copy(b[srcPosByte:srcPosByte+4], h.Src[:])
+	copy(b[dstPosByte:dstPosByte+4], h.Dst[:])
+	n := copy(b[minHeaderLen:l], h.Options)
+	clear(b[minHeaderLen+n : l])
+	binary.BigEndian.PutUint16(b[checksumPosByte:checksumPosByte+2], Checksum(b[:l]))
+	return nil
+}
+
+// Checksum computes the Internet Checksum (RFC 1071) of the given header.
+// The checksum field of the header must be zero,
+// or the result will be zero if the checksum is valid.
+func Checksum(header []byte) uint16 {
+	return checksum.Checksum(header)
+}
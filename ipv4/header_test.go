@@ -0,0 +1,57 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package ipv4
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestHeader(t *testing.T) {
+	h := &Header{
+		DF:         true,
+		TTL:        64,
+		Protocol:   17,
+		Src:        [4]byte{192, 168, 0, 1},
+		Dst:        [4]byte{192, 168, 0, 199},
+		PayloadLen: 95,
+	}
+	b, err := h.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := []byte{
+		0x45, 0x00, 0x00, 0x73,
+		0x00, 0x00, 0x40, 0x00,
+		0x40, 0x11, 0xb8, 0x61,
+		192, 168, 0, 1,
+		192, 168, 0, 199,
+	}
+	if diff := cmp.Diff(b, res); diff != "" {
+		t.Error(diff)
+	}
+	if Checksum(b) != 0 {
+		t.Error("Checksum of a valid header should be zero")
+	}
+
+	h.Options = []byte{0x94, 0x04}
+	b, err = h.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b[0] != 0x46 || len(b) != 24 {
+		t.Errorf("Options are not padded correctly: %v", b)
+	}
+	if Checksum(b) != 0 {
+		t.Error("Checksum of a valid header should be zero")
+	}
+
+	h.PayloadLen = 0xFFFF
+	if _, err := h.Marshal(); err != ErrTooLong {
+		t.Errorf("Total Length overflow should be detected: %v", err)
+	}
+}
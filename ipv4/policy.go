@@ -0,0 +1,113 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package ipv4
+
+const (
+	// Minimal IPv4 header
+	minHeaderLen = 20 // size of an IPv4 header without options in bytes
+
+	// Field Version
+	versionPosBit  = 4 // position from right of the byte in bits
+	versionPosByte = 0 // position from left in bytes
+
+	// Field IHL
+	ihlPosByte = 0    // position from left in bytes
+	ihlMask    = 0x0F // mask (decoding: no shift required)
+
+	// Flag DF
+	dfPosByte = 6    // position from left in bytes
+	dfMask    = 0x40 // mask of the flag in the byte
+)
+
+// DFPolicy defines how the DF bit of the outer IPv4 header is set
+// when End.M.GTP4.E rebuilds the outer header.
+//
+// RFC 9433 does not specify this behavior, and each choice has drawbacks:
+// copying the inner DF bit preserves end-to-end Path MTU Discovery,
+// but makes the GTP4 path MTU visible to the UE;
+// clearing it allows fragmentation of the outer packet,
+// which may be dropped by middleboxes.
+type DFPolicy uint8
+
+const (
+	// DFCopy copies the DF bit from the inner IPv4 header.
+	// If the inner packet is not IPv4, the DF bit is cleared.
+	DFCopy DFPolicy = iota
+	// DFClear always clears the DF bit.
+	DFClear
+	// DFSet always sets the DF bit.
+	DFSet
+)
+
+// OptionsPolicy defines how IPv4 options of the inner header are handled
+// when End.M.GTP4.E rebuilds the outer header.
+type OptionsPolicy uint8
+
+const (
+	// OptionsClear never puts options in the outer header.
+	OptionsClear OptionsPolicy = iota
+	// OptionsCopy copies options from the inner IPv4 header to the outer header.
+	OptionsCopy
+	// OptionsReject rejects inner IPv4 packets carrying options.
+	OptionsReject
+)
+
+// Policy groups the DF bit and the options handling of the outer IPv4 header.
+// The zero value copies the DF bit and clears options.
+type Policy struct {
+	DF      DFPolicy
+	Options OptionsPolicy
+}
+
+// Apply returns the DF bit and the options to be used in the outer IPv4 header,
+// given the inner packet. The inner packet is not required to be an IPv4 packet
+// (e.g. IPv6 or Ethernet PDU Sessions), in this case it is considered
+// as not having the DF bit set, nor any option.
+// The returned options slice shares memory with the inner packet.
+func (p Policy) Apply(inner []byte) (df bool, options []byte, err error) {
+	innerDF, innerOptions, err := p.parseInner(inner)
+	if err != nil {
+		return false, nil, err
+	}
+	switch p.DF {
+	case DFCopy:
+		df = innerDF
+	case DFSet:
+		df = true
+	}
+	switch p.Options {
+	case OptionsCopy:
+		options = innerOptions
+	case OptionsReject:
+		if len(innerOptions) > 0 {
+			return false, nil, ErrOptionsRejected
+		}
+	}
+	return df, options, nil
+}
+
+// parseInner extracts the DF bit and the options from the inner packet
+// when they are required by the policy.
+func (p Policy) parseInner(inner []byte) (df bool, options []byte, err error) {
+	if p.DF != DFCopy && p.Options == OptionsClear {
+		// inner header is not used
+		return false, nil, nil
+	}
+	if len(inner) == 0 || (inner[versionPosByte]>>versionPosBit) != 4 {
+		return false, nil, nil
+	}
+	if len(inner) < minHeaderLen {
+		return false, nil, ErrTooShortToParse
+	}
+	ihl := int(inner[ihlPosByte]&ihlMask) * 4
+	if ihl < minHeaderLen {
+		return false, nil, ErrMalformedHeader
+	}
+	if len(inner) < ihl {
+		return false, nil, ErrTooShortToParse
+	}
+	return inner[dfPosByte]&dfMask != 0, inner[minHeaderLen:ihl], nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package ipv4
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPolicy(t *testing.T) {
+	withOptions := []byte{
+		0x46, 0x00, 0x00, 0x18,
+		0x00, 0x00, 0x40, 0x00,
+		0x40, 0x11, 0x00, 0x00,
+		192, 0, 2, 1,
+		198, 51, 100, 1,
+		0x94, 0x04, 0x00, 0x00, // Router Alert
+	}
+	df, options, err := Policy{}.Apply(withOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !df {
+		t.Error("DF bit should be copied")
+	}
+	if len(options) != 0 {
+		t.Errorf("Options should be cleared: %v", options)
+	}
+
+	df, options, err = Policy{DF: DFClear, Options: OptionsCopy}.Apply(withOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if df {
+		t.Error("DF bit should be cleared")
+	}
+	if diff := cmp.Diff(options, []byte{0x94, 0x04, 0x00, 0x00}); diff != "" {
+		t.Error(diff)
+	}
+
+	if _, _, err := (Policy{Options: OptionsReject}).Apply(withOptions); !errors.Is(err, ErrOptionsRejected) {
+		t.Errorf("Options should be rejected: %v", err)
+	}
+
+	ipv6 := []byte{0x60, 0x00, 0x00, 0x00}
+	df, _, err = Policy{Options: OptionsReject}.Apply(ipv6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if df {
+		t.Error("DF bit should not be set for non-IPv4 packets")
+	}
+	if df, _, _ := (Policy{DF: DFSet}).Apply(ipv6); !df {
+		t.Error("DF bit should be set")
+	}
+
+	if _, _, err := (Policy{}).Apply(withOptions[:22]); !errors.Is(err, ErrTooShortToParse) {
+		t.Errorf("Truncated header should not be parsed: %v", err)
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package jsbind exposes the encoders of package encoding to JavaScript,
+// when built for js/wasm, so a browser-based decoder can reuse them.
+package jsbind
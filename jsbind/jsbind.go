@@ -0,0 +1,150 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build js && wasm
+
+package jsbind
+
+import (
+	"errors"
+	"net/netip"
+	"syscall/js"
+
+	"github.com/nextmn/rfc9433/encoding"
+)
+
+var errArguments = errors.New("invalid arguments")
+
+// Register exposes the following functions as methods of a global object with the given name:
+//
+//	encodeMGTP4IPv6Dst(prefix: string, ipv4: string, qfi: number, r: boolean, u: boolean, teid: number): string
+//	decodeMGTP4IPv6Dst(addr: string, prefixLength: number): {prefix, ipv4, qfi, r, u, teid}
+//	encodeMGTP4IPv6Src(prefix: string, ipv4: string, udpPort: number): string
+//	decodeMGTP4IPv6Src(addr: string): {ipv4, udpPort}
+//
+// On error, an object {error: string} is returned instead.
+func Register(name string) {
+	js.Global().Set(name, js.ValueOf(map[string]any{
+		"encodeMGTP4IPv6Dst": wrap(encodeMGTP4IPv6Dst),
+		"decodeMGTP4IPv6Dst": wrap(decodeMGTP4IPv6Dst),
+		"encodeMGTP4IPv6Src": wrap(encodeMGTP4IPv6Src),
+		"decodeMGTP4IPv6Src": wrap(decodeMGTP4IPv6Src),
+	}))
+}
+
+// wrap converts errors into {error: string} objects.
+func wrap(f func(args []js.Value) (any, error)) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) any {
+		res, err := f(args)
+		if err != nil {
+			return map[string]any{"error": err.Error()}
+		}
+		return res
+	})
+}
+
+// checkArgs verifies the types of the arguments.
+func checkArgs(args []js.Value, types ...js.Type) error {
+	if len(args) != len(types) {
+		return errArguments
+	}
+	for i, t := range types {
+		if args[i].Type() != t {
+			return errArguments
+		}
+	}
+	return nil
+}
+
+func encodeMGTP4IPv6Dst(args []js.Value) (any, error) {
+	if err := checkArgs(args, js.TypeString, js.TypeString, js.TypeNumber, js.TypeBoolean, js.TypeBoolean, js.TypeNumber); err != nil {
+		return nil, err
+	}
+	prefix, err := netip.ParsePrefix(args[0].String())
+	if err != nil {
+		return nil, err
+	}
+	ipv4, err := netip.ParseAddr(args[1].String())
+	if err != nil {
+		return nil, err
+	}
+	if !ipv4.Is4() {
+		return nil, errArguments
+	}
+	a := encoding.NewArgsMobSession(uint8(args[2].Int()), args[3].Bool(), args[4].Bool(), uint32(args[5].Int()))
+	b, err := encoding.NewMGTP4IPv6Dst(prefix, ipv4.As4(), a).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return netip.AddrFrom16([16]byte(b)).String(), nil
+}
+
+func decodeMGTP4IPv6Dst(args []js.Value) (any, error) {
+	if err := checkArgs(args, js.TypeString, js.TypeNumber); err != nil {
+		return nil, err
+	}
+	addr, err := netip.ParseAddr(args[0].String())
+	if err != nil {
+		return nil, err
+	}
+	if !addr.Is6() {
+		return nil, errArguments
+	}
+	dst, err := encoding.ParseMGTP4IPv6Dst(addr.As16(), uint(args[1].Int()))
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{
+		"prefix": dst.Prefix().String(),
+		"ipv4":   dst.IPv4().String(),
+		"qfi":    int(dst.QFI()),
+		"r":      dst.R(),
+		"u":      dst.U(),
+		"teid":   int(dst.PDUSessionID()),
+	}, nil
+}
+
+func encodeMGTP4IPv6Src(args []js.Value) (any, error) {
+	if err := checkArgs(args, js.TypeString, js.TypeString, js.TypeNumber); err != nil {
+		return nil, err
+	}
+	prefix, err := netip.ParsePrefix(args[0].String())
+	if err != nil {
+		return nil, err
+	}
+	ipv4, err := netip.ParseAddr(args[1].String())
+	if err != nil {
+		return nil, err
+	}
+	if !ipv4.Is4() {
+		return nil, errArguments
+	}
+	b, err := encoding.NewMGTP4IPv6Src(prefix, ipv4.As4(), uint16(args[2].Int())).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return netip.AddrFrom16([16]byte(b)).String(), nil
+}
+
+func decodeMGTP4IPv6Src(args []js.Value) (any, error) {
+	if err := checkArgs(args, js.TypeString); err != nil {
+		return nil, err
+	}
+	addr, err := netip.ParseAddr(args[0].String())
+	if err != nil {
+		return nil, err
+	}
+	if !addr.Is6() {
+		return nil, errArguments
+	}
+	src, err := encoding.ParseMGTP4IPv6SrcNextMN(addr.As16())
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{
+		"ipv4":    src.IPv4().String(),
+		"udpPort": int(src.UDPPortNumber()),
+	}, nil
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build js && wasm
+
+package jsbind
+
+import (
+	"syscall/js"
+	"testing"
+)
+
+func TestRegister(t *testing.T) {
+	Register("rfc9433")
+	m := js.Global().Get("rfc9433")
+
+	dst := m.Call("encodeMGTP4IPv6Dst", "3fff::/20", "203.0.113.1", 5, true, false, 1)
+	if dst.Type() != js.TypeString {
+		t.Fatalf("Unexpected result: %v", dst)
+	}
+	res := m.Call("decodeMGTP4IPv6Dst", dst, 20)
+	if !res.Get("error").IsUndefined() {
+		t.Fatal(res.Get("error").String())
+	}
+	if res.Get("ipv4").String() != "203.0.113.1" || res.Get("qfi").Int() != 5 || !res.Get("r").Bool() || res.Get("teid").Int() != 1 {
+		t.Errorf("Unexpected decoding: %v", res)
+	}
+
+	src := m.Call("encodeMGTP4IPv6Src", "fd00:1:1::/48", "10.0.4.1", 0x1234)
+	if src.String() != "fd00:1:1:a00:401:1234:0:30" {
+		t.Errorf("Unexpected encoding: %s", src.String())
+	}
+	res = m.Call("decodeMGTP4IPv6Src", src)
+	if res.Get("ipv4").String() != "10.0.4.1" || res.Get("udpPort").Int() != 0x1234 {
+		t.Errorf("Unexpected decoding: %v", res)
+	}
+
+	if res := m.Call("decodeMGTP4IPv6Src", 42); res.Get("error").IsUndefined() {
+		t.Error("Invalid arguments should be rejected")
+	}
+}
@@ -0,0 +1,322 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package locator
+
+import (
+	"iter"
+	"net/netip"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Usage reports the usage of a locator of an Allocator.
+type Usage struct {
+	Locator   netip.Prefix
+	Allocated uint64 // number of allocated values
+	Held      uint64 // number of released values in hold-down
+	Size      uint64 // number of values (capped to 2^63)
+}
+
+// Allocation is a SID allocated by an Allocator.
+type Allocation struct {
+	SID   netip.Prefix // locator and value
+	Owner string
+}
+
+// Clock returns the current time of the hold-downs of an Allocator.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc is an adapter to allow the use of ordinary functions as Clock.
+type ClockFunc func() time.Time
+
+// Now calls f().
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// pool is the state of a locator of an Allocator.
+type pool struct {
+	locator netip.Prefix
+	size    uint64
+	next    uint64               // next value to try
+	owners  map[uint64]string    // allocated values
+	held    map[uint64]time.Time // released values, and the end of their hold-down
+	queue   []uint64             // held values, in release order
+}
+
+// Allocator allocates unique SIDs under a pool of locators, by assigning values
+// to the bits following the locator: FUNCT, or Args.Mob.Session when the locator
+// is the prefix of End.M.GTP4.E SIDs including the IPv4 DA.
+// Released values are held down before being reused, so that in-flight packets
+// using a released SID are not delivered to a new session.
+// Allocator is safe for concurrent use.
+type Allocator struct {
+	mu       sync.Mutex
+	pools    []*pool
+	bits     int // number of bits of the values
+	holdDown time.Duration
+	now      func() time.Time
+	detector *Detector
+}
+
+// NewAllocator creates an Allocator of values of the given number of bits,
+// under the locators, which are used in order.
+func NewAllocator(bits int, holdDown time.Duration, locators ...netip.Prefix) (*Allocator, error) {
+	if bits <= 0 || len(locators) == 0 {
+		return nil, ErrInvalidPool
+	}
+	a := &Allocator{
+		pools:    make([]*pool, 0, len(locators)),
+		bits:     bits,
+		holdDown: holdDown,
+		now:      time.Now,
+	}
+	for _, l := range locators {
+		if !l.IsValid() || !l.Addr().Is6() || l.Bits()+bits > 128 {
+			return nil, ErrInvalidPool
+		}
+		for _, p := range a.pools {
+			if p.locator.Overlaps(l) {
+				return nil, ErrInvalidPool
+			}
+		}
+		a.pools = append(a.pools, &pool{
+			locator: l.Masked(),
+			size:    uint64(1) << min(bits, 63),
+			owners:  make(map[uint64]string),
+			held:    make(map[uint64]time.Time),
+		})
+	}
+	return a, nil
+}
+
+// SetClock sets the Clock of the hold-downs. By default, time.Now is used.
+func (a *Allocator) SetClock(c Clock) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.now = c.Now
+}
+
+// SetDetector provisions the SIDs allocated from now on in d, and removes them from d once released.
+// Values whose SID collides in d are not allocated.
+func (a *Allocator) SetDetector(d *Detector) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.detector = d
+}
+
+// Allocate allocates a free SID to the owner, and returns its prefix (locator and value).
+func (a *Allocator) Allocate(owner string) (netip.Prefix, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	now := a.now()
+	for _, p := range a.pools {
+		p.expire(now)
+		if uint64(len(p.owners)+len(p.held)) >= p.size {
+			continue
+		}
+		// at least one value is free, but it may collide in the Detector
+		for i := uint64(0); i < p.size; i++ {
+			v := p.next
+			p.next = (p.next + 1) % p.size
+			if !p.free(v) {
+				continue
+			}
+			sid := withValue(p.locator, p.locator.Bits()+a.bits, v)
+			if a.detector != nil && a.detector.AddSID(sid.Addr(), owner) != nil {
+				continue
+			}
+			p.owners[v] = owner
+			return sid, nil
+		}
+	}
+	return netip.Prefix{}, ErrNoFreeSID
+}
+
+// Reserve allocates the given SID to the owner, e.g. to restore the state of a control plane.
+func (a *Allocator) Reserve(sid netip.Addr, owner string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	p, v, ok := a.lookup(sid)
+	if !ok {
+		return ErrInvalidSID
+	}
+	p.expire(a.now())
+	if o, ok := p.owners[v]; ok {
+		s := withValue(p.locator, p.locator.Bits()+a.bits, v).String()
+		return &CollisionError{New: s, NewOwner: owner, Existing: s, Owner: o}
+	}
+	if _, ok := p.held[v]; ok {
+		return ErrHeldDown
+	}
+	if a.detector != nil {
+		if err := a.detector.AddSID(withValue(p.locator, p.locator.Bits()+a.bits, v).Addr(), owner); err != nil {
+			return err
+		}
+	}
+	p.owners[v] = owner
+	return nil
+}
+
+// Release releases an allocated SID, which is held down before being reused.
+func (a *Allocator) Release(sid netip.Addr) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	p, v, ok := a.lookup(sid)
+	if !ok {
+		return ErrInvalidSID
+	}
+	if _, ok := p.owners[v]; !ok {
+		return ErrNotAllocated
+	}
+	delete(p.owners, v)
+	if a.detector != nil {
+		a.detector.RemoveSID(withValue(p.locator, p.locator.Bits()+a.bits, v).Addr())
+	}
+	if a.holdDown > 0 {
+		p.held[v] = a.now().Add(a.holdDown)
+		p.queue = append(p.queue, v)
+	}
+	return nil
+}
+
+// Owner returns the owner of an allocated SID.
+func (a *Allocator) Owner(sid netip.Addr) (string, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	p, v, ok := a.lookup(sid)
+	if !ok {
+		return "", false
+	}
+	o, ok := p.owners[v]
+	return o, ok
+}
+
+// Prefix returns the prefix (locator and value) of a SID under a locator of the Allocator.
+func (a *Allocator) Prefix(sid netip.Addr) (netip.Prefix, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	p, v, ok := a.lookup(sid)
+	if !ok {
+		return netip.Prefix{}, false
+	}
+	return withValue(p.locator, p.locator.Bits()+a.bits, v), true
+}
+
+// Usage returns the usage of each locator, in order.
+func (a *Allocator) Usage() []Usage {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	now := a.now()
+	u := make([]Usage, 0, len(a.pools))
+	for _, p := range a.pools {
+		p.expire(now)
+		u = append(u, Usage{
+			Locator:   p.locator,
+			Allocated: uint64(len(p.owners)),
+			Held:      uint64(len(p.held)),
+			Size:      p.size,
+		})
+	}
+	return u
+}
+
+// Allocations returns the allocated SIDs, ordered by locator and value.
+func (a *Allocator) Allocations() []Allocation {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	var allocations []Allocation
+	for _, p := range a.pools {
+		values := make([]uint64, 0, len(p.owners))
+		for v := range p.owners {
+			values = append(values, v)
+		}
+		slices.Sort(values)
+		for _, v := range values {
+			allocations = append(allocations, Allocation{
+				SID:   withValue(p.locator, p.locator.Bits()+a.bits, v),
+				Owner: p.owners[v],
+			})
+		}
+	}
+	return allocations
+}
+
+// All returns an iterator over the allocated SIDs and their owner, by locator, in no particular order within a locator.
+// Unlike Allocations, the Allocator is not copied but locked during the iteration: the loop must not use the Allocator.
+func (a *Allocator) All() iter.Seq2[netip.Prefix, string] {
+	return a.filter(func(netip.Prefix) bool { return true }, func(netip.Prefix, string) bool { return true })
+}
+
+// ByPrefix returns an iterator over the allocated SIDs contained in the prefix, and their owner (see All).
+func (a *Allocator) ByPrefix(prefix netip.Prefix) iter.Seq2[netip.Prefix, string] {
+	prefix = prefix.Masked()
+	return a.filter(prefix.Overlaps, func(sid netip.Prefix, _ string) bool {
+		return prefix.Bits() <= sid.Bits() && prefix.Contains(sid.Addr())
+	})
+}
+
+// ByOwner returns an iterator over the SIDs allocated to the owner (e.g. a control plane peer) (see All).
+func (a *Allocator) ByOwner(owner string) iter.Seq2[netip.Prefix, string] {
+	return a.filter(func(netip.Prefix) bool { return true }, func(_ netip.Prefix, o string) bool {
+		return o == owner
+	})
+}
+
+// filter returns an iterator over the allocated SIDs of the locators matching pool, which match fn.
+func (a *Allocator) filter(pool func(locator netip.Prefix) bool, fn func(sid netip.Prefix, owner string) bool) iter.Seq2[netip.Prefix, string] {
+	return func(yield func(netip.Prefix, string) bool) {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		for _, p := range a.pools {
+			if !pool(p.locator) {
+				continue
+			}
+			for v, o := range p.owners {
+				sid := withValue(p.locator, p.locator.Bits()+a.bits, v)
+				if fn(sid, o) && !yield(sid, o) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// lookup returns the pool containing the SID, and the value of the SID.
+func (a *Allocator) lookup(sid netip.Addr) (*pool, uint64, bool) {
+	for _, p := range a.pools {
+		if p.locator.Contains(sid) {
+			return p, valueOf(sid, p.locator, p.locator.Bits()+a.bits), true
+		}
+	}
+	return nil, 0, false
+}
+
+// free returns true if the value is neither allocated nor held down.
+func (p *pool) free(v uint64) bool {
+	if _, ok := p.owners[v]; ok {
+		return false
+	}
+	_, ok := p.held[v]
+	return !ok
+}
+
+// expire ends the hold-down of the values released long enough ago.
+func (p *pool) expire(now time.Time) {
+	for len(p.queue) > 0 {
+		v := p.queue[0]
+		if end, ok := p.held[v]; ok {
+			if now.Before(end) {
+				return
+			}
+			delete(p.held, v)
+		}
+		p.queue = p.queue[1:]
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package locator
+
+import (
+	"errors"
+	"maps"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAllocator(t *testing.T) {
+	l1 := netip.MustParsePrefix("fd00:1::/32")
+	l2 := netip.MustParsePrefix("fd00:2::/48")
+	a, err := NewAllocator(2, time.Second, l1, l2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(0, 0)
+	a.SetClock(ClockFunc(func() time.Time { return now }))
+
+	expected := []netip.Prefix{
+		netip.MustParsePrefix("fd00:1::/34"),
+		netip.MustParsePrefix("fd00:1:4000::/34"),
+		netip.MustParsePrefix("fd00:1:8000::/34"),
+		netip.MustParsePrefix("fd00:1:c000::/34"),
+		netip.MustParsePrefix("fd00:2::/50"),
+	}
+	for i, want := range expected {
+		sid, err := a.Allocate("smf")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sid != want {
+			t.Errorf("Unexpected SID %d: %s instead of %s", i, sid, want)
+		}
+	}
+	if o, ok := a.Owner(netip.MustParseAddr("fd00:1:4000::1")); !ok || o != "smf" {
+		t.Errorf("Unexpected owner: %s, %t", o, ok)
+	}
+	if p, ok := a.Prefix(netip.MustParseAddr("fd00:1:4000::1")); !ok || p != expected[1] {
+		t.Errorf("Unexpected prefix: %s, %t", p, ok)
+	}
+	if _, ok := a.Prefix(netip.MustParseAddr("fd00:3::1")); ok {
+		t.Error("SID outside of the locators should not have a prefix")
+	}
+
+	// reservation
+	if err := a.Reserve(netip.MustParseAddr("fd00:2:0:c000::"), "restored"); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Reserve(netip.MustParseAddr("fd00:2::"), "restored"); !errors.Is(err, ErrCollision) {
+		t.Errorf("Expected ErrCollision, got %v", err)
+	}
+	if err := a.Reserve(netip.MustParseAddr("fd00:3::"), "restored"); err != ErrInvalidSID {
+		t.Errorf("Expected ErrInvalidSID, got %v", err)
+	}
+	for _, want := range []string{"fd00:2:0:4000::/50", "fd00:2:0:8000::/50"} {
+		if sid, err := a.Allocate("smf"); err != nil || sid != netip.MustParsePrefix(want) {
+			t.Errorf("Unexpected SID: %s, %v", sid, err)
+		}
+	}
+	if _, err := a.Allocate("smf"); err != ErrNoFreeSID {
+		t.Errorf("Expected ErrNoFreeSID, got %v", err)
+	}
+
+	// release with hold-down
+	sid := netip.MustParseAddr("fd00:1:4000::")
+	if err := a.Release(sid); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Release(sid); err != ErrNotAllocated {
+		t.Errorf("Expected ErrNotAllocated, got %v", err)
+	}
+	if err := a.Reserve(sid, "smf"); err != ErrHeldDown {
+		t.Errorf("Expected ErrHeldDown, got %v", err)
+	}
+	if _, err := a.Allocate("smf"); err != ErrNoFreeSID {
+		t.Errorf("Released SID should be held down: %v", err)
+	}
+	if diff := cmp.Diff(a.Usage(), []Usage{
+		{Locator: l1, Allocated: 3, Held: 1, Size: 4},
+		{Locator: l2, Allocated: 4, Held: 0, Size: 4},
+	}, cmp.Comparer(func(a, b netip.Prefix) bool { return a == b })); diff != "" {
+		t.Error(diff)
+	}
+	now = now.Add(time.Second)
+	if p, err := a.Allocate("smf"); err != nil || p != netip.MustParsePrefix("fd00:1:4000::/34") {
+		t.Errorf("Released SID should be reused after hold-down: %s, %v", p, err)
+	}
+}
+
+func TestNewAllocator(t *testing.T) {
+	for _, tc := range []struct {
+		bits     int
+		locators []netip.Prefix
+	}{
+		{0, []netip.Prefix{netip.MustParsePrefix("fd00::/32")}},
+		{16, nil},
+		{97, []netip.Prefix{netip.MustParsePrefix("fd00::/32")}},
+		{16, []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}},
+		{16, []netip.Prefix{netip.MustParsePrefix("fd00::/32"), netip.MustParsePrefix("fd00:0:1::/48")}},
+	} {
+		if _, err := NewAllocator(tc.bits, 0, tc.locators...); err != ErrInvalidPool {
+			t.Errorf("Expected ErrInvalidPool for %d bits and %v, got %v", tc.bits, tc.locators, err)
+		}
+	}
+}
+
+func TestAllocatorValues(t *testing.T) {
+	// values crossing the 64 bits boundary, e.g. Args.Mob.Session of End.M.GTP4.E SIDs
+	l := netip.MustParsePrefix("fd00:1:c000:201::/64")
+	a, err := NewAllocator(40, 0, l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sid := netip.MustParseAddr("fd00:1:c000:201:ab:cdef:1200::")
+	if err := a.Reserve(sid, "smf"); err != nil {
+		t.Fatal(err)
+	}
+	if v := valueOf(sid, l, 104); v != 0xabcdef12 {
+		t.Errorf("Unexpected value: %x", v)
+	}
+	if p := withValue(l, 104, 0xabcdef12); p.Addr() != sid {
+		t.Errorf("Unexpected SID: %s", p)
+	}
+	if err := a.Release(sid); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Reserve(sid, "smf"); err != nil {
+		t.Errorf("SID without hold-down should be reusable: %v", err)
+	}
+}
+
+func TestAllocatorAllocations(t *testing.T) {
+	l1 := netip.MustParsePrefix("fd00:1::/32")
+	l2 := netip.MustParsePrefix("fd00:2::/48")
+	a, err := NewAllocator(8, 0, l1, l2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Reserve(netip.MustParseAddr("fd00:2:0:500::"), "upf"); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Reserve(netip.MustParseAddr("fd00:1:300::"), "smf2"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.Allocate("smf1"); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(a.Allocations(), []Allocation{
+		{SID: netip.MustParsePrefix("fd00:1::/40"), Owner: "smf1"},
+		{SID: netip.MustParsePrefix("fd00:1:300::/40"), Owner: "smf2"},
+		{SID: netip.MustParsePrefix("fd00:2:0:500::/56"), Owner: "upf"},
+	}, cmp.Comparer(func(a, b netip.Prefix) bool { return a == b })); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestAllocatorDetector(t *testing.T) {
+	a, err := NewAllocator(8, 0, netip.MustParsePrefix("fd00:1::/32"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDetector()
+	a.SetDetector(d)
+	// value 0 is already used by a session
+	if err := d.AddSID(netip.MustParseAddr("fd00:1::"), "session"); err != nil {
+		t.Fatal(err)
+	}
+	sid, err := a.Allocate("smf1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sid != netip.MustParsePrefix("fd00:1:100::/40") {
+		t.Errorf("Colliding value should be skipped: %s", sid)
+	}
+	if err := d.AddSID(sid.Addr(), "smf2"); !errors.Is(err, ErrCollision) {
+		t.Errorf("Allocated SID should be provisioned in the Detector: %v", err)
+	}
+	if err := a.Reserve(netip.MustParseAddr("fd00:1::"), "smf2"); !errors.Is(err, ErrCollision) {
+		t.Errorf("Reserved SID colliding in the Detector should be rejected: %v", err)
+	}
+	if err := a.Release(sid.Addr()); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.AddSID(sid.Addr(), "smf2"); err != nil {
+		t.Errorf("Released SID should be removed from the Detector: %v", err)
+	}
+}
+
+func TestAllocatorIterators(t *testing.T) {
+	a, err := NewAllocator(8, 0, netip.MustParsePrefix("fd00:1::/32"), netip.MustParsePrefix("fd00:2::/48"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for sid, owner := range map[string]string{"fd00:1::": "smf1", "fd00:1:100::": "smf2", "fd00:2:0:500::": "smf1"} {
+		if err := a.Reserve(netip.MustParseAddr(sid), owner); err != nil {
+			t.Fatal(err)
+		}
+	}
+	prefixes := cmp.Comparer(func(a, b netip.Prefix) bool { return a == b })
+	if diff := cmp.Diff(maps.Collect(a.All()), map[netip.Prefix]string{
+		netip.MustParsePrefix("fd00:1::/40"):       "smf1",
+		netip.MustParsePrefix("fd00:1:100::/40"):   "smf2",
+		netip.MustParsePrefix("fd00:2:0:500::/56"): "smf1",
+	}, prefixes); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(maps.Collect(a.ByOwner("smf1")), map[netip.Prefix]string{
+		netip.MustParsePrefix("fd00:1::/40"):       "smf1",
+		netip.MustParsePrefix("fd00:2:0:500::/56"): "smf1",
+	}, prefixes); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(maps.Collect(a.ByPrefix(netip.MustParsePrefix("fd00:1:100::/40"))), map[netip.Prefix]string{
+		netip.MustParsePrefix("fd00:1:100::/40"): "smf2",
+	}, prefixes); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(maps.Collect(a.ByPrefix(netip.MustParsePrefix("fd00::/16"))), maps.Collect(a.All()), prefixes); diff != "" {
+		t.Error(diff)
+	}
+	if n := len(maps.Collect(a.ByPrefix(netip.MustParsePrefix("fd00:1:100::/48")))); n != 0 {
+		t.Errorf("SIDs larger than the prefix should not be returned: %d", n)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package locator
+
+import (
+	"fmt"
+	"net/netip"
+	"sync"
+)
+
+// CollisionError is returned when a provisioning operation would result
+// in SIDs sharing the same value.
+type CollisionError struct {
+	New      string // value being provisioned (SID or locator)
+	NewOwner string
+	Existing string // already provisioned value colliding with the new one
+	Owner    string // owner of the already provisioned value
+}
+
+// Error returns a description of the collision.
+func (e *CollisionError) Error() string {
+	return fmt.Sprintf("%s (%s) collides with %s (%s)", e.New, e.NewOwner, e.Existing, e.Owner)
+}
+
+// Is allows the use of errors.Is(err, ErrCollision).
+func (e *CollisionError) Is(target error) bool {
+	return target == ErrCollision
+}
+
+// marshaler is implemented by SID encoders.
+type marshaler interface {
+	Marshal() ([]byte, error)
+}
+
+// Detector rejects SIDs and locators colliding with already provisioned ones:
+// two SIDs sharing the same 128 bits value, two overlapping locators,
+// or a locator containing a SID provisioned by another owner.
+// The empty owner is shared: its SIDs and locators do not conflict with the ones of other owners.
+// Detector is safe for concurrent use.
+type Detector struct {
+	mu       sync.RWMutex
+	sids     map[netip.Addr]string
+	locators map[netip.Prefix]string
+}
+
+// NewDetector creates a Detector.
+func NewDetector() *Detector {
+	return &Detector{
+		sids:     make(map[netip.Addr]string),
+		locators: make(map[netip.Prefix]string),
+	}
+}
+
+// AddSID provisions a SID, unless it collides with an existing SID
+// or is contained in a locator of another owner.
+func (d *Detector) AddSID(sid netip.Addr, owner string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if o, ok := d.sids[sid]; ok {
+		return &CollisionError{New: sid.String(), NewOwner: owner, Existing: sid.String(), Owner: o}
+	}
+	for p, o := range d.locators {
+		if conflict(o, owner) && p.Contains(sid) {
+			return &CollisionError{New: sid.String(), NewOwner: owner, Existing: p.String(), Owner: o}
+		}
+	}
+	d.sids[sid] = owner
+	return nil
+}
+
+// AddEncoded provisions a SID given as an encoder (e.g. MGTP4IPv6Dst), unless it collides with an existing SID.
+func (d *Detector) AddEncoded(m marshaler, owner string) error {
+	b, err := m.Marshal()
+	if err != nil {
+		return err
+	}
+	sid, ok := netip.AddrFromSlice(b)
+	if !ok {
+		return ErrInvalidSID
+	}
+	return d.AddSID(sid, owner)
+}
+
+// RemoveSID removes a SID.
+func (d *Detector) RemoveSID(sid netip.Addr) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.sids, sid)
+}
+
+// AddLocator provisions a locator, unless it overlaps with an existing locator
+// or contains a SID of another owner.
+func (d *Detector) AddLocator(prefix netip.Prefix, owner string) error {
+	prefix = prefix.Masked()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for p, o := range d.locators {
+		if p.Overlaps(prefix) {
+			return &CollisionError{New: prefix.String(), NewOwner: owner, Existing: p.String(), Owner: o}
+		}
+	}
+	for sid, o := range d.sids {
+		if conflict(o, owner) && prefix.Contains(sid) {
+			return &CollisionError{New: prefix.String(), NewOwner: owner, Existing: sid.String(), Owner: o}
+		}
+	}
+	d.locators[prefix] = owner
+	return nil
+}
+
+// RemoveLocator removes a locator.
+func (d *Detector) RemoveLocator(prefix netip.Prefix) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.locators, prefix.Masked())
+}
+
+// conflict returns true if both owners are set and differ.
+func conflict(a, b string) bool {
+	return a != "" && b != "" && a != b
+}
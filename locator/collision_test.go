@@ -0,0 +1,55 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package locator
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/nextmn/rfc9433/encoding"
+)
+
+func TestDetector(t *testing.T) {
+	d := NewDetector()
+	prefix := netip.MustParsePrefix("fd00:2:2::/48")
+	if err := d.AddLocator(prefix, "gnb1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.AddLocator(netip.MustParsePrefix("fd00:2:2:1::/64"), "gnb2"); !errors.Is(err, ErrCollision) {
+		t.Errorf("Overlapping locator should be rejected: %v", err)
+	}
+
+	dst := encoding.NewMGTP4IPv6Dst(netip.MustParsePrefix("fd00:3:3::/48"), [4]byte{198, 51, 100, 1}, encoding.NewArgsMobSession(0, false, false, 1))
+	if err := d.AddEncoded(dst, "session1"); err != nil {
+		t.Fatal(err)
+	}
+	err := d.AddEncoded(dst, "session2")
+	var collision *CollisionError
+	if !errors.As(err, &collision) {
+		t.Fatalf("Duplicated SID should be rejected: %v", err)
+	}
+	if collision.Owner != "session1" || collision.NewOwner != "session2" {
+		t.Errorf("Unexpected collision: %v", collision)
+	}
+	if err := d.AddLocator(netip.MustParsePrefix("fd00:3:3::/48"), "gnb3"); !errors.Is(err, ErrCollision) {
+		t.Errorf("Locator containing a SID of another owner should be rejected: %v", err)
+	}
+	if err := d.AddSID(netip.MustParseAddr("fd00:2:2::1"), "gnb2"); !errors.Is(err, ErrCollision) {
+		t.Errorf("SID in a locator of another owner should be rejected: %v", err)
+	}
+	if err := d.AddSID(netip.MustParseAddr("fd00:2:2::1"), "gnb1"); err != nil {
+		t.Errorf("SID in a locator of the same owner should be accepted: %v", err)
+	}
+	if err := d.AddSID(netip.MustParseAddr("fd00:2:2::2"), ""); err != nil {
+		t.Errorf("SID without owner should be accepted: %v", err)
+	}
+	d.RemoveSID(netip.MustParseAddr("fd00:2:2::1"))
+	d.RemoveLocator(prefix)
+	if err := d.AddLocator(netip.MustParsePrefix("fd00:2:2:1::/64"), "gnb2"); err != nil {
+		t.Errorf("Locator should be accepted after removal: %v", err)
+	}
+}
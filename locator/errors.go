@@ -0,0 +1,21 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package locator
+
+import "errors"
+
+var (
+	ErrCollision        = errors.New("SID collision")
+	ErrInvalidSID       = errors.New("invalid SID")
+	ErrInvalidPartition = errors.New("invalid partition")
+	ErrExhausted        = errors.New("no free sub-locator")
+	ErrNotDelegated     = errors.New("sub-locator is not delegated")
+	ErrNotOwner         = errors.New("sub-locator is delegated to another owner")
+	ErrInvalidPool      = errors.New("invalid locator pool")
+	ErrNoFreeSID        = errors.New("no free SID")
+	ErrNotAllocated     = errors.New("SID is not allocated")
+	ErrHeldDown         = errors.New("SID is held down")
+)
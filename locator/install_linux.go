@@ -0,0 +1,112 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package locator
+
+import (
+	"errors"
+	"net"
+	"net/netip"
+	"sync"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// Mode defines how prefixes are installed.
+type Mode uint8
+
+const (
+	// ModeLocal installs prefixes as local routes (AnyIP):
+	// packets are delivered to local sockets (e.g. raw sockets) for any address of the prefix.
+	ModeLocal Mode = iota
+	// ModeRoute installs prefixes as routes toward the interface (e.g. a TUN interface).
+	ModeRoute
+)
+
+// Installer installs the locator prefixes and the source address prefix
+// of the SR Gateway on an interface, so received SR traffic reaches the datapath.
+type Installer struct {
+	mu        sync.Mutex
+	link      string
+	mode      Mode
+	prefixes  []netip.Prefix
+	installed map[netip.Prefix]*netlink.Route
+}
+
+// NewInstaller creates an Installer.
+func NewInstaller(link string, mode Mode, prefixes []netip.Prefix) *Installer {
+	return &Installer{
+		link:      link,
+		mode:      mode,
+		prefixes:  prefixes,
+		installed: make(map[netip.Prefix]*netlink.Route),
+	}
+}
+
+// Install installs the prefixes. It may be called again, e.g. after the interface is recreated:
+// prefixes are installed only once. On failure, prefixes installed by this call are removed,
+// and those installed by a previous call are kept.
+func (i *Installer) Install() error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	link, err := netlink.LinkByName(i.link)
+	if err != nil {
+		return err
+	}
+	var added []netip.Prefix
+	for _, prefix := range i.prefixes {
+		prefix = prefix.Masked()
+		route := &netlink.Route{
+			LinkIndex: link.Attrs().Index,
+			Dst: &net.IPNet{
+				IP:   prefix.Addr().AsSlice(),
+				Mask: net.CIDRMask(prefix.Bits(), prefix.Addr().BitLen()),
+			},
+		}
+		if i.mode == ModeLocal {
+			route.Table = unix.RT_TABLE_LOCAL
+			route.Type = unix.RTN_LOCAL
+			route.Scope = netlink.SCOPE_HOST
+		}
+		if err := netlink.RouteReplace(route); err != nil {
+			return errors.Join(err, i.uninstall(added))
+		}
+		if _, ok := i.installed[prefix]; !ok {
+			added = append(added, prefix)
+		}
+		i.installed[prefix] = route
+	}
+	return nil
+}
+
+// Uninstall removes the prefixes previously installed.
+func (i *Installer) Uninstall() error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	prefixes := make([]netip.Prefix, 0, len(i.installed))
+	for prefix := range i.installed {
+		prefixes = append(prefixes, prefix)
+	}
+	return i.uninstall(prefixes)
+}
+
+// uninstall removes the given prefixes, if installed.
+func (i *Installer) uninstall(prefixes []netip.Prefix) error {
+	var errs []error
+	for _, prefix := range prefixes {
+		route, ok := i.installed[prefix]
+		if !ok {
+			continue
+		}
+		if err := netlink.RouteDel(route); err != nil {
+			errs = append(errs, err)
+		}
+		delete(i.installed, prefix)
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,34 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package locator
+
+import (
+	"errors"
+	"net/netip"
+	"os"
+	"testing"
+)
+
+func TestInstaller(t *testing.T) {
+	i := NewInstaller("lo", ModeLocal, []netip.Prefix{netip.MustParsePrefix("fd00:db8:9433::/48")})
+	if err := i.Install(); errors.Is(err, os.ErrPermission) {
+		t.Skip("CAP_NET_ADMIN is required")
+	} else if err != nil {
+		t.Fatal(err)
+	}
+	// installing again must not record the prefix twice
+	if err := i.Install(); err != nil {
+		t.Fatal(err)
+	}
+	if len(i.installed) != 1 {
+		t.Errorf("Prefix should be recorded once, got %d routes", len(i.installed))
+	}
+	if err := i.Uninstall(); err != nil {
+		t.Fatal(err)
+	}
+}
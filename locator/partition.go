@@ -0,0 +1,171 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package locator
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"slices"
+	"sync"
+)
+
+// Partition splits a locator into sub-locators of the same length,
+// delegated to different consumers (e.g. one per gNB, or one per worker).
+// Partition is safe for concurrent use.
+type Partition struct {
+	mu      sync.RWMutex
+	locator netip.Prefix
+	bits    int // length of sub-locators
+	owners  map[netip.Prefix]string
+}
+
+// NewPartition creates a Partition of the locator into sub-locators of length bits.
+func NewPartition(locator netip.Prefix, bits int) (*Partition, error) {
+	if !locator.IsValid() || !locator.Addr().Is6() || bits <= locator.Bits() || bits > 128 {
+		return nil, ErrInvalidPartition
+	}
+	return &Partition{
+		locator: locator.Masked(),
+		bits:    bits,
+		owners:  make(map[netip.Prefix]string),
+	}, nil
+}
+
+// Locator returns the partitioned locator.
+func (p *Partition) Locator() netip.Prefix {
+	return p.locator
+}
+
+// subLocator returns the n-th sub-locator.
+func (p *Partition) subLocator(n uint64) netip.Prefix {
+	return withValue(p.locator, p.bits, n)
+}
+
+// withValue returns the prefix of length bits, whose last bits after the locator are set to n.
+func withValue(locator netip.Prefix, bits int, n uint64) netip.Prefix {
+	a := locator.Addr().As16()
+	hi := binary.BigEndian.Uint64(a[:8])
+	lo := binary.BigEndian.Uint64(a[8:])
+	shift := 128 - bits
+	switch {
+	case shift >= 64:
+		hi |= n << (shift - 64)
+	case shift == 0:
+		lo |= n
+	default:
+		lo |= n << shift
+		hi |= n >> (64 - shift)
+	}
+	binary.BigEndian.PutUint64(a[:8], hi)
+	binary.BigEndian.PutUint64(a[8:], lo)
+	return netip.PrefixFrom(netip.AddrFrom16(a), bits)
+}
+
+// valueOf returns the last bits of the prefix of length bits after the locator (see withValue).
+func valueOf(addr netip.Addr, locator netip.Prefix, bits int) uint64 {
+	a := addr.As16()
+	hi := binary.BigEndian.Uint64(a[:8])
+	lo := binary.BigEndian.Uint64(a[8:])
+	shift := 128 - bits
+	var n uint64
+	switch {
+	case shift >= 64:
+		n = hi >> (shift - 64)
+	case shift == 0:
+		n = lo
+	default:
+		n = lo>>shift | hi<<(64-shift)
+	}
+	if width := bits - locator.Bits(); width < 64 {
+		n &= 1<<width - 1
+	}
+	return n
+}
+
+// Delegate delegates the first free sub-locator to the owner.
+func (p *Partition) Delegate(owner string) (netip.Prefix, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	count := uint64(1) << min(p.bits-p.locator.Bits(), 63)
+	for n := uint64(0); n < count; n++ {
+		sub := p.subLocator(n)
+		if _, ok := p.owners[sub]; !ok {
+			p.owners[sub] = owner
+			return sub, nil
+		}
+	}
+	return netip.Prefix{}, ErrExhausted
+}
+
+// DelegateSubLocator delegates the given sub-locator to the owner.
+func (p *Partition) DelegateSubLocator(sub netip.Prefix, owner string) error {
+	if sub.Bits() != p.bits || !p.locator.Contains(sub.Addr()) {
+		return ErrInvalidPartition
+	}
+	sub = sub.Masked()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if o, ok := p.owners[sub]; ok {
+		return &CollisionError{New: sub.String(), NewOwner: owner, Existing: sub.String(), Owner: o}
+	}
+	p.owners[sub] = owner
+	return nil
+}
+
+// Release releases a delegated sub-locator.
+func (p *Partition) Release(sub netip.Prefix) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	sub = sub.Masked()
+	if _, ok := p.owners[sub]; !ok {
+		return ErrNotDelegated
+	}
+	delete(p.owners, sub)
+	return nil
+}
+
+// Owner returns the owner of the sub-locator containing the address.
+func (p *Partition) Owner(addr netip.Addr) (string, bool) {
+	if !p.locator.Contains(addr) {
+		return "", false
+	}
+	sub, err := addr.Prefix(p.bits)
+	if err != nil {
+		return "", false
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	o, ok := p.owners[sub]
+	return o, ok
+}
+
+// CheckAllocation returns an error if the SID is not in a sub-locator delegated to the owner.
+func (p *Partition) CheckAllocation(sid netip.Addr, owner string) error {
+	o, ok := p.Owner(sid)
+	if !ok {
+		return ErrNotDelegated
+	}
+	if o != owner {
+		return ErrNotOwner
+	}
+	return nil
+}
+
+// SubLocators returns the sorted list of sub-locators delegated to the owner.
+func (p *Partition) SubLocators(owner string) []netip.Prefix {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	subs := []netip.Prefix{}
+	for sub, o := range p.owners {
+		if o == owner {
+			subs = append(subs, sub)
+		}
+	}
+	slices.SortFunc(subs, func(a, b netip.Prefix) int {
+		return a.Addr().Compare(b.Addr())
+	})
+	return subs
+}
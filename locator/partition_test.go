@@ -0,0 +1,64 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package locator
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPartition(t *testing.T) {
+	p, err := NewPartition(netip.MustParsePrefix("fd00:2::/32"), 34)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []netip.Prefix{
+		netip.MustParsePrefix("fd00:2::/34"),
+		netip.MustParsePrefix("fd00:2:4000::/34"),
+		netip.MustParsePrefix("fd00:2:8000::/34"),
+	}
+	for i, owner := range []string{"gnb1", "gnb2", "gnb1"} {
+		sub, err := p.Delegate(owner)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sub != expected[i] {
+			t.Errorf("Unexpected sub-locator: %s instead of %s", sub, expected[i])
+		}
+	}
+	if err := p.DelegateSubLocator(netip.MustParsePrefix("fd00:2:4000::/34"), "gnb3"); !errors.Is(err, ErrCollision) {
+		t.Errorf("Sub-locator should not be delegated twice: %v", err)
+	}
+	if err := p.DelegateSubLocator(netip.MustParsePrefix("fd00:2:c000::/34"), "gnb3"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Delegate("gnb4"); err != ErrExhausted {
+		t.Errorf("Partition should be exhausted: %v", err)
+	}
+	if diff := cmp.Diff(p.SubLocators("gnb1"), []netip.Prefix{expected[0], expected[2]}, cmp.Comparer(func(a, b netip.Prefix) bool { return a == b })); diff != "" {
+		t.Error(diff)
+	}
+
+	if err := p.CheckAllocation(netip.MustParseAddr("fd00:2:4000::1"), "gnb2"); err != nil {
+		t.Error(err)
+	}
+	if err := p.CheckAllocation(netip.MustParseAddr("fd00:2:4000::1"), "gnb1"); err != ErrNotOwner {
+		t.Errorf("Cross-consumer allocation should be rejected: %v", err)
+	}
+	if err := p.Release(expected[1]); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.CheckAllocation(netip.MustParseAddr("fd00:2:4000::1"), "gnb2"); err != ErrNotDelegated {
+		t.Errorf("Released sub-locator should not be owned: %v", err)
+	}
+
+	if _, err := NewPartition(netip.MustParsePrefix("fd00:2::/32"), 32); err != ErrInvalidPartition {
+		t.Errorf("Invalid partition should be rejected: %v", err)
+	}
+}
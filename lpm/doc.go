@@ -0,0 +1,8 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package lpm provides a longest-prefix-match table for IP prefixes,
+// used to look up the SIDs and locators matching an address.
+package lpm
@@ -0,0 +1,12 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package lpm
+
+import "errors"
+
+var (
+	ErrInvalidPrefix = errors.New("invalid prefix")
+)
@@ -0,0 +1,274 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package lpm
+
+import (
+	mbits "math/bits"
+	"net/netip"
+	"sync"
+	"sync/atomic"
+)
+
+// node is a node of a path-compressed binary trie.
+// Nodes are never modified once reachable from a published snapshot.
+type node[V any] struct {
+	prefix netip.Prefix // in canonical form
+	value  V
+	ok     bool // true if the node holds a value (otherwise, it only joins its children)
+	child  [2]*node[V]
+}
+
+// snapshot is an immutable version of the Table.
+type snapshot[V any] struct {
+	v4  *node[V]
+	v6  *node[V]
+	len int
+}
+
+// root returns the trie of the address family of addr.
+func (s *snapshot[V]) root(addr netip.Addr) *node[V] {
+	if addr.Is4() {
+		return s.v4
+	}
+	return s.v6
+}
+
+// Table maps IPv4 and IPv6 prefixes to values, and finds the longest prefix matching an address.
+// Lookups are lock-free and can run concurrently with updates:
+// updates copy the modified nodes and publish a new version of the table (copy-on-write),
+// which makes them more expensive than lookups.
+// Table is safe for concurrent use.
+type Table[V any] struct {
+	mu   sync.Mutex // serializes updates
+	snap atomic.Pointer[snapshot[V]]
+}
+
+// NewTable creates an empty Table.
+func NewTable[V any]() *Table[V] {
+	t := &Table[V]{}
+	t.snap.Store(&snapshot[V]{})
+	return t
+}
+
+// Insert adds an entry for the prefix, replacing any existing one.
+// IPv4-mapped IPv6 prefixes are rejected.
+func (t *Table[V]) Insert(prefix netip.Prefix, value V) error {
+	if !prefix.IsValid() || prefix.Addr().Is4In6() {
+		return ErrInvalidPrefix
+	}
+	prefix = prefix.Masked()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s := *t.snap.Load()
+	var added bool
+	if prefix.Addr().Is4() {
+		s.v4, added = insert(s.v4, prefix, value)
+	} else {
+		s.v6, added = insert(s.v6, prefix, value)
+	}
+	if added {
+		s.len++
+	}
+	t.snap.Store(&s)
+	return nil
+}
+
+// Replace sets the value of an existing entry, and returns its previous value.
+// It returns false, and leaves the Table unchanged, if there is no entry for the prefix.
+func (t *Table[V]) Replace(prefix netip.Prefix, value V) (old V, ok bool) {
+	if !prefix.IsValid() {
+		return old, false
+	}
+	prefix = prefix.Masked()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s := *t.snap.Load()
+	if old, ok = s.get(prefix); !ok {
+		return old, false
+	}
+	if prefix.Addr().Is4() {
+		s.v4, _ = insert(s.v4, prefix, value)
+	} else {
+		s.v6, _ = insert(s.v6, prefix, value)
+	}
+	t.snap.Store(&s)
+	return old, true
+}
+
+// Delete removes the entry of the prefix, and returns false if there is none.
+func (t *Table[V]) Delete(prefix netip.Prefix) bool {
+	if !prefix.IsValid() {
+		return false
+	}
+	prefix = prefix.Masked()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s := *t.snap.Load()
+	var deleted bool
+	if prefix.Addr().Is4() {
+		s.v4, deleted = remove(s.v4, prefix)
+	} else {
+		s.v6, deleted = remove(s.v6, prefix)
+	}
+	if !deleted {
+		return false
+	}
+	s.len--
+	t.snap.Store(&s)
+	return true
+}
+
+// Lookup returns the longest prefix containing addr, and its value.
+func (t *Table[V]) Lookup(addr netip.Addr) (prefix netip.Prefix, value V, ok bool) {
+	if addr.Is4In6() {
+		addr = addr.Unmap()
+	}
+	var best *node[V]
+	for n := t.snap.Load().root(addr); n != nil && n.prefix.Contains(addr); {
+		if n.ok {
+			best = n
+		}
+		if n.prefix.Bits() == addr.BitLen() {
+			break
+		}
+		n = n.child[bit(addr, n.prefix.Bits())]
+	}
+	if best == nil {
+		return netip.Prefix{}, value, false
+	}
+	return best.prefix, best.value, true
+}
+
+// Get returns the value of the prefix.
+func (t *Table[V]) Get(prefix netip.Prefix) (value V, ok bool) {
+	if !prefix.IsValid() {
+		return value, false
+	}
+	return t.snap.Load().get(prefix.Masked())
+}
+
+// get returns the value of the prefix, which must be in canonical form.
+func (s *snapshot[V]) get(prefix netip.Prefix) (value V, ok bool) {
+	for n := s.root(prefix.Addr()); n != nil && n.prefix.Bits() <= prefix.Bits() && n.prefix.Contains(prefix.Addr()); {
+		if n.prefix == prefix {
+			return n.value, n.ok
+		}
+		n = n.child[bit(prefix.Addr(), n.prefix.Bits())]
+	}
+	return value, false
+}
+
+// Len returns the number of entries.
+func (t *Table[V]) Len() int {
+	return t.snap.Load().len
+}
+
+// Walk calls fn for each entry, IPv4 prefixes first, shorter prefixes before the longer ones they contain,
+// until fn returns false. Entries updated during the walk are not visited.
+func (t *Table[V]) Walk(fn func(prefix netip.Prefix, value V) bool) {
+	s := t.snap.Load()
+	_ = walk(s.v4, fn) && walk(s.v6, fn)
+}
+
+// walk calls fn for each entry of the trie n, and returns false if fn returned false.
+func walk[V any](n *node[V], fn func(prefix netip.Prefix, value V) bool) bool {
+	if n == nil {
+		return true
+	}
+	if n.ok && !fn(n.prefix, n.value) {
+		return false
+	}
+	return walk(n.child[0], fn) && walk(n.child[1], fn)
+}
+
+// insert returns a copy of the trie n with the entry added, and true if the prefix was not in the trie.
+func insert[V any](n *node[V], prefix netip.Prefix, value V) (*node[V], bool) {
+	if n == nil {
+		return &node[V]{prefix: prefix, value: value, ok: true}, true
+	}
+	common := commonBits(n.prefix, prefix)
+	switch {
+	case common == n.prefix.Bits() && common == prefix.Bits():
+		c := *n
+		c.value, c.ok = value, true
+		return &c, !n.ok
+	case common == n.prefix.Bits():
+		// prefix is below n
+		c := *n
+		b := bit(prefix.Addr(), common)
+		var added bool
+		c.child[b], added = insert(n.child[b], prefix, value)
+		return &c, added
+	case common == prefix.Bits():
+		// n is below prefix
+		c := &node[V]{prefix: prefix, value: value, ok: true}
+		c.child[bit(n.prefix.Addr(), common)] = n
+		return c, true
+	default:
+		// prefix and n diverge: join them
+		c := &node[V]{prefix: netip.PrefixFrom(prefix.Addr(), common).Masked()}
+		c.child[bit(n.prefix.Addr(), common)] = n
+		c.child[bit(prefix.Addr(), common)] = &node[V]{prefix: prefix, value: value, ok: true}
+		return c, true
+	}
+}
+
+// remove returns a copy of the trie n with the entry removed, and true if the prefix was in the trie.
+func remove[V any](n *node[V], prefix netip.Prefix) (*node[V], bool) {
+	if n == nil || n.prefix.Bits() > prefix.Bits() || !n.prefix.Contains(prefix.Addr()) {
+		return n, false
+	}
+	c := *n
+	if n.prefix == prefix {
+		if !n.ok {
+			return n, false
+		}
+		var zero V
+		c.value, c.ok = zero, false
+	} else {
+		b := bit(prefix.Addr(), n.prefix.Bits())
+		child, removed := remove(n.child[b], prefix)
+		if !removed {
+			return n, false
+		}
+		c.child[b] = child
+	}
+	// nodes without value are kept only to join two children
+	if !c.ok {
+		if c.child[0] == nil {
+			return c.child[1], true
+		}
+		if c.child[1] == nil {
+			return c.child[0], true
+		}
+	}
+	return &c, true
+}
+
+// bit returns the bit of addr at position i from the left.
+func bit(addr netip.Addr, i int) int {
+	a := addr.As16()
+	if addr.Is4() {
+		i += 96
+	}
+	return int(a[i/8]>>(7-i%8)) & 1
+}
+
+// commonBits returns the length of the longest prefix common to a and b.
+func commonBits(a netip.Prefix, b netip.Prefix) int {
+	l := min(a.Bits(), b.Bits())
+	x, y := a.Addr().As16(), b.Addr().As16()
+	offset := 0
+	if a.Addr().Is4() {
+		offset = 96
+	}
+	for i := offset / 8; 8*i < offset+l; i++ {
+		if d := x[i] ^ y[i]; d != 0 {
+			return min(8*i+mbits.LeadingZeros8(d)-offset, l)
+		}
+	}
+	return l
+}
@@ -0,0 +1,172 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package lpm
+
+import (
+	"errors"
+	"math/rand"
+	"net/netip"
+	"sync"
+	"testing"
+)
+
+func TestTable(t *testing.T) {
+	tb := NewTable[string]()
+	for p, v := range map[string]string{
+		"2001:db8::/32":       "a",
+		"2001:db8:1::/48":     "b",
+		"2001:db8:1:2::/64":   "c",
+		"2001:db8:2::/48":     "d",
+		"203.0.113.0/24":      "e",
+		"203.0.113.128/25":    "f",
+		"2001:db8:1:2::1/128": "g",
+	} {
+		if err := tb.Insert(netip.MustParsePrefix(p), v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tb.Insert(netip.MustParsePrefix("::ffff:10.0.0.0/104"), "x"); !errors.Is(err, ErrInvalidPrefix) {
+		t.Errorf("Expected ErrInvalidPrefix, got %v", err)
+	}
+	if tb.Len() != 7 {
+		t.Errorf("Unexpected length: %d", tb.Len())
+	}
+	for _, tc := range []struct {
+		addr   string
+		prefix string
+		value  string
+	}{
+		{"2001:db8::1", "2001:db8::/32", "a"},
+		{"2001:db8:1::1", "2001:db8:1::/48", "b"},
+		{"2001:db8:1:2::2", "2001:db8:1:2::/64", "c"},
+		{"2001:db8:1:2::1", "2001:db8:1:2::1/128", "g"},
+		{"2001:db8:2:ffff::1", "2001:db8:2::/48", "d"},
+		{"203.0.113.1", "203.0.113.0/24", "e"},
+		{"::ffff:203.0.113.200", "203.0.113.128/25", "f"},
+	} {
+		prefix, value, ok := tb.Lookup(netip.MustParseAddr(tc.addr))
+		if !ok || prefix != netip.MustParsePrefix(tc.prefix) || value != tc.value {
+			t.Errorf("%s: unexpected result %s %s %t", tc.addr, prefix, value, ok)
+		}
+	}
+	for _, addr := range []string{"2001:db9::1", "198.51.100.1", "::1"} {
+		if _, _, ok := tb.Lookup(netip.MustParseAddr(addr)); ok {
+			t.Errorf("%s: unexpected match", addr)
+		}
+	}
+
+	if v, ok := tb.Get(netip.MustParsePrefix("2001:db8:1::/48")); !ok || v != "b" {
+		t.Errorf("Unexpected value: %s", v)
+	}
+	if _, ok := tb.Get(netip.MustParsePrefix("2001:db8:1::/47")); ok {
+		t.Error("Unexpected value")
+	}
+	if old, ok := tb.Replace(netip.MustParsePrefix("2001:db8:1::/48"), "h"); !ok || old != "b" {
+		t.Errorf("Unexpected previous value: %s", old)
+	}
+	if v, _ := tb.Get(netip.MustParsePrefix("2001:db8:1::/48")); v != "h" || tb.Len() != 7 {
+		t.Errorf("Unexpected value: %s", v)
+	}
+	if _, ok := tb.Replace(netip.MustParsePrefix("2001:db8:1::/47"), "x"); ok || tb.Len() != 7 {
+		t.Error("Missing prefix should not be replaced")
+	}
+	if !tb.Delete(netip.MustParsePrefix("2001:db8:1::/48")) || tb.Delete(netip.MustParsePrefix("2001:db8:1::/48")) {
+		t.Error("Prefix should be deleted once")
+	}
+	if prefix, _, _ := tb.Lookup(netip.MustParseAddr("2001:db8:1::1")); prefix != netip.MustParsePrefix("2001:db8::/32") {
+		t.Errorf("Unexpected prefix: %s", prefix)
+	}
+	if prefix, _, _ := tb.Lookup(netip.MustParseAddr("2001:db8:1:2::2")); prefix != netip.MustParsePrefix("2001:db8:1:2::/64") {
+		t.Errorf("Unexpected prefix: %s", prefix)
+	}
+
+	var walked []string
+	tb.Walk(func(prefix netip.Prefix, value string) bool {
+		walked = append(walked, value)
+		return true
+	})
+	if len(walked) != 6 || walked[0] != "e" || walked[2] != "a" {
+		t.Errorf("Unexpected walk: %v", walked)
+	}
+}
+
+// TestTableRandom compares the Table with a linear search.
+func TestTableRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tb := NewTable[int]()
+	ref := make(map[netip.Prefix]int)
+	randomAddr := func() netip.Addr {
+		// few distinct high bits to create overlapping prefixes
+		var a [16]byte
+		a[0] = 0x20
+		a[1] = byte(r.Intn(4))
+		a[2] = byte(r.Intn(256))
+		a[15] = byte(r.Intn(256))
+		return netip.AddrFrom16(a)
+	}
+	for i := 0; i < 2000; i++ {
+		p := netip.PrefixFrom(randomAddr(), r.Intn(129)).Masked()
+		if r.Intn(3) == 0 {
+			_, ok := ref[p]
+			if tb.Delete(p) != ok {
+				t.Fatalf("Delete(%s) inconsistent", p)
+			}
+			delete(ref, p)
+		} else {
+			if err := tb.Insert(p, i); err != nil {
+				t.Fatal(err)
+			}
+			ref[p] = i
+		}
+		if tb.Len() != len(ref) {
+			t.Fatalf("Unexpected length: %d instead of %d", tb.Len(), len(ref))
+		}
+		addr := randomAddr()
+		var best netip.Prefix
+		var found bool
+		for p := range ref {
+			if p.Contains(addr) && (!found || p.Bits() > best.Bits()) {
+				best, found = p, true
+			}
+		}
+		prefix, value, ok := tb.Lookup(addr)
+		if ok != found || (ok && (prefix != best || value != ref[best])) {
+			t.Fatalf("Lookup(%s): got %s %t, expected %s %t", addr, prefix, ok, best, found)
+		}
+	}
+}
+
+func TestTableConcurrent(t *testing.T) {
+	tb := NewTable[int]()
+	if err := tb.Insert(netip.MustParsePrefix("2001:db8::/32"), 0); err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			p := netip.PrefixFrom(netip.AddrFrom16([16]byte{0x20, 0x01, 0x0d, 0xb8, byte(i >> 8), byte(i)}), 48)
+			if err := tb.Insert(p, i); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			if _, _, ok := tb.Lookup(netip.MustParseAddr("2001:db8:ffff::1")); !ok {
+				t.Error("Missing prefix")
+				return
+			}
+		}
+	}()
+	wg.Wait()
+	if tb.Len() != 1001 {
+		t.Errorf("Unexpected length: %d", tb.Len())
+	}
+}
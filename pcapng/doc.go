@@ -0,0 +1,9 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package pcapng provides a minimal pcapng writer (draft-ietf-opsawg-pcapng)
+// and a packet-mirroring sink annotating packets with their decoded SID fields,
+// giving self-describing captures.
+package pcapng
@@ -0,0 +1,84 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package pcapng
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nextmn/rfc9433/encoding"
+)
+
+// Annotate returns a description of the fields encoded in the addresses of an IPv6 packet:
+// the IPv6 SA is decoded using the NextMN encoding, and the IPv6 DA is decoded
+// as an End.M.GTP4.E SID with the given prefix length.
+// Fields that cannot be decoded are omitted.
+func Annotate(packet []byte, dstPrefixLen uint) string {
+	if len(packet) < 40 || packet[0]>>4 != 6 {
+		return ""
+	}
+	var sa, da [16]byte
+	copy(sa[:], packet[8:24])
+	copy(da[:], packet[24:40])
+	var fields []string
+	if src, err := encoding.ParseMGTP4IPv6SrcNextMN(sa); err == nil {
+		fields = append(fields, fmt.Sprintf("src: ipv4=%s udp=%d", src.IPv4(), src.UDPPortNumber()))
+	}
+	if dst, err := encoding.ParseMGTP4IPv6Dst(da, dstPrefixLen); err == nil {
+		fields = append(fields, fmt.Sprintf("dst: ipv4=%s teid=0x%08x qfi=%d r=%t u=%t",
+			dst.IPv4(), dst.PDUSessionID(), dst.QFI(), dst.R(), dst.U()))
+	}
+	return strings.Join(fields, "; ")
+}
+
+// Sink mirrors selected packets to a Writer, with annotations.
+// Sink is safe for concurrent use.
+type Sink struct {
+	mu           sync.Mutex
+	w            *Writer
+	dstPrefixLen uint
+	sampling     uint64
+	count        uint64
+	now          func() time.Time
+}
+
+// NewSink creates a Sink. The prefix length of End.M.GTP4.E SIDs is used for annotations.
+// Sample mirrors one packet out of sampling packets; if sampling is 0, Sample never mirrors packets.
+func NewSink(w *Writer, dstPrefixLen uint, sampling uint64) *Sink {
+	return &Sink{
+		w:            w,
+		dstPrefixLen: dstPrefixLen,
+		sampling:     sampling,
+		now:          time.Now,
+	}
+}
+
+// Drop mirrors a dropped packet, with the reason of the drop.
+func (s *Sink) Drop(packet []byte, reason error) error {
+	comment := "drop: " + reason.Error()
+	if a := Annotate(packet, s.dstPrefixLen); a != "" {
+		comment += "; " + a
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.w.WritePacket(s.now(), packet, comment)
+}
+
+// Sample mirrors a fraction of the packets.
+func (s *Sink) Sample(packet []byte) error {
+	if s.sampling == 0 {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.count++
+	if s.count%s.sampling != 0 {
+		return nil
+	}
+	return s.w.WritePacket(s.now(), packet, Annotate(packet, s.dstPrefixLen))
+}
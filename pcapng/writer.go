@@ -0,0 +1,117 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package pcapng
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+const (
+	// Block types
+	blockTypeSHB = 0x0A0D0D0A // Section Header Block
+	blockTypeIDB = 0x00000001 // Interface Description Block
+	blockTypeEPB = 0x00000006 // Enhanced Packet Block
+
+	byteOrderMagic = 0x1A2B3C4D
+
+	// Options
+	optEndOfOpt = 0
+	optComment  = 1
+	optTsResol  = 9
+
+	tsResolNano = 9 // timestamps in nanoseconds
+
+	// LinkTypeRaw is the link type for raw IPv4 and IPv6 packets.
+	LinkTypeRaw = 101
+	// LinkTypeEthernet is the link type for Ethernet frames.
+	LinkTypeEthernet = 1
+)
+
+// Writer writes packets in pcapng format, using a single interface.
+// Writer is not safe for concurrent use.
+type Writer struct {
+	w   io.Writer
+	buf []byte
+}
+
+// NewWriter creates a Writer, and writes the Section Header Block
+// and the Interface Description Block.
+func NewWriter(w io.Writer, linkType uint16) (*Writer, error) {
+	wr := &Writer{w: w}
+	// Section Header Block
+	b := wr.begin(blockTypeSHB)
+	b = binary.LittleEndian.AppendUint32(b, byteOrderMagic)
+	b = binary.LittleEndian.AppendUint16(b, 1)                  // major version
+	b = binary.LittleEndian.AppendUint16(b, 0)                  // minor version
+	b = binary.LittleEndian.AppendUint64(b, 0xFFFFFFFFFFFFFFFF) // section length not specified
+	if err := wr.end(b); err != nil {
+		return nil, err
+	}
+	// Interface Description Block
+	b = wr.begin(blockTypeIDB)
+	b = binary.LittleEndian.AppendUint16(b, linkType)
+	b = binary.LittleEndian.AppendUint16(b, 0) // reserved
+	b = binary.LittleEndian.AppendUint32(b, 0) // no snap length limit
+	b = appendOption(b, optTsResol, []byte{tsResolNano})
+	b = appendOption(b, optEndOfOpt, nil)
+	if err := wr.end(b); err != nil {
+		return nil, err
+	}
+	return wr, nil
+}
+
+// WritePacket writes a packet in an Enhanced Packet Block.
+// If comment is not empty, it is added as opt_comment option.
+func (wr *Writer) WritePacket(ts time.Time, data []byte, comment string) error {
+	b := wr.begin(blockTypeEPB)
+	nano := uint64(ts.UnixNano())
+	b = binary.LittleEndian.AppendUint32(b, 0) // interface ID
+	b = binary.LittleEndian.AppendUint32(b, uint32(nano>>32))
+	b = binary.LittleEndian.AppendUint32(b, uint32(nano))
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(data))) // captured length
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(data))) // original length
+	b = appendPadded(b, data)
+	if comment != "" {
+		b = appendOption(b, optComment, []byte(comment))
+		b = appendOption(b, optEndOfOpt, nil)
+	}
+	return wr.end(b)
+}
+
+// begin starts a new block in the internal buffer.
+// The total length is written by end.
+func (wr *Writer) begin(blockType uint32) []byte {
+	b := binary.LittleEndian.AppendUint32(wr.buf[:0], blockType)
+	return binary.LittleEndian.AppendUint32(b, 0) // total length
+}
+
+// end writes the total length of the block, and writes the block.
+func (wr *Writer) end(b []byte) error {
+	l := uint32(len(b) + 4)
+	binary.LittleEndian.PutUint32(b[4:8], l)
+	b = binary.LittleEndian.AppendUint32(b, l)
+	wr.buf = b
+	_, err := wr.w.Write(b)
+	return err
+}
+
+// appendOption appends an option, padded to 32 bits.
+func appendOption(b []byte, code uint16, value []byte) []byte {
+	b = binary.LittleEndian.AppendUint16(b, code)
+	b = binary.LittleEndian.AppendUint16(b, uint16(len(value)))
+	return appendPadded(b, value)
+}
+
+// appendPadded appends data, padded to 32 bits.
+func appendPadded(b []byte, data []byte) []byte {
+	b = append(b, data...)
+	for i := len(data); i%4 != 0; i++ {
+		b = append(b, 0)
+	}
+	return b
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package pcapng
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding"
+)
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, LinkTypeRaw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WritePacket(time.Unix(1, 2), []byte{0x60, 0x00, 0x00}, "abc"); err != nil {
+		t.Fatal(err)
+	}
+	b := buf.Bytes()
+	for _, block := range []struct {
+		blockType uint32
+		length    int
+	}{
+		{blockTypeSHB, 28},
+		{blockTypeIDB, 32},
+		{blockTypeEPB, 48},
+	} {
+		if len(b) < block.length {
+			t.Fatalf("Block %x is truncated", block.blockType)
+		}
+		if bt := binary.LittleEndian.Uint32(b[0:4]); bt != block.blockType {
+			t.Errorf("Unexpected block type: %x instead of %x", bt, block.blockType)
+		}
+		if l := binary.LittleEndian.Uint32(b[4:8]); int(l) != block.length {
+			t.Errorf("Unexpected block length: %d instead of %d", l, block.length)
+		}
+		if l := binary.LittleEndian.Uint32(b[block.length-4 : block.length]); int(l) != block.length {
+			t.Errorf("Unexpected trailing block length: %d instead of %d", l, block.length)
+		}
+		if block.blockType == blockTypeEPB {
+			if ts := uint64(binary.LittleEndian.Uint32(b[12:16]))<<32 | uint64(binary.LittleEndian.Uint32(b[16:20])); ts != 1000000002 {
+				t.Errorf("Unexpected timestamp: %d", ts)
+			}
+			if diff := cmp.Diff(b[28:44], []byte{0x60, 0, 0, 0, 1, 0, 3, 0, 'a', 'b', 'c', 0, 0, 0, 0, 0}); diff != "" {
+				t.Error(diff)
+			}
+		}
+		b = b[block.length:]
+	}
+	if len(b) != 0 {
+		t.Errorf("Unexpected trailing data: %v", b)
+	}
+}
+
+func TestAnnotate(t *testing.T) {
+	sa, _ := encoding.NewMGTP4IPv6Src(netip.MustParsePrefix("fd00:1:1::/48"), [4]byte{192, 0, 2, 1}, 1337).Marshal()
+	da, _ := encoding.NewMGTP4IPv6Dst(netip.MustParsePrefix("fd00:2:2::/48"), [4]byte{198, 51, 100, 1}, encoding.NewArgsMobSession(9, true, false, 0x1234)).Marshal()
+	packet := append([]byte{0x60, 0, 0, 0, 0, 0, 59, 64}, sa...)
+	packet = append(packet, da...)
+	expected := "src: ipv4=192.0.2.1 udp=1337; dst: ipv4=198.51.100.1 teid=0x00001234 qfi=9 r=true u=false"
+	if a := Annotate(packet, 48); a != expected {
+		t.Errorf("Unexpected annotation: %s", a)
+	}
+}
@@ -0,0 +1,10 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package pfcp maps the PFCP rules of a UPF (PDR, FAR and QER, 3GPP TS 29.244)
+// into sessions of a session.Table, whose SIDs carry the Args.Mob.Session of RFC 9433.
+// Only the Information Elements needed by this mapping are modeled: a N4 implementation
+// copies them from its own PFCP messages.
+package pfcp
@@ -0,0 +1,14 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package pfcp
+
+import "errors"
+
+var (
+	ErrFARNotFound     = errors.New("FAR not found")
+	ErrQERNotFound     = errors.New("QER not found")
+	ErrInvalidEndpoint = errors.New("invalid GTP-U tunnel endpoint")
+)
@@ -0,0 +1,165 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package pfcp
+
+import (
+	"cmp"
+	"errors"
+	"net/netip"
+	"slices"
+
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/session"
+)
+
+// SIDBuilder returns the SID of a GTP-U tunnel endpoint, carrying its Args.Mob.Session.
+type SIDBuilder interface {
+	SID(k session.Key, a *encoding.ArgsMobSession) (netip.Addr, error)
+}
+
+// SIDBuilderFunc is an adapter to allow the use of ordinary functions as SIDBuilder.
+type SIDBuilderFunc func(k session.Key, a *encoding.ArgsMobSession) (netip.Addr, error)
+
+// SID calls f(k, a).
+func (f SIDBuilderFunc) SID(k session.Key, a *encoding.ArgsMobSession) (netip.Addr, error) {
+	return f(k, a)
+}
+
+// MGTP4SID returns a SIDBuilder of End.M.GTP4.E SIDs in prefix (encoding.MGTP4IPv6Dst),
+// whose endpoints must be IPv4 addresses.
+func MGTP4SID(prefix netip.Prefix) SIDBuilder {
+	return SIDBuilderFunc(func(k session.Key, a *encoding.ArgsMobSession) (netip.Addr, error) {
+		m, err := encoding.NewMGTP4IPv6DstFromAddr(prefix, k.Peer, a)
+		if err != nil {
+			return netip.Addr{}, errors.Join(ErrInvalidEndpoint, err)
+		}
+		return m.Addr()
+	})
+}
+
+// MGTP6SID returns a SIDBuilder of End.M.GTP6.E SIDs in prefix (encoding.MGTP6IPv6Dst).
+// The address of the endpoint is not part of the SID.
+func MGTP6SID(prefix netip.Prefix) SIDBuilder {
+	return SIDBuilderFunc(func(k session.Key, a *encoding.ArgsMobSession) (netip.Addr, error) {
+		var b [16]byte
+		if err := encoding.NewMGTP6IPv6Dst(prefix, a).MarshalTo(b[:]); err != nil {
+			return netip.Addr{}, err
+		}
+		return netip.AddrFrom16(b), nil
+	})
+}
+
+// Entry is a session mapped from a PDR.
+type Entry struct {
+	PDRID   uint16
+	Key     session.Key
+	Session session.Session
+}
+
+// Entries maps the rules into sessions, whose SIDs are built by b and visited after segments.
+//
+// Each forwarding PDR (whose FAR has the FORW action) is mapped into up to two sessions:
+// one for its F-TEID (packets received through GTP-U, e.g. uplink on N3),
+// and one for the GTP-U outer header created by its FAR (e.g. downlink toward the gNB).
+// The Args.Mob.Session carries the TEID of the endpoint, and the QFI and RQI of the first QER of the PDR setting a QFI,
+// or else the QFI of the PDR.
+// When several PDRs share an endpoint, the session is mapped from the PDR taking precedence.
+func Entries(r *Rules, b SIDBuilder, segments [][16]byte) ([]Entry, error) {
+	pdrs := slices.Clone(r.PDRs)
+	slices.SortStableFunc(pdrs, func(a, b PDR) int {
+		return cmp.Compare(a.Precedence, b.Precedence)
+	})
+	entries := []Entry{}
+	seen := make(map[session.Key]struct{})
+	for _, pdr := range pdrs {
+		far, err := r.FAR(pdr.FARID)
+		if err != nil {
+			return nil, err
+		}
+		if far.ApplyAction&ApplyActionFORW == 0 {
+			continue
+		}
+		qfi, rqi, err := r.qos(&pdr)
+		if err != nil {
+			return nil, err
+		}
+		keys := make([]session.Key, 0, 2)
+		if pdr.FTEID != nil {
+			k, err := pdr.FTEID.Key()
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, k)
+		}
+		if o := far.OuterHeaderCreation; o != nil && o.GTPU() {
+			k, err := o.Key()
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, k)
+		}
+		for _, k := range keys {
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			a := encoding.NewArgsMobSession(qfi, rqi, false, k.TEID)
+			if err := a.Validate(); err != nil {
+				return nil, err
+			}
+			sid, err := b.SID(k, a)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, Entry{
+				PDRID:   pdr.ID,
+				Key:     k,
+				Session: session.Session{SID: sid, Args: a, Segments: segments},
+			})
+		}
+	}
+	return entries, nil
+}
+
+// qos returns the QFI and RQI of a PDR.
+func (r *Rules) qos(pdr *PDR) (uint8, bool, error) {
+	for _, id := range pdr.QERIDs {
+		qer, err := r.QER(id)
+		if err != nil {
+			return 0, false, err
+		}
+		if qer.QFI != 0 {
+			return qer.QFI, qer.RQI, nil
+		}
+	}
+	return pdr.QFI, false, nil
+}
+
+// Apply adds the entries to t, or updates their session if it already exists
+// (e.g. on PFCP Session Modification).
+func Apply(t *session.Table, entries []Entry) error {
+	for _, e := range entries {
+		err := t.Add(e.Key, e.Session)
+		if errors.Is(err, session.ErrExists) {
+			err = t.Update(e.Key, e.Session)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Remove deletes the sessions of the entries from t (e.g. on PFCP Session Deletion).
+// Sessions already deleted are ignored.
+func Remove(t *session.Table, entries []Entry) error {
+	for _, e := range entries {
+		if err := t.Delete(e.Key); err != nil && !errors.Is(err, session.ErrNotFound) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package pfcp
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/session"
+)
+
+// n3Rules returns the rules of a PDU session with an uplink and a downlink PDR on N3.
+func n3Rules() *Rules {
+	return &Rules{
+		PDRs: []PDR{
+			{
+				ID: 2, Precedence: 200, SourceInterface: InterfaceCore,
+				UEIPAddress: netip.MustParseAddr("10.60.0.1"), FARID: 2, QERIDs: []uint32{1},
+			},
+			{
+				ID: 1, Precedence: 100, SourceInterface: InterfaceAccess,
+				FTEID: &FTEID{TEID: 0x100, IPv4: netip.MustParseAddr("10.0.0.1")}, QFI: 9, FARID: 1,
+			},
+			{
+				ID: 3, Precedence: 300, SourceInterface: InterfaceAccess,
+				FTEID: &FTEID{TEID: 0x100, IPv4: netip.MustParseAddr("10.0.0.1")}, QFI: 5, FARID: 1,
+			},
+		},
+		FARs: []FAR{
+			{ID: 1, ApplyAction: ApplyActionFORW, DestinationInterface: InterfaceCore},
+			{
+				ID: 2, ApplyAction: ApplyActionFORW, DestinationInterface: InterfaceAccess,
+				OuterHeaderCreation: &OuterHeaderCreation{
+					Description: OuterHeaderCreationGTPUUDPIPv4, TEID: 0x200, IPv4: netip.MustParseAddr("10.0.0.2"),
+				},
+			},
+		},
+		QERs: []QER{{ID: 1, QFI: 5, RQI: true}},
+	}
+}
+
+func TestEntries(t *testing.T) {
+	prefix := netip.MustParsePrefix("2001:db8::/48")
+	segments := [][16]byte{netip.MustParseAddr("2001:db8:1::1").As16()}
+	entries, err := Entries(n3Rules(), MGTP4SID(prefix), segments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sid := func(ipv4 string, a *encoding.ArgsMobSession) netip.Addr {
+		addr, err := encoding.NewMGTP4IPv6Dst(prefix, netip.MustParseAddr(ipv4).As4(), a).Addr()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return addr
+	}
+	up := encoding.NewArgsMobSession(9, false, false, 0x100)
+	down := encoding.NewArgsMobSession(5, true, false, 0x200)
+	if diff := cmp.Diff(entries, []Entry{
+		{
+			PDRID:   1,
+			Key:     session.Key{Peer: netip.MustParseAddr("10.0.0.1"), TEID: 0x100},
+			Session: session.Session{SID: sid("10.0.0.1", up), Args: up, Segments: segments},
+		},
+		{
+			PDRID:   2,
+			Key:     session.Key{Peer: netip.MustParseAddr("10.0.0.2"), TEID: 0x200},
+			Session: session.Session{SID: sid("10.0.0.2", down), Args: down, Segments: segments},
+		},
+	}, cmp.Comparer(func(a, b netip.Addr) bool { return a == b }), cmp.Comparer(func(a, b *encoding.ArgsMobSession) bool {
+		return a.QFI() == b.QFI() && a.R() == b.R() && a.U() == b.U() && a.PDUSessionID() == b.PDUSessionID()
+	})); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestEntriesErrors(t *testing.T) {
+	b := MGTP4SID(netip.MustParsePrefix("2001:db8::/48"))
+	r := n3Rules()
+	r.FARs[1].ApplyAction = ApplyActionBUFF
+	if entries, err := Entries(r, b, nil); err != nil || len(entries) != 1 {
+		t.Errorf("Buffering PDR should be ignored: %+v, %v", entries, err)
+	}
+	r = n3Rules()
+	r.PDRs[0].FARID = 3
+	if _, err := Entries(r, b, nil); !errors.Is(err, ErrFARNotFound) {
+		t.Errorf("Unknown FAR should be rejected: %v", err)
+	}
+	r = n3Rules()
+	r.PDRs[0].QERIDs = []uint32{2}
+	if _, err := Entries(r, b, nil); !errors.Is(err, ErrQERNotFound) {
+		t.Errorf("Unknown QER should be rejected: %v", err)
+	}
+	r = n3Rules()
+	r.PDRs[1].FTEID.IPv4 = netip.Addr{}
+	r.PDRs[1].FTEID.IPv6 = netip.MustParseAddr("2001:db8:2::1")
+	if _, err := Entries(r, b, nil); !errors.Is(err, ErrInvalidEndpoint) {
+		t.Errorf("IPv6 endpoint should be rejected by MGTP4SID: %v", err)
+	}
+	if entries, err := Entries(r, MGTP6SID(netip.MustParsePrefix("2001:db8::/48")), nil); err != nil || len(entries) != 3 {
+		t.Errorf("Unexpected entries: %+v, %v", entries, err)
+	}
+}
+
+func TestApplyRemove(t *testing.T) {
+	entries, err := Entries(n3Rules(), MGTP4SID(netip.MustParsePrefix("2001:db8::/48")), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	table := session.NewTable()
+	if err := Apply(table, entries); err != nil {
+		t.Fatal(err)
+	}
+	// PFCP Session Modification: new downlink TEID
+	r := n3Rules()
+	r.FARs[1].OuterHeaderCreation.TEID = 0x201
+	modified, err := Entries(r, MGTP4SID(netip.MustParsePrefix("2001:db8::/48")), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Apply(table, modified); err != nil {
+		t.Fatal(err)
+	}
+	if table.Len() != 3 {
+		t.Errorf("Unexpected number of sessions: %d", table.Len())
+	}
+	if err := Remove(table, append(entries, modified...)); err != nil {
+		t.Fatal(err)
+	}
+	if table.Len() != 0 {
+		t.Errorf("Unexpected number of sessions: %d", table.Len())
+	}
+}
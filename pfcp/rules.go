@@ -0,0 +1,146 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package pfcp
+
+import (
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/session"
+)
+
+// Interface is the value of the Source Interface and Destination Interface IEs (3GPP TS 29.244, section 8.2.2).
+type Interface uint8
+
+const (
+	InterfaceAccess      Interface = 0
+	InterfaceCore        Interface = 1
+	InterfaceSGiLANN6LAN Interface = 2
+	InterfaceCPFunction  Interface = 3
+)
+
+// ApplyAction is the value of the Apply Action IE (3GPP TS 29.244, section 8.2.26).
+type ApplyAction uint16
+
+const (
+	ApplyActionDROP ApplyAction = 0x01
+	ApplyActionFORW ApplyAction = 0x02
+	ApplyActionBUFF ApplyAction = 0x04
+	ApplyActionNOCP ApplyAction = 0x08
+	ApplyActionDUPL ApplyAction = 0x10
+)
+
+// Outer Header Creation Descriptions (3GPP TS 29.244, section 8.2.56), as the first two octets of the IE value.
+const (
+	OuterHeaderCreationGTPUUDPIPv4 = 0x0100
+	OuterHeaderCreationGTPUUDPIPv6 = 0x0200
+)
+
+// FTEID is the F-TEID IE (3GPP TS 29.244, section 8.2.3), once allocated.
+type FTEID struct {
+	TEID uint32
+	IPv4 netip.Addr // may be invalid
+	IPv6 netip.Addr // may be invalid
+}
+
+// Key returns the GTP-U tunnel endpoint of the F-TEID; the IPv4 address is used if both are present.
+func (f *FTEID) Key() (session.Key, error) {
+	return endpoint(f.IPv4, f.IPv6, f.TEID)
+}
+
+// OuterHeaderCreation is the Outer Header Creation IE (3GPP TS 29.244, section 8.2.56).
+type OuterHeaderCreation struct {
+	Description uint16
+	TEID        uint32
+	IPv4        netip.Addr // may be invalid
+	IPv6        netip.Addr // may be invalid
+}
+
+// GTPU returns true if the created outer header is GTP-U/UDP/IP.
+func (o *OuterHeaderCreation) GTPU() bool {
+	return o.Description&(OuterHeaderCreationGTPUUDPIPv4|OuterHeaderCreationGTPUUDPIPv6) != 0
+}
+
+// Key returns the GTP-U tunnel endpoint of the created outer header;
+// the IPv4 address is used if both are present.
+func (o *OuterHeaderCreation) Key() (session.Key, error) {
+	if !o.GTPU() {
+		return session.Key{}, ErrInvalidEndpoint
+	}
+	var ipv4, ipv6 netip.Addr
+	if o.Description&OuterHeaderCreationGTPUUDPIPv4 != 0 {
+		ipv4 = o.IPv4
+	}
+	if o.Description&OuterHeaderCreationGTPUUDPIPv6 != 0 {
+		ipv6 = o.IPv6
+	}
+	return endpoint(ipv4, ipv6, o.TEID)
+}
+
+// endpoint returns the key of a GTP-U tunnel endpoint.
+func endpoint(ipv4, ipv6 netip.Addr, teid uint32) (session.Key, error) {
+	switch {
+	case ipv4.Is4():
+		return session.Key{Peer: ipv4, TEID: teid}, nil
+	case ipv6.Is6() && !ipv6.Is4In6():
+		return session.Key{Peer: ipv6, TEID: teid}, nil
+	default:
+		return session.Key{}, ErrInvalidEndpoint
+	}
+}
+
+// PDR is a Packet Detection Rule (3GPP TS 29.244, section 7.5.2.2).
+type PDR struct {
+	ID              uint16
+	Precedence      uint32 // lower values take precedence
+	SourceInterface Interface
+	FTEID           *FTEID     // local F-TEID of the PDI, nil if packets are not received through GTP-U
+	UEIPAddress     netip.Addr // UE IP address of the PDI, may be invalid
+	QFI             uint8      // QFI of the PDI, 0 if absent
+	FARID           uint32
+	QERIDs          []uint32
+}
+
+// FAR is a Forwarding Action Rule (3GPP TS 29.244, section 7.5.2.3).
+type FAR struct {
+	ID                   uint32
+	ApplyAction          ApplyAction
+	DestinationInterface Interface
+	OuterHeaderCreation  *OuterHeaderCreation // nil if no outer header is created
+}
+
+// QER is a QoS Enforcement Rule (3GPP TS 29.244, section 7.5.2.5).
+type QER struct {
+	ID  uint32
+	QFI uint8 // 0 if absent
+	RQI bool
+}
+
+// Rules are the rules of a PFCP session.
+type Rules struct {
+	PDRs []PDR
+	FARs []FAR
+	QERs []QER
+}
+
+// FAR returns the FAR with the given ID.
+func (r *Rules) FAR(id uint32) (*FAR, error) {
+	for i := range r.FARs {
+		if r.FARs[i].ID == id {
+			return &r.FARs[i], nil
+		}
+	}
+	return nil, ErrFARNotFound
+}
+
+// QER returns the QER with the given ID.
+func (r *Rules) QER(id uint32) (*QER, error) {
+	for i := range r.QERs {
+		if r.QERs[i].ID == id {
+			return &r.QERs[i], nil
+		}
+	}
+	return nil, ErrQERNotFound
+}
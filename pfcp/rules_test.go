@@ -0,0 +1,58 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package pfcp
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/nextmn/rfc9433/session"
+)
+
+func TestEndpointKeys(t *testing.T) {
+	f := FTEID{TEID: 1, IPv4: netip.MustParseAddr("10.0.0.1"), IPv6: netip.MustParseAddr("2001:db8::1")}
+	if k, err := f.Key(); err != nil || k != (session.Key{Peer: f.IPv4, TEID: 1}) {
+		t.Errorf("Unexpected key: %+v, %v", k, err)
+	}
+	f.IPv4 = netip.Addr{}
+	if k, err := f.Key(); err != nil || k != (session.Key{Peer: f.IPv6, TEID: 1}) {
+		t.Errorf("Unexpected key: %+v, %v", k, err)
+	}
+	if _, err := (&FTEID{TEID: 1}).Key(); !errors.Is(err, ErrInvalidEndpoint) {
+		t.Errorf("F-TEID without address should be rejected: %v", err)
+	}
+
+	o := OuterHeaderCreation{
+		Description: OuterHeaderCreationGTPUUDPIPv6,
+		TEID:        2,
+		IPv4:        netip.MustParseAddr("10.0.0.2"),
+		IPv6:        netip.MustParseAddr("2001:db8::2"),
+	}
+	if k, err := o.Key(); err != nil || k != (session.Key{Peer: o.IPv6, TEID: 2}) {
+		t.Errorf("Unexpected key: %+v, %v", k, err)
+	}
+	o.Description = 0x0400 // UDP/IPv4
+	if _, err := o.Key(); o.GTPU() || !errors.Is(err, ErrInvalidEndpoint) {
+		t.Errorf("Outer header without GTP-U should be rejected: %v", err)
+	}
+}
+
+func TestRulesLookup(t *testing.T) {
+	r := &Rules{FARs: []FAR{{ID: 1}, {ID: 2}}, QERs: []QER{{ID: 3, QFI: 9}}}
+	if far, err := r.FAR(2); err != nil || far != &r.FARs[1] {
+		t.Errorf("Unexpected FAR: %+v, %v", far, err)
+	}
+	if _, err := r.FAR(3); !errors.Is(err, ErrFARNotFound) {
+		t.Errorf("Unknown FAR should not be found: %v", err)
+	}
+	if qer, err := r.QER(3); err != nil || qer.QFI != 9 {
+		t.Errorf("Unexpected QER: %+v, %v", qer, err)
+	}
+	if _, err := r.QER(1); !errors.Is(err, ErrQERNotFound) {
+		t.Errorf("Unknown QER should not be found: %v", err)
+	}
+}
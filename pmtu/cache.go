@@ -0,0 +1,109 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package pmtu
+
+import (
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/nextmn/rfc9433/icmp"
+)
+
+const (
+	// MinMTU is the minimum link MTU for IPv6 (RFC 8200, section 5).
+	MinMTU = 1280
+	// DefaultTimeout is the recommended delay before trying to increase
+	// the Path MTU estimate after it has been reduced (RFC 8201, section 4).
+	DefaultTimeout = 10 * time.Minute
+)
+
+type entry struct {
+	mtu     uint32
+	updated time.Time
+}
+
+// Cache stores the Path MTU learned from Packet Too Big messages for each destination SID.
+// Entries are aged out after a timeout, so the Path MTU estimate goes back to the link MTU
+// and increases of the Path MTU can be discovered.
+type Cache struct {
+	mu      sync.RWMutex
+	entries map[netip.Addr]entry
+	linkMTU uint32
+	timeout time.Duration
+	now     func() time.Time
+}
+
+// NewCache creates a Cache. The link MTU is returned for destinations without Path MTU estimate.
+// If timeout is zero, DefaultTimeout is used.
+func NewCache(linkMTU uint32, timeout time.Duration) *Cache {
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	return &Cache{
+		entries: make(map[netip.Addr]entry),
+		linkMTU: max(linkMTU, MinMTU),
+		timeout: timeout,
+		now:     time.Now,
+	}
+}
+
+// Update records the MTU reported by a Packet Too Big message for the given destination SID.
+// Reported MTUs lower than MinMTU are raised to MinMTU,
+// and the Path MTU estimate is never increased in response to a Packet Too Big message (RFC 8201, section 4).
+func (c *Cache) Update(sid netip.Addr, mtu uint32) {
+	mtu = max(mtu, MinMTU)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	if e, ok := c.entries[sid]; ok && now.Sub(e.updated) < c.timeout && e.mtu <= mtu {
+		return
+	}
+	if mtu >= c.linkMTU {
+		return
+	}
+	c.entries[sid] = entry{mtu: mtu, updated: now}
+}
+
+// HandlePacketTooBig updates the Cache from an ICMPv6 Packet Too Big message (starting with the ICMPv6 header).
+// The destination SID is the last segment of the invoking packet.
+func (c *Cache) HandlePacketTooBig(msg []byte) error {
+	mtu, sid, err := icmp.ParsePacketTooBig(msg)
+	if err != nil {
+		return err
+	}
+	c.Update(netip.AddrFrom16(sid), mtu)
+	return nil
+}
+
+// MTU returns the Path MTU estimate for the given destination SID.
+func (c *Cache) MTU(sid netip.Addr) uint32 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if e, ok := c.entries[sid]; ok && c.now().Sub(e.updated) < c.timeout {
+		return e.mtu
+	}
+	return c.linkMTU
+}
+
+// Expire removes aged out entries. It should be called periodically to limit memory usage.
+func (c *Cache) Expire() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	for sid, e := range c.entries {
+		if now.Sub(e.updated) >= c.timeout {
+			delete(c.entries, sid)
+		}
+	}
+}
+
+// Len returns the number of entries in the Cache, including aged out entries not yet expired.
+func (c *Cache) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.entries)
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package pmtu
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	now := time.Now()
+	c := NewCache(1500, 0)
+	c.now = func() time.Time { return now }
+	sid := netip.MustParseAddr("fd00:2:2::1")
+
+	if mtu := c.MTU(sid); mtu != 1500 {
+		t.Errorf("Link MTU should be returned: %d", mtu)
+	}
+	c.Update(sid, 1400)
+	if mtu := c.MTU(sid); mtu != 1400 {
+		t.Errorf("Path MTU should be reduced: %d", mtu)
+	}
+	c.Update(sid, 1450)
+	if mtu := c.MTU(sid); mtu != 1400 {
+		t.Errorf("Path MTU should not be increased: %d", mtu)
+	}
+	c.Update(sid, 1000)
+	if mtu := c.MTU(sid); mtu != MinMTU {
+		t.Errorf("Path MTU should not be lower than the minimum MTU: %d", mtu)
+	}
+
+	now = now.Add(DefaultTimeout)
+	if mtu := c.MTU(sid); mtu != 1500 {
+		t.Errorf("Path MTU should be aged out: %d", mtu)
+	}
+	c.Expire()
+	if c.Len() != 0 {
+		t.Errorf("Aged out entry should be removed")
+	}
+}
+
+func TestHandlePacketTooBig(t *testing.T) {
+	c := NewCache(1500, time.Minute)
+	msg := []byte{
+		2, 0, 0, 0, 0x00, 0x00, 0x05, 0x78, // Packet Too Big, MTU: 1400
+		0x60, 0x00, 0x00, 0x00, 0x00, 0x18, 43, 64,
+		0xfd, 0x00, 0x00, 0x01, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+		0xfd, 0x00, 0x00, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+		// SRH with a single segment
+		4, 2, 4, 0, 0, 0, 0, 0,
+		0xfd, 0x00, 0x00, 0x02, 0x00, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+	}
+	if err := c.HandlePacketTooBig(msg); err != nil {
+		t.Fatal(err)
+	}
+	if mtu := c.MTU(netip.MustParseAddr("fd00:2:2::1")); mtu != 1400 {
+		t.Errorf("Path MTU should be learned for the last segment: %d", mtu)
+	}
+}
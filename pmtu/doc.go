@@ -0,0 +1,11 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package pmtu provides Path MTU Discovery (RFC 8201) across the SR domain,
+// with a Path MTU estimate for each destination SID.
+//
+// A Cache is fed with the Packet Too Big messages received by an icmp.Translator
+// (icmp.WithPacketTooBigHandler), and is the MTUSource of a behavior.MTUPolicy.
+package pmtu
@@ -0,0 +1,8 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package qos provides the marking of the outer headers built by RFC 9433 translators:
+// mapping of 5G QoS flows to the DSCP, and ECN propagation (RFC 6040).
+package qos
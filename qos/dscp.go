@@ -0,0 +1,99 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package qos
+
+const (
+	maxQFI  = 0x3F
+	maxDSCP = 0x3F
+
+	dscpPosBit = 2    // position from right of the DSCP in the Traffic Class (or TOS) in bits
+	ecnMask    = 0x03 // mask of the ECN field in the Traffic Class (or TOS)
+)
+
+// DSCP values (RFC 2474, RFC 2597, RFC 3246).
+const (
+	DSCPDefault = 0
+	DSCPAF11    = 10
+	DSCPAF21    = 18
+	DSCPAF22    = 20
+	DSCPAF31    = 26
+	DSCPAF41    = 34
+	DSCPAF42    = 36
+	DSCPCS5     = 40
+	DSCPEF      = 46
+)
+
+// DSCPMapper returns the DSCP of a QoS flow, and false if the QoS flow is not mapped.
+type DSCPMapper interface {
+	DSCP(qfi uint8) (dscp uint8, ok bool)
+}
+
+// DSCPMapperFunc is an adapter to allow the use of ordinary functions as DSCPMapper.
+type DSCPMapperFunc func(qfi uint8) (uint8, bool)
+
+// DSCP calls f(qfi).
+func (f DSCPMapperFunc) DSCP(qfi uint8) (uint8, bool) {
+	return f(qfi)
+}
+
+// DSCPTable is a QFI to DSCP table. The zero value maps no QoS flow.
+type DSCPTable struct {
+	dscp   [maxQFI + 1]uint8
+	mapped uint64 // bit i is set if QFI i is mapped
+}
+
+// NewDSCPTable creates a DSCPTable from a map of QFIs to DSCPs.
+func NewDSCPTable(m map[uint8]uint8) (*DSCPTable, error) {
+	t := &DSCPTable{}
+	for qfi, dscp := range m {
+		if qfi > maxQFI || dscp > maxDSCP {
+			return nil, ErrOutOfRange
+		}
+		t.dscp[qfi] = dscp
+		t.mapped |= 1 << qfi
+	}
+	return t, nil
+}
+
+// DefaultDSCPTable returns a DSCPTable for QFIs equal to the standardized 5QI
+// of their QoS flow (3GPP TS 23.501, table 5.7.4-1), following the usual mapping of
+// the QoS classes of GSMA IR.34: conversational voice is mapped to EF,
+// signalling to CS5, video and gaming to AF4x and AF3x, and other non-GBR flows to AF2x, AF11 and Default.
+func DefaultDSCPTable() *DSCPTable {
+	t, _ := NewDSCPTable(map[uint8]uint8{
+		1: DSCPEF,      // conversational voice
+		2: DSCPAF41,    // conversational video
+		3: DSCPAF31,    // real time gaming, V2X
+		4: DSCPAF42,    // non-conversational video
+		5: DSCPCS5,     // IMS signalling
+		6: DSCPAF21,    // buffered video, TCP-based
+		7: DSCPAF22,    // voice, live streaming, interactive gaming
+		8: DSCPAF11,    // buffered video, TCP-based
+		9: DSCPDefault, // buffered video, TCP-based (default bearer)
+	})
+	return t
+}
+
+// DSCP returns the DSCP of the QoS flow, and false if it is not mapped.
+func (t *DSCPTable) DSCP(qfi uint8) (uint8, bool) {
+	if qfi > maxQFI || t.mapped&(1<<qfi) == 0 {
+		return 0, false
+	}
+	return t.dscp[qfi], true
+}
+
+// TrafficClass returns the IPv6 Traffic Class (or IPv4 TOS) tc with the DSCP of the QoS flow.
+// The ECN field is kept. If m is nil or the QoS flow is not mapped, tc is returned unchanged.
+func TrafficClass(m DSCPMapper, qfi uint8, tc uint8) uint8 {
+	if m == nil {
+		return tc
+	}
+	dscp, ok := m.DSCP(qfi)
+	if !ok {
+		return tc
+	}
+	return dscp<<dscpPosBit | tc&ecnMask
+}
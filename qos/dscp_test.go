@@ -0,0 +1,73 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package qos
+
+import "testing"
+
+func TestDSCPTable(t *testing.T) {
+	tbl, err := NewDSCPTable(map[uint8]uint8{1: DSCPEF, 63: DSCPAF41})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dscp, ok := tbl.DSCP(1); !ok || dscp != DSCPEF {
+		t.Errorf("Unexpected DSCP for QFI 1: %d, %t", dscp, ok)
+	}
+	if dscp, ok := tbl.DSCP(63); !ok || dscp != DSCPAF41 {
+		t.Errorf("Unexpected DSCP for QFI 63: %d, %t", dscp, ok)
+	}
+	if _, ok := tbl.DSCP(2); ok {
+		t.Error("QFI 2 should not be mapped")
+	}
+	if _, ok := tbl.DSCP(64); ok {
+		t.Error("QFI 64 should not be mapped")
+	}
+	if _, err := NewDSCPTable(map[uint8]uint8{64: DSCPEF}); err != ErrOutOfRange {
+		t.Errorf("Expected ErrOutOfRange, got %v", err)
+	}
+	if _, err := NewDSCPTable(map[uint8]uint8{1: 64}); err != ErrOutOfRange {
+		t.Errorf("Expected ErrOutOfRange, got %v", err)
+	}
+	var zero DSCPTable
+	if _, ok := zero.DSCP(0); ok {
+		t.Error("The zero DSCPTable should not map QFI 0")
+	}
+}
+
+func TestDefaultDSCPTable(t *testing.T) {
+	tbl := DefaultDSCPTable()
+	for qfi, want := range map[uint8]uint8{1: DSCPEF, 5: DSCPCS5, 9: DSCPDefault} {
+		if dscp, ok := tbl.DSCP(qfi); !ok || dscp != want {
+			t.Errorf("Unexpected DSCP for QFI %d: %d, %t", qfi, dscp, ok)
+		}
+	}
+	if _, ok := tbl.DSCP(10); ok {
+		t.Error("QFI 10 should not be mapped")
+	}
+}
+
+func TestTrafficClass(t *testing.T) {
+	tbl := DefaultDSCPTable()
+	for _, tc := range []struct {
+		qfi  uint8
+		tc   uint8
+		want uint8
+	}{
+		{1, 0x00, DSCPEF << 2},
+		{1, 0x03, DSCPEF<<2 | 0x03}, // ECN is kept
+		{10, 0xb9, 0xb9},            // QoS flow not mapped
+	} {
+		if got := TrafficClass(tbl, tc.qfi, tc.tc); got != tc.want {
+			t.Errorf("TrafficClass(%d, %#x) = %#x, want %#x", tc.qfi, tc.tc, got, tc.want)
+		}
+	}
+	if got := TrafficClass(nil, 1, 0xb9); got != 0xb9 {
+		t.Errorf("TrafficClass without mapper should keep the Traffic Class: %#x", got)
+	}
+	f := DSCPMapperFunc(func(qfi uint8) (uint8, bool) { return qfi, true })
+	if got := TrafficClass(f, 5, 0x01); got != 5<<2|0x01 {
+		t.Errorf("Unexpected Traffic Class with DSCPMapperFunc: %#x", got)
+	}
+}
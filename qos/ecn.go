@@ -0,0 +1,62 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package qos
+
+// ECN codepoints (RFC 3168, section 5).
+const (
+	NotECT = 0x00 // Not ECN-Capable Transport
+	ECT1   = 0x01 // ECN-Capable Transport (1)
+	ECT0   = 0x02 // ECN-Capable Transport (0)
+	CE     = 0x03 // Congestion Experienced
+)
+
+// ECNMode is the mode of a tunnel ingress (RFC 6040, section 4.1).
+type ECNMode uint8
+
+const (
+	// ECNNormal copies the ECN field of the inner header to the outer header.
+	ECNNormal ECNMode = iota
+	// ECNCompatibility sets the ECN field of the outer header to Not-ECT,
+	// for tunnel egresses not supporting RFC 6040 or RFC 3168.
+	ECNCompatibility
+)
+
+// ECN returns the ECN field of an IPv6 Traffic Class (or IPv4 TOS).
+func ECN(tc uint8) uint8 {
+	return tc & ecnMask
+}
+
+// SetECN returns the IPv6 Traffic Class (or IPv4 TOS) tc with the ECN field set to ecn.
+func SetECN(tc uint8, ecn uint8) uint8 {
+	return tc&^ecnMask | ecn&ecnMask
+}
+
+// Encapsulate returns the ECN field of the outer header built by a tunnel ingress,
+// given the ECN field of the inner header (RFC 6040, section 4.1).
+func Encapsulate(mode ECNMode, inner uint8) uint8 {
+	if mode == ECNCompatibility {
+		return NotECT
+	}
+	return inner & ecnMask
+}
+
+// Decapsulate returns the ECN field of the inner header forwarded by a tunnel egress,
+// given the ECN fields of the inner and outer headers (RFC 6040, section 4.2, Figure 4).
+// It returns false if the packet must be dropped (congestion experienced on a Not-ECT packet).
+func Decapsulate(inner uint8, outer uint8) (uint8, bool) {
+	inner &= ecnMask
+	outer &= ecnMask
+	switch {
+	case inner == NotECT && outer == CE:
+		return NotECT, false
+	case inner == NotECT, inner == CE:
+		return inner, true
+	case outer == CE, outer == ECT1:
+		return outer, true
+	default:
+		return inner, true
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package qos
+
+import "testing"
+
+func TestDecapsulate(t *testing.T) {
+	// RFC 6040, section 4.2, Figure 4
+	table := [4][4]struct {
+		ecn uint8
+		ok  bool
+	}{
+		// outer: Not-ECT, ECT(1), ECT(0), CE
+		NotECT: {{NotECT, true}, {NotECT, true}, {NotECT, true}, {NotECT, false}},
+		ECT1:   {{ECT1, true}, {ECT1, true}, {ECT1, true}, {CE, true}},
+		ECT0:   {{ECT0, true}, {ECT1, true}, {ECT0, true}, {CE, true}},
+		CE:     {{CE, true}, {CE, true}, {CE, true}, {CE, true}},
+	}
+	for inner, row := range table {
+		for outer, want := range row {
+			ecn, ok := Decapsulate(uint8(inner), uint8(outer))
+			if ecn != want.ecn || ok != want.ok {
+				t.Errorf("Decapsulate(%d, %d) = %d, %t; want %d, %t", inner, outer, ecn, ok, want.ecn, want.ok)
+			}
+		}
+	}
+}
+
+func TestEncapsulate(t *testing.T) {
+	for ecn := uint8(0); ecn < 4; ecn++ {
+		if got := Encapsulate(ECNNormal, ecn); got != ecn {
+			t.Errorf("Encapsulate(ECNNormal, %d) = %d", ecn, got)
+		}
+		if got := Encapsulate(ECNCompatibility, ecn); got != NotECT {
+			t.Errorf("Encapsulate(ECNCompatibility, %d) = %d", ecn, got)
+		}
+	}
+}
+
+func TestSetECN(t *testing.T) {
+	if tc := SetECN(0xb8, CE); tc != 0xbb || ECN(tc) != CE {
+		t.Errorf("Unexpected Traffic Class: %#x", tc)
+	}
+	if tc := SetECN(0xbb, NotECT); tc != 0xb8 || ECN(tc) != NotECT {
+		t.Errorf("Unexpected Traffic Class: %#x", tc)
+	}
+}
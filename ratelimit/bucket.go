@@ -0,0 +1,61 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// TokenBucket is a token-bucket rate limiter.
+// TokenBucket is safe for concurrent use.
+type TokenBucket struct {
+	mu     sync.Mutex
+	rate   float64 // tokens per second
+	burst  float64 // size of the bucket
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket creates a full TokenBucket filled at rate tokens per second, holding at most burst tokens.
+func NewTokenBucket(rate uint64, burst uint64, now time.Time) *TokenBucket {
+	return &TokenBucket{
+		rate:   float64(rate),
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   now,
+	}
+}
+
+// SetRate updates the rate and the burst of the TokenBucket.
+func (t *TokenBucket) SetRate(rate uint64, burst uint64, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.refill(now)
+	t.rate = float64(rate)
+	t.burst = float64(burst)
+	t.tokens = min(t.tokens, t.burst)
+}
+
+// refill adds the tokens accumulated since the last update.
+func (t *TokenBucket) refill(now time.Time) {
+	if now.After(t.last) {
+		t.tokens = min(t.burst, t.tokens+now.Sub(t.last).Seconds()*t.rate)
+		t.last = now
+	}
+}
+
+// AllowN returns true and consumes n tokens if n tokens are available at time now.
+func (t *TokenBucket) AllowN(now time.Time, n uint64) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.refill(now)
+	if t.tokens < float64(n) {
+		return false
+	}
+	t.tokens -= float64(n)
+	return true
+}
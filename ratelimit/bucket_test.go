@@ -0,0 +1,28 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := NewTokenBucket(100, 200, now)
+	if !b.AllowN(now, 150) {
+		t.Error("Bucket should be full at creation")
+	}
+	if b.AllowN(now, 100) {
+		t.Error("Bucket should not allow more than available tokens")
+	}
+	if !b.AllowN(now.Add(500*time.Millisecond), 100) {
+		t.Error("Bucket should be refilled")
+	}
+	if b.AllowN(now.Add(10*time.Second), 201) {
+		t.Error("Bucket should not hold more than burst")
+	}
+}
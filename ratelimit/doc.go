@@ -0,0 +1,8 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package ratelimit provides token-bucket rate limiters to enforce
+// the End.Limit behavior of RFC 9433 (section 6.8).
+package ratelimit
@@ -0,0 +1,84 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package ratelimit
+
+import (
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/nextmn/rfc9433/encoding"
+)
+
+// DefaultBurst is the duration of traffic at the limit rate allowed in a burst.
+const DefaultBurst = time.Second
+
+// key identifies a rate-limit group.
+type key struct {
+	prefix  netip.Prefix
+	groupID uint64
+}
+
+// Limiter enforces End.Limit SIDs, with one TokenBucket per rate-limit group
+// (LOC+FUNC and group-id of the SID).
+// The unit of the limit rate is not specified by RFC 9433: Limiter uses bytes per second.
+// Limiter is safe for concurrent use.
+type Limiter struct {
+	mu      sync.Mutex
+	buckets map[key]*TokenBucket
+	burst   time.Duration
+	now     func() time.Time
+}
+
+// NewLimiter creates a Limiter. If burst is zero, DefaultBurst is used.
+func NewLimiter(burst time.Duration) *Limiter {
+	if burst == 0 {
+		burst = DefaultBurst
+	}
+	return &Limiter{
+		buckets: make(map[key]*TokenBucket),
+		burst:   burst,
+		now:     time.Now,
+	}
+}
+
+// Allow returns true if a packet of the given size matching the End.Limit SID can be forwarded.
+// The rate of the group is updated with the limit rate of the SID.
+func (l *Limiter) Allow(sid *encoding.EndLimit, size uint64) bool {
+	if sid.LimitRate() == 0 {
+		return true
+	}
+	now := l.now()
+	rate := sid.LimitRate()
+	burst := max(uint64(float64(rate)*l.burst.Seconds()), 1)
+	k := key{prefix: sid.Prefix(), groupID: sid.GroupID()}
+
+	l.mu.Lock()
+	b, ok := l.buckets[k]
+	if !ok {
+		b = NewTokenBucket(rate, burst, now)
+		l.buckets[k] = b
+	}
+	l.mu.Unlock()
+	if ok {
+		b.SetRate(rate, burst, now)
+	}
+	return b.AllowN(now, size)
+}
+
+// Remove removes the TokenBucket of the rate-limit group of the End.Limit SID.
+func (l *Limiter) Remove(sid *encoding.EndLimit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.buckets, key{prefix: sid.Prefix(), groupID: sid.GroupID()})
+}
+
+// Len returns the number of rate-limit groups.
+func (l *Limiter) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.buckets)
+}
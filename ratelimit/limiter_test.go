@@ -0,0 +1,46 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package ratelimit
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/nextmn/rfc9433/encoding"
+)
+
+func TestLimiter(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := NewLimiter(time.Second)
+	l.now = func() time.Time { return now }
+	prefix := netip.MustParsePrefix("fd00:1:1::/48")
+
+	if !l.Allow(encoding.NewEndLimit(prefix, 1, 16, 0, 16), 1_000_000) {
+		t.Error("Limit rate 0 should not be limited")
+	}
+	sid := encoding.NewEndLimit(prefix, 1, 16, 1000, 16)
+	if !l.Allow(sid, 600) {
+		t.Error("First packet should be allowed")
+	}
+	if l.Allow(sid, 600) {
+		t.Error("Second packet should be limited")
+	}
+	if !l.Allow(encoding.NewEndLimit(prefix, 2, 16, 1000, 16), 600) {
+		t.Error("Other groups should not be limited")
+	}
+	now = now.Add(time.Second)
+	if !l.Allow(sid, 600) {
+		t.Error("Bucket should be refilled")
+	}
+	if l.Len() != 2 {
+		t.Errorf("Unexpected number of groups: %d", l.Len())
+	}
+	l.Remove(sid)
+	if l.Len() != 1 {
+		t.Errorf("Unexpected number of groups: %d", l.Len())
+	}
+}
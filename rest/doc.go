@@ -0,0 +1,11 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package rest provides an HTTP handler exposing a control.Service as a REST API,
+// with the same operations as its gRPC definition (control.proto).
+// The API is described by the OpenAPI definition served at /openapi.yaml.
+// WithDebug additionally mounts expvar and net/http/pprof under /debug;
+// publish control.Service.Var with expvar.Publish to report the sizes of its tables.
+package rest
@@ -0,0 +1,13 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package rest
+
+import "errors"
+
+var (
+	ErrInvalidBody = errors.New("invalid request body")
+	ErrInvalidPath = errors.New("invalid path parameter")
+)
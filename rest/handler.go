@@ -0,0 +1,505 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package rest
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+	"net/netip"
+	"strconv"
+
+	"github.com/nextmn/rfc9433/control"
+	"github.com/nextmn/rfc9433/locator"
+	"github.com/nextmn/rfc9433/session"
+)
+
+// maxBodySize is the maximum size of request bodies.
+const maxBodySize = 1 << 20
+
+//go:embed openapi.yaml
+var openAPI []byte
+
+// Option configures a Handler.
+type Option func(*Handler)
+
+// WithDebug mounts the expvar variables at /debug/vars, and the net/http/pprof profiles at /debug/pprof/.
+// These endpoints are not part of the API and may expose sensitive data.
+func WithDebug() Option {
+	return func(h *Handler) {
+		h.mux.Handle("GET /debug/vars", expvar.Handler())
+		h.mux.HandleFunc("GET /debug/pprof/", pprof.Index)
+		h.mux.HandleFunc("GET /debug/pprof/cmdline", pprof.Cmdline)
+		h.mux.HandleFunc("GET /debug/pprof/profile", pprof.Profile)
+		h.mux.HandleFunc("GET /debug/pprof/symbol", pprof.Symbol)
+		h.mux.HandleFunc("POST /debug/pprof/symbol", pprof.Symbol)
+		h.mux.HandleFunc("GET /debug/pprof/trace", pprof.Trace)
+	}
+}
+
+// Handler is an http.Handler exposing a control.Service.
+// Use http.StripPrefix to serve it under a path prefix.
+type Handler struct {
+	s   *control.Service
+	mux *http.ServeMux
+}
+
+// NewHandler creates a Handler.
+func NewHandler(s *control.Service, opts ...Option) *Handler {
+	h := &Handler{
+		s:   s,
+		mux: http.NewServeMux(),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	h.mux.HandleFunc("GET /openapi.yaml", h.getOpenAPI)
+	h.mux.HandleFunc("GET /sessions", h.listSessions)
+	h.mux.HandleFunc("POST /sessions", h.createSession)
+	h.mux.HandleFunc("GET /sessions/{peer}/{teid}", h.getSession)
+	h.mux.HandleFunc("PUT /sessions/{peer}/{teid}", h.updateSession)
+	h.mux.HandleFunc("DELETE /sessions/{peer}/{teid}", h.deleteSession)
+	h.mux.HandleFunc("GET /sessions/{peer}/{teid}/stats", h.getSessionStats)
+	h.mux.HandleFunc("GET /locators", h.listLocators)
+	h.mux.HandleFunc("POST /locators", h.addLocator)
+	h.mux.HandleFunc("PUT /locators/{addr}/{bits}", h.updateLocator)
+	h.mux.HandleFunc("DELETE /locators/{addr}/{bits}", h.deleteLocator)
+	h.mux.HandleFunc("GET /behaviors", h.listBehaviors)
+	h.mux.HandleFunc("PUT /behaviors/{addr}/{bits}", h.setBehavior)
+	h.mux.HandleFunc("DELETE /behaviors/{addr}/{bits}", h.deleteBehavior)
+	h.mux.HandleFunc("GET /behaviors/{addr}/{bits}/trace", h.getTrace)
+	h.mux.HandleFunc("GET /sids", h.listSIDAllocations)
+	h.mux.HandleFunc("POST /sids", h.allocateSID)
+	h.mux.HandleFunc("GET /sids/{sid}", h.getSIDAllocation)
+	h.mux.HandleFunc("DELETE /sids/{sid}", h.releaseSID)
+	h.mux.HandleFunc("POST /decode", h.decode)
+	h.mux.HandleFunc("GET /config", h.getConfig)
+	h.mux.HandleFunc("PUT /config", h.applyConfig)
+	h.mux.HandleFunc("POST /config/diff", h.diffConfig)
+	return h
+}
+
+// OpenAPI returns the OpenAPI definition of the API.
+func OpenAPI() []byte {
+	return append([]byte(nil), openAPI...)
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// getOpenAPI writes the OpenAPI definition.
+func (h *Handler) getOpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/yaml")
+	w.Write(openAPI)
+}
+
+func (h *Handler) listSessions(w http.ResponseWriter, r *http.Request) {
+	entries := h.s.Sessions()
+	sessions := make([]sessionJSON, len(entries))
+	for i, e := range entries {
+		sessions[i] = newSessionJSON(e.Key, e.Session)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"sessions": sessions})
+}
+
+func (h *Handler) createSession(w http.ResponseWriter, r *http.Request) {
+	var j sessionJSON
+	if err := readJSON(w, r, &j); err != nil {
+		writeError(w, err)
+		return
+	}
+	s, err := j.session()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := h.s.CreateSession(j.key(), s); err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, newSessionJSON(j.key(), s))
+}
+
+func (h *Handler) getSession(w http.ResponseWriter, r *http.Request) {
+	k, err := sessionKey(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	s, err := h.s.GetSession(k)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newSessionJSON(k, s))
+}
+
+func (h *Handler) updateSession(w http.ResponseWriter, r *http.Request) {
+	k, err := sessionKey(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	var j sessionJSON
+	if err := readJSON(w, r, &j); err != nil {
+		writeError(w, err)
+		return
+	}
+	s, err := j.session()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := h.s.UpdateSession(k, s); err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newSessionJSON(k, s))
+}
+
+func (h *Handler) deleteSession(w http.ResponseWriter, r *http.Request) {
+	k, err := sessionKey(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := h.s.DeleteSession(k); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) getSessionStats(w http.ResponseWriter, r *http.Request) {
+	k, err := sessionKey(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	stats, err := h.s.SessionStats(k)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	flows := make([]flowStatsJSON, len(stats))
+	for i, f := range stats {
+		flows[i] = newFlowStatsJSON(f)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"flows": flows})
+}
+
+func (h *Handler) listLocators(w http.ResponseWriter, r *http.Request) {
+	locators := h.s.Locators()
+	res := make([]locatorJSON, len(locators))
+	for i, l := range locators {
+		res[i] = locatorJSON(l)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"locators": res})
+}
+
+func (h *Handler) addLocator(w http.ResponseWriter, r *http.Request) {
+	var j locatorJSON
+	if err := readJSON(w, r, &j); err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := h.s.AddLocator(control.Locator(j)); err != nil {
+		writeError(w, err)
+		return
+	}
+	j.Prefix = j.Prefix.Masked()
+	writeJSON(w, http.StatusCreated, j)
+}
+
+func (h *Handler) updateLocator(w http.ResponseWriter, r *http.Request) {
+	prefix, err := pathPrefix(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	var j locatorJSON
+	if err := readJSON(w, r, &j); err != nil {
+		writeError(w, err)
+		return
+	}
+	j.Prefix = prefix.Masked()
+	if err := h.s.UpdateLocator(control.Locator(j)); err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, j)
+}
+
+func (h *Handler) deleteLocator(w http.ResponseWriter, r *http.Request) {
+	prefix, err := pathPrefix(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := h.s.DeleteLocator(prefix); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) listBehaviors(w http.ResponseWriter, r *http.Request) {
+	specs := h.s.Behaviors()
+	res := make([]behaviorJSON, len(specs))
+	for i, spec := range specs {
+		res[i] = behaviorJSON(spec)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"behaviors": res})
+}
+
+func (h *Handler) setBehavior(w http.ResponseWriter, r *http.Request) {
+	sid, err := pathPrefix(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	var j behaviorJSON
+	if err := readJSON(w, r, &j); err != nil {
+		writeError(w, err)
+		return
+	}
+	j.SID = sid.Masked()
+	if err := h.s.SetBehavior(control.BehaviorSpec(j)); err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, j)
+}
+
+func (h *Handler) deleteBehavior(w http.ResponseWriter, r *http.Request) {
+	sid, err := pathPrefix(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := h.s.DeleteBehavior(sid); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) getTrace(w http.ResponseWriter, r *http.Request) {
+	sid, err := pathPrefix(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	records, err := h.s.Trace(sid)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	res := make([]traceRecordJSON, len(records))
+	for i, rec := range records {
+		res[i] = newTraceRecordJSON(rec)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"records": res})
+}
+
+func (h *Handler) listSIDAllocations(w http.ResponseWriter, r *http.Request) {
+	allocations, err := h.s.SIDAllocations()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	res := make([]allocationJSON, len(allocations))
+	for i, a := range allocations {
+		res[i] = allocationJSON(a)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"allocations": res})
+}
+
+func (h *Handler) allocateSID(w http.ResponseWriter, r *http.Request) {
+	var j allocateJSON
+	if err := readJSON(w, r, &j); err != nil {
+		writeError(w, err)
+		return
+	}
+	var a locator.Allocation
+	if j.SID.IsValid() {
+		if err := h.s.ReserveSID(j.SID, j.Owner); err != nil {
+			writeError(w, err)
+			return
+		}
+		var err error
+		if a, err = h.s.SIDAllocation(j.SID); err != nil {
+			writeError(w, err)
+			return
+		}
+	} else {
+		sid, err := h.s.AllocateSID(j.Owner)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		a = locator.Allocation{SID: sid, Owner: j.Owner}
+	}
+	writeJSON(w, http.StatusCreated, allocationJSON(a))
+}
+
+func (h *Handler) getSIDAllocation(w http.ResponseWriter, r *http.Request) {
+	sid, err := netip.ParseAddr(r.PathValue("sid"))
+	if err != nil {
+		writeError(w, ErrInvalidPath)
+		return
+	}
+	a, err := h.s.SIDAllocation(sid)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, allocationJSON(a))
+}
+
+func (h *Handler) releaseSID(w http.ResponseWriter, r *http.Request) {
+	sid, err := netip.ParseAddr(r.PathValue("sid"))
+	if err != nil {
+		writeError(w, ErrInvalidPath)
+		return
+	}
+	if err := h.s.ReleaseSID(sid); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) decode(w http.ResponseWriter, r *http.Request) {
+	var j decodeJSON
+	if err := readJSON(w, r, &j); err != nil {
+		writeError(w, err)
+		return
+	}
+	d, err := control.Decode(j.Address, j.Layout, j.PrefixLength)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if d.MGTP4Dst != nil {
+		writeJSON(w, http.StatusOK, d.MGTP4Dst)
+		return
+	}
+	writeJSON(w, http.StatusOK, d.MGTP4Src)
+}
+
+func (h *Handler) getConfig(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, newConfigJSON(h.s.Config()))
+}
+
+func (h *Handler) applyConfig(w http.ResponseWriter, r *http.Request) {
+	cfg, err := readConfig(w, r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	d, err := h.s.ApplyConfig(cfg)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newDiffJSON(d))
+}
+
+func (h *Handler) diffConfig(w http.ResponseWriter, r *http.Request) {
+	cfg, err := readConfig(w, r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	d, err := h.s.Diff(cfg)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newDiffJSON(d))
+}
+
+// readConfig decodes the control.Config of the body of the request.
+func readConfig(w http.ResponseWriter, r *http.Request) (control.Config, error) {
+	var j configJSON
+	if err := readJSON(w, r, &j); err != nil {
+		return control.Config{}, err
+	}
+	return j.config()
+}
+
+// sessionKey returns the session key of the path.
+func sessionKey(r *http.Request) (session.Key, error) {
+	peer, err := netip.ParseAddr(r.PathValue("peer"))
+	if err != nil {
+		return session.Key{}, ErrInvalidPath
+	}
+	teid, err := strconv.ParseUint(r.PathValue("teid"), 0, 32)
+	if err != nil {
+		return session.Key{}, ErrInvalidPath
+	}
+	return session.Key{Peer: peer, TEID: uint32(teid)}, nil
+}
+
+// pathPrefix returns the prefix of the path, given as address and length.
+func pathPrefix(r *http.Request) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(r.PathValue("addr") + "/" + r.PathValue("bits"))
+	if err != nil {
+		return netip.Prefix{}, ErrInvalidPath
+	}
+	return prefix, nil
+}
+
+// readJSON decodes the body of the request into v.
+func readJSON(w http.ResponseWriter, r *http.Request, v any) error {
+	d := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize))
+	d.DisallowUnknownFields()
+	if err := d.Decode(v); err != nil {
+		return errors.Join(ErrInvalidBody, err)
+	}
+	return nil
+}
+
+// writeJSON writes v as response body.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response, whose status depends on err.
+func writeError(w http.ResponseWriter, err error) {
+	writeJSON(w, statusOf(err), errorJSON{Error: err.Error()})
+}
+
+// statusOf returns the HTTP status of an error.
+func statusOf(err error) int {
+	switch {
+	case errors.Is(err, session.ErrNotFound),
+		errors.Is(err, control.ErrLocatorNotFound),
+		errors.Is(err, control.ErrBehaviorNotFound),
+		errors.Is(err, locator.ErrNotAllocated):
+		return http.StatusNotFound
+	case errors.Is(err, session.ErrExists),
+		errors.Is(err, session.ErrSIDInUse),
+		errors.Is(err, control.ErrLocatorInUse),
+		errors.Is(err, locator.ErrCollision),
+		errors.Is(err, locator.ErrHeldDown),
+		errors.Is(err, locator.ErrNoFreeSID):
+		return http.StatusConflict
+	case errors.Is(err, control.ErrNoAllocator),
+		errors.Is(err, control.ErrNoRecorder):
+		return http.StatusNotImplemented
+	default:
+		return http.StatusBadRequest
+	}
+}
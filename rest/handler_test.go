@@ -0,0 +1,328 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/behavior"
+	"github.com/nextmn/rfc9433/control"
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/locator"
+	"github.com/nextmn/rfc9433/session"
+	"github.com/nextmn/rfc9433/trace"
+)
+
+// do sends a request to h, and returns the status and the decoded response body.
+func do(t *testing.T, h http.Handler, method, target, body string) (int, map[string]any) {
+	t.Helper()
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(method, target, bytes.NewBufferString(body)))
+	var res map[string]any
+	if w.Body.Len() > 0 {
+		if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return w.Code, res
+}
+
+func TestHandlerSessions(t *testing.T) {
+	h := NewHandler(control.NewService(session.NewTable(), behavior.NewRegistry()))
+	body := `{"peer":"10.0.0.2","teid":1,"sid":"2001:db8::1","args":{"teid":1,"qfi":5,"r":false,"u":false}}`
+	if code, res := do(t, h, "POST", "/sessions", body); code != http.StatusCreated {
+		t.Fatalf("Unexpected status %d: %v", code, res)
+	}
+	if code, _ := do(t, h, "POST", "/sessions", body); code != http.StatusConflict {
+		t.Errorf("Duplicated session should be rejected: %d", code)
+	}
+	if code, _ := do(t, h, "POST", "/sessions", `{"peer":"10.0.0.2","teid":2,"sid":"10.0.0.1"}`); code != http.StatusBadRequest {
+		t.Errorf("IPv4 SID should be rejected: %d", code)
+	}
+	if code, _ := do(t, h, "POST", "/sessions", `{"unknown":1}`); code != http.StatusBadRequest {
+		t.Errorf("Unknown field should be rejected: %d", code)
+	}
+	if code, res := do(t, h, "PUT", "/sessions/10.0.0.2/0x1", `{"sid":"2001:db8::2","segments":["2001:db8:1::1"]}`); code != http.StatusOK {
+		t.Fatalf("Unexpected status %d: %v", code, res)
+	}
+	code, res := do(t, h, "GET", "/sessions/10.0.0.2/1", "")
+	if code != http.StatusOK {
+		t.Fatalf("Unexpected status %d: %v", code, res)
+	}
+	if diff := cmp.Diff(res, map[string]any{
+		"peer":     "10.0.0.2",
+		"teid":     1.0,
+		"sid":      "2001:db8::2",
+		"segments": []any{"2001:db8:1::1"},
+	}); diff != "" {
+		t.Error(diff)
+	}
+	if code, res := do(t, h, "GET", "/sessions", ""); code != http.StatusOK || len(res["sessions"].([]any)) != 1 {
+		t.Errorf("Unexpected sessions %d: %v", code, res)
+	}
+	if code, _ := do(t, h, "DELETE", "/sessions/10.0.0.2/1", ""); code != http.StatusNoContent {
+		t.Errorf("Unexpected status: %d", code)
+	}
+	if code, _ := do(t, h, "GET", "/sessions/10.0.0.2/1", ""); code != http.StatusNotFound {
+		t.Errorf("Deleted session should not be found: %d", code)
+	}
+	if code, _ := do(t, h, "GET", "/sessions/10.0.0.2/teid", ""); code != http.StatusBadRequest {
+		t.Errorf("Invalid TEID should be rejected: %d", code)
+	}
+}
+
+func TestHandlerSessionStats(t *testing.T) {
+	table := session.NewTable()
+	h := NewHandler(control.NewService(table, behavior.NewRegistry()))
+	if code, _ := do(t, h, "GET", "/sessions/10.0.0.2/1/stats", ""); code != http.StatusNotFound {
+		t.Errorf("Stats of a missing session should not be found: %d", code)
+	}
+	k := session.Key{Peer: netip.MustParseAddr("10.0.0.2"), TEID: 1}
+	if err := table.Add(k, session.Session{SID: netip.MustParseAddr("2001:db8::1")}); err != nil {
+		t.Fatal(err)
+	}
+	table.Count(k, session.QoSFlow{PDUSessionID: 1, QFI: 5}, 100)
+	table.Drop(k, session.QoSFlow{PDUSessionID: 1, QFI: 5})
+	code, res := do(t, h, "GET", "/sessions/10.0.0.2/1/stats", "")
+	if code != http.StatusOK {
+		t.Fatalf("Unexpected status %d: %v", code, res)
+	}
+	if diff := cmp.Diff(res, map[string]any{"flows": []any{map[string]any{
+		"pduSessionId": 1.0,
+		"qfi":          5.0,
+		"packets":      1.0,
+		"bytes":        100.0,
+		"drops":        1.0,
+	}}}); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestHandlerLocatorsAndBehaviors(t *testing.T) {
+	h := NewHandler(control.NewService(session.NewTable(), behavior.NewRegistry()))
+	if code, res := do(t, h, "POST", "/locators", `{"prefix":"2001:db8::/48","owner":"srgw"}`); code != http.StatusCreated {
+		t.Fatalf("Unexpected status %d: %v", code, res)
+	}
+	if code, _ := do(t, h, "POST", "/locators", `{"prefix":"2001:db8::/64"}`); code != http.StatusConflict {
+		t.Errorf("Overlapping locator should be rejected: %d", code)
+	}
+	body := `{"action":"End.M.GTP4.E","srcPrefix":"2001:db8:1::/48"}`
+	if code, res := do(t, h, "PUT", "/behaviors/2001:db8::/64", body); code != http.StatusOK || res["sid"] != "2001:db8::/64" {
+		t.Fatalf("Unexpected behavior %d: %v", code, res)
+	}
+	if code, res := do(t, h, "GET", "/behaviors", ""); code != http.StatusOK || len(res["behaviors"].([]any)) != 1 {
+		t.Errorf("Unexpected behaviors %d: %v", code, res)
+	}
+	if code, res := do(t, h, "PUT", "/locators/2001:db8::/48", `{"owner":"upf"}`); code != http.StatusOK || res["owner"] != "upf" {
+		t.Errorf("Unexpected locator %d: %v", code, res)
+	}
+	if code, _ := do(t, h, "PUT", "/locators/2001:db9::/48", `{"owner":"upf"}`); code != http.StatusNotFound {
+		t.Errorf("Missing locator should not be updated: %d", code)
+	}
+	if code, _ := do(t, h, "DELETE", "/locators/2001:db8::/48", ""); code != http.StatusConflict {
+		t.Errorf("Locator in use should not be deleted: %d", code)
+	}
+	if code, _ := do(t, h, "DELETE", "/behaviors/2001:db8::/64", ""); code != http.StatusNoContent {
+		t.Errorf("Unexpected status: %d", code)
+	}
+	if code, _ := do(t, h, "DELETE", "/behaviors/2001:db8::/64", ""); code != http.StatusNotFound {
+		t.Errorf("Deleted behavior should not be found: %d", code)
+	}
+	if code, _ := do(t, h, "DELETE", "/locators/2001:db8::/48", ""); code != http.StatusNoContent {
+		t.Errorf("Unexpected status: %d", code)
+	}
+	if code, res := do(t, h, "GET", "/locators", ""); code != http.StatusOK || len(res["locators"].([]any)) != 0 {
+		t.Errorf("Unexpected locators %d: %v", code, res)
+	}
+}
+
+func TestHandlerTrace(t *testing.T) {
+	if code, _ := do(t, NewHandler(control.NewService(session.NewTable(), behavior.NewRegistry())), "GET", "/behaviors/2001:db8::/64/trace", ""); code != http.StatusNotImplemented {
+		t.Errorf("Trace without Recorder should not be implemented: %d", code)
+	}
+	r := behavior.NewRegistry(behavior.WithRecorder(trace.NewRecorder(8)))
+	h := NewHandler(control.NewService(session.NewTable(), r))
+	if code, _ := do(t, h, "GET", "/behaviors/2001:db8::/64/trace", ""); code != http.StatusNotFound {
+		t.Errorf("Missing behavior should not be found: %d", code)
+	}
+	if code, res := do(t, h, "POST", "/locators", `{"prefix":"2001:db8::/48"}`); code != http.StatusCreated {
+		t.Fatalf("Unexpected status %d: %v", code, res)
+	}
+	if code, res := do(t, h, "PUT", "/behaviors/2001:db8::/64", `{"action":"End.M.GTP4.E"}`); code != http.StatusOK {
+		t.Fatalf("Unexpected status %d: %v", code, res)
+	}
+	// IPv6 header without payload, dropped by End.M.GTP4.E
+	pkt := make([]byte, 40)
+	pkt[0] = 0x60
+	pkt[6] = 59
+	copy(pkt[24:40], netip.MustParseAddr("2001:db8::1").AsSlice())
+	r.Process(pkt, nil)
+	code, res := do(t, h, "GET", "/behaviors/2001:db8::/64/trace", "")
+	if code != http.StatusOK {
+		t.Fatalf("Unexpected status %d: %v", code, res)
+	}
+	records := res["records"].([]any)
+	if len(records) != 1 || records[0].(map[string]any)["decision"] != "drop" || records[0].(map[string]any)["dst"] != "2001:db8::1" {
+		t.Errorf("Unexpected records: %v", records)
+	}
+}
+
+func TestHandlerSIDs(t *testing.T) {
+	if code, _ := do(t, NewHandler(control.NewService(session.NewTable(), behavior.NewRegistry())), "GET", "/sids", ""); code != http.StatusNotImplemented {
+		t.Errorf("SIDs without Allocator should not be implemented: %d", code)
+	}
+	a, err := locator.NewAllocator(16, 0, netip.MustParsePrefix("2001:db8::/48"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewHandler(control.NewService(session.NewTable(), behavior.NewRegistry(), control.WithAllocator(a)))
+	if code, res := do(t, h, "POST", "/sids", `{"owner":"smf1"}`); code != http.StatusCreated || res["owner"] != "smf1" {
+		t.Fatalf("Unexpected allocation %d: %v", code, res)
+	}
+	code, res := do(t, h, "POST", "/sids", `{"owner":"smf2","sid":"2001:db8:0:ff::"}`)
+	if code != http.StatusCreated {
+		t.Fatalf("Unexpected status %d: %v", code, res)
+	}
+	if diff := cmp.Diff(res, map[string]any{"sid": "2001:db8:0:ff::/64", "owner": "smf2"}); diff != "" {
+		t.Error(diff)
+	}
+	if code, _ := do(t, h, "POST", "/sids", `{"owner":"smf3","sid":"2001:db8:0:ff::"}`); code != http.StatusConflict {
+		t.Errorf("Allocated SID should not be reserved: %d", code)
+	}
+	if code, res := do(t, h, "GET", "/sids", ""); code != http.StatusOK || len(res["allocations"].([]any)) != 2 {
+		t.Errorf("Unexpected allocations %d: %v", code, res)
+	}
+	if code, res := do(t, h, "GET", "/sids/2001:db8:0:ff::", ""); code != http.StatusOK || res["owner"] != "smf2" {
+		t.Errorf("Unexpected allocation %d: %v", code, res)
+	}
+	if code, _ := do(t, h, "DELETE", "/sids/2001:db8:0:ff::", ""); code != http.StatusNoContent {
+		t.Errorf("Unexpected status: %d", code)
+	}
+	if code, _ := do(t, h, "GET", "/sids/2001:db8:0:ff::", ""); code != http.StatusNotFound {
+		t.Errorf("Released SID should not be found: %d", code)
+	}
+}
+
+func TestHandlerDecode(t *testing.T) {
+	h := NewHandler(control.NewService(session.NewTable(), behavior.NewRegistry()))
+	b, err := encoding.NewMGTP4IPv6Dst(netip.MustParsePrefix("2001:db8::/32"), [4]byte{10, 0, 0, 1}, encoding.NewArgsMobSession(5, true, false, 0xcafe)).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := json.Marshal(decodeJSON{Address: netip.AddrFrom16([16]byte(b)), Layout: control.LayoutMGTP4Dst, PrefixLength: 32})
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, res := do(t, h, "POST", "/decode", string(body))
+	if code != http.StatusOK {
+		t.Fatalf("Unexpected status %d: %v", code, res)
+	}
+	if diff := cmp.Diff(res, map[string]any{
+		"prefix": "2001:db8::/32",
+		"ipv4":   "10.0.0.1",
+		"teid":   float64(0xcafe),
+		"qfi":    5.0,
+		"r":      true,
+		"u":      false,
+	}); diff != "" {
+		t.Error(diff)
+	}
+	if code, _ := do(t, h, "POST", "/decode", `{"address":"10.0.0.1","layout":"mgtp4-dst","prefixLength":32}`); code != http.StatusBadRequest {
+		t.Errorf("IPv4 address should be rejected: %d", code)
+	}
+}
+
+func TestHandlerConfig(t *testing.T) {
+	h := NewHandler(control.NewService(session.NewTable(), behavior.NewRegistry()))
+	body := `{"locators":[{"prefix":"2001:db8::/48","owner":"srgw"}],` +
+		`"behaviors":[{"sid":"2001:db8::/64","action":"End.M.GTP4.E"}],` +
+		`"sessions":[{"peer":"10.0.0.2","teid":1,"sid":"2001:db8::1"}]}`
+	code, res := do(t, h, "POST", "/config/diff", body)
+	if code != http.StatusOK || len(res["addLocators"].([]any)) != 1 || len(res["setBehaviors"].([]any)) != 1 || len(res["createSessions"].([]any)) != 1 {
+		t.Fatalf("Unexpected diff %d: %v", code, res)
+	}
+	if code, res := do(t, h, "GET", "/locators", ""); code != http.StatusOK || len(res["locators"].([]any)) != 0 {
+		t.Errorf("Diff should not change the running state %d: %v", code, res)
+	}
+	if code, res := do(t, h, "PUT", "/config", body); code != http.StatusOK || len(res["addLocators"].([]any)) != 1 {
+		t.Fatalf("Unexpected applied diff %d: %v", code, res)
+	}
+	if code, res := do(t, h, "POST", "/config/diff", body); code != http.StatusOK || len(res) != 0 {
+		t.Errorf("Running state should match the configuration %d: %v", code, res)
+	}
+	if code, res := do(t, h, "GET", "/config", ""); code != http.StatusOK || len(res["sessions"].([]any)) != 1 {
+		t.Errorf("Unexpected configuration %d: %v", code, res)
+	}
+	code, res = do(t, h, "PUT", "/config", `{}`)
+	if code != http.StatusOK || len(res["deleteLocators"].([]any)) != 1 || len(res["deleteSessions"].([]any)) != 1 {
+		t.Errorf("Unexpected applied diff %d: %v", code, res)
+	}
+	if code, _ := do(t, h, "PUT", "/config", `{"locators":[{"prefix":"2001:db8::/48"},{"prefix":"2001:db8::/48"}]}`); code != http.StatusBadRequest {
+		t.Errorf("Duplicate locators should be rejected: %d", code)
+	}
+}
+
+func TestDecodeConfig(t *testing.T) {
+	cfg, err := DecodeConfig(bytes.NewBufferString(`{"locators":[{"prefix":"2001:db8::/48","owner":"srgw"}],` +
+		`"behaviors":[{"sid":"2001:db8::/64","action":"End.M.GTP4.E"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(cfg, control.Config{
+		Locators:  []control.Locator{{Prefix: netip.MustParsePrefix("2001:db8::/48"), Owner: "srgw"}},
+		Behaviors: []control.BehaviorSpec{{SID: netip.MustParsePrefix("2001:db8::/64"), Action: "End.M.GTP4.E"}},
+		Sessions:  []control.SessionEntry{},
+	}, cmp.Comparer(func(a, b netip.Prefix) bool { return a == b }), cmp.Comparer(func(a, b netip.Addr) bool { return a == b })); diff != "" {
+		t.Error(diff)
+	}
+	if _, err := DecodeConfig(bytes.NewBufferString(`{"unknown":[]}`)); !errors.Is(err, ErrInvalidBody) {
+		t.Errorf("Unknown fields should be rejected: %v", err)
+	}
+}
+
+func TestHandlerDebug(t *testing.T) {
+	s := control.NewService(session.NewTable(), behavior.NewRegistry())
+	w := httptest.NewRecorder()
+	NewHandler(s).ServeHTTP(w, httptest.NewRequest("GET", "/debug/vars", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Debug endpoints should not be mounted by default: %d", w.Code)
+	}
+	h := NewHandler(s, WithDebug())
+	if code, res := do(t, h, "GET", "/debug/vars", ""); code != http.StatusOK || res["memstats"] == nil {
+		t.Errorf("Unexpected expvar variables %d: %v", code, res)
+	}
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/debug/pprof/goroutine?debug=1", nil))
+	if w.Code != http.StatusOK || !bytes.Contains(w.Body.Bytes(), []byte("goroutine profile")) {
+		t.Errorf("Unexpected goroutine profile %d: %s", w.Code, w.Body)
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	w := httptest.NewRecorder()
+	NewHandler(control.NewService(session.NewTable(), behavior.NewRegistry())).ServeHTTP(w, httptest.NewRequest("GET", "/openapi.yaml", nil))
+	if w.Code != http.StatusOK || !bytes.Equal(w.Body.Bytes(), openAPI) {
+		t.Fatalf("Unexpected OpenAPI definition: %d", w.Code)
+	}
+	// each route must be described
+	for _, p := range []string{
+		"/openapi.yaml", "/sessions", "/sessions/{peer}/{teid}", "/sessions/{peer}/{teid}/stats", "/locators", "/locators/{addr}/{bits}",
+		"/behaviors", "/behaviors/{addr}/{bits}", "/behaviors/{addr}/{bits}/trace", "/sids", "/sids/{sid}", "/decode",
+		"/config", "/config/diff",
+	} {
+		if !regexp.MustCompile(`(?m)^  ` + regexp.QuoteMeta(p) + `:$`).Match(openAPI) {
+			t.Errorf("Path %s is not described", p)
+		}
+	}
+}
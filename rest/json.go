@@ -0,0 +1,256 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/netip"
+	"time"
+
+	"github.com/nextmn/rfc9433/control"
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/iproute2"
+	"github.com/nextmn/rfc9433/session"
+	"github.com/nextmn/rfc9433/trace"
+)
+
+// sessionJSON is the JSON form of a session and its key.
+type sessionJSON struct {
+	Peer     netip.Addr               `json:"peer"`
+	TEID     uint32                   `json:"teid"`
+	SID      netip.Addr               `json:"sid"`
+	Args     *encoding.ArgsMobSession `json:"args,omitempty"`
+	Segments []netip.Addr             `json:"segments,omitempty"`
+}
+
+// newSessionJSON returns the JSON form of a session.
+func newSessionJSON(k session.Key, s session.Session) sessionJSON {
+	j := sessionJSON{
+		Peer: k.Peer,
+		TEID: k.TEID,
+		SID:  s.SID,
+		Args: s.Args,
+	}
+	for _, seg := range s.Segments {
+		j.Segments = append(j.Segments, netip.AddrFrom16(seg))
+	}
+	return j
+}
+
+// key returns the key of the session.
+func (j *sessionJSON) key() session.Key {
+	return session.Key{Peer: j.Peer, TEID: j.TEID}
+}
+
+// session returns the session.
+func (j *sessionJSON) session() (session.Session, error) {
+	s := session.Session{
+		SID:  j.SID,
+		Args: j.Args,
+	}
+	if !s.SID.Is6() {
+		return s, ErrInvalidBody
+	}
+	for _, seg := range j.Segments {
+		if !seg.Is6() {
+			return s, ErrInvalidBody
+		}
+		s.Segments = append(s.Segments, seg.As16())
+	}
+	return s, nil
+}
+
+// sessionKeyJSON is the JSON form of a session key.
+type sessionKeyJSON struct {
+	Peer netip.Addr `json:"peer"`
+	TEID uint32     `json:"teid"`
+}
+
+// flowStatsJSON is the JSON form of control.FlowStats.
+type flowStatsJSON struct {
+	PDUSessionID uint32 `json:"pduSessionId"`
+	QFI          uint8  `json:"qfi"`
+	Packets      uint64 `json:"packets"`
+	Bytes        uint64 `json:"bytes"`
+	Drops        uint64 `json:"drops"`
+}
+
+// newFlowStatsJSON returns the JSON form of control.FlowStats.
+func newFlowStatsJSON(f control.FlowStats) flowStatsJSON {
+	return flowStatsJSON{
+		PDUSessionID: f.Flow.PDUSessionID,
+		QFI:          f.Flow.QFI,
+		Packets:      f.Stats.Packets,
+		Bytes:        f.Stats.Bytes,
+		Drops:        f.Stats.Drops,
+	}
+}
+
+// traceRecordJSON is the JSON form of trace.Record.
+type traceRecordJSON struct {
+	Time     time.Time  `json:"time"`
+	Src      netip.Addr `json:"src"`
+	Dst      netip.Addr `json:"dst"`
+	Len      int        `json:"len"`
+	Decision string     `json:"decision"`
+	Reason   string     `json:"reason,omitempty"`
+}
+
+// newTraceRecordJSON returns the JSON form of trace.Record.
+func newTraceRecordJSON(r trace.Record) traceRecordJSON {
+	return traceRecordJSON{
+		Time:     r.Time,
+		Src:      r.Src,
+		Dst:      r.Dst,
+		Len:      r.Len,
+		Decision: r.Decision.String(),
+		Reason:   r.Reason,
+	}
+}
+
+// locatorJSON is the JSON form of control.Locator.
+type locatorJSON struct {
+	Prefix netip.Prefix `json:"prefix"`
+	Owner  string       `json:"owner"`
+}
+
+// behaviorJSON is the JSON form of control.BehaviorSpec.
+// Addresses and prefixes unused by the action are empty strings.
+type behaviorJSON struct {
+	SID       netip.Prefix    `json:"sid"`
+	Action    iproute2.Action `json:"action"`
+	Source    netip.Addr      `json:"source"`
+	SrcPrefix netip.Prefix    `json:"srcPrefix"`
+	DstPrefix netip.Prefix    `json:"dstPrefix"`
+	Segments  []netip.Addr    `json:"segments,omitempty"`
+	Reduced   bool            `json:"reduced,omitempty"`
+	HopLimit  uint8           `json:"hopLimit,omitempty"`
+}
+
+// allocationJSON is the JSON form of locator.Allocation.
+type allocationJSON struct {
+	SID   netip.Prefix `json:"sid"`
+	Owner string       `json:"owner"`
+}
+
+// allocateJSON is the body of a SID allocation request.
+type allocateJSON struct {
+	Owner string     `json:"owner"`
+	SID   netip.Addr `json:"sid"` // SID to reserve, optional
+}
+
+// decodeJSON is the body of a decode request.
+type decodeJSON struct {
+	Address      netip.Addr     `json:"address"`
+	Layout       control.Layout `json:"layout"`
+	PrefixLength uint           `json:"prefixLength"`
+}
+
+// configJSON is the JSON form of control.Config.
+type configJSON struct {
+	Locators  []locatorJSON  `json:"locators"`
+	Behaviors []behaviorJSON `json:"behaviors"`
+	Sessions  []sessionJSON  `json:"sessions"`
+}
+
+// newConfigJSON returns the JSON form of a control.Config.
+func newConfigJSON(cfg control.Config) configJSON {
+	j := configJSON{
+		Locators:  make([]locatorJSON, len(cfg.Locators)),
+		Behaviors: make([]behaviorJSON, len(cfg.Behaviors)),
+		Sessions:  make([]sessionJSON, len(cfg.Sessions)),
+	}
+	for i, l := range cfg.Locators {
+		j.Locators[i] = locatorJSON(l)
+	}
+	for i, spec := range cfg.Behaviors {
+		j.Behaviors[i] = behaviorJSON(spec)
+	}
+	for i, e := range cfg.Sessions {
+		j.Sessions[i] = newSessionJSON(e.Key, e.Session)
+	}
+	return j
+}
+
+// DecodeConfig reads a control.Config in the JSON form used by /config (e.g. saved from GET /config).
+func DecodeConfig(r io.Reader) (control.Config, error) {
+	var j configJSON
+	d := json.NewDecoder(r)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&j); err != nil {
+		return control.Config{}, errors.Join(ErrInvalidBody, err)
+	}
+	return j.config()
+}
+
+// config returns the control.Config.
+func (j *configJSON) config() (control.Config, error) {
+	cfg := control.Config{
+		Locators:  make([]control.Locator, len(j.Locators)),
+		Behaviors: make([]control.BehaviorSpec, len(j.Behaviors)),
+		Sessions:  make([]control.SessionEntry, len(j.Sessions)),
+	}
+	for i, l := range j.Locators {
+		cfg.Locators[i] = control.Locator(l)
+	}
+	for i, b := range j.Behaviors {
+		cfg.Behaviors[i] = control.BehaviorSpec(b)
+	}
+	for i, e := range j.Sessions {
+		s, err := e.session()
+		if err != nil {
+			return cfg, err
+		}
+		cfg.Sessions[i] = control.SessionEntry{Key: e.key(), Session: s}
+	}
+	return cfg, nil
+}
+
+// diffJSON is the JSON form of control.Diff.
+type diffJSON struct {
+	AddLocators     []locatorJSON    `json:"addLocators,omitempty"`
+	UpdateLocators  []locatorJSON    `json:"updateLocators,omitempty"`
+	DeleteLocators  []netip.Prefix   `json:"deleteLocators,omitempty"`
+	SetBehaviors    []behaviorJSON   `json:"setBehaviors,omitempty"`
+	DeleteBehaviors []netip.Prefix   `json:"deleteBehaviors,omitempty"`
+	CreateSessions  []sessionJSON    `json:"createSessions,omitempty"`
+	UpdateSessions  []sessionJSON    `json:"updateSessions,omitempty"`
+	DeleteSessions  []sessionKeyJSON `json:"deleteSessions,omitempty"`
+}
+
+// newDiffJSON returns the JSON form of a control.Diff.
+func newDiffJSON(d *control.Diff) diffJSON {
+	j := diffJSON{
+		DeleteLocators:  d.DeleteLocators,
+		DeleteBehaviors: d.DeleteBehaviors,
+	}
+	for _, l := range d.AddLocators {
+		j.AddLocators = append(j.AddLocators, locatorJSON(l))
+	}
+	for _, l := range d.UpdateLocators {
+		j.UpdateLocators = append(j.UpdateLocators, locatorJSON(l))
+	}
+	for _, spec := range d.SetBehaviors {
+		j.SetBehaviors = append(j.SetBehaviors, behaviorJSON(spec))
+	}
+	for _, e := range d.CreateSessions {
+		j.CreateSessions = append(j.CreateSessions, newSessionJSON(e.Key, e.Session))
+	}
+	for _, e := range d.UpdateSessions {
+		j.UpdateSessions = append(j.UpdateSessions, newSessionJSON(e.Key, e.Session))
+	}
+	for _, k := range d.DeleteSessions {
+		j.DeleteSessions = append(j.DeleteSessions, sessionKeyJSON(k))
+	}
+	return j
+}
+
+// errorJSON is the body of error responses.
+type errorJSON struct {
+	Error string `json:"error"`
+}
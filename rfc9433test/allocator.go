@@ -0,0 +1,126 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package rfc9433test
+
+import (
+	"net/netip"
+	"slices"
+	"sync"
+
+	"github.com/nextmn/rfc9433/locator"
+)
+
+// Allocator is a control.SIDAllocator allocating a fixed list of SIDs, in order, without hold-down.
+// Released SIDs are not allocated again by Allocate.
+// Allocator is safe for concurrent use.
+type Allocator struct {
+	mu       sync.Mutex
+	free     []netip.Prefix // SIDs of the next calls of Allocate
+	sids     map[netip.Addr]locator.Allocation
+	detector *locator.Detector
+}
+
+// NewAllocator creates an Allocator of the given SIDs.
+func NewAllocator(sids ...netip.Prefix) *Allocator {
+	return &Allocator{
+		free: slices.Clone(sids),
+		sids: make(map[netip.Addr]locator.Allocation),
+	}
+}
+
+// SetDetector provisions the SIDs allocated from now on in d, and removes them from d once released.
+func (a *Allocator) SetDetector(d *locator.Detector) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.detector = d
+}
+
+// Allocate allocates the next free SID to the owner, skipping SIDs which are allocated or collide in the Detector.
+func (a *Allocator) Allocate(owner string) (netip.Prefix, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for len(a.free) > 0 {
+		sid := a.free[0]
+		a.free = a.free[1:]
+		if a.add(sid, owner) == nil {
+			return sid, nil
+		}
+	}
+	return netip.Prefix{}, locator.ErrNoFreeSID
+}
+
+// Reserve allocates the given SID to the owner.
+func (a *Allocator) Reserve(sid netip.Addr, owner string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.add(netip.PrefixFrom(sid, sid.BitLen()), owner)
+}
+
+// add allocates a SID; a.mu must be held.
+func (a *Allocator) add(sid netip.Prefix, owner string) error {
+	if !sid.IsValid() {
+		return locator.ErrInvalidSID
+	}
+	if e, ok := a.sids[sid.Addr()]; ok {
+		return &locator.CollisionError{New: sid.String(), NewOwner: owner, Existing: e.SID.String(), Owner: e.Owner}
+	}
+	if a.detector != nil {
+		if err := a.detector.AddSID(sid.Addr(), owner); err != nil {
+			return err
+		}
+	}
+	a.sids[sid.Addr()] = locator.Allocation{SID: sid, Owner: owner}
+	return nil
+}
+
+// Release releases an allocated SID.
+func (a *Allocator) Release(sid netip.Addr) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, ok := a.sids[sid]; !ok {
+		return locator.ErrNotAllocated
+	}
+	delete(a.sids, sid)
+	if a.detector != nil {
+		a.detector.RemoveSID(sid)
+	}
+	return nil
+}
+
+// Owner returns the owner of an allocated SID.
+func (a *Allocator) Owner(sid netip.Addr) (string, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	e, ok := a.sids[sid]
+	return e.Owner, ok
+}
+
+// Prefix returns the prefix of an allocated SID.
+func (a *Allocator) Prefix(sid netip.Addr) (netip.Prefix, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	e, ok := a.sids[sid]
+	return e.SID, ok
+}
+
+// Allocations returns the allocated SIDs, sorted by SID.
+func (a *Allocator) Allocations() []locator.Allocation {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	allocs := make([]locator.Allocation, 0, len(a.sids))
+	for _, e := range a.sids {
+		allocs = append(allocs, e)
+	}
+	slices.SortFunc(allocs, func(x, y locator.Allocation) int {
+		return x.SID.Addr().Compare(y.SID.Addr())
+	})
+	return allocs
+}
+
+// Usage returns no usage, since the Allocator has no locator.
+func (a *Allocator) Usage() []locator.Usage {
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package rfc9433test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/control"
+	"github.com/nextmn/rfc9433/session"
+)
+
+// compareAddrs compares netip.Addr by value, since their fields are unexported.
+var compareAddrs = cmp.Comparer(func(a, b netip.Addr) bool { return a == b })
+
+// AssertWritten fails the test if the packets written to the Device are not want, in order.
+func AssertWritten(t testing.TB, d *Device, want ...[]byte) {
+	t.Helper()
+	got := d.Written()
+	if len(want) == 0 {
+		want = nil
+	}
+	if len(got) == 0 {
+		got = nil
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("written packets mismatch (-got +want):\n%s", diff)
+	}
+}
+
+// AssertSession fails the test if the session of the key is not want.
+func AssertSession(t testing.TB, s control.SessionStore, k session.Key, want session.Session) {
+	t.Helper()
+	got, ok := s.Lookup(k)
+	if !ok {
+		t.Errorf("session %s/%d not found", k.Peer, k.TEID)
+		return
+	}
+	if diff := cmp.Diff(got, want, compareAddrs); diff != "" {
+		t.Errorf("session %s/%d mismatch (-got +want):\n%s", k.Peer, k.TEID, diff)
+	}
+}
+
+// AssertNoSession fails the test if a session exists for the key.
+func AssertNoSession(t testing.TB, s control.SessionStore, k session.Key) {
+	t.Helper()
+	if _, ok := s.Lookup(k); ok {
+		t.Errorf("unexpected session %s/%d", k.Peer, k.TEID)
+	}
+}
+
+// AssertAllocated fails the test if the SID is not allocated to the owner.
+func AssertAllocated(t testing.TB, a control.SIDAllocator, sid netip.Addr, owner string) {
+	t.Helper()
+	got, ok := a.Owner(sid)
+	if !ok {
+		t.Errorf("SID %s is not allocated", sid)
+		return
+	}
+	if got != owner {
+		t.Errorf("SID %s is allocated to %q, want %q", sid, got, owner)
+	}
+}
+
+// AssertNotAllocated fails the test if the SID is allocated.
+func AssertNotAllocated(t testing.TB, a control.SIDAllocator, sid netip.Addr) {
+	t.Helper()
+	if owner, ok := a.Owner(sid); ok {
+		t.Errorf("SID %s is allocated to %q", sid, owner)
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package rfc9433test
+
+import (
+	"context"
+	"io"
+	"slices"
+	"sync"
+)
+
+// Device is an in-memory datapath.Device, which also implements datapath.Drainer.
+// Packets injected with Inject are read by ReadPacket, and written packets are kept in memory.
+// Device is safe for concurrent use.
+type Device struct {
+	mu          sync.Mutex
+	queue       [][]byte
+	written     [][]byte
+	interrupted bool
+	closed      bool
+	changed     chan struct{} // closed and replaced on every change of the Device
+}
+
+// NewDevice creates an empty Device.
+func NewDevice() *Device {
+	return &Device{
+		changed: make(chan struct{}),
+	}
+}
+
+// notify wakes up the goroutines waiting for a change of the Device; d.mu must be held.
+func (d *Device) notify() {
+	close(d.changed)
+	d.changed = make(chan struct{})
+}
+
+// Inject queues copies of the packets, to be read by ReadPacket.
+func (d *Device) Inject(pkts ...[]byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, pkt := range pkts {
+		d.queue = append(d.queue, slices.Clone(pkt))
+	}
+	d.notify()
+}
+
+// ReadPacket reads a single packet into b, and returns its length.
+// It blocks until a packet is injected, and returns ErrClosed once the Device is closed or interrupted.
+func (d *Device) ReadPacket(b []byte) (int, error) {
+	for {
+		d.mu.Lock()
+		if d.closed || d.interrupted {
+			d.mu.Unlock()
+			return 0, ErrClosed
+		}
+		if len(d.queue) > 0 {
+			defer d.mu.Unlock()
+			return d.dequeue(b)
+		}
+		changed := d.changed
+		d.mu.Unlock()
+		<-changed
+	}
+}
+
+// ReadQueuedPacket reads a single packet already queued into b without blocking, and returns its length.
+// It returns ErrNoPacket if no packet is queued.
+func (d *Device) ReadQueuedPacket(b []byte) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return 0, ErrClosed
+	}
+	if len(d.queue) == 0 {
+		return 0, ErrNoPacket
+	}
+	return d.dequeue(b)
+}
+
+// dequeue reads the first queued packet into b; d.mu must be held.
+func (d *Device) dequeue(b []byte) (int, error) {
+	pkt := d.queue[0]
+	d.queue = d.queue[1:]
+	if len(b) < len(pkt) {
+		return 0, io.ErrShortBuffer
+	}
+	return copy(b, pkt), nil
+}
+
+// WritePacket keeps a copy of the packet.
+func (d *Device) WritePacket(pkt []byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return ErrClosed
+	}
+	d.written = append(d.written, slices.Clone(pkt))
+	d.notify()
+	return nil
+}
+
+// Written returns the packets written so far.
+func (d *Device) Written() [][]byte {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return slices.Clone(d.written)
+}
+
+// WaitWritten waits until at least n packets are written, and returns the packets written so far.
+func (d *Device) WaitWritten(ctx context.Context, n int) ([][]byte, error) {
+	for {
+		d.mu.Lock()
+		if len(d.written) >= n {
+			defer d.mu.Unlock()
+			return slices.Clone(d.written), nil
+		}
+		changed := d.changed
+		d.mu.Unlock()
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return d.Written(), ctx.Err()
+		}
+	}
+}
+
+// Queued returns the number of injected packets not read yet.
+func (d *Device) Queued() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.queue)
+}
+
+// Interrupt makes the pending and later calls of ReadPacket return ErrClosed, without closing the Device.
+func (d *Device) Interrupt() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.interrupted = true
+	d.notify()
+	return nil
+}
+
+// Close closes the Device.
+func (d *Device) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return ErrClosed
+	}
+	d.closed = true
+	d.notify()
+	return nil
+}
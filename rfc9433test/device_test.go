@@ -0,0 +1,78 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package rfc9433test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nextmn/rfc9433/behavior"
+	"github.com/nextmn/rfc9433/datapath"
+)
+
+func TestDeviceForwarder(t *testing.T) {
+	dev := NewDevice()
+	f, err := datapath.NewForwarder(dev, behavior.BehaviorFunc(func(pkt []byte, meta *behavior.Metadata) ([]byte, error) {
+		return append(pkt, 'x'), nil
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	go func() {
+		done <- f.Run(context.Background())
+	}()
+	dev.Inject([]byte{1}, []byte{2})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := dev.WaitWritten(ctx, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	// queued packets are drained on Shutdown
+	dev.Inject([]byte{3})
+	if err := f.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; !errors.Is(err, datapath.ErrShutdown) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	AssertWritten(t, dev, []byte{1, 'x'}, []byte{2, 'x'}, []byte{3, 'x'})
+	if err := dev.WritePacket([]byte{4}); !errors.Is(err, ErrClosed) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestDeviceRead(t *testing.T) {
+	dev := NewDevice()
+	b := make([]byte, 2)
+	if _, err := dev.ReadQueuedPacket(b); !errors.Is(err, ErrNoPacket) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	dev.Inject([]byte{1, 2, 3}, []byte{4})
+	if _, err := dev.ReadPacket(b); err == nil {
+		t.Error("packet larger than the buffer should not be read")
+	}
+	if n, err := dev.ReadQueuedPacket(b); err != nil || n != 1 || b[0] != 4 {
+		t.Errorf("unexpected packet: %v, %v", b[:n], err)
+	}
+	if dev.Queued() != 0 {
+		t.Errorf("unexpected queued packets: %d", dev.Queued())
+	}
+	read := make(chan error)
+	go func() {
+		_, err := dev.ReadPacket(b)
+		read <- err
+	}()
+	if err := dev.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-read; !errors.Is(err, ErrClosed) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
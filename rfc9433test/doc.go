@@ -0,0 +1,9 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package rfc9433test provides in-memory fakes of the integration points of this module
+// (datapath.Device, egress.RouteLookup, control.SessionStore, control.SIDAllocator and locator.Clock),
+// and assertion helpers, to unit-test applications embedding it without sockets or root privileges.
+package rfc9433test
@@ -0,0 +1,62 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package rfc9433test
+
+import (
+	"net/netip"
+	"slices"
+	"sync"
+
+	"github.com/nextmn/rfc9433/egress"
+)
+
+// Routes is an egress.RouteLookup with a route per destination, which records the looked up destinations.
+// Routes is safe for concurrent use.
+type Routes struct {
+	mu      sync.Mutex
+	routes  map[netip.Addr]egress.Route
+	lookups []netip.Addr
+}
+
+// NewRoutes creates a Routes without route.
+func NewRoutes() *Routes {
+	return &Routes{
+		routes: make(map[netip.Addr]egress.Route),
+	}
+}
+
+// Set sets the route of a destination.
+func (r *Routes) Set(dst netip.Addr, route egress.Route) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.routes[dst.Unmap()] = route
+}
+
+// Delete removes the route of a destination.
+func (r *Routes) Delete(dst netip.Addr) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.routes, dst.Unmap())
+}
+
+// Lookup returns the route of the destination, or egress.ErrNoRoute.
+func (r *Routes) Lookup(dst netip.Addr) (egress.Route, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lookups = append(r.lookups, dst)
+	route, ok := r.routes[dst.Unmap()]
+	if !ok {
+		return egress.Route{}, egress.ErrNoRoute
+	}
+	return route, nil
+}
+
+// Lookups returns the destinations looked up so far, in order.
+func (r *Routes) Lookups() []netip.Addr {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.lookups)
+}
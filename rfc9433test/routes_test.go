@@ -0,0 +1,32 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package rfc9433test
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/egress"
+)
+
+func TestRoutes(t *testing.T) {
+	r := NewRoutes()
+	dst := netip.MustParseAddr("192.0.2.1")
+	want := egress.Route{Interface: "eth0", NextHop: netip.MustParseAddr("192.0.2.254")}
+	r.Set(dst, want)
+	if got, err := r.Lookup(netip.MustParseAddr("::ffff:192.0.2.1")); err != nil || got != want {
+		t.Errorf("unexpected route: %v, %v", got, err)
+	}
+	r.Delete(dst)
+	if _, err := r.Lookup(dst); !errors.Is(err, egress.ErrNoRoute) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(r.Lookups(), []netip.Addr{netip.MustParseAddr("::ffff:192.0.2.1"), dst}, cmp.Comparer(func(a, b netip.Addr) bool { return a == b })); diff != "" {
+		t.Error(diff)
+	}
+}
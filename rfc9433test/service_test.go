@@ -0,0 +1,84 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package rfc9433test
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/nextmn/rfc9433/behavior"
+	"github.com/nextmn/rfc9433/control"
+	"github.com/nextmn/rfc9433/locator"
+	"github.com/nextmn/rfc9433/session"
+)
+
+func TestServiceSessions(t *testing.T) {
+	sessions := NewSessions()
+	s := control.NewService(sessions, behavior.NewRegistry())
+	k := session.Key{Peer: netip.MustParseAddr("192.0.2.1"), TEID: 1}
+	sess := session.Session{SID: netip.MustParseAddr("2001:db8::1")}
+
+	errStore := errors.New("store")
+	sessions.FailNext(errStore)
+	if err := s.CreateSession(k, sess); !errors.Is(err, errStore) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	AssertNoSession(t, sessions, k)
+	if err := s.CreateSession(k, sess); err != nil {
+		t.Fatal(err)
+	}
+	AssertSession(t, sessions, k, sess)
+	if err := s.DeleteSession(k); err != nil {
+		t.Fatal(err)
+	}
+	AssertNoSession(t, sessions, k)
+}
+
+func TestServiceAllocator(t *testing.T) {
+	sid := netip.MustParsePrefix("2001:db8:0:1::/64")
+	a := NewAllocator(netip.MustParsePrefix("2001:db8::/64"), sid)
+	s := control.NewService(NewSessions(), behavior.NewRegistry(), control.WithAllocator(a))
+	// the first SID collides with a session
+	if err := s.CreateSession(session.Key{Peer: netip.MustParseAddr("192.0.2.1"), TEID: 1}, session.Session{SID: netip.MustParseAddr("2001:db8::")}); err != nil {
+		t.Fatal(err)
+	}
+	if p, err := s.AllocateSID("smf"); err != nil || p != sid {
+		t.Errorf("unexpected allocation: %v, %v", p, err)
+	}
+	AssertAllocated(t, a, sid.Addr(), "smf")
+	if _, err := s.AllocateSID("smf"); !errors.Is(err, locator.ErrNoFreeSID) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := s.ReleaseSID(sid.Addr()); err != nil {
+		t.Fatal(err)
+	}
+	AssertNotAllocated(t, a, sid.Addr())
+}
+
+func TestClockAllocator(t *testing.T) {
+	c := NewClock(time.Unix(0, 0))
+	a, err := locator.NewAllocator(1, time.Minute, netip.MustParsePrefix("2001:db8::/127"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.SetClock(c)
+	sid := netip.MustParseAddr("2001:db8::")
+	if err := a.Reserve(sid, "smf"); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Release(sid); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Reserve(sid, "smf"); !errors.Is(err, locator.ErrHeldDown) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	c.Advance(time.Minute)
+	if err := a.Reserve(sid, "smf"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package rfc9433test
+
+import (
+	"sync"
+
+	"github.com/nextmn/rfc9433/session"
+)
+
+// Sessions is a control.SessionStore backed by a session.Table, whose changes can be made to fail.
+// Sessions is safe for concurrent use.
+type Sessions struct {
+	*session.Table
+
+	mu   sync.Mutex
+	errs []error // errors of the next changes
+}
+
+// NewSessions creates an empty Sessions.
+func NewSessions() *Sessions {
+	return &Sessions{
+		Table: session.NewTable(),
+	}
+}
+
+// FailNext makes the next calls of Add, Update and Delete return errs, in order, without changing the sessions.
+func (s *Sessions) FailNext(errs ...error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errs = append(s.errs, errs...)
+}
+
+// fail returns the error of the next change, if any.
+func (s *Sessions) fail() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.errs) == 0 {
+		return nil
+	}
+	err := s.errs[0]
+	s.errs = s.errs[1:]
+	return err
+}
+
+// Add adds a session, unless it is made to fail.
+func (s *Sessions) Add(k session.Key, sess session.Session) error {
+	if err := s.fail(); err != nil {
+		return err
+	}
+	return s.Table.Add(k, sess)
+}
+
+// Update replaces an existing session, unless it is made to fail.
+func (s *Sessions) Update(k session.Key, sess session.Session) error {
+	if err := s.fail(); err != nil {
+		return err
+	}
+	return s.Table.Update(k, sess)
+}
+
+// Delete removes a session, unless it is made to fail.
+func (s *Sessions) Delete(k session.Key) error {
+	if err := s.fail(); err != nil {
+		return err
+	}
+	return s.Table.Delete(k)
+}
@@ -0,0 +1,9 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package session provides a session table mapping GTP-U tunnels
+// to their SRv6 counterpart, for stateful deployments of RFC 9433 behaviors.
+// Packets, bytes and drops are counted for each QoS flow (PDU Session ID and QFI) of a session.
+package session
@@ -0,0 +1,15 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package session
+
+import "errors"
+
+var (
+	ErrExists     = errors.New("session already exists")
+	ErrNotFound   = errors.New("session not found")
+	ErrSIDInUse   = errors.New("SID already used by another session")
+	ErrInvalidKey = errors.New("invalid session key")
+)
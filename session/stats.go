@@ -0,0 +1,112 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package session
+
+import (
+	"maps"
+	"sync"
+)
+
+// QoSFlow identifies a QoS flow of a PDU session.
+type QoSFlow struct {
+	PDUSessionID uint32
+	QFI          uint8
+}
+
+// QoSFlow returns the QoS flow of the Args.Mob.Session of the session, or the zero QoSFlow without Args.
+func (s Session) QoSFlow() QoSFlow {
+	if s.Args == nil {
+		return QoSFlow{}
+	}
+	return QoSFlow{PDUSessionID: s.Args.PDUSessionID(), QFI: s.Args.QFI()}
+}
+
+// Stats are the counters of a QoS flow.
+type Stats struct {
+	Packets uint64 // forwarded packets
+	Bytes   uint64 // forwarded bytes
+	Drops   uint64 // dropped packets
+}
+
+// flowStats are the Stats of the QoS flows of a session.
+type flowStats struct {
+	mu    sync.Mutex
+	flows map[QoSFlow]Stats
+}
+
+// newFlowStats creates an empty flowStats.
+func newFlowStats() *flowStats {
+	return &flowStats{
+		flows: make(map[QoSFlow]Stats),
+	}
+}
+
+// count counts a forwarded packet of n bytes.
+func (f *flowStats) count(flow QoSFlow, n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	s := f.flows[flow]
+	s.Packets++
+	s.Bytes += uint64(n)
+	f.flows[flow] = s
+}
+
+// drop counts a dropped packet.
+func (f *flowStats) drop(flow QoSFlow) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	s := f.flows[flow]
+	s.Drops++
+	f.flows[flow] = s
+}
+
+// snapshot returns a copy of the Stats.
+func (f *flowStats) snapshot() map[QoSFlow]Stats {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return maps.Clone(f.flows)
+}
+
+// flowStats returns the flowStats of a session.
+func (t *Table) flowStats(k Key) (*flowStats, bool) {
+	k.Peer = k.Peer.Unmap()
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	f, ok := t.stats[k]
+	return f, ok
+}
+
+// Count counts a packet of n bytes forwarded on a QoS flow of a session.
+// It returns false if the session does not exist.
+func (t *Table) Count(k Key, flow QoSFlow, n int) bool {
+	f, ok := t.flowStats(k)
+	if !ok {
+		return false
+	}
+	f.count(flow, n)
+	return true
+}
+
+// Drop counts a packet dropped on a QoS flow of a session.
+// It returns false if the session does not exist.
+func (t *Table) Drop(k Key, flow QoSFlow) bool {
+	f, ok := t.flowStats(k)
+	if !ok {
+		return false
+	}
+	f.drop(flow)
+	return true
+}
+
+// Stats returns the Stats of the QoS flows of a session.
+// Stats are kept when the session is updated, and removed with the session.
+func (t *Table) Stats(k Key) (map[QoSFlow]Stats, bool) {
+	f, ok := t.flowStats(k)
+	if !ok {
+		return nil, false
+	}
+	return f.snapshot(), true
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package session
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding"
+)
+
+func TestTableStats(t *testing.T) {
+	table := NewTable()
+	k := Key{Peer: netip.MustParseAddr("10.0.0.1"), TEID: 1}
+	s := Session{SID: netip.MustParseAddr("2001:db8::1"), Args: encoding.NewArgsMobSession(5, false, false, 1)}
+	f1 := s.QoSFlow()
+	f2 := QoSFlow{PDUSessionID: 1, QFI: 9}
+	if f1 != (QoSFlow{PDUSessionID: 1, QFI: 5}) {
+		t.Errorf("Unexpected QoS flow: %+v", f1)
+	}
+	if table.Count(k, f1, 100) {
+		t.Error("Packets of a missing session should not be counted")
+	}
+	if err := table.Add(k, s); err != nil {
+		t.Fatal(err)
+	}
+	table.Count(k, f1, 100)
+	table.Count(k, f1, 50)
+	table.Drop(k, f1)
+	// counted with the mapped peer address
+	table.Count(Key{Peer: netip.MustParseAddr("::ffff:10.0.0.1"), TEID: 1}, f2, 10)
+	s.SID = netip.MustParseAddr("2001:db8::2")
+	if err := table.Update(k, s); err != nil {
+		t.Fatal(err)
+	}
+	stats, ok := table.Stats(k)
+	if !ok {
+		t.Fatal("Stats should be found")
+	}
+	if diff := cmp.Diff(stats, map[QoSFlow]Stats{
+		f1: {Packets: 2, Bytes: 150, Drops: 1},
+		f2: {Packets: 1, Bytes: 10},
+	}); diff != "" {
+		t.Error(diff)
+	}
+	if err := table.Delete(k); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := table.Stats(k); ok {
+		t.Error("Stats should be removed with the session")
+	}
+	if (Session{}).QoSFlow() != (QoSFlow{}) {
+		t.Error("Session without Args should have the zero QoS flow")
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package session
+
+import (
+	"iter"
+	"net/netip"
+	"sync"
+
+	"github.com/nextmn/rfc9433/encoding"
+)
+
+// Key identifies a GTP-U tunnel by the address of the peer (gNB or UPF) and the TEID.
+type Key struct {
+	Peer netip.Addr // IPv4 or IPv6 address; IPv4-mapped IPv6 addresses are unmapped
+	TEID uint32
+}
+
+// Session is the SRv6 counterpart of a GTP-U tunnel.
+type Session struct {
+	SID      netip.Addr               // SID of the session (e.g. End.M.GTP4.E SID, or last segment pushed by H.M.GTP4.D)
+	Args     *encoding.ArgsMobSession // Args.Mob.Session carried by the SID; must not be modified once stored
+	Segments [][16]byte               // segments visited before SID, may be empty; must not be modified once stored
+}
+
+// Table maps GTP-U tunnels to Sessions, and SIDs back to GTP-U tunnels.
+// A SID is used by at most one session. Table is safe for concurrent use.
+type Table struct {
+	mu       sync.RWMutex
+	sessions map[Key]Session
+	sids     map[netip.Addr]Key
+	stats    map[Key]*flowStats
+}
+
+// NewTable creates an empty Table.
+func NewTable() *Table {
+	return &Table{
+		sessions: make(map[Key]Session),
+		sids:     make(map[netip.Addr]Key),
+		stats:    make(map[Key]*flowStats),
+	}
+}
+
+// normalize returns the key with its peer address unmapped.
+func normalize(k Key) (Key, error) {
+	if !k.Peer.IsValid() {
+		return k, ErrInvalidKey
+	}
+	k.Peer = k.Peer.Unmap()
+	return k, nil
+}
+
+// Add adds a session, unless a session already exists for the key, or its SID is already used.
+func (t *Table) Add(k Key, s Session) error {
+	k, err := normalize(k)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.sessions[k]; ok {
+		return ErrExists
+	}
+	if s.SID.IsValid() {
+		if _, ok := t.sids[s.SID]; ok {
+			return ErrSIDInUse
+		}
+		t.sids[s.SID] = k
+	}
+	t.sessions[k] = s
+	t.stats[k] = newFlowStats()
+	return nil
+}
+
+// Update replaces an existing session, unless its new SID is used by another session.
+func (t *Table) Update(k Key, s Session) error {
+	k, err := normalize(k)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	old, ok := t.sessions[k]
+	if !ok {
+		return ErrNotFound
+	}
+	if s.SID.IsValid() {
+		if other, ok := t.sids[s.SID]; ok && other != k {
+			return ErrSIDInUse
+		}
+	}
+	if old.SID.IsValid() {
+		delete(t.sids, old.SID)
+	}
+	if s.SID.IsValid() {
+		t.sids[s.SID] = k
+	}
+	t.sessions[k] = s
+	return nil
+}
+
+// Delete removes a session.
+func (t *Table) Delete(k Key) error {
+	k, err := normalize(k)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s, ok := t.sessions[k]
+	if !ok {
+		return ErrNotFound
+	}
+	if s.SID.IsValid() {
+		delete(t.sids, s.SID)
+	}
+	delete(t.sessions, k)
+	delete(t.stats, k)
+	return nil
+}
+
+// Lookup returns the session of a GTP-U tunnel.
+func (t *Table) Lookup(k Key) (Session, bool) {
+	k.Peer = k.Peer.Unmap()
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	s, ok := t.sessions[k]
+	return s, ok
+}
+
+// LookupSID returns the GTP-U tunnel and the session using the SID.
+func (t *Table) LookupSID(sid netip.Addr) (Key, Session, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	k, ok := t.sids[sid]
+	if !ok {
+		return Key{}, Session{}, false
+	}
+	return k, t.sessions[k], true
+}
+
+// Len returns the number of sessions.
+func (t *Table) Len() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.sessions)
+}
+
+// Range calls fn for each session, in no particular order, until fn returns false.
+// fn is called on a snapshot of the table, and may modify the table.
+func (t *Table) Range(fn func(k Key, s Session) bool) {
+	t.mu.RLock()
+	keys := make([]Key, 0, len(t.sessions))
+	sessions := make([]Session, 0, len(t.sessions))
+	for k, s := range t.sessions {
+		keys = append(keys, k)
+		sessions = append(sessions, s)
+	}
+	t.mu.RUnlock()
+	for i, k := range keys {
+		if !fn(k, sessions[i]) {
+			return
+		}
+	}
+}
+
+// All returns an iterator over the sessions, in no particular order.
+// Unlike Range, the Table is not copied but read-locked during the iteration: the loop must not modify the Table.
+func (t *Table) All() iter.Seq2[Key, Session] {
+	return func(yield func(Key, Session) bool) {
+		t.mu.RLock()
+		defer t.mu.RUnlock()
+		for k, s := range t.sessions {
+			if !yield(k, s) {
+				return
+			}
+		}
+	}
+}
+
+// ByPeer returns an iterator over the sessions of a peer, in no particular order (see All).
+func (t *Table) ByPeer(peer netip.Addr) iter.Seq2[Key, Session] {
+	peer = peer.Unmap()
+	return t.filter(func(k Key, s Session) bool {
+		return k.Peer == peer
+	})
+}
+
+// ByPrefix returns an iterator over the sessions whose SID is in the prefix (e.g. a locator),
+// in no particular order (see All).
+func (t *Table) ByPrefix(prefix netip.Prefix) iter.Seq2[Key, Session] {
+	return t.filter(func(k Key, s Session) bool {
+		return s.SID.IsValid() && prefix.Contains(s.SID)
+	})
+}
+
+// filter returns an iterator over the sessions matching fn.
+func (t *Table) filter(fn func(k Key, s Session) bool) iter.Seq2[Key, Session] {
+	return func(yield func(Key, Session) bool) {
+		for k, s := range t.All() {
+			if fn(k, s) && !yield(k, s) {
+				return
+			}
+		}
+	}
+}
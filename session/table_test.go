@@ -0,0 +1,158 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package session
+
+import (
+	"maps"
+	"net/netip"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding"
+)
+
+func TestTable(t *testing.T) {
+	tbl := NewTable()
+	k1 := Key{Peer: netip.MustParseAddr("192.0.2.1"), TEID: 1}
+	k2 := Key{Peer: netip.MustParseAddr("2001:db8::1"), TEID: 1}
+	sid1 := netip.MustParseAddr("2001:db8:e::1")
+	sid2 := netip.MustParseAddr("2001:db8:e::2")
+	s1 := Session{SID: sid1, Args: encoding.NewArgsMobSession(5, false, false, 1)}
+	s2 := Session{SID: sid2, Segments: [][16]byte{{0x20, 0x01, 0x0d, 0xb8, 15: 1}}}
+
+	if err := tbl.Add(k1, s1); err != nil {
+		t.Fatal(err)
+	}
+	if err := tbl.Add(k2, s2); err != nil {
+		t.Fatal(err)
+	}
+	if err := tbl.Add(k1, s2); err != ErrExists {
+		t.Errorf("Expected ErrExists, got %v", err)
+	}
+	if err := tbl.Add(Key{Peer: k1.Peer, TEID: 2}, s1); err != ErrSIDInUse {
+		t.Errorf("Expected ErrSIDInUse, got %v", err)
+	}
+	if err := tbl.Add(Key{TEID: 2}, s1); err != ErrInvalidKey {
+		t.Errorf("Expected ErrInvalidKey, got %v", err)
+	}
+	if tbl.Len() != 2 {
+		t.Errorf("Unexpected length: %d", tbl.Len())
+	}
+
+	// IPv4-mapped IPv6 addresses are unmapped
+	s, ok := tbl.Lookup(Key{Peer: netip.MustParseAddr("::ffff:192.0.2.1"), TEID: 1})
+	if !ok {
+		t.Fatal("Session not found")
+	}
+	if s.SID != sid1 || s.Args.QFI() != 5 {
+		t.Errorf("Unexpected session: %+v", s)
+	}
+	k, s, ok := tbl.LookupSID(sid2)
+	if !ok || k != k2 || !cmp.Equal(s.Segments, s2.Segments) {
+		t.Errorf("Unexpected session: %+v, %+v, %t", k, s, ok)
+	}
+
+	// update
+	if err := tbl.Update(k1, s2); err != ErrSIDInUse {
+		t.Errorf("Expected ErrSIDInUse, got %v", err)
+	}
+	sid3 := netip.MustParseAddr("2001:db8:e::3")
+	if err := tbl.Update(k1, Session{SID: sid3}); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, ok := tbl.LookupSID(sid1); ok {
+		t.Error("Previous SID should be released")
+	}
+	if k, _, ok := tbl.LookupSID(sid3); !ok || k != k1 {
+		t.Errorf("Unexpected key for the new SID: %+v, %t", k, ok)
+	}
+	if err := tbl.Update(Key{Peer: k1.Peer, TEID: 3}, s1); err != ErrNotFound {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+
+	// iteration
+	seen := make(map[Key]netip.Addr)
+	tbl.Range(func(k Key, s Session) bool {
+		seen[k] = s.SID
+		return true
+	})
+	if diff := cmp.Diff(seen, map[Key]netip.Addr{k1: sid3, k2: sid2}, cmp.Comparer(func(a, b netip.Addr) bool { return a == b })); diff != "" {
+		t.Error(diff)
+	}
+	n := 0
+	tbl.Range(func(k Key, s Session) bool {
+		n++
+		return tbl.Delete(k) != nil
+	})
+	if n != 1 || tbl.Len() != 1 {
+		t.Errorf("Range should stop when fn returns false: %d calls, %d sessions", n, tbl.Len())
+	}
+	if err := tbl.Delete(Key{Peer: k1.Peer, TEID: 3}); err != ErrNotFound {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestTableIterators(t *testing.T) {
+	tbl := NewTable()
+	k1 := Key{Peer: netip.MustParseAddr("192.0.2.1"), TEID: 1}
+	k2 := Key{Peer: netip.MustParseAddr("192.0.2.1"), TEID: 2}
+	k3 := Key{Peer: netip.MustParseAddr("192.0.2.2"), TEID: 1}
+	s1 := Session{SID: netip.MustParseAddr("2001:db8:1::1")}
+	s2 := Session{SID: netip.MustParseAddr("2001:db8:2::1")}
+	s3 := Session{}
+	for k, s := range map[Key]Session{k1: s1, k2: s2, k3: s3} {
+		if err := tbl.Add(k, s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	addrs := cmp.Comparer(func(a, b netip.Addr) bool { return a == b })
+	if diff := cmp.Diff(maps.Collect(tbl.All()), map[Key]Session{k1: s1, k2: s2, k3: s3}, addrs); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(maps.Collect(tbl.ByPeer(netip.MustParseAddr("::ffff:192.0.2.1"))), map[Key]Session{k1: s1, k2: s2}, addrs); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(maps.Collect(tbl.ByPrefix(netip.MustParsePrefix("2001:db8:2::/48"))), map[Key]Session{k2: s2}, addrs); diff != "" {
+		t.Error(diff)
+	}
+	n := 0
+	for range tbl.All() {
+		n++
+		break
+	}
+	if n != 1 {
+		t.Errorf("Iteration should stop on break: %d", n)
+	}
+}
+
+func TestTableConcurrent(t *testing.T) {
+	tbl := NewTable()
+	peer := netip.MustParseAddr("192.0.2.1")
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				k := Key{Peer: peer, TEID: uint32(i*100 + j)}
+				sid := netip.AddrFrom16([16]byte{0x20, 0x01, 0x0d, 0xb8, 14: byte(i), 15: byte(j)})
+				if err := tbl.Add(k, Session{SID: sid}); err != nil {
+					t.Error(err)
+					return
+				}
+				if _, ok := tbl.Lookup(k); !ok {
+					t.Error("Session not found")
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	if tbl.Len() != 400 {
+		t.Errorf("Unexpected length: %d", tbl.Len())
+	}
+}
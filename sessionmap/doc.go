@@ -0,0 +1,28 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package sessionmap exports sessions into pinned eBPF maps, so an XDP or tc program
+// can do the data-plane translation while Go remains the control plane.
+//
+// Two hash maps share the same value, an Entry in a fixed layout:
+// the SID map is keyed by the SID (16 bytes), for End.M.GTP4.E,
+// and the peer map is keyed by the IPv4 address of the GTP-U peer followed by the TEID (8 bytes),
+// for H.M.GTP4.D. Multi-byte fields are in network byte order:
+//
+//	struct rfc9433_session {
+//		__u8   sid[16];
+//		__u8   ipv4[4];
+//		__be32 teid;
+//		__be16 udp_port;
+//		__u8   qfi;
+//		__u8   flags; /* 0x01: R, 0x02: U */
+//		__u8   reserved[4];
+//	};
+//
+//	struct rfc9433_peer_key {
+//		__u8   ipv4[4];
+//		__be32 teid;
+//	};
+package sessionmap
@@ -0,0 +1,149 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package sessionmap
+
+import (
+	"encoding/binary"
+	"net/netip"
+
+	"github.com/nextmn/rfc9433/session"
+)
+
+const (
+	// EntryLen is the size of an Entry in bytes.
+	EntryLen = 32
+	// SIDKeyLen is the size of the keys of the SID map in bytes.
+	SIDKeyLen = 16
+	// PeerKeyLen is the size of the keys of the peer map in bytes.
+	PeerKeyLen = 8
+
+	// Byte positions of the fields
+	sidPosByte     = 0
+	ipv4PosByte    = 16
+	teidPosByte    = 20
+	udpPortPosByte = 24
+	qfiPosByte     = 26
+	flagsPosByte   = 27
+
+	// Field Flags
+	rMask = 0x01
+	uMask = 0x02
+)
+
+// Entry is a session, as seen by the data plane.
+type Entry struct {
+	SID     netip.Addr // IPv6 SID of the session
+	IPv4    netip.Addr // IPv4 address of the GTP-U peer
+	TEID    uint32
+	QFI     uint8
+	R       bool   // Reflective QoS Indication
+	U       bool   // reserved bit of Args.Mob.Session
+	UDPPort uint16 // UDP source port of the GTP-U packets
+}
+
+// NewEntry creates an Entry from a session of a session.Table, whose peer must be an IPv4 address.
+func NewEntry(k session.Key, s session.Session, udpPort uint16) (*Entry, error) {
+	e := &Entry{
+		SID:     s.SID,
+		IPv4:    k.Peer.Unmap(),
+		TEID:    k.TEID,
+		UDPPort: udpPort,
+	}
+	if s.Args != nil {
+		e.QFI = s.Args.QFI()
+		e.R = s.Args.R()
+		e.U = s.Args.U()
+	}
+	if err := e.validate(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// validate checks the addresses of the Entry.
+func (e *Entry) validate() error {
+	if !e.SID.Is6() || e.SID.Is4In6() || !e.IPv4.Is4() {
+		return ErrInvalidEntry
+	}
+	return nil
+}
+
+// SIDKey returns the key of the Entry in the SID map.
+func (e *Entry) SIDKey() []byte {
+	sid := e.SID.As16()
+	return sid[:]
+}
+
+// PeerKey returns the key of the Entry in the peer map.
+func (e *Entry) PeerKey() []byte {
+	ipv4 := e.IPv4.As4()
+	return binary.BigEndian.AppendUint32(ipv4[:], e.TEID)
+}
+
+// MarshalLen returns the serial length of Entry.
+func (e *Entry) MarshalLen() int {
+	return EntryLen
+}
+
+// Marshal returns the byte sequence generated from Entry.
+func (e *Entry) Marshal() ([]byte, error) {
+	b := make([]byte, e.MarshalLen())
+	if err := e.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (e *Entry) MarshalTo(b []byte) error {
+	if len(b) < EntryLen {
+		return ErrTooShortToMarshal
+	}
+	if err := e.validate(); err != nil {
+		return err
+	}
+	clear(b[:EntryLen])
+	sid := e.SID.As16()
+	copy(b[sidPosByte:], sid[:])
+	ipv4 := e.IPv4.As4()
+	copy(b[ipv4PosByte:], ipv4[:])
+	binary.BigEndian.PutUint32(b[teidPosByte:teidPosByte+4], e.TEID)
+	binary.BigEndian.PutUint16(b[udpPortPosByte:udpPortPosByte+2], e.UDPPort)
+	b[qfiPosByte] = e.QFI
+	if e.R {
+		b[flagsPosByte] |= rMask
+	}
+	if e.U {
+		b[flagsPosByte] |= uMask
+	}
+	return nil
+}
+
+// ParseEntry parses a given byte sequence as an Entry.
+func ParseEntry(b []byte) (*Entry, error) {
+	e := &Entry{}
+	if err := e.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in an Entry.
+func (e *Entry) UnmarshalBinary(b []byte) error {
+	if len(b) < EntryLen {
+		return ErrTooShortToParse
+	}
+	*e = Entry{
+		SID:     netip.AddrFrom16([16]byte(b[sidPosByte : sidPosByte+16])),
+		IPv4:    netip.AddrFrom4([4]byte(b[ipv4PosByte : ipv4PosByte+4])),
+		TEID:    binary.BigEndian.Uint32(b[teidPosByte : teidPosByte+4]),
+		UDPPort: binary.BigEndian.Uint16(b[udpPortPosByte : udpPortPosByte+2]),
+		QFI:     b[qfiPosByte],
+		R:       b[flagsPosByte]&rMask != 0,
+		U:       b[flagsPosByte]&uMask != 0,
+	}
+	return nil
+}
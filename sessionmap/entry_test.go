@@ -0,0 +1,69 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package sessionmap
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/session"
+)
+
+func TestEntry(t *testing.T) {
+	e, err := NewEntry(
+		session.Key{Peer: netip.MustParseAddr("::ffff:192.0.2.1"), TEID: 0x01020304},
+		session.Session{SID: netip.MustParseAddr("fd00:db8::1"), Args: encoding.NewArgsMobSession(9, true, false, 0x01020304)},
+		2152,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := e.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{
+		0xfd, 0x00, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x01,
+		192, 0, 2, 1,
+		0x01, 0x02, 0x03, 0x04,
+		0x08, 0x68,
+		9,
+		0x01,
+		0, 0, 0, 0,
+	}
+	if diff := cmp.Diff(want, b); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff([]byte{192, 0, 2, 1, 0x01, 0x02, 0x03, 0x04}, e.PeerKey()); diff != "" {
+		t.Error(diff)
+	}
+	parsed, err := ParseEntry(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(e, parsed, cmp.Comparer(func(a, b netip.Addr) bool { return a == b })); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestEntryErrors(t *testing.T) {
+	if _, err := NewEntry(session.Key{Peer: netip.MustParseAddr("2001:db8::1")}, session.Session{SID: netip.MustParseAddr("fd00::1")}, 0); !errors.Is(err, ErrInvalidEntry) {
+		t.Errorf("IPv6 peer should be rejected: %v", err)
+	}
+	if _, err := NewEntry(session.Key{Peer: netip.MustParseAddr("192.0.2.1")}, session.Session{}, 0); !errors.Is(err, ErrInvalidEntry) {
+		t.Errorf("missing SID should be rejected: %v", err)
+	}
+	e := &Entry{SID: netip.MustParseAddr("fd00::1"), IPv4: netip.MustParseAddr("192.0.2.1")}
+	if err := e.MarshalTo(make([]byte, EntryLen-1)); !errors.Is(err, ErrTooShortToMarshal) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := ParseEntry(make([]byte, EntryLen-1)); !errors.Is(err, ErrTooShortToParse) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,15 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package sessionmap
+
+import "errors"
+
+var (
+	ErrTooShortToMarshal = errors.New("too short to marshal")
+	ErrTooShortToParse   = errors.New("too short to parse")
+	ErrInvalidEntry      = errors.New("invalid session entry")
+	ErrInvalidMap        = errors.New("map does not match the session layout")
+)
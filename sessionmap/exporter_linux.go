@@ -0,0 +1,149 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package sessionmap
+
+import (
+	"errors"
+
+	"github.com/nextmn/rfc9433/ebpf"
+	"github.com/nextmn/rfc9433/session"
+)
+
+// SIDMapSpec returns the description of the SID map.
+func SIDMapSpec(maxEntries uint32) ebpf.MapSpec {
+	return ebpf.MapSpec{
+		Name:       "rfc9433_sids",
+		Type:       ebpf.MapTypeHash,
+		KeySize:    SIDKeyLen,
+		ValueSize:  EntryLen,
+		MaxEntries: maxEntries,
+	}
+}
+
+// PeerMapSpec returns the description of the peer map.
+func PeerMapSpec(maxEntries uint32) ebpf.MapSpec {
+	return ebpf.MapSpec{
+		Name:       "rfc9433_peers",
+		Type:       ebpf.MapTypeHash,
+		KeySize:    PeerKeyLen,
+		ValueSize:  EntryLen,
+		MaxEntries: maxEntries,
+	}
+}
+
+// Exporter writes Entries into the SID map and the peer map.
+type Exporter struct {
+	sids  *ebpf.Map
+	peers *ebpf.Map
+}
+
+// newExporter creates an Exporter, after checking the layout of the maps.
+func newExporter(sids, peers *ebpf.Map) (*Exporter, error) {
+	if s := sids.Spec(); s.KeySize != SIDKeyLen || s.ValueSize != EntryLen {
+		return nil, ErrInvalidMap
+	}
+	if s := peers.Spec(); s.KeySize != PeerKeyLen || s.ValueSize != EntryLen {
+		return nil, ErrInvalidMap
+	}
+	return &Exporter{sids: sids, peers: peers}, nil
+}
+
+// OpenExporter creates an Exporter using maps pinned in the BPF file system (e.g. by the loader of the data plane).
+func OpenExporter(sidMapPath, peerMapPath string) (*Exporter, error) {
+	sids, err := ebpf.OpenPinnedMap(sidMapPath)
+	if err != nil {
+		return nil, err
+	}
+	peers, err := ebpf.OpenPinnedMap(peerMapPath)
+	if err != nil {
+		return nil, errors.Join(err, sids.Close())
+	}
+	x, err := newExporter(sids, peers)
+	if err != nil {
+		return nil, errors.Join(err, sids.Close(), peers.Close())
+	}
+	return x, nil
+}
+
+// CreateExporter creates the maps, pins them in the BPF file system, and returns their Exporter.
+func CreateExporter(sidMapPath, peerMapPath string, maxEntries uint32) (*Exporter, error) {
+	sids, err := ebpf.NewMap(SIDMapSpec(maxEntries))
+	if err != nil {
+		return nil, err
+	}
+	peers, err := ebpf.NewMap(PeerMapSpec(maxEntries))
+	if err != nil {
+		return nil, errors.Join(err, sids.Close())
+	}
+	if err := sids.Pin(sidMapPath); err != nil {
+		return nil, errors.Join(err, sids.Close(), peers.Close())
+	}
+	if err := peers.Pin(peerMapPath); err != nil {
+		return nil, errors.Join(err, sids.Close(), peers.Close())
+	}
+	return &Exporter{sids: sids, peers: peers}, nil
+}
+
+// Put adds or updates an Entry in both maps.
+func (x *Exporter) Put(e *Entry) error {
+	value, err := e.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := x.sids.Update(e.SIDKey(), value, ebpf.UpdateAny); err != nil {
+		return err
+	}
+	return x.peers.Update(e.PeerKey(), value, ebpf.UpdateAny)
+}
+
+// Delete removes an Entry from both maps. Missing elements are ignored.
+func (x *Exporter) Delete(e *Entry) error {
+	if err := e.validate(); err != nil {
+		return err
+	}
+	if err := x.sids.Delete(e.SIDKey()); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+		return err
+	}
+	if err := x.peers.Delete(e.PeerKey()); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+		return err
+	}
+	return nil
+}
+
+// Lookup returns the Entry of a SID.
+func (x *Exporter) Lookup(sid [16]byte) (*Entry, error) {
+	value := make([]byte, EntryLen)
+	if err := x.sids.Lookup(sid[:], value); err != nil {
+		return nil, err
+	}
+	return ParseEntry(value)
+}
+
+// Export puts the sessions of the table whose peer is an IPv4 address and SID is set;
+// other sessions are not handled by the data plane, and are skipped.
+// udpPort returns the UDP source port of a session.
+func (x *Exporter) Export(t *session.Table, udpPort func(k session.Key) uint16) error {
+	var err error
+	t.Range(func(k session.Key, s session.Session) bool {
+		if !k.Peer.Is4() || !s.SID.Is6() {
+			return true
+		}
+		var e *Entry
+		if e, err = NewEntry(k, s, udpPort(k)); err != nil {
+			return false
+		}
+		err = x.Put(e)
+		return err == nil
+	})
+	return err
+}
+
+// Close closes the maps, which remain pinned.
+func (x *Exporter) Close() error {
+	return errors.Join(x.sids.Close(), x.peers.Close())
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+//go:build linux
+
+package sessionmap
+
+import (
+	"errors"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/ebpf"
+	"github.com/nextmn/rfc9433/encoding"
+	"github.com/nextmn/rfc9433/session"
+	"golang.org/x/sys/unix"
+)
+
+// newTestMap creates a map, or skips the test if eBPF is not available.
+func newTestMap(t *testing.T, spec ebpf.MapSpec) *ebpf.Map {
+	t.Helper()
+	m, err := ebpf.NewMap(spec)
+	if errors.Is(err, unix.EPERM) || errors.Is(err, unix.ENOSYS) {
+		t.Skip("CAP_BPF is required")
+	} else if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { m.Close() })
+	return m
+}
+
+func TestExporter(t *testing.T) {
+	x, err := newExporter(newTestMap(t, SIDMapSpec(16)), newTestMap(t, PeerMapSpec(16)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	table := session.NewTable()
+	k := session.Key{Peer: netip.MustParseAddr("192.0.2.1"), TEID: 1}
+	sid := netip.MustParseAddr("fd00:db8::1")
+	if err := table.Add(k, session.Session{SID: sid, Args: encoding.NewArgsMobSession(5, false, false, 1)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := table.Add(session.Key{Peer: netip.MustParseAddr("2001:db8::1"), TEID: 2}, session.Session{SID: netip.MustParseAddr("fd00:db8::2")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := x.Export(table, func(session.Key) uint16 { return 50000 }); err != nil {
+		t.Fatal(err)
+	}
+	e, err := x.Lookup(sid.As16())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Entry{SID: sid, IPv4: k.Peer, TEID: 1, QFI: 5, UDPPort: 50000}
+	if diff := cmp.Diff(want, e, cmp.Comparer(func(a, b netip.Addr) bool { return a == b })); diff != "" {
+		t.Error(diff)
+	}
+	value := make([]byte, EntryLen)
+	if err := x.peers.Lookup(e.PeerKey(), value); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := x.Lookup(netip.MustParseAddr("fd00:db8::2").As16()); !errors.Is(err, ebpf.ErrKeyNotExist) {
+		t.Errorf("session with IPv6 peer should be skipped: %v", err)
+	}
+	if err := x.Delete(e); err != nil {
+		t.Fatal(err)
+	}
+	if err := x.Delete(e); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := x.Lookup(sid.As16()); !errors.Is(err, ebpf.ErrKeyNotExist) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := x.peers.Lookup(e.PeerKey(), value); !errors.Is(err, ebpf.ErrKeyNotExist) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestExporterInvalidMap(t *testing.T) {
+	spec := PeerMapSpec(16)
+	spec.ValueSize = EntryLen + 8
+	if _, err := newExporter(newTestMap(t, SIDMapSpec(16)), newTestMap(t, spec)); !errors.Is(err, ErrInvalidMap) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestExporterPinned(t *testing.T) {
+	const bpfFSPath = "/sys/fs/bpf"
+	var fs unix.Statfs_t
+	if err := unix.Statfs(bpfFSPath, &fs); err != nil || uint32(fs.Type) != unix.BPF_FS_MAGIC {
+		t.Skip("BPF file system is not mounted")
+	}
+	suffix := strconv.Itoa(os.Getpid())
+	sidPath := filepath.Join(bpfFSPath, "rfc9433_sids_"+suffix)
+	peerPath := filepath.Join(bpfFSPath, "rfc9433_peers_"+suffix)
+	x, err := CreateExporter(sidPath, peerPath, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(sidPath)
+	defer os.Remove(peerPath)
+	e := &Entry{SID: netip.MustParseAddr("fd00:db8::1"), IPv4: netip.MustParseAddr("192.0.2.1"), TEID: 1}
+	if err := x.Put(e); err != nil {
+		t.Fatal(err)
+	}
+	if err := x.Close(); err != nil {
+		t.Fatal(err)
+	}
+	x, err = OpenExporter(sidPath, peerPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer x.Close()
+	if _, err := x.Lookup(e.SID.As16()); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package srh provides a codec for the Segment Routing Header (RFC 8754),
+// which carries the SIDs of RFC 9433 behaviors.
+package srh
@@ -0,0 +1,18 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package srh
+
+import "errors"
+
+var (
+	ErrTooShortToMarshal = errors.New("too short to serialize")
+	ErrTooShortToParse   = errors.New("too short to parse")
+	ErrMalformedHeader   = errors.New("malformed SRH")
+	ErrTooLong           = errors.New("SRH is too long")
+	ErrUnknownKey        = errors.New("unknown HMAC Key ID")
+	ErrHMACMissing       = errors.New("missing HMAC TLV")
+	ErrHMACMismatch      = errors.New("HMAC verification failed")
+)
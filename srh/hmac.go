@@ -0,0 +1,163 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package srh
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+)
+
+const (
+	hmacFixedLen = 6    // D flag, Reserved and HMAC Key ID in bytes
+	hmacMaxLen   = 32   // maximum size of the HMAC field in bytes
+	hmacDMask    = 0x80 // D flag
+)
+
+func init() {
+	RegisterTLV(TLVTypeHMAC, parseHMACTLV)
+}
+
+// HMACTLV is the HMAC TLV (RFC 8754, section 2.1.2).
+type HMACTLV struct {
+	D     bool   // Destination Address verification is disabled
+	KeyID uint32 // HMAC Key ID
+	HMAC  []byte // HMAC, whose length must be a multiple of 8 bytes, up to 32 bytes
+}
+
+// Type returns TLVTypeHMAC.
+func (h *HMACTLV) Type() uint8 {
+	return TLVTypeHMAC
+}
+
+// MarshalLen returns the serial length of HMACTLV.
+func (h *HMACTLV) MarshalLen() int {
+	return tlvHeaderLen + hmacFixedLen + len(h.HMAC)
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (h *HMACTLV) MarshalTo(b []byte) error {
+	l := h.MarshalLen()
+	if len(b) < l {
+		return ErrTooShortToMarshal
+	}
+	if len(h.HMAC)%8 != 0 || len(h.HMAC) > hmacMaxLen {
+		return ErrMalformedHeader
+	}
+	b[0] = TLVTypeHMAC
+	b[1] = uint8(l - tlvHeaderLen)
+	b[2] = 0
+	if h.D {
+		b[2] = hmacDMask
+	}
+	b[3] = 0
+	binary.BigEndian.PutUint32(b[4:8], h.KeyID)
+	copy(b[tlvHeaderLen+hmacFixedLen:l], h.HMAC)
+	return nil
+}
+
+// parseHMACTLV parses the value of a HMAC TLV.
+func parseHMACTLV(value []byte) (TLV, error) {
+	if len(value) < hmacFixedLen {
+		return nil, ErrTooShortToParse
+	}
+	if (len(value)-hmacFixedLen)%8 != 0 || len(value)-hmacFixedLen > hmacMaxLen {
+		return nil, ErrMalformedHeader
+	}
+	return &HMACTLV{
+		D:     value[0]&hmacDMask != 0,
+		KeyID: binary.BigEndian.Uint32(value[2:6]),
+		HMAC:  append([]byte(nil), value[hmacFixedLen:]...),
+	}, nil
+}
+
+// HMACKey is a pre-shared key of the SR domain.
+type HMACKey struct {
+	Hash   func() hash.Hash // hash function (nil means SHA-256)
+	Secret []byte
+}
+
+// KeyStore returns the HMACKey of a HMAC Key ID.
+type KeyStore interface {
+	Key(keyID uint32) (HMACKey, bool)
+}
+
+// StaticKeys is a KeyStore with a fixed set of keys.
+type StaticKeys map[uint32]HMACKey
+
+// Key returns the HMACKey of keyID.
+func (s StaticKeys) Key(keyID uint32) (HMACKey, bool) {
+	k, ok := s[keyID]
+	return k, ok
+}
+
+// ComputeHMAC computes the HMAC of the SRH for the given IPv6 SA and key (RFC 8754, section 2.1.2.1),
+// truncated to 32 bytes.
+func (s *SRH) ComputeHMAC(src [16]byte, keyID uint32, key HMACKey) []byte {
+	h := key.Hash
+	if h == nil {
+		h = sha256.New
+	}
+	mac := hmac.New(h, key.Secret)
+	var fixed [16 + 1 + 1 + 4]byte
+	copy(fixed[:16], src[:])
+	fixed[16] = uint8(len(s.Segments) - 1)
+	fixed[17] = s.Flags
+	binary.BigEndian.PutUint32(fixed[18:], keyID)
+	mac.Write(fixed[:])
+	for _, seg := range s.Segments {
+		mac.Write(seg[:])
+	}
+	sum := mac.Sum(nil)
+	return sum[:min(len(sum)&^7, hmacMaxLen)]
+}
+
+// SignHMAC adds a HMAC TLV to the SRH, replacing any previous HMAC TLV.
+// Other TLVs are kept, and padding is recomputed.
+func (s *SRH) SignHMAC(src [16]byte, keyID uint32, keys KeyStore) error {
+	key, ok := keys.Key(keyID)
+	if !ok {
+		return ErrUnknownKey
+	}
+	tlvs, err := s.ParseTLVs()
+	if err != nil {
+		return err
+	}
+	// the HMAC TLV is put first, to be 8 bytes aligned
+	r := []TLV{&HMACTLV{KeyID: keyID, HMAC: s.ComputeHMAC(src, keyID, key)}}
+	for _, t := range tlvs {
+		switch t.Type() {
+		case TLVTypePad1, TLVTypePadN, TLVTypeHMAC:
+		default:
+			r = append(r, t)
+		}
+	}
+	return s.SetTLVs(r...)
+}
+
+// VerifyHMAC checks the HMAC TLV of the SRH in constant time.
+func (s *SRH) VerifyHMAC(src [16]byte, keys KeyStore) error {
+	tlvs, err := s.ParseTLVs()
+	if err != nil {
+		return err
+	}
+	for _, t := range tlvs {
+		h, ok := t.(*HMACTLV)
+		if !ok {
+			continue
+		}
+		key, ok := keys.Key(h.KeyID)
+		if !ok {
+			return ErrUnknownKey
+		}
+		if !hmac.Equal(h.HMAC, s.ComputeHMAC(src, h.KeyID, key)) {
+			return ErrHMACMismatch
+		}
+		return nil
+	}
+	return ErrHMACMissing
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package srh
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"net/netip"
+	"testing"
+)
+
+func TestHMAC(t *testing.T) {
+	keys := StaticKeys{1: {Secret: []byte("secret")}}
+	src := netip.MustParseAddr("fd00:1:1::1").As16()
+	s := NewSRH(4, [][16]byte{
+		netip.MustParseAddr("fd00:2:2::1").As16(),
+		netip.MustParseAddr("fd00:3:3::1").As16(),
+	})
+	if err := s.VerifyHMAC(src, keys); err != ErrHMACMissing {
+		t.Errorf("Missing HMAC should be detected: %v", err)
+	}
+	if err := s.SignHMAC(src, 2, keys); err != ErrUnknownKey {
+		t.Errorf("Unknown key should be rejected: %v", err)
+	}
+	if err := s.SetTLVs(&RawTLV{T: 0x81, Value: []byte{1}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SignHMAC(src, 1, keys); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.TLVs) != 48 {
+		t.Errorf("Unexpected TLVs length: %d", len(s.TLVs))
+	}
+
+	// HMAC(src | Last Entry | Flags | Key ID | Segment List)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(src[:])
+	mac.Write([]byte{1, 0, 0, 0, 0, 1})
+	for _, seg := range s.Segments {
+		mac.Write(seg[:])
+	}
+	tlvs, err := s.ParseTLVs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h, ok := tlvs[0].(*HMACTLV); !ok || h.KeyID != 1 || !hmac.Equal(h.HMAC, mac.Sum(nil)) {
+		t.Errorf("Unexpected HMAC TLV: %v", tlvs[0])
+	}
+	if r, ok := tlvs[1].(*RawTLV); !ok || r.T != 0x81 {
+		t.Errorf("Other TLVs should be kept: %v", tlvs)
+	}
+
+	b, err := s.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.VerifyHMAC(src, keys); err != nil {
+		t.Errorf("Valid HMAC should be accepted: %v", err)
+	}
+	p.Segments[0][15] = 2
+	if err := p.VerifyHMAC(src, keys); err != ErrHMACMismatch {
+		t.Errorf("Modified segment list should be detected: %v", err)
+	}
+	if err := s.VerifyHMAC(netip.MustParseAddr("fd00:1:1::2").As16(), keys); err != ErrHMACMismatch {
+		t.Errorf("Modified source address should be detected: %v", err)
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package srh
+
+import "encoding/binary"
+
+const (
+	// ProtocolNumber is the Next Header value of the Routing Header (IPv6-Route).
+	ProtocolNumber = 43
+	// RoutingType is the Routing Type of the Segment Routing Header.
+	RoutingType = 4
+
+	fixedLen   = 8  // size of the SRH without segments and TLVs in bytes
+	segmentLen = 16 // size of a segment in bytes
+	maxLen     = fixedLen + 8*0xFF
+
+	// Byte positions of the fields
+	nextHeaderPosByte   = 0
+	hdrExtLenPosByte    = 1
+	routingTypePosByte  = 2
+	segmentsLeftPosByte = 3
+	lastEntryPosByte    = 4
+	flagsPosByte        = 5
+	tagPosByte          = 6
+)
+
+// SRH is a Segment Routing Header as defined in RFC 8754, section 2.
+//
+//	 0                   1                   2                   3
+//	 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1
+//	+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+//	| Next Header   |  Hdr Ext Len  | Routing Type  | Segments Left |
+//	+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+//	|  Last Entry   |     Flags     |              Tag              |
+//	+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+//	|            Segment List[0] (128-bit IPv6 address)             |
+//	|                              ...                              |
+//	|            Segment List[n] (128-bit IPv6 address)             |
+//	+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+//	//         Optional Type Length Value objects (variable)       //
+//	+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+//
+// Segments are in SRH order: Segments[0] is the last segment of the path.
+// Last Entry and Hdr Ext Len are computed when marshaling.
+// In a reduced SRH (see NewReducedSRH), the first segment of the path is only carried in the IPv6 DA,
+// and SegmentsLeft is equal to len(Segments).
+type SRH struct {
+	NextHeader   uint8
+	SegmentsLeft uint8
+	Flags        uint8
+	Tag          uint16
+	Segments     [][16]byte
+	TLVs         []byte // TLVs, whose length must be a multiple of 8 bytes
+}
+
+// NewSRH creates a SRH for the given path, whose first segment is the active segment.
+func NewSRH(nextHeader uint8, path [][16]byte) *SRH {
+	segments := make([][16]byte, len(path))
+	for i, s := range path {
+		segments[len(path)-1-i] = s
+	}
+	return &SRH{
+		NextHeader:   nextHeader,
+		SegmentsLeft: uint8(max(len(path)-1, 0)),
+		Segments:     segments,
+	}
+}
+
+// NewReducedSRH creates a reduced SRH for the given path, as done by H.Encaps.Red (RFC 8986, section 5.2):
+// the first segment is returned as IPv6 DA, and is omitted from the SRH.
+// If the path has a single segment, no SRH is needed and the returned SRH is nil.
+func NewReducedSRH(nextHeader uint8, path [][16]byte) ([16]byte, *SRH) {
+	if len(path) == 0 {
+		return [16]byte{}, nil
+	}
+	if len(path) == 1 {
+		return path[0], nil
+	}
+	s := NewSRH(nextHeader, path[1:])
+	s.SegmentsLeft = uint8(len(path) - 1)
+	return path[0], s
+}
+
+// Reduced returns true if the first segment of the path is omitted from the SRH.
+func (s *SRH) Reduced() bool {
+	return int(s.SegmentsLeft) == len(s.Segments)
+}
+
+// Expand returns the SRH with the full segment list, given the IPv6 DA of the packet:
+// if the SRH is reduced, the IPv6 DA is the omitted segment.
+func (s *SRH) Expand(da [16]byte) *SRH {
+	r := *s
+	r.Segments = append(make([][16]byte, 0, len(s.Segments)+1), s.Segments...)
+	if s.Reduced() {
+		r.Segments = append(r.Segments, da)
+	}
+	return &r
+}
+
+// ActiveSegment returns the segment at index SegmentsLeft,
+// and false if SegmentsLeft is out of the segment list (e.g. in a reduced SRH, see Expand).
+func (s *SRH) ActiveSegment() ([16]byte, bool) {
+	if int(s.SegmentsLeft) >= len(s.Segments) {
+		return [16]byte{}, false
+	}
+	return s.Segments[s.SegmentsLeft], true
+}
+
+// Path returns the segments in the order they are visited.
+func (s *SRH) Path() [][16]byte {
+	path := make([][16]byte, len(s.Segments))
+	for i, seg := range s.Segments {
+		path[len(s.Segments)-1-i] = seg
+	}
+	return path
+}
+
+// MarshalLen returns the serial length of SRH.
+func (s *SRH) MarshalLen() int {
+	return fixedLen + segmentLen*len(s.Segments) + len(s.TLVs)
+}
+
+// Marshal returns the byte sequence generated from SRH.
+func (s *SRH) Marshal() ([]byte, error) {
+	b := make([]byte, s.MarshalLen())
+	if err := s.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (s *SRH) MarshalTo(b []byte) error {
+	l := s.MarshalLen()
+	if len(b) < l {
+		return ErrTooShortToMarshal
+	}
+	if len(s.Segments) == 0 || int(s.SegmentsLeft) > len(s.Segments) || len(s.TLVs)%8 != 0 {
+		return ErrMalformedHeader
+	}
+	if l > maxLen {
+		return ErrTooLong
+	}
+	b[nextHeaderPosByte] = s.NextHeader
+	b[hdrExtLenPosByte] = uint8((l - fixedLen) / 8)
+	b[routingTypePosByte] = RoutingType
+	b[segmentsLeftPosByte] = s.SegmentsLeft
+	b[lastEntryPosByte] = uint8(len(s.Segments) - 1)
+	b[flagsPosByte] = s.Flags
+	binary.BigEndian.PutUint16(b[tagPosByte:tagPosByte+2], s.Tag)
+	for i, seg := range s.Segments {
+		copy(b[fixedLen+segmentLen*i:], seg[:])
+	}
+	copy(b[fixedLen+segmentLen*len(s.Segments):l], s.TLVs)
+	return nil
+}
+
+// Parse parses a given byte sequence as a SRH.
+// The payload starts at offset MarshalLen() of b.
+func Parse(b []byte) (*SRH, error) {
+	s := &SRH{}
+	if err := s.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in a SRH.
+func (s *SRH) UnmarshalBinary(b []byte) error {
+	r := SRH{}
+	if err := r.Decode(b); err != nil {
+		return err
+	}
+	if len(r.TLVs) > 0 {
+		r.TLVs = append([]byte(nil), r.TLVs...)
+	}
+	*s = r
+	return nil
+}
+
+// Decode sets the values retrieved from byte sequence in a SRH, like UnmarshalBinary, without allocating
+// once s.Segments has enough capacity: s.Segments is reused, and s.TLVs references b.
+// s is not modified if b is not a valid SRH.
+func (s *SRH) Decode(b []byte) error {
+	if len(b) < fixedLen {
+		return ErrTooShortToParse
+	}
+	if b[routingTypePosByte] != RoutingType {
+		return ErrMalformedHeader
+	}
+	l := fixedLen + 8*int(b[hdrExtLenPosByte])
+	if len(b) < l {
+		return ErrTooShortToParse
+	}
+	n := int(b[lastEntryPosByte]) + 1
+	if fixedLen+segmentLen*n > l || int(b[segmentsLeftPosByte]) > n {
+		return ErrMalformedHeader
+	}
+	s.NextHeader = b[nextHeaderPosByte]
+	s.SegmentsLeft = b[segmentsLeftPosByte]
+	s.Flags = b[flagsPosByte]
+	s.Tag = binary.BigEndian.Uint16(b[tagPosByte : tagPosByte+2])
+	s.Segments = s.Segments[:0]
+	for i := 0; i < n; i++ {
+		s.Segments = append(s.Segments, [16]byte(b[fixedLen+segmentLen*i:fixedLen+segmentLen*(i+1)]))
+	}
+	s.TLVs = nil
+	if tlvs := b[fixedLen+segmentLen*n : l : l]; len(tlvs) > 0 {
+		s.TLVs = tlvs
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package srh
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSRH(t *testing.T) {
+	path := [][16]byte{
+		netip.MustParseAddr("fd00:1:1::1").As16(),
+		netip.MustParseAddr("fd00:2:2::1").As16(),
+	}
+	s := NewSRH(4, path)
+	s.Tag = 0x1234
+	b, err := s.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := []byte{
+		0x04, 0x04, 0x04, 0x01,
+		0x01, 0x00, 0x12, 0x34,
+		0xfd, 0x00, 0x00, 0x02, 0x00, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+		0xfd, 0x00, 0x00, 0x01, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+	}
+	if diff := cmp.Diff(b, res); diff != "" {
+		t.Error(diff)
+	}
+	p, err := Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(p, s); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(p.Path(), path); diff != "" {
+		t.Error(diff)
+	}
+	if a, ok := p.ActiveSegment(); !ok || a != path[0] {
+		t.Errorf("Unexpected active segment: %s", netip.AddrFrom16(a))
+	}
+
+	s.TLVs = []byte{0x04, 0x06, 0, 0, 0, 0, 0, 0}
+	b, err = s.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b[hdrExtLenPosByte] != 5 {
+		t.Errorf("Unexpected Hdr Ext Len: %d", b[hdrExtLenPosByte])
+	}
+	p, err = Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(p, s); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestSRHErrors(t *testing.T) {
+	if _, err := (&SRH{}).Marshal(); err != ErrMalformedHeader {
+		t.Errorf("Empty segment list should be rejected: %v", err)
+	}
+	if _, err := (&SRH{Segments: make([][16]byte, 128)}).Marshal(); err != ErrTooLong {
+		t.Errorf("Too many segments should be rejected: %v", err)
+	}
+	tests := []struct {
+		b   []byte
+		err error
+	}{
+		{b: []byte{0x04, 0x02, 0x04, 0x00}, err: ErrTooShortToParse},
+		{b: []byte{0x04, 0x02, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00}, err: ErrMalformedHeader},
+		{b: []byte{0x04, 0x02, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00}, err: ErrTooShortToParse},
+		{b: append([]byte{0x04, 0x02, 0x04, 0x00, 0x01, 0x00, 0x00, 0x00}, make([]byte, 16)...), err: ErrMalformedHeader},
+	}
+	for i, tc := range tests {
+		if _, err := Parse(tc.b); err != tc.err {
+			t.Errorf("test %d: expected error %v, got %v", i, tc.err, err)
+		}
+	}
+}
+
+func TestSRHDecode(t *testing.T) {
+	s := NewSRH(4, [][16]byte{{0x20, 0x01, 0x0d, 0xb8, 15: 1}, {0x20, 0x01, 0x0d, 0xb8, 15: 2}})
+	s.TLVs = []byte{TLVTypePadN, 6, 0, 0, 0, 0, 0, 0}
+	b, err := s.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &SRH{}
+	if err := d.Decode(b); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(d, s); diff != "" {
+		t.Error(diff)
+	}
+	if &d.TLVs[0] != &b[len(b)-8] {
+		t.Error("TLVs should reference the decoded bytes")
+	}
+	if n := testing.AllocsPerRun(100, func() { d.Decode(b) }); n != 0 {
+		t.Errorf("Decode should not allocate: %v allocations", n)
+	}
+	if err := d.Decode(b[:8]); err != ErrTooShortToParse {
+		t.Errorf("Expected ErrTooShortToParse, got %v", err)
+	}
+	if diff := cmp.Diff(d, s); diff != "" {
+		t.Errorf("SRH should not be modified on error: %s", diff)
+	}
+}
+
+func TestReducedSRH(t *testing.T) {
+	path := [][16]byte{
+		netip.MustParseAddr("fd00:1:1::1").As16(),
+		netip.MustParseAddr("fd00:2:2::1").As16(),
+		netip.MustParseAddr("fd00:3:3::1").As16(),
+	}
+	da, s := NewReducedSRH(4, path)
+	if da != path[0] {
+		t.Errorf("Unexpected IPv6 DA: %s", netip.AddrFrom16(da))
+	}
+	b, err := s.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) != fixedLen+2*segmentLen || b[segmentsLeftPosByte] != 2 || b[lastEntryPosByte] != 1 {
+		t.Errorf("Unexpected reduced SRH: %v", b)
+	}
+	p, err := Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.Reduced() {
+		t.Error("SRH should be reduced")
+	}
+	full := p.Expand(da)
+	if diff := cmp.Diff(full, NewSRH(4, path)); diff != "" {
+		t.Error(diff)
+	}
+	if full.Reduced() {
+		t.Error("Expanded SRH should not be reduced")
+	}
+	if _, s := NewReducedSRH(4, path[:1]); s != nil {
+		t.Error("Single segment path should not need a SRH")
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package srh
+
+import "sync"
+
+// TLV types (RFC 8754, section 2.1).
+const (
+	TLVTypePad1 = 0
+	TLVTypePadN = 4
+	TLVTypeHMAC = 5
+)
+
+const tlvHeaderLen = 2 // Type and Length
+
+// TLV is a Type Length Value object of the SRH (RFC 8754, section 2.1).
+type TLV interface {
+	// Type returns the TLV type.
+	Type() uint8
+	// MarshalLen returns the serial length of the TLV, including Type and Length.
+	MarshalLen() int
+	// MarshalTo puts the byte sequence of the TLV, including Type and Length, in b.
+	MarshalTo(b []byte) error
+}
+
+// TLVParser parses the value of a TLV.
+type TLVParser func(value []byte) (TLV, error)
+
+var (
+	tlvParsersMu sync.RWMutex
+	tlvParsers   = map[uint8]TLVParser{}
+)
+
+// RegisterTLV registers the parser used by ParseTLVs for TLVs of type t,
+// replacing the previous one. TLVs without registered parser are parsed as RawTLV.
+func RegisterTLV(t uint8, parser TLVParser) {
+	tlvParsersMu.Lock()
+	defer tlvParsersMu.Unlock()
+	tlvParsers[t] = parser
+}
+
+// Pad1 is a single byte of padding.
+type Pad1 struct{}
+
+// Type returns TLVTypePad1.
+func (Pad1) Type() uint8 {
+	return TLVTypePad1
+}
+
+// MarshalLen returns the serial length of Pad1.
+func (Pad1) MarshalLen() int {
+	return 1
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (Pad1) MarshalTo(b []byte) error {
+	if len(b) < 1 {
+		return ErrTooShortToMarshal
+	}
+	b[0] = TLVTypePad1
+	return nil
+}
+
+// PadN is a padding of Len bytes of zeroes, in addition to Type and Length.
+type PadN struct {
+	Len int
+}
+
+// Type returns TLVTypePadN.
+func (PadN) Type() uint8 {
+	return TLVTypePadN
+}
+
+// MarshalLen returns the serial length of PadN.
+func (p PadN) MarshalLen() int {
+	return tlvHeaderLen + p.Len
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (p PadN) MarshalTo(b []byte) error {
+	if len(b) < p.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	if p.Len < 0 || p.Len > 0xFF {
+		return ErrMalformedHeader
+	}
+	b[0] = TLVTypePadN
+	b[1] = uint8(p.Len)
+	clear(b[tlvHeaderLen:p.MarshalLen()])
+	return nil
+}
+
+// RawTLV is a TLV whose value is not interpreted (e.g. an opaque or vendor TLV).
+type RawTLV struct {
+	T     uint8
+	Value []byte
+}
+
+// Type returns the TLV type.
+func (r *RawTLV) Type() uint8 {
+	return r.T
+}
+
+// MarshalLen returns the serial length of RawTLV.
+func (r *RawTLV) MarshalLen() int {
+	return tlvHeaderLen + len(r.Value)
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (r *RawTLV) MarshalTo(b []byte) error {
+	if len(b) < r.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	if len(r.Value) > 0xFF || r.T == TLVTypePad1 {
+		return ErrMalformedHeader
+	}
+	b[0] = r.T
+	b[1] = uint8(len(r.Value))
+	copy(b[tlvHeaderLen:], r.Value)
+	return nil
+}
+
+// MarshalTLVs returns the byte sequence generated from tlvs,
+// padded with Pad1 or PadN to a multiple of 8 bytes.
+func MarshalTLVs(tlvs ...TLV) ([]byte, error) {
+	l := 0
+	for _, t := range tlvs {
+		l += t.MarshalLen()
+	}
+	var pad TLV
+	switch p := (8 - l%8) % 8; p {
+	case 0:
+	case 1:
+		pad = Pad1{}
+	default:
+		pad = PadN{Len: p - tlvHeaderLen}
+	}
+	if pad != nil {
+		tlvs = append(tlvs[:len(tlvs):len(tlvs)], pad)
+		l += pad.MarshalLen()
+	}
+	b := make([]byte, l)
+	off := 0
+	for _, t := range tlvs {
+		if err := t.MarshalTo(b[off:]); err != nil {
+			return nil, err
+		}
+		off += t.MarshalLen()
+	}
+	return b, nil
+}
+
+// ParseTLVs parses a given byte sequence as a list of TLVs, using the parsers registered with RegisterTLV.
+// Padding (Pad1 and PadN) is included in the result.
+func ParseTLVs(b []byte) ([]TLV, error) {
+	var tlvs []TLV
+	for len(b) > 0 {
+		if b[0] == TLVTypePad1 {
+			tlvs = append(tlvs, Pad1{})
+			b = b[1:]
+			continue
+		}
+		if len(b) < tlvHeaderLen {
+			return nil, ErrTooShortToParse
+		}
+		l := tlvHeaderLen + int(b[1])
+		if len(b) < l {
+			return nil, ErrTooShortToParse
+		}
+		t, err := parseTLV(b[0], b[tlvHeaderLen:l])
+		if err != nil {
+			return nil, err
+		}
+		tlvs = append(tlvs, t)
+		b = b[l:]
+	}
+	return tlvs, nil
+}
+
+// parseTLV parses the value of a TLV of type t.
+func parseTLV(t uint8, value []byte) (TLV, error) {
+	if t == TLVTypePadN {
+		return PadN{Len: len(value)}, nil
+	}
+	tlvParsersMu.RLock()
+	parser, ok := tlvParsers[t]
+	tlvParsersMu.RUnlock()
+	if ok {
+		return parser(value)
+	}
+	return &RawTLV{T: t, Value: append([]byte(nil), value...)}, nil
+}
+
+// ParseTLVs parses the TLVs of the SRH (see ParseTLVs).
+func (s *SRH) ParseTLVs() ([]TLV, error) {
+	return ParseTLVs(s.TLVs)
+}
+
+// SetTLVs sets the TLVs of the SRH, padded to a multiple of 8 bytes (see MarshalTLVs).
+func (s *SRH) SetTLVs(tlvs ...TLV) error {
+	b, err := MarshalTLVs(tlvs...)
+	if err != nil {
+		return err
+	}
+	s.TLVs = b
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package srh
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// testVendorTLV carries a 32 bits identifier.
+type testVendorTLV struct {
+	id uint32
+}
+
+func (v *testVendorTLV) Type() uint8 {
+	return 0x80
+}
+
+func (v *testVendorTLV) MarshalLen() int {
+	return tlvHeaderLen + 4
+}
+
+func (v *testVendorTLV) MarshalTo(b []byte) error {
+	if len(b) < v.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	b[0] = v.Type()
+	b[1] = 4
+	binary.BigEndian.PutUint32(b[2:6], v.id)
+	return nil
+}
+
+func TestTLVs(t *testing.T) {
+	RegisterTLV(0x80, func(value []byte) (TLV, error) {
+		if len(value) != 4 {
+			return nil, ErrMalformedHeader
+		}
+		return &testVendorTLV{id: binary.BigEndian.Uint32(value)}, nil
+	})
+	s := NewSRH(4, [][16]byte{netip.MustParseAddr("fd00:1:1::1").As16()})
+	if err := s.SetTLVs(&testVendorTLV{id: 0xCAFE}, &RawTLV{T: 0x81, Value: []byte{1, 2, 3}}); err != nil {
+		t.Fatal(err)
+	}
+	res := []byte{
+		0x80, 0x04, 0x00, 0x00, 0xca, 0xfe,
+		0x81, 0x03, 0x01, 0x02, 0x03,
+		0x04, 0x03, 0x00, 0x00, 0x00,
+	}
+	if diff := cmp.Diff(s.TLVs, res); diff != "" {
+		t.Error(diff)
+	}
+	b, err := s.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tlvs, err := p.ParseTLVs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []TLV{
+		&testVendorTLV{id: 0xCAFE},
+		&RawTLV{T: 0x81, Value: []byte{1, 2, 3}},
+		PadN{Len: 3},
+	}
+	if diff := cmp.Diff(tlvs, expected, cmp.AllowUnexported(testVendorTLV{})); diff != "" {
+		t.Error(diff)
+	}
+
+	if tlvs, err := ParseTLVs([]byte{0x00, 0x04, 0x00}); err != nil || len(tlvs) != 2 {
+		t.Errorf("Unexpected padding: %v %v", tlvs, err)
+	}
+	if _, err := ParseTLVs([]byte{0x81, 0x03, 0x00}); err != ErrTooShortToParse {
+		t.Errorf("Truncated TLV should be rejected: %v", err)
+	}
+}
@@ -0,0 +1,9 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package trace provides a flight recorder keeping the metadata and decisions
+// of the most recent packets processed by each behavior, so transient forwarding
+// issues can be diagnosed after the fact without always-on capture.
+package trace
@@ -0,0 +1,135 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package trace
+
+import (
+	"net/netip"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Decision is the outcome of the processing of a packet.
+type Decision uint8
+
+const (
+	DecisionForward Decision = iota
+	DecisionDrop
+)
+
+// String returns the name of the Decision.
+func (d Decision) String() string {
+	switch d {
+	case DecisionForward:
+		return "forward"
+	case DecisionDrop:
+		return "drop"
+	}
+	return "unknown"
+}
+
+// Record contains the metadata of a packet and the decision taken.
+type Record struct {
+	Time     time.Time
+	Src      netip.Addr // source address of the received packet
+	Dst      netip.Addr // destination address of the received packet
+	Len      int        // length of the received packet
+	Decision Decision
+	Reason   string // reason of the decision, if any
+}
+
+// Ring is a bounded buffer keeping the most recent Records.
+// Ring is safe for concurrent use.
+type Ring struct {
+	mu      sync.Mutex
+	records []Record
+	next    int
+	full    bool
+}
+
+// NewRing creates a Ring keeping up to size Records.
+func NewRing(size int) *Ring {
+	return &Ring{
+		records: make([]Record, max(size, 1)),
+	}
+}
+
+// Add adds a Record, overwriting the oldest one if the Ring is full.
+func (r *Ring) Add(rec Record) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records[r.next] = rec
+	r.next++
+	if r.next == len(r.records) {
+		r.next = 0
+		r.full = true
+	}
+}
+
+// Snapshot returns a copy of the Records, from the oldest to the most recent.
+func (r *Ring) Snapshot() []Record {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.full {
+		return slices.Clone(r.records[:r.next])
+	}
+	return append(slices.Clone(r.records[r.next:]), r.records[:r.next]...)
+}
+
+// Recorder keeps a Ring for each behavior.
+// Recorder is safe for concurrent use.
+type Recorder struct {
+	mu    sync.RWMutex
+	size  int
+	rings map[string]*Ring
+}
+
+// NewRecorder creates a Recorder keeping up to size Records for each behavior.
+func NewRecorder(size int) *Recorder {
+	return &Recorder{
+		size:  size,
+		rings: make(map[string]*Ring),
+	}
+}
+
+// Record adds a Record for the behavior.
+func (r *Recorder) Record(behavior string, rec Record) {
+	r.mu.RLock()
+	ring, ok := r.rings[behavior]
+	r.mu.RUnlock()
+	if !ok {
+		r.mu.Lock()
+		if ring, ok = r.rings[behavior]; !ok {
+			ring = NewRing(r.size)
+			r.rings[behavior] = ring
+		}
+		r.mu.Unlock()
+	}
+	ring.Add(rec)
+}
+
+// Snapshot returns a copy of the Records of the behavior, from the oldest to the most recent.
+func (r *Recorder) Snapshot(behavior string) []Record {
+	r.mu.RLock()
+	ring, ok := r.rings[behavior]
+	r.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+	return ring.Snapshot()
+}
+
+// Behaviors returns the sorted list of behaviors having Records.
+func (r *Recorder) Behaviors() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	behaviors := make([]string, 0, len(r.rings))
+	for b := range r.rings {
+		behaviors = append(behaviors, b)
+	}
+	slices.Sort(behaviors)
+	return behaviors
+}
@@ -0,0 +1,45 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package trace
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRing(t *testing.T) {
+	r := NewRing(3)
+	if s := r.Snapshot(); len(s) != 0 {
+		t.Errorf("Unexpected records: %v", s)
+	}
+	for i := 1; i <= 5; i++ {
+		r.Add(Record{Len: i})
+	}
+	lens := []int{}
+	for _, rec := range r.Snapshot() {
+		lens = append(lens, rec.Len)
+	}
+	if diff := cmp.Diff(lens, []int{3, 4, 5}); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	r := NewRecorder(2)
+	r.Record("End.M.GTP4.E", Record{Len: 1})
+	r.Record("H.M.GTP4.D", Record{Len: 2, Decision: DecisionDrop, Reason: "unknown TEID"})
+	if diff := cmp.Diff(r.Behaviors(), []string{"End.M.GTP4.E", "H.M.GTP4.D"}); diff != "" {
+		t.Error(diff)
+	}
+	s := r.Snapshot("H.M.GTP4.D")
+	if len(s) != 1 || s[0].Decision != DecisionDrop || s[0].Decision.String() != "drop" {
+		t.Errorf("Unexpected records: %v", s)
+	}
+	if s := r.Snapshot("End.MAP"); s != nil {
+		t.Errorf("Unexpected records: %v", s)
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package tunnel
+
+import (
+	"strconv"
+
+	"github.com/nextmn/rfc9433/session"
+)
+
+// Addr is the address of a GTP-U session, used with a Conn.
+type Addr session.Key
+
+// Network returns "srv6".
+func (a Addr) Network() string {
+	return "srv6"
+}
+
+// String returns the address of the peer and the TEID, separated by a slash.
+func (a Addr) String() string {
+	return a.Peer.String() + "/" + strconv.FormatUint(uint64(a.TEID), 10)
+}
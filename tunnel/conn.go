@@ -0,0 +1,233 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package tunnel
+
+import (
+	"net"
+	"net/netip"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/nextmn/rfc9433/behavior"
+	"github.com/nextmn/rfc9433/datapath"
+	"github.com/nextmn/rfc9433/session"
+)
+
+// defaultBufferSize is large enough for any IPv6 packet without jumbogram.
+const defaultBufferSize = 0xFFFF + 40
+
+// Config configures a Conn.
+type Config struct {
+	Source     netip.Addr     // IPv6 SA of the SRv6 packets
+	Outbound   *session.Table // sessions of WriteTo: packets are sent through the segments and the SID of the session
+	Inbound    *session.Table // sessions of ReadFrom, whose SID is the IPv6 DA of the SRv6 packets; nil means Outbound
+	Reduced    bool           // omit the first segment from the SRH (H.Encaps.Red)
+	HopLimit   uint8          // 0 means behavior.DefaultHopLimit
+	BufferSize int            // size of the buffers receiving packets; 0 means large enough for any IPv6 packet
+}
+
+// Conn is a net.PacketConn whose addresses are GTP-U sessions (Addr), and whose packets are the IPv4 or IPv6 packets
+// carried by these sessions (T-PDUs). Packets written to a session are encapsulated in SRv6 (H.Encaps),
+// and SRv6 packets whose IPv6 DA is the SID of a session are decapsulated; other packets are discarded.
+type Conn struct {
+	dev           datapath.Device
+	cfg           Config
+	source        [16]byte
+	packets       chan []byte
+	readErr       error
+	readDone      chan struct{}
+	closed        chan struct{}
+	closeOnce     sync.Once
+	writing       sync.RWMutex // read-locked while a packet is written to the device
+	readDeadline  *deadline
+	writeDeadline *deadline
+}
+
+// NewConn creates a Conn sending and receiving SRv6 packets with dev.
+// dev is closed when the Conn is closed.
+func NewConn(dev datapath.Device, cfg Config) (*Conn, error) {
+	if !cfg.Source.Is6() || cfg.Source.Is4In6() {
+		return nil, ErrInvalidSource
+	}
+	if cfg.Outbound == nil {
+		return nil, ErrInvalidSessions
+	}
+	if cfg.Inbound == nil {
+		cfg.Inbound = cfg.Outbound
+	}
+	if cfg.BufferSize == 0 {
+		cfg.BufferSize = defaultBufferSize
+	}
+	if cfg.BufferSize < 0 {
+		return nil, ErrInvalidBufferSize
+	}
+	c := &Conn{
+		dev:           dev,
+		cfg:           cfg,
+		source:        cfg.Source.As16(),
+		packets:       make(chan []byte),
+		readDone:      make(chan struct{}),
+		closed:        make(chan struct{}),
+		readDeadline:  newDeadline(),
+		writeDeadline: newDeadline(),
+	}
+	go c.read()
+	return c, nil
+}
+
+// read reads packets from the device until it fails.
+func (c *Conn) read() {
+	defer close(c.readDone)
+	for {
+		buf := make([]byte, c.cfg.BufferSize)
+		n, err := c.dev.ReadPacket(buf)
+		if err != nil {
+			c.readErr = err
+			return
+		}
+		select {
+		case c.packets <- buf[:n]:
+		case <-c.closed:
+			return
+		}
+	}
+}
+
+// ReadFrom reads a packet carried by a session, and returns the Addr of the session.
+func (c *Conn) ReadFrom(p []byte) (int, net.Addr, error) {
+	for {
+		select {
+		case <-c.closed:
+			return 0, nil, net.ErrClosed
+		case <-c.readDeadline.wait():
+			return 0, nil, os.ErrDeadlineExceeded
+		default:
+		}
+		select {
+		case pkt := <-c.packets:
+			addr, inner, ok := c.decapsulate(pkt)
+			if !ok {
+				continue
+			}
+			return copy(p, inner), addr, nil
+		case <-c.readDone:
+			if isClosed(c.closed) {
+				return 0, nil, net.ErrClosed
+			}
+			return 0, nil, c.readErr
+		case <-c.closed:
+		case <-c.readDeadline.wait():
+		}
+	}
+}
+
+// decapsulate returns the session and the inner packet of an SRv6 packet.
+func (c *Conn) decapsulate(pkt []byte) (Addr, []byte, bool) {
+	_, dst, inner, err := behavior.Decapsulate(pkt)
+	if err != nil {
+		return Addr{}, nil, false
+	}
+	k, s, ok := c.cfg.Inbound.LookupSID(netip.AddrFrom16(dst))
+	if !ok {
+		return Addr{}, nil, false
+	}
+	c.cfg.Inbound.Count(k, s.QoSFlow(), len(inner))
+	return Addr(k), inner, true
+}
+
+// WriteTo writes an IPv4 or IPv6 packet to the session addr, which is an Addr.
+func (c *Conn) WriteTo(p []byte, addr net.Addr) (int, error) {
+	c.writing.RLock()
+	defer c.writing.RUnlock()
+	select {
+	case <-c.closed:
+		return 0, net.ErrClosed
+	case <-c.writeDeadline.wait():
+		return 0, os.ErrDeadlineExceeded
+	default:
+	}
+	var k session.Key
+	switch a := addr.(type) {
+	case Addr:
+		k = session.Key(a)
+	case *Addr:
+		if a == nil {
+			return 0, ErrInvalidAddr
+		}
+		k = session.Key(*a)
+	default:
+		return 0, ErrInvalidAddr
+	}
+	s, ok := c.cfg.Outbound.Lookup(k)
+	if !ok || !s.SID.IsValid() {
+		return 0, ErrNoSession
+	}
+	path := append(s.Segments[:len(s.Segments):len(s.Segments)], s.SID.As16())
+	out, err := behavior.Encapsulate(path, c.cfg.Reduced, c.cfg.HopLimit, c.source, trafficClass(p), p)
+	if err != nil {
+		c.cfg.Outbound.Drop(k, s.QoSFlow())
+		return 0, err
+	}
+	if err := c.dev.WritePacket(out); err != nil {
+		c.cfg.Outbound.Drop(k, s.QoSFlow())
+		return 0, err
+	}
+	c.cfg.Outbound.Count(k, s.QoSFlow(), len(p))
+	return len(p), nil
+}
+
+// trafficClass returns the TOS of an IPv4 packet, or the Traffic Class of an IPv6 packet.
+func trafficClass(pkt []byte) uint8 {
+	if len(pkt) < 2 {
+		return 0
+	}
+	if pkt[0]>>4 == 6 {
+		return pkt[0]<<4 | pkt[1]>>4
+	}
+	return pkt[1]
+}
+
+// Close closes the Conn and its device.
+// Packets being written are sent before the device is closed,
+// and Close returns once the goroutine reading the device is stopped.
+// Packets read from the device and not yet returned by ReadFrom are dropped.
+func (c *Conn) Close() error {
+	err := net.ErrClosed
+	c.closeOnce.Do(func() {
+		close(c.closed)
+		c.writing.Lock()
+		err = c.dev.Close()
+		c.writing.Unlock()
+		<-c.readDone
+	})
+	return err
+}
+
+// LocalAddr returns the IPv6 SA of the SRv6 packets.
+func (c *Conn) LocalAddr() net.Addr {
+	return &net.IPAddr{IP: c.cfg.Source.AsSlice()}
+}
+
+// SetDeadline implements net.PacketConn.
+func (c *Conn) SetDeadline(t time.Time) error {
+	c.readDeadline.set(t)
+	c.writeDeadline.set(t)
+	return nil
+}
+
+// SetReadDeadline implements net.PacketConn.
+func (c *Conn) SetReadDeadline(t time.Time) error {
+	c.readDeadline.set(t)
+	return nil
+}
+
+// SetWriteDeadline implements net.PacketConn.
+// The deadline is only checked before writing to the device.
+func (c *Conn) SetWriteDeadline(t time.Time) error {
+	c.writeDeadline.set(t)
+	return nil
+}
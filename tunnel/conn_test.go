@@ -0,0 +1,233 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package tunnel
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/netip"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nextmn/rfc9433/behavior"
+	"github.com/nextmn/rfc9433/session"
+)
+
+// fakeDevice returns the packets of in, and sends the written packets to out.
+type fakeDevice struct {
+	in     chan []byte
+	out    chan []byte
+	closed chan struct{}
+	once   sync.Once
+}
+
+func newFakeDevice() *fakeDevice {
+	return &fakeDevice{
+		in:     make(chan []byte, 16),
+		out:    make(chan []byte, 16),
+		closed: make(chan struct{}),
+	}
+}
+
+func (d *fakeDevice) ReadPacket(b []byte) (int, error) {
+	select {
+	case pkt := <-d.in:
+		return copy(b, pkt), nil
+	case <-d.closed:
+		return 0, io.EOF
+	}
+}
+
+func (d *fakeDevice) WritePacket(pkt []byte) error {
+	d.out <- append([]byte(nil), pkt...)
+	return nil
+}
+
+func (d *fakeDevice) Close() error {
+	d.once.Do(func() { close(d.closed) })
+	return nil
+}
+
+var (
+	source  = netip.MustParseAddr("2001:db8:a::1")
+	peer    = netip.MustParseAddr("2001:db8:b::1")
+	transit = [16]byte{0x20, 0x01, 0x0d, 0xb8, 0xff, 15: 1}
+	outSID  = netip.MustParseAddr("2001:db8:b::100")
+	inSID   = netip.MustParseAddr("2001:db8:a::100")
+	inner   = []byte{0x45, 0xb8, 0x00, 0x14, 0, 0, 0, 0, 64, 17, 0, 0, 10, 45, 0, 1, 10, 45, 0, 2}
+	key     = session.Key{Peer: netip.MustParseAddr("10.0.0.2"), TEID: 1}
+)
+
+func newTestConn(t *testing.T) (*Conn, *fakeDevice) {
+	t.Helper()
+	outbound := session.NewTable()
+	if err := outbound.Add(key, session.Session{SID: outSID, Segments: [][16]byte{transit}}); err != nil {
+		t.Fatal(err)
+	}
+	inbound := session.NewTable()
+	if err := inbound.Add(key, session.Session{SID: inSID}); err != nil {
+		t.Fatal(err)
+	}
+	dev := newFakeDevice()
+	c, err := NewConn(dev, Config{Source: source, Outbound: outbound, Inbound: inbound})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c, dev
+}
+
+func TestConn(t *testing.T) {
+	c, dev := newTestConn(t)
+	defer c.Close()
+	var _ net.PacketConn = c
+
+	// WriteTo
+	n, err := c.WriteTo(inner, Addr(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != len(inner) {
+		t.Errorf("Unexpected length: %d", n)
+	}
+	want, err := behavior.Encapsulate([][16]byte{transit, outSID.As16()}, false, 0, source.As16(), 0xb8, inner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(<-dev.out, want); diff != "" {
+		t.Error(diff)
+	}
+
+	// ReadFrom, the first packet is not for a session
+	other, err := behavior.Encapsulate([][16]byte{outSID.As16()}, true, 0, peer.As16(), 0, inner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkt, err := behavior.Encapsulate([][16]byte{inSID.As16()}, true, 0, peer.As16(), 0, inner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dev.in <- other
+	dev.in <- pkt
+	buf := make([]byte, 1500)
+	n, addr, err := c.ReadFrom(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(buf[:n], inner); diff != "" {
+		t.Error(diff)
+	}
+	if addr != Addr(key) || addr.String() != "10.0.0.2/1" {
+		t.Errorf("Unexpected address: %v", addr)
+	}
+
+	// Stats
+	want1 := map[session.QoSFlow]session.Stats{{}: {Packets: 1, Bytes: uint64(len(inner))}}
+	if stats, _ := c.cfg.Outbound.Stats(key); !cmp.Equal(stats, want1) {
+		t.Errorf("Unexpected outbound stats: %v", stats)
+	}
+	if stats, _ := c.cfg.Inbound.Stats(key); !cmp.Equal(stats, want1) {
+		t.Errorf("Unexpected inbound stats: %v", stats)
+	}
+}
+
+func TestConnErrors(t *testing.T) {
+	if _, err := NewConn(newFakeDevice(), Config{Source: netip.MustParseAddr("10.0.0.1"), Outbound: session.NewTable()}); !errors.Is(err, ErrInvalidSource) {
+		t.Errorf("IPv4 source should be rejected: %v", err)
+	}
+	if _, err := NewConn(newFakeDevice(), Config{Source: source}); !errors.Is(err, ErrInvalidSessions) {
+		t.Errorf("Missing session table should be rejected: %v", err)
+	}
+
+	c, _ := newTestConn(t)
+	if _, err := c.WriteTo(inner, &net.UDPAddr{}); !errors.Is(err, ErrInvalidAddr) {
+		t.Errorf("Invalid address should be rejected: %v", err)
+	}
+	if _, err := c.WriteTo(inner, &Addr{Peer: key.Peer, TEID: 2}); !errors.Is(err, ErrNoSession) {
+		t.Errorf("Unknown session should be rejected: %v", err)
+	}
+
+	c.SetReadDeadline(time.Now().Add(10 * time.Millisecond))
+	if _, _, err := c.ReadFrom(make([]byte, 1500)); !errors.Is(err, os.ErrDeadlineExceeded) {
+		t.Errorf("Read deadline should be exceeded: %v", err)
+	}
+	c.SetDeadline(time.Now().Add(-time.Second))
+	if _, err := c.WriteTo(inner, Addr(key)); !errors.Is(err, os.ErrDeadlineExceeded) {
+		t.Errorf("Write deadline should be exceeded: %v", err)
+	}
+	c.SetDeadline(time.Time{})
+
+	done := make(chan error)
+	go func() {
+		_, _, err := c.ReadFrom(make([]byte, 1500))
+		done <- err
+	}()
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; !errors.Is(err, net.ErrClosed) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if _, err := c.WriteTo(inner, Addr(key)); !errors.Is(err, net.ErrClosed) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := c.Close(); !errors.Is(err, net.ErrClosed) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+// blockingDevice is a fakeDevice whose WritePacket blocks until release is closed.
+type blockingDevice struct {
+	*fakeDevice
+	writing chan struct{}
+	release chan struct{}
+}
+
+func (d *blockingDevice) WritePacket(pkt []byte) error {
+	close(d.writing)
+	<-d.release
+	return d.fakeDevice.WritePacket(pkt)
+}
+
+func TestConnCloseDrain(t *testing.T) {
+	dev := &blockingDevice{fakeDevice: newFakeDevice(), writing: make(chan struct{}), release: make(chan struct{})}
+	outbound := session.NewTable()
+	if err := outbound.Add(key, session.Session{SID: outSID}); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewConn(dev, Config{Source: source, Outbound: outbound})
+	if err != nil {
+		t.Fatal(err)
+	}
+	written := make(chan error)
+	go func() {
+		_, err := c.WriteTo(inner, Addr(key))
+		written <- err
+	}()
+	<-dev.writing
+	closed := make(chan error)
+	go func() {
+		closed <- c.Close()
+	}()
+	select {
+	case err := <-closed:
+		t.Fatalf("Close should wait for the packet being written: %v", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+	close(dev.release)
+	if err := <-written; err != nil {
+		t.Errorf("Packet being written should be sent: %v", err)
+	}
+	if err := <-closed; err != nil {
+		t.Fatal(err)
+	}
+	if len(dev.out) != 1 {
+		t.Errorf("Unexpected number of packets: %d", len(dev.out))
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package tunnel
+
+import (
+	"sync"
+	"time"
+)
+
+// deadline is a channel closed when a deadline is exceeded.
+type deadline struct {
+	mu       sync.Mutex
+	timer    *time.Timer
+	exceeded chan struct{}
+}
+
+func newDeadline() *deadline {
+	return &deadline{
+		exceeded: make(chan struct{}),
+	}
+}
+
+// set sets the deadline. A zero value means no deadline.
+func (d *deadline) set(t time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.timer != nil && !d.timer.Stop() {
+		<-d.exceeded // the timer is closing the channel
+	}
+	d.timer = nil
+	closed := isClosed(d.exceeded)
+	if t.IsZero() {
+		if closed {
+			d.exceeded = make(chan struct{})
+		}
+		return
+	}
+	if dur := time.Until(t); dur > 0 {
+		if closed {
+			d.exceeded = make(chan struct{})
+		}
+		exceeded := d.exceeded
+		d.timer = time.AfterFunc(dur, func() {
+			close(exceeded)
+		})
+		return
+	}
+	if !closed {
+		close(d.exceeded)
+	}
+}
+
+// wait returns a channel closed when the deadline is exceeded.
+func (d *deadline) wait() <-chan struct{} {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.exceeded
+}
+
+// isClosed returns true if c is closed.
+func isClosed(c <-chan struct{}) bool {
+	select {
+	case <-c:
+		return true
+	default:
+		return false
+	}
+}
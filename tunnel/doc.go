@@ -0,0 +1,9 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+// Package tunnel provides a net.PacketConn tunneling the packets of GTP-U sessions through an SR domain:
+// packets are encapsulated in SRv6 on write and decapsulated on read,
+// using a session.Table as session context.
+package tunnel
@@ -0,0 +1,16 @@
+// Copyright 2026 Louis Royer and the NextMN contributors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+// SPDX-License-Identifier: MIT
+
+package tunnel
+
+import "errors"
+
+var (
+	ErrInvalidAddr       = errors.New("invalid address")
+	ErrNoSession         = errors.New("no session for this address")
+	ErrInvalidSource     = errors.New("source is not an IPv6 address")
+	ErrInvalidSessions   = errors.New("missing session table")
+	ErrInvalidBufferSize = errors.New("invalid buffer size")
+)